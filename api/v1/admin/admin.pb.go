@@ -10,6 +10,7 @@ import (
 	shared "github.com/studyguides-com/study-guides-api/api/v1/shared"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -175,10 +176,11 @@ func (x *NewTagAdminResponse) GetTag() *shared.Tag {
 }
 
 type KillUserAdminRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Email             string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	ConfirmationToken string                 `protobuf:"bytes,2,opt,name=confirmation_token,json=confirmationToken,proto3" json:"confirmation_token,omitempty"` // from PreviewKillUser
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *KillUserAdminRequest) Reset() {
@@ -218,6 +220,13 @@ func (x *KillUserAdminRequest) GetEmail() string {
 	return ""
 }
 
+func (x *KillUserAdminRequest) GetConfirmationToken() string {
+	if x != nil {
+		return x.ConfirmationToken
+	}
+	return ""
+}
+
 type KillUserAdminResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Ok            bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
@@ -263,10 +272,12 @@ func (x *KillUserAdminResponse) GetOk() bool {
 }
 
 type KillTreeAdminRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RetentionDays     int32                  `protobuf:"varint,2,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"`            // days to keep the tree in trash, defaults to 30
+	ConfirmationToken string                 `protobuf:"bytes,3,opt,name=confirmation_token,json=confirmationToken,proto3" json:"confirmation_token,omitempty"` // from PreviewKillTree
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *KillTreeAdminRequest) Reset() {
@@ -306,9 +317,25 @@ func (x *KillTreeAdminRequest) GetId() string {
 	return ""
 }
 
+func (x *KillTreeAdminRequest) GetRetentionDays() int32 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
+func (x *KillTreeAdminRequest) GetConfirmationToken() string {
+	if x != nil {
+		return x.ConfirmationToken
+	}
+	return ""
+}
+
 type KillTreeAdminResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DeletedIds    []string               `protobuf:"bytes,1,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	TrashId       string                 `protobuf:"bytes,2,opt,name=trash_id,json=trashId,proto3" json:"trash_id,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -350,97 +377,2250 @@ func (x *KillTreeAdminResponse) GetDeletedIds() []string {
 	return nil
 }
 
-var File_v1_admin_admin_proto protoreflect.FileDescriptor
+func (x *KillTreeAdminResponse) GetTrashId() string {
+	if x != nil {
+		return x.TrashId
+	}
+	return ""
+}
 
-const file_v1_admin_admin_proto_rawDesc = "" +
-	"\n" +
-	"\x14v1/admin/admin.proto\x12\badmin.v1\x1a\x17v1/shared/tagtype.proto\x1a\x1dv1/shared/contentrating.proto\x1a%v1/shared/contentdescriptortype.proto\x1a\x1av1/shared/parsertype.proto\x1a\x13v1/shared/tag.proto\"\xd1\x03\n" +
-	"\x12NewTagAdminRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
-	"\x04hash\x18\x02 \x01(\tR\x04hash\x12&\n" +
-	"\x04type\x18\x03 \x01(\x0e2\x12.shared.v1.TagTypeR\x04type\x12\x1b\n" +
-	"\tparent_id\x18\x04 \x01(\tR\bparentId\x120\n" +
-	"\x06rating\x18\x05 \x01(\x0e2\x18.shared.v1.ContentRatingR\x06rating\x12B\n" +
-	"\vdescriptors\x18\x06 \x03(\x0e2 .shared.v1.ContentDescriptorTypeR\vdescriptors\x12\x1b\n" +
-	"\tmeta_tags\x18\a \x03(\tR\bmetaTags\x126\n" +
-	"\vparser_type\x18\b \x01(\x0e2\x15.shared.v1.ParserTypeR\n" +
-	"parserType\x12F\n" +
-	"\bmetadata\x18\t \x03(\v2*.admin.v1.NewTagAdminRequest.MetadataEntryR\bmetadata\x1a;\n" +
-	"\rMetadataEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"7\n" +
-	"\x13NewTagAdminResponse\x12 \n" +
-	"\x03tag\x18\x01 \x01(\v2\x0e.shared.v1.TagR\x03tag\",\n" +
-	"\x14KillUserAdminRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\"'\n" +
-	"\x15KillUserAdminResponse\x12\x0e\n" +
-	"\x02ok\x18\x01 \x01(\bR\x02ok\"&\n" +
-	"\x14KillTreeAdminRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"8\n" +
-	"\x15KillTreeAdminResponse\x12\x1f\n" +
-	"\vdeleted_ids\x18\x01 \x03(\tR\n" +
-	"deletedIds2\xac\x01\n" +
-	"\fAdminService\x12M\n" +
-	"\bKillUser\x12\x1e.admin.v1.KillUserAdminRequest\x1a\x1f.admin.v1.KillUserAdminResponse\"\x00\x12M\n" +
-	"\bKillTree\x12\x1e.admin.v1.KillTreeAdminRequest\x1a\x1f.admin.v1.KillTreeAdminResponse\"\x00BBZ@github.com/studyguides-com/study-guides-api/api/v1/admin;adminv1b\x06proto3"
+func (x *KillTreeAdminResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
 
-var (
-	file_v1_admin_admin_proto_rawDescOnce sync.Once
-	file_v1_admin_admin_proto_rawDescData []byte
-)
+type ImpactCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Table         string                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	Detail        string                 `protobuf:"bytes,2,opt,name=detail,proto3" json:"detail,omitempty"` // e.g. the tag type for Tag rows
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-func file_v1_admin_admin_proto_rawDescGZIP() []byte {
-	file_v1_admin_admin_proto_rawDescOnce.Do(func() {
-		file_v1_admin_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_admin_admin_proto_rawDesc), len(file_v1_admin_admin_proto_rawDesc)))
-	})
-	return file_v1_admin_admin_proto_rawDescData
+func (x *ImpactCount) Reset() {
+	*x = ImpactCount{}
+	mi := &file_v1_admin_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-var file_v1_admin_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
-var file_v1_admin_admin_proto_goTypes = []any{
-	(*NewTagAdminRequest)(nil),        // 0: admin.v1.NewTagAdminRequest
-	(*NewTagAdminResponse)(nil),       // 1: admin.v1.NewTagAdminResponse
-	(*KillUserAdminRequest)(nil),      // 2: admin.v1.KillUserAdminRequest
-	(*KillUserAdminResponse)(nil),     // 3: admin.v1.KillUserAdminResponse
-	(*KillTreeAdminRequest)(nil),      // 4: admin.v1.KillTreeAdminRequest
-	(*KillTreeAdminResponse)(nil),     // 5: admin.v1.KillTreeAdminResponse
-	nil,                               // 6: admin.v1.NewTagAdminRequest.MetadataEntry
-	(shared.TagType)(0),               // 7: shared.v1.TagType
-	(shared.ContentRating)(0),         // 8: shared.v1.ContentRating
-	(shared.ContentDescriptorType)(0), // 9: shared.v1.ContentDescriptorType
-	(shared.ParserType)(0),            // 10: shared.v1.ParserType
-	(*shared.Tag)(nil),                // 11: shared.v1.Tag
+func (x *ImpactCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-var file_v1_admin_admin_proto_depIdxs = []int32{
-	7,  // 0: admin.v1.NewTagAdminRequest.type:type_name -> shared.v1.TagType
-	8,  // 1: admin.v1.NewTagAdminRequest.rating:type_name -> shared.v1.ContentRating
-	9,  // 2: admin.v1.NewTagAdminRequest.descriptors:type_name -> shared.v1.ContentDescriptorType
-	10, // 3: admin.v1.NewTagAdminRequest.parser_type:type_name -> shared.v1.ParserType
-	6,  // 4: admin.v1.NewTagAdminRequest.metadata:type_name -> admin.v1.NewTagAdminRequest.MetadataEntry
-	11, // 5: admin.v1.NewTagAdminResponse.tag:type_name -> shared.v1.Tag
-	2,  // 6: admin.v1.AdminService.KillUser:input_type -> admin.v1.KillUserAdminRequest
-	4,  // 7: admin.v1.AdminService.KillTree:input_type -> admin.v1.KillTreeAdminRequest
-	3,  // 8: admin.v1.AdminService.KillUser:output_type -> admin.v1.KillUserAdminResponse
-	5,  // 9: admin.v1.AdminService.KillTree:output_type -> admin.v1.KillTreeAdminResponse
-	8,  // [8:10] is the sub-list for method output_type
-	6,  // [6:8] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+
+func (*ImpactCount) ProtoMessage() {}
+
+func (x *ImpactCount) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func init() { file_v1_admin_admin_proto_init() }
-func file_v1_admin_admin_proto_init() {
-	if File_v1_admin_admin_proto != nil {
-		return
+// Deprecated: Use ImpactCount.ProtoReflect.Descriptor instead.
+func (*ImpactCount) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ImpactCount) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *ImpactCount) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *ImpactCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type KillImpactReport struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Counts            []*ImpactCount         `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"`
+	TopLevelNames     []string               `protobuf:"bytes,2,rep,name=top_level_names,json=topLevelNames,proto3" json:"top_level_names,omitempty"`
+	ConfirmationToken string                 `protobuf:"bytes,3,opt,name=confirmation_token,json=confirmationToken,proto3" json:"confirmation_token,omitempty"`
+	TokenExpiresAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=token_expires_at,json=tokenExpiresAt,proto3" json:"token_expires_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *KillImpactReport) Reset() {
+	*x = KillImpactReport{}
+	mi := &file_v1_admin_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KillImpactReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillImpactReport) ProtoMessage() {}
+
+func (x *KillImpactReport) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillImpactReport.ProtoReflect.Descriptor instead.
+func (*KillImpactReport) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *KillImpactReport) GetCounts() []*ImpactCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *KillImpactReport) GetTopLevelNames() []string {
+	if x != nil {
+		return x.TopLevelNames
+	}
+	return nil
+}
+
+func (x *KillImpactReport) GetConfirmationToken() string {
+	if x != nil {
+		return x.ConfirmationToken
+	}
+	return ""
+}
+
+func (x *KillImpactReport) GetTokenExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TokenExpiresAt
+	}
+	return nil
+}
+
+type PreviewKillTreeAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewKillTreeAdminRequest) Reset() {
+	*x = PreviewKillTreeAdminRequest{}
+	mi := &file_v1_admin_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewKillTreeAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewKillTreeAdminRequest) ProtoMessage() {}
+
+func (x *PreviewKillTreeAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewKillTreeAdminRequest.ProtoReflect.Descriptor instead.
+func (*PreviewKillTreeAdminRequest) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PreviewKillTreeAdminRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PreviewKillTreeAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *KillImpactReport      `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewKillTreeAdminResponse) Reset() {
+	*x = PreviewKillTreeAdminResponse{}
+	mi := &file_v1_admin_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewKillTreeAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewKillTreeAdminResponse) ProtoMessage() {}
+
+func (x *PreviewKillTreeAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewKillTreeAdminResponse.ProtoReflect.Descriptor instead.
+func (*PreviewKillTreeAdminResponse) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PreviewKillTreeAdminResponse) GetReport() *KillImpactReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type PreviewKillUserAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewKillUserAdminRequest) Reset() {
+	*x = PreviewKillUserAdminRequest{}
+	mi := &file_v1_admin_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewKillUserAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewKillUserAdminRequest) ProtoMessage() {}
+
+func (x *PreviewKillUserAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewKillUserAdminRequest.ProtoReflect.Descriptor instead.
+func (*PreviewKillUserAdminRequest) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PreviewKillUserAdminRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type PreviewKillUserAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *KillImpactReport      `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewKillUserAdminResponse) Reset() {
+	*x = PreviewKillUserAdminResponse{}
+	mi := &file_v1_admin_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewKillUserAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewKillUserAdminResponse) ProtoMessage() {}
+
+func (x *PreviewKillUserAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewKillUserAdminResponse.ProtoReflect.Descriptor instead.
+func (*PreviewKillUserAdminResponse) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PreviewKillUserAdminResponse) GetReport() *KillImpactReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type ImportCounts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Created       int32                  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                  `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	Unchanged     int32                  `protobuf:"varint,3,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCounts) Reset() {
+	*x = ImportCounts{}
+	mi := &file_v1_admin_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCounts) ProtoMessage() {}
+
+func (x *ImportCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCounts.ProtoReflect.Descriptor instead.
+func (*ImportCounts) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ImportCounts) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportCounts) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportCounts) GetUnchanged() int32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+type ImportGuideAdminRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportGuideAdminRequest_GobPayload
+	//	*ImportGuideAdminRequest_Guide
+	Payload       isImportGuideAdminRequest_Payload `protobuf_oneof:"payload"`
+	FileName      string                            `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"` // recorded on the ImportBatch/ImportFile, defaults to the guide title
+	DryRun        bool                              `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`      // report what would change without writing anything
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportGuideAdminRequest) Reset() {
+	*x = ImportGuideAdminRequest{}
+	mi := &file_v1_admin_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportGuideAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportGuideAdminRequest) ProtoMessage() {}
+
+func (x *ImportGuideAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportGuideAdminRequest.ProtoReflect.Descriptor instead.
+func (*ImportGuideAdminRequest) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ImportGuideAdminRequest) GetPayload() isImportGuideAdminRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportGuideAdminRequest) GetGobPayload() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportGuideAdminRequest_GobPayload); ok {
+			return x.GobPayload
+		}
+	}
+	return nil
+}
+
+func (x *ImportGuideAdminRequest) GetGuide() *shared.GuideData {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportGuideAdminRequest_Guide); ok {
+			return x.Guide
+		}
+	}
+	return nil
+}
+
+func (x *ImportGuideAdminRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ImportGuideAdminRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type isImportGuideAdminRequest_Payload interface {
+	isImportGuideAdminRequest_Payload()
+}
+
+type ImportGuideAdminRequest_GobPayload struct {
+	GobPayload []byte `protobuf:"bytes,1,opt,name=gob_payload,json=gobPayload,proto3,oneof"`
+}
+
+type ImportGuideAdminRequest_Guide struct {
+	Guide *shared.GuideData `protobuf:"bytes,2,opt,name=guide,proto3,oneof"`
+}
+
+func (*ImportGuideAdminRequest_GobPayload) isImportGuideAdminRequest_Payload() {}
+
+func (*ImportGuideAdminRequest_Guide) isImportGuideAdminRequest_Payload() {}
+
+type ImportFieldDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue      string                 `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue      string                 `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportFieldDiff) Reset() {
+	*x = ImportFieldDiff{}
+	mi := &file_v1_admin_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportFieldDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportFieldDiff) ProtoMessage() {}
+
+func (x *ImportFieldDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportFieldDiff.ProtoReflect.Descriptor instead.
+func (*ImportFieldDiff) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ImportFieldDiff) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ImportFieldDiff) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *ImportFieldDiff) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+type ImportChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjectType    string                 `protobuf:"bytes,1,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"` // Tag, Passage or Question
+	Hash          string                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Label         string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"` // create, update or unchanged
+	Diffs         []*ImportFieldDiff     `protobuf:"bytes,5,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	OtherTagIds   []string               `protobuf:"bytes,6,rep,name=other_tag_ids,json=otherTagIds,proto3" json:"other_tag_ids,omitempty"` // tags a prompt is already linked to outside its section's topic
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportChange) Reset() {
+	*x = ImportChange{}
+	mi := &file_v1_admin_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportChange) ProtoMessage() {}
+
+func (x *ImportChange) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportChange.ProtoReflect.Descriptor instead.
+func (*ImportChange) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ImportChange) GetObjectType() string {
+	if x != nil {
+		return x.ObjectType
+	}
+	return ""
+}
+
+func (x *ImportChange) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ImportChange) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ImportChange) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ImportChange) GetDiffs() []*ImportFieldDiff {
+	if x != nil {
+		return x.Diffs
+	}
+	return nil
+}
+
+func (x *ImportChange) GetOtherTagIds() []string {
+	if x != nil {
+		return x.OtherTagIds
+	}
+	return nil
+}
+
+type ImportGuideAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchId       string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	FileId        string                 `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Tags          *ImportCounts          `protobuf:"bytes,3,opt,name=tags,proto3" json:"tags,omitempty"`
+	Passages      *ImportCounts          `protobuf:"bytes,4,opt,name=passages,proto3" json:"passages,omitempty"`
+	Questions     *ImportCounts          `protobuf:"bytes,5,opt,name=questions,proto3" json:"questions,omitempty"`
+	QuestionTags  *ImportCounts          `protobuf:"bytes,6,opt,name=question_tags,json=questionTags,proto3" json:"question_tags,omitempty"`
+	Changes       []*ImportChange        `protobuf:"bytes,7,rep,name=changes,proto3" json:"changes,omitempty"` // only set for dry runs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportGuideAdminResponse) Reset() {
+	*x = ImportGuideAdminResponse{}
+	mi := &file_v1_admin_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportGuideAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportGuideAdminResponse) ProtoMessage() {}
+
+func (x *ImportGuideAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportGuideAdminResponse.ProtoReflect.Descriptor instead.
+func (*ImportGuideAdminResponse) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ImportGuideAdminResponse) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *ImportGuideAdminResponse) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *ImportGuideAdminResponse) GetTags() *ImportCounts {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ImportGuideAdminResponse) GetPassages() *ImportCounts {
+	if x != nil {
+		return x.Passages
+	}
+	return nil
+}
+
+func (x *ImportGuideAdminResponse) GetQuestions() *ImportCounts {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *ImportGuideAdminResponse) GetQuestionTags() *ImportCounts {
+	if x != nil {
+		return x.QuestionTags
+	}
+	return nil
+}
+
+func (x *ImportGuideAdminResponse) GetChanges() []*ImportChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type TrashEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RootTagId     string                 `protobuf:"bytes,2,opt,name=root_tag_id,json=rootTagId,proto3" json:"root_tag_id,omitempty"`
+	RootTagName   string                 `protobuf:"bytes,3,opt,name=root_tag_name,json=rootTagName,proto3" json:"root_tag_name,omitempty"`
+	TagCount      int32                  `protobuf:"varint,4,opt,name=tag_count,json=tagCount,proto3" json:"tag_count,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,5,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrashEntry) Reset() {
+	*x = TrashEntry{}
+	mi := &file_v1_admin_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashEntry) ProtoMessage() {}
+
+func (x *TrashEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashEntry.ProtoReflect.Descriptor instead.
+func (*TrashEntry) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TrashEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TrashEntry) GetRootTagId() string {
+	if x != nil {
+		return x.RootTagId
+	}
+	return ""
+}
+
+func (x *TrashEntry) GetRootTagName() string {
+	if x != nil {
+		return x.RootTagName
+	}
+	return ""
+}
+
+func (x *TrashEntry) GetTagCount() int32 {
+	if x != nil {
+		return x.TagCount
+	}
+	return 0
+}
+
+func (x *TrashEntry) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *TrashEntry) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *TrashEntry) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ListTrashAdminRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IncludeExpired bool                   `protobuf:"varint,1,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListTrashAdminRequest) Reset() {
+	*x = ListTrashAdminRequest{}
+	mi := &file_v1_admin_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashAdminRequest) ProtoMessage() {}
+
+func (x *ListTrashAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashAdminRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashAdminRequest) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListTrashAdminRequest) GetIncludeExpired() bool {
+	if x != nil {
+		return x.IncludeExpired
+	}
+	return false
+}
+
+type ListTrashAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*TrashEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashAdminResponse) Reset() {
+	*x = ListTrashAdminResponse{}
+	mi := &file_v1_admin_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashAdminResponse) ProtoMessage() {}
+
+func (x *ListTrashAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashAdminResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashAdminResponse) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListTrashAdminResponse) GetEntries() []*TrashEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type RestoreTreeAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TrashId       string                 `protobuf:"bytes,1,opt,name=trash_id,json=trashId,proto3" json:"trash_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTreeAdminRequest) Reset() {
+	*x = RestoreTreeAdminRequest{}
+	mi := &file_v1_admin_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTreeAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTreeAdminRequest) ProtoMessage() {}
+
+func (x *RestoreTreeAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTreeAdminRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTreeAdminRequest) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RestoreTreeAdminRequest) GetTrashId() string {
+	if x != nil {
+		return x.TrashId
+	}
+	return ""
+}
+
+type RestoreTreeAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RestoredIds   []string               `protobuf:"bytes,1,rep,name=restored_ids,json=restoredIds,proto3" json:"restored_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTreeAdminResponse) Reset() {
+	*x = RestoreTreeAdminResponse{}
+	mi := &file_v1_admin_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTreeAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTreeAdminResponse) ProtoMessage() {}
+
+func (x *RestoreTreeAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTreeAdminResponse.ProtoReflect.Descriptor instead.
+func (*RestoreTreeAdminResponse) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RestoreTreeAdminResponse) GetRestoredIds() []string {
+	if x != nil {
+		return x.RestoredIds
+	}
+	return nil
+}
+
+type PurgeTrashAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TrashId       string                 `protobuf:"bytes,1,opt,name=trash_id,json=trashId,proto3" json:"trash_id,omitempty"` // purge a single entry, otherwise all expired entries
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTrashAdminRequest) Reset() {
+	*x = PurgeTrashAdminRequest{}
+	mi := &file_v1_admin_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTrashAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTrashAdminRequest) ProtoMessage() {}
+
+func (x *PurgeTrashAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTrashAdminRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTrashAdminRequest) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PurgeTrashAdminRequest) GetTrashId() string {
+	if x != nil {
+		return x.TrashId
+	}
+	return ""
+}
+
+type PurgeTrashAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Purged        int32                  `protobuf:"varint,1,opt,name=purged,proto3" json:"purged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTrashAdminResponse) Reset() {
+	*x = PurgeTrashAdminResponse{}
+	mi := &file_v1_admin_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTrashAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTrashAdminResponse) ProtoMessage() {}
+
+func (x *PurgeTrashAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTrashAdminResponse.ProtoReflect.Descriptor instead.
+func (*PurgeTrashAdminResponse) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PurgeTrashAdminResponse) GetPurged() int32 {
+	if x != nil {
+		return x.Purged
+	}
+	return 0
+}
+
+type ContentAuditTag struct {
+	state              protoimpl.MessageState         `protogen:"open.v1"`
+	Id                 string                         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Context            *shared.ContextType            `protobuf:"varint,3,opt,name=context,proto3,enum=shared.v1.ContextType,oneof" json:"context,omitempty"`
+	ParentName         string                         `protobuf:"bytes,4,opt,name=parent_name,json=parentName,proto3" json:"parent_name,omitempty"`
+	ContentRating      shared.ContentRating           `protobuf:"varint,5,opt,name=content_rating,json=contentRating,proto3,enum=shared.v1.ContentRating" json:"content_rating,omitempty"`
+	ContentDescriptors []shared.ContentDescriptorType `protobuf:"varint,6,rep,packed,name=content_descriptors,json=contentDescriptors,proto3,enum=shared.v1.ContentDescriptorType" json:"content_descriptors,omitempty"`
+	MetaTags           []string                       `protobuf:"bytes,7,rep,name=meta_tags,json=metaTags,proto3" json:"meta_tags,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ContentAuditTag) Reset() {
+	*x = ContentAuditTag{}
+	mi := &file_v1_admin_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentAuditTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentAuditTag) ProtoMessage() {}
+
+func (x *ContentAuditTag) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentAuditTag.ProtoReflect.Descriptor instead.
+func (*ContentAuditTag) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ContentAuditTag) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContentAuditTag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContentAuditTag) GetContext() shared.ContextType {
+	if x != nil && x.Context != nil {
+		return *x.Context
+	}
+	return shared.ContextType(0)
+}
+
+func (x *ContentAuditTag) GetParentName() string {
+	if x != nil {
+		return x.ParentName
+	}
+	return ""
+}
+
+func (x *ContentAuditTag) GetContentRating() shared.ContentRating {
+	if x != nil {
+		return x.ContentRating
+	}
+	return shared.ContentRating(0)
+}
+
+func (x *ContentAuditTag) GetContentDescriptors() []shared.ContentDescriptorType {
+	if x != nil {
+		return x.ContentDescriptors
+	}
+	return nil
+}
+
+func (x *ContentAuditTag) GetMetaTags() []string {
+	if x != nil {
+		return x.MetaTags
+	}
+	return nil
+}
+
+type ListContentAuditAdminRequest struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	MissingContentRating      bool                   `protobuf:"varint,1,opt,name=missing_content_rating,json=missingContentRating,proto3" json:"missing_content_rating,omitempty"` // no missing flags means missing any of them
+	MissingContentDescriptors bool                   `protobuf:"varint,2,opt,name=missing_content_descriptors,json=missingContentDescriptors,proto3" json:"missing_content_descriptors,omitempty"`
+	MissingMetaTags           bool                   `protobuf:"varint,3,opt,name=missing_meta_tags,json=missingMetaTags,proto3" json:"missing_meta_tags,omitempty"`
+	Context                   *shared.ContextType    `protobuf:"varint,4,opt,name=context,proto3,enum=shared.v1.ContextType,oneof" json:"context,omitempty"`
+	AncestorId                string                 `protobuf:"bytes,5,opt,name=ancestor_id,json=ancestorId,proto3" json:"ancestor_id,omitempty"`
+	Limit                     int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset                    int32                  `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *ListContentAuditAdminRequest) Reset() {
+	*x = ListContentAuditAdminRequest{}
+	mi := &file_v1_admin_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContentAuditAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContentAuditAdminRequest) ProtoMessage() {}
+
+func (x *ListContentAuditAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContentAuditAdminRequest.ProtoReflect.Descriptor instead.
+func (*ListContentAuditAdminRequest) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListContentAuditAdminRequest) GetMissingContentRating() bool {
+	if x != nil {
+		return x.MissingContentRating
+	}
+	return false
+}
+
+func (x *ListContentAuditAdminRequest) GetMissingContentDescriptors() bool {
+	if x != nil {
+		return x.MissingContentDescriptors
+	}
+	return false
+}
+
+func (x *ListContentAuditAdminRequest) GetMissingMetaTags() bool {
+	if x != nil {
+		return x.MissingMetaTags
+	}
+	return false
+}
+
+func (x *ListContentAuditAdminRequest) GetContext() shared.ContextType {
+	if x != nil && x.Context != nil {
+		return *x.Context
+	}
+	return shared.ContextType(0)
+}
+
+func (x *ListContentAuditAdminRequest) GetAncestorId() string {
+	if x != nil {
+		return x.AncestorId
+	}
+	return ""
+}
+
+func (x *ListContentAuditAdminRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListContentAuditAdminRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListContentAuditAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*ContentAuditTag     `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContentAuditAdminResponse) Reset() {
+	*x = ListContentAuditAdminResponse{}
+	mi := &file_v1_admin_admin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContentAuditAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContentAuditAdminResponse) ProtoMessage() {}
+
+func (x *ListContentAuditAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContentAuditAdminResponse.ProtoReflect.Descriptor instead.
+func (*ListContentAuditAdminResponse) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListContentAuditAdminResponse) GetTags() []*ContentAuditTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListContentAuditAdminResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type BulkUpdateContentAdminRequest struct {
+	state                 protoimpl.MessageState         `protogen:"open.v1"`
+	TagIds                []string                       `protobuf:"bytes,1,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	ContentRating         *shared.ContentRating          `protobuf:"varint,2,opt,name=content_rating,json=contentRating,proto3,enum=shared.v1.ContentRating,oneof" json:"content_rating,omitempty"`
+	SetContentDescriptors bool                           `protobuf:"varint,3,opt,name=set_content_descriptors,json=setContentDescriptors,proto3" json:"set_content_descriptors,omitempty"` // replace descriptors, an empty list clears them
+	ContentDescriptors    []shared.ContentDescriptorType `protobuf:"varint,4,rep,packed,name=content_descriptors,json=contentDescriptors,proto3,enum=shared.v1.ContentDescriptorType" json:"content_descriptors,omitempty"`
+	SetMetaTags           bool                           `protobuf:"varint,5,opt,name=set_meta_tags,json=setMetaTags,proto3" json:"set_meta_tags,omitempty"` // replace meta tags, an empty list clears them
+	MetaTags              []string                       `protobuf:"bytes,6,rep,name=meta_tags,json=metaTags,proto3" json:"meta_tags,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *BulkUpdateContentAdminRequest) Reset() {
+	*x = BulkUpdateContentAdminRequest{}
+	mi := &file_v1_admin_admin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateContentAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateContentAdminRequest) ProtoMessage() {}
+
+func (x *BulkUpdateContentAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateContentAdminRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateContentAdminRequest) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BulkUpdateContentAdminRequest) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *BulkUpdateContentAdminRequest) GetContentRating() shared.ContentRating {
+	if x != nil && x.ContentRating != nil {
+		return *x.ContentRating
+	}
+	return shared.ContentRating(0)
+}
+
+func (x *BulkUpdateContentAdminRequest) GetSetContentDescriptors() bool {
+	if x != nil {
+		return x.SetContentDescriptors
+	}
+	return false
+}
+
+func (x *BulkUpdateContentAdminRequest) GetContentDescriptors() []shared.ContentDescriptorType {
+	if x != nil {
+		return x.ContentDescriptors
+	}
+	return nil
+}
+
+func (x *BulkUpdateContentAdminRequest) GetSetMetaTags() bool {
+	if x != nil {
+		return x.SetMetaTags
+	}
+	return false
+}
+
+func (x *BulkUpdateContentAdminRequest) GetMetaTags() []string {
+	if x != nil {
+		return x.MetaTags
+	}
+	return nil
+}
+
+type BulkUpdateContentAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UpdatedIds    []string               `protobuf:"bytes,1,rep,name=updated_ids,json=updatedIds,proto3" json:"updated_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateContentAdminResponse) Reset() {
+	*x = BulkUpdateContentAdminResponse{}
+	mi := &file_v1_admin_admin_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateContentAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateContentAdminResponse) ProtoMessage() {}
+
+func (x *BulkUpdateContentAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateContentAdminResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateContentAdminResponse) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BulkUpdateContentAdminResponse) GetUpdatedIds() []string {
+	if x != nil {
+		return x.UpdatedIds
+	}
+	return nil
+}
+
+type BulkUpdateMetadataAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjectType    string                 `protobuf:"bytes,1,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"` // Tag or Question
+	SubtreeId     string                 `protobuf:"bytes,2,opt,name=subtree_id,json=subtreeId,proto3" json:"subtree_id,omitempty"`
+	BatchId       string                 `protobuf:"bytes,3,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	ParserType    *shared.ParserType     `protobuf:"varint,4,opt,name=parser_type,json=parserType,proto3,enum=shared.v1.ParserType,oneof" json:"parser_type,omitempty"`
+	Key           string                 `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
+	Value         *string                `protobuf:"bytes,6,opt,name=value,proto3,oneof" json:"value,omitempty"`                       // only match rows where key has this value
+	MergePatch    string                 `protobuf:"bytes,7,opt,name=merge_patch,json=mergePatch,proto3" json:"merge_patch,omitempty"` // RFC 7386 JSON merge patch, values must be strings or null
+	Set           map[string]string      `protobuf:"bytes,8,rep,name=set,proto3" json:"set,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Unset         []string               `protobuf:"bytes,9,rep,name=unset,proto3" json:"unset,omitempty"`
+	DryRun        bool                   `protobuf:"varint,10,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateMetadataAdminRequest) Reset() {
+	*x = BulkUpdateMetadataAdminRequest{}
+	mi := &file_v1_admin_admin_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateMetadataAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateMetadataAdminRequest) ProtoMessage() {}
+
+func (x *BulkUpdateMetadataAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateMetadataAdminRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateMetadataAdminRequest) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BulkUpdateMetadataAdminRequest) GetObjectType() string {
+	if x != nil {
+		return x.ObjectType
+	}
+	return ""
+}
+
+func (x *BulkUpdateMetadataAdminRequest) GetSubtreeId() string {
+	if x != nil {
+		return x.SubtreeId
+	}
+	return ""
+}
+
+func (x *BulkUpdateMetadataAdminRequest) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *BulkUpdateMetadataAdminRequest) GetParserType() shared.ParserType {
+	if x != nil && x.ParserType != nil {
+		return *x.ParserType
+	}
+	return shared.ParserType(0)
+}
+
+func (x *BulkUpdateMetadataAdminRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BulkUpdateMetadataAdminRequest) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+func (x *BulkUpdateMetadataAdminRequest) GetMergePatch() string {
+	if x != nil {
+		return x.MergePatch
+	}
+	return ""
+}
+
+func (x *BulkUpdateMetadataAdminRequest) GetSet() map[string]string {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *BulkUpdateMetadataAdminRequest) GetUnset() []string {
+	if x != nil {
+		return x.Unset
+	}
+	return nil
+}
+
+func (x *BulkUpdateMetadataAdminRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type BulkUpdateMetadataAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matched       int32                  `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`
+	AffectedIds   []string               `protobuf:"bytes,2,rep,name=affected_ids,json=affectedIds,proto3" json:"affected_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateMetadataAdminResponse) Reset() {
+	*x = BulkUpdateMetadataAdminResponse{}
+	mi := &file_v1_admin_admin_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateMetadataAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateMetadataAdminResponse) ProtoMessage() {}
+
+func (x *BulkUpdateMetadataAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateMetadataAdminResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateMetadataAdminResponse) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BulkUpdateMetadataAdminResponse) GetMatched() int32 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *BulkUpdateMetadataAdminResponse) GetAffectedIds() []string {
+	if x != nil {
+		return x.AffectedIds
+	}
+	return nil
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Roles         []string               `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	Method        string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	TargetIds     []string               `protobuf:"bytes,5,rep,name=target_ids,json=targetIds,proto3" json:"target_ids,omitempty"`
+	RequestJson   string                 `protobuf:"bytes,6,opt,name=request_json,json=requestJson,proto3" json:"request_json,omitempty"`
+	Outcome       string                 `protobuf:"bytes,7,opt,name=outcome,proto3" json:"outcome,omitempty"` // gRPC status code name, OK on success
+	ErrorMessage  string                 `protobuf:"bytes,8,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	BeforeJson    string                 `protobuf:"bytes,9,opt,name=before_json,json=beforeJson,proto3" json:"before_json,omitempty"`
+	AfterJson     string                 `protobuf:"bytes,10,opt,name=after_json,json=afterJson,proto3" json:"after_json,omitempty"`
+	DurationMs    int32                  `protobuf:"varint,11,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_v1_admin_admin_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTargetIds() []string {
+	if x != nil {
+		return x.TargetIds
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetRequestJson() string {
+	if x != nil {
+		return x.RequestJson
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetBeforeJson() string {
+	if x != nil {
+		return x.BeforeJson
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAfterJson() string {
+	if x != nil {
+		return x.AfterJson
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetDurationMs() int32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListAuditEventsAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorId       string                 `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"` // substring of the full method name, e.g. KillTree
+	TargetId      string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Outcome       string                 `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=until,proto3" json:"until,omitempty"`
+	Limit         int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsAdminRequest) Reset() {
+	*x = ListAuditEventsAdminRequest{}
+	mi := &file_v1_admin_admin_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsAdminRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsAdminRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsAdminRequest) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListAuditEventsAdminRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsAdminRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ListAuditEventsAdminRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsAdminRequest) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *ListAuditEventsAdminRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListAuditEventsAdminRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *ListAuditEventsAdminRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAuditEventsAdminRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListAuditEventsAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsAdminResponse) Reset() {
+	*x = ListAuditEventsAdminResponse{}
+	mi := &file_v1_admin_admin_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsAdminResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_admin_admin_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsAdminResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsAdminResponse) Descriptor() ([]byte, []int) {
+	return file_v1_admin_admin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListAuditEventsAdminResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsAdminResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_v1_admin_admin_proto protoreflect.FileDescriptor
+
+const file_v1_admin_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x14v1/admin/admin.proto\x12\badmin.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17v1/shared/tagtype.proto\x1a\x1dv1/shared/contentrating.proto\x1a%v1/shared/contentdescriptortype.proto\x1a\x1av1/shared/parsertype.proto\x1a\x13v1/shared/tag.proto\x1a\x15v1/shared/guide.proto\x1a\x1bv1/shared/contexttype.proto\"\xd1\x03\n" +
+	"\x12NewTagAdminRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\tR\x04hash\x12&\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x12.shared.v1.TagTypeR\x04type\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\tR\bparentId\x120\n" +
+	"\x06rating\x18\x05 \x01(\x0e2\x18.shared.v1.ContentRatingR\x06rating\x12B\n" +
+	"\vdescriptors\x18\x06 \x03(\x0e2 .shared.v1.ContentDescriptorTypeR\vdescriptors\x12\x1b\n" +
+	"\tmeta_tags\x18\a \x03(\tR\bmetaTags\x126\n" +
+	"\vparser_type\x18\b \x01(\x0e2\x15.shared.v1.ParserTypeR\n" +
+	"parserType\x12F\n" +
+	"\bmetadata\x18\t \x03(\v2*.admin.v1.NewTagAdminRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"7\n" +
+	"\x13NewTagAdminResponse\x12 \n" +
+	"\x03tag\x18\x01 \x01(\v2\x0e.shared.v1.TagR\x03tag\"[\n" +
+	"\x14KillUserAdminRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12-\n" +
+	"\x12confirmation_token\x18\x02 \x01(\tR\x11confirmationToken\"'\n" +
+	"\x15KillUserAdminResponse\x12\x0e\n" +
+	"\x02ok\x18\x01 \x01(\bR\x02ok\"|\n" +
+	"\x14KillTreeAdminRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0eretention_days\x18\x02 \x01(\x05R\rretentionDays\x12-\n" +
+	"\x12confirmation_token\x18\x03 \x01(\tR\x11confirmationToken\"\x8e\x01\n" +
+	"\x15KillTreeAdminResponse\x12\x1f\n" +
+	"\vdeleted_ids\x18\x01 \x03(\tR\n" +
+	"deletedIds\x12\x19\n" +
+	"\btrash_id\x18\x02 \x01(\tR\atrashId\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"Q\n" +
+	"\vImpactCount\x12\x14\n" +
+	"\x05table\x18\x01 \x01(\tR\x05table\x12\x16\n" +
+	"\x06detail\x18\x02 \x01(\tR\x06detail\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"\xde\x01\n" +
+	"\x10KillImpactReport\x12-\n" +
+	"\x06counts\x18\x01 \x03(\v2\x15.admin.v1.ImpactCountR\x06counts\x12&\n" +
+	"\x0ftop_level_names\x18\x02 \x03(\tR\rtopLevelNames\x12-\n" +
+	"\x12confirmation_token\x18\x03 \x01(\tR\x11confirmationToken\x12D\n" +
+	"\x10token_expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0etokenExpiresAt\"-\n" +
+	"\x1bPreviewKillTreeAdminRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"R\n" +
+	"\x1cPreviewKillTreeAdminResponse\x122\n" +
+	"\x06report\x18\x01 \x01(\v2\x1a.admin.v1.KillImpactReportR\x06report\"3\n" +
+	"\x1bPreviewKillUserAdminRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"R\n" +
+	"\x1cPreviewKillUserAdminResponse\x122\n" +
+	"\x06report\x18\x01 \x01(\v2\x1a.admin.v1.KillImpactReportR\x06report\"`\n" +
+	"\fImportCounts\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x05R\aupdated\x12\x1c\n" +
+	"\tunchanged\x18\x03 \x01(\x05R\tunchanged\"\xab\x01\n" +
+	"\x17ImportGuideAdminRequest\x12!\n" +
+	"\vgob_payload\x18\x01 \x01(\fH\x00R\n" +
+	"gobPayload\x12,\n" +
+	"\x05guide\x18\x02 \x01(\v2\x14.shared.v1.GuideDataH\x00R\x05guide\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRunB\t\n" +
+	"\apayload\"a\n" +
+	"\x0fImportFieldDiff\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x02 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x03 \x01(\tR\bnewValue\"\xc6\x01\n" +
+	"\fImportChange\x12\x1f\n" +
+	"\vobject_type\x18\x01 \x01(\tR\n" +
+	"objectType\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\tR\x04hash\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12/\n" +
+	"\x05diffs\x18\x05 \x03(\v2\x19.admin.v1.ImportFieldDiffR\x05diffs\x12\"\n" +
+	"\rother_tag_ids\x18\x06 \x03(\tR\votherTagIds\"\xd3\x02\n" +
+	"\x18ImportGuideAdminResponse\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12*\n" +
+	"\x04tags\x18\x03 \x01(\v2\x16.admin.v1.ImportCountsR\x04tags\x122\n" +
+	"\bpassages\x18\x04 \x01(\v2\x16.admin.v1.ImportCountsR\bpassages\x124\n" +
+	"\tquestions\x18\x05 \x01(\v2\x16.admin.v1.ImportCountsR\tquestions\x12;\n" +
+	"\rquestion_tags\x18\x06 \x01(\v2\x16.admin.v1.ImportCountsR\fquestionTags\x120\n" +
+	"\achanges\x18\a \x03(\v2\x16.admin.v1.ImportChangeR\achanges\"\x92\x02\n" +
+	"\n" +
+	"TrashEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
+	"\vroot_tag_id\x18\x02 \x01(\tR\trootTagId\x12\"\n" +
+	"\rroot_tag_name\x18\x03 \x01(\tR\vrootTagName\x12\x1b\n" +
+	"\ttag_count\x18\x04 \x01(\x05R\btagCount\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x05 \x01(\tR\tdeletedBy\x129\n" +
+	"\n" +
+	"deleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"@\n" +
+	"\x15ListTrashAdminRequest\x12'\n" +
+	"\x0finclude_expired\x18\x01 \x01(\bR\x0eincludeExpired\"H\n" +
+	"\x16ListTrashAdminResponse\x12.\n" +
+	"\aentries\x18\x01 \x03(\v2\x14.admin.v1.TrashEntryR\aentries\"4\n" +
+	"\x17RestoreTreeAdminRequest\x12\x19\n" +
+	"\btrash_id\x18\x01 \x01(\tR\atrashId\"=\n" +
+	"\x18RestoreTreeAdminResponse\x12!\n" +
+	"\frestored_ids\x18\x01 \x03(\tR\vrestoredIds\"3\n" +
+	"\x16PurgeTrashAdminRequest\x12\x19\n" +
+	"\btrash_id\x18\x01 \x01(\tR\atrashId\"1\n" +
+	"\x17PurgeTrashAdminResponse\x12\x16\n" +
+	"\x06purged\x18\x01 \x01(\x05R\x06purged\"\xca\x02\n" +
+	"\x0fContentAuditTag\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x125\n" +
+	"\acontext\x18\x03 \x01(\x0e2\x16.shared.v1.ContextTypeH\x00R\acontext\x88\x01\x01\x12\x1f\n" +
+	"\vparent_name\x18\x04 \x01(\tR\n" +
+	"parentName\x12?\n" +
+	"\x0econtent_rating\x18\x05 \x01(\x0e2\x18.shared.v1.ContentRatingR\rcontentRating\x12Q\n" +
+	"\x13content_descriptors\x18\x06 \x03(\x0e2 .shared.v1.ContentDescriptorTypeR\x12contentDescriptors\x12\x1b\n" +
+	"\tmeta_tags\x18\a \x03(\tR\bmetaTagsB\n" +
+	"\n" +
+	"\b_context\"\xd2\x02\n" +
+	"\x1cListContentAuditAdminRequest\x124\n" +
+	"\x16missing_content_rating\x18\x01 \x01(\bR\x14missingContentRating\x12>\n" +
+	"\x1bmissing_content_descriptors\x18\x02 \x01(\bR\x19missingContentDescriptors\x12*\n" +
+	"\x11missing_meta_tags\x18\x03 \x01(\bR\x0fmissingMetaTags\x125\n" +
+	"\acontext\x18\x04 \x01(\x0e2\x16.shared.v1.ContextTypeH\x00R\acontext\x88\x01\x01\x12\x1f\n" +
+	"\vancestor_id\x18\x05 \x01(\tR\n" +
+	"ancestorId\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\a \x01(\x05R\x06offsetB\n" +
+	"\n" +
+	"\b_context\"d\n" +
+	"\x1dListContentAuditAdminResponse\x12-\n" +
+	"\x04tags\x18\x01 \x03(\v2\x19.admin.v1.ContentAuditTagR\x04tags\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\xdd\x02\n" +
+	"\x1dBulkUpdateContentAdminRequest\x12\x17\n" +
+	"\atag_ids\x18\x01 \x03(\tR\x06tagIds\x12D\n" +
+	"\x0econtent_rating\x18\x02 \x01(\x0e2\x18.shared.v1.ContentRatingH\x00R\rcontentRating\x88\x01\x01\x126\n" +
+	"\x17set_content_descriptors\x18\x03 \x01(\bR\x15setContentDescriptors\x12Q\n" +
+	"\x13content_descriptors\x18\x04 \x03(\x0e2 .shared.v1.ContentDescriptorTypeR\x12contentDescriptors\x12\"\n" +
+	"\rset_meta_tags\x18\x05 \x01(\bR\vsetMetaTags\x12\x1b\n" +
+	"\tmeta_tags\x18\x06 \x03(\tR\bmetaTagsB\x11\n" +
+	"\x0f_content_rating\"A\n" +
+	"\x1eBulkUpdateContentAdminResponse\x12\x1f\n" +
+	"\vupdated_ids\x18\x01 \x03(\tR\n" +
+	"updatedIds\"\xcc\x03\n" +
+	"\x1eBulkUpdateMetadataAdminRequest\x12\x1f\n" +
+	"\vobject_type\x18\x01 \x01(\tR\n" +
+	"objectType\x12\x1d\n" +
+	"\n" +
+	"subtree_id\x18\x02 \x01(\tR\tsubtreeId\x12\x19\n" +
+	"\bbatch_id\x18\x03 \x01(\tR\abatchId\x12;\n" +
+	"\vparser_type\x18\x04 \x01(\x0e2\x15.shared.v1.ParserTypeH\x00R\n" +
+	"parserType\x88\x01\x01\x12\x10\n" +
+	"\x03key\x18\x05 \x01(\tR\x03key\x12\x19\n" +
+	"\x05value\x18\x06 \x01(\tH\x01R\x05value\x88\x01\x01\x12\x1f\n" +
+	"\vmerge_patch\x18\a \x01(\tR\n" +
+	"mergePatch\x12C\n" +
+	"\x03set\x18\b \x03(\v21.admin.v1.BulkUpdateMetadataAdminRequest.SetEntryR\x03set\x12\x14\n" +
+	"\x05unset\x18\t \x03(\tR\x05unset\x12\x17\n" +
+	"\adry_run\x18\n" +
+	" \x01(\bR\x06dryRun\x1a6\n" +
+	"\bSetEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
+	"\f_parser_typeB\b\n" +
+	"\x06_value\"^\n" +
+	"\x1fBulkUpdateMetadataAdminResponse\x12\x18\n" +
+	"\amatched\x18\x01 \x01(\x05R\amatched\x12!\n" +
+	"\faffected_ids\x18\x02 \x03(\tR\vaffectedIds\"\x82\x03\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12\x14\n" +
+	"\x05roles\x18\x03 \x03(\tR\x05roles\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12\x1d\n" +
+	"\n" +
+	"target_ids\x18\x05 \x03(\tR\ttargetIds\x12!\n" +
+	"\frequest_json\x18\x06 \x01(\tR\vrequestJson\x12\x18\n" +
+	"\aoutcome\x18\a \x01(\tR\aoutcome\x12#\n" +
+	"\rerror_message\x18\b \x01(\tR\ferrorMessage\x12\x1f\n" +
+	"\vbefore_json\x18\t \x01(\tR\n" +
+	"beforeJson\x12\x1d\n" +
+	"\n" +
+	"after_json\x18\n" +
+	" \x01(\tR\tafterJson\x12\x1f\n" +
+	"\vduration_ms\x18\v \x01(\x05R\n" +
+	"durationMs\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x99\x02\n" +
+	"\x1bListAuditEventsAdminRequest\x12\x19\n" +
+	"\bactor_id\x18\x01 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x12\x18\n" +
+	"\aoutcome\x18\x04 \x01(\tR\aoutcome\x120\n" +
+	"\x05since\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\b \x01(\x05R\x06offset\"b\n" +
+	"\x1cListAuditEventsAdminResponse\x12,\n" +
+	"\x06events\x18\x01 \x03(\v2\x14.admin.v1.AuditEventR\x06events\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total2\xed\b\n" +
+	"\fAdminService\x12M\n" +
+	"\bKillUser\x12\x1e.admin.v1.KillUserAdminRequest\x1a\x1f.admin.v1.KillUserAdminResponse\"\x00\x12M\n" +
+	"\bKillTree\x12\x1e.admin.v1.KillTreeAdminRequest\x1a\x1f.admin.v1.KillTreeAdminResponse\"\x00\x12b\n" +
+	"\x0fPreviewKillUser\x12%.admin.v1.PreviewKillUserAdminRequest\x1a&.admin.v1.PreviewKillUserAdminResponse\"\x00\x12b\n" +
+	"\x0fPreviewKillTree\x12%.admin.v1.PreviewKillTreeAdminRequest\x1a&.admin.v1.PreviewKillTreeAdminResponse\"\x00\x12V\n" +
+	"\vImportGuide\x12!.admin.v1.ImportGuideAdminRequest\x1a\".admin.v1.ImportGuideAdminResponse\"\x00\x12P\n" +
+	"\tListTrash\x12\x1f.admin.v1.ListTrashAdminRequest\x1a .admin.v1.ListTrashAdminResponse\"\x00\x12V\n" +
+	"\vRestoreTree\x12!.admin.v1.RestoreTreeAdminRequest\x1a\".admin.v1.RestoreTreeAdminResponse\"\x00\x12S\n" +
+	"\n" +
+	"PurgeTrash\x12 .admin.v1.PurgeTrashAdminRequest\x1a!.admin.v1.PurgeTrashAdminResponse\"\x00\x12k\n" +
+	"\x12BulkUpdateMetadata\x12(.admin.v1.BulkUpdateMetadataAdminRequest\x1a).admin.v1.BulkUpdateMetadataAdminResponse\"\x00\x12e\n" +
+	"\x10ListContentAudit\x12&.admin.v1.ListContentAuditAdminRequest\x1a'.admin.v1.ListContentAuditAdminResponse\"\x00\x12h\n" +
+	"\x11BulkUpdateContent\x12'.admin.v1.BulkUpdateContentAdminRequest\x1a(.admin.v1.BulkUpdateContentAdminResponse\"\x00\x12b\n" +
+	"\x0fListAuditEvents\x12%.admin.v1.ListAuditEventsAdminRequest\x1a&.admin.v1.ListAuditEventsAdminResponse\"\x00BBZ@github.com/studyguides-com/study-guides-api/api/v1/admin;adminv1b\x06proto3"
+
+var (
+	file_v1_admin_admin_proto_rawDescOnce sync.Once
+	file_v1_admin_admin_proto_rawDescData []byte
+)
+
+func file_v1_admin_admin_proto_rawDescGZIP() []byte {
+	file_v1_admin_admin_proto_rawDescOnce.Do(func() {
+		file_v1_admin_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_admin_admin_proto_rawDesc), len(file_v1_admin_admin_proto_rawDesc)))
+	})
+	return file_v1_admin_admin_proto_rawDescData
+}
+
+var file_v1_admin_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_v1_admin_admin_proto_goTypes = []any{
+	(*NewTagAdminRequest)(nil),              // 0: admin.v1.NewTagAdminRequest
+	(*NewTagAdminResponse)(nil),             // 1: admin.v1.NewTagAdminResponse
+	(*KillUserAdminRequest)(nil),            // 2: admin.v1.KillUserAdminRequest
+	(*KillUserAdminResponse)(nil),           // 3: admin.v1.KillUserAdminResponse
+	(*KillTreeAdminRequest)(nil),            // 4: admin.v1.KillTreeAdminRequest
+	(*KillTreeAdminResponse)(nil),           // 5: admin.v1.KillTreeAdminResponse
+	(*ImpactCount)(nil),                     // 6: admin.v1.ImpactCount
+	(*KillImpactReport)(nil),                // 7: admin.v1.KillImpactReport
+	(*PreviewKillTreeAdminRequest)(nil),     // 8: admin.v1.PreviewKillTreeAdminRequest
+	(*PreviewKillTreeAdminResponse)(nil),    // 9: admin.v1.PreviewKillTreeAdminResponse
+	(*PreviewKillUserAdminRequest)(nil),     // 10: admin.v1.PreviewKillUserAdminRequest
+	(*PreviewKillUserAdminResponse)(nil),    // 11: admin.v1.PreviewKillUserAdminResponse
+	(*ImportCounts)(nil),                    // 12: admin.v1.ImportCounts
+	(*ImportGuideAdminRequest)(nil),         // 13: admin.v1.ImportGuideAdminRequest
+	(*ImportFieldDiff)(nil),                 // 14: admin.v1.ImportFieldDiff
+	(*ImportChange)(nil),                    // 15: admin.v1.ImportChange
+	(*ImportGuideAdminResponse)(nil),        // 16: admin.v1.ImportGuideAdminResponse
+	(*TrashEntry)(nil),                      // 17: admin.v1.TrashEntry
+	(*ListTrashAdminRequest)(nil),           // 18: admin.v1.ListTrashAdminRequest
+	(*ListTrashAdminResponse)(nil),          // 19: admin.v1.ListTrashAdminResponse
+	(*RestoreTreeAdminRequest)(nil),         // 20: admin.v1.RestoreTreeAdminRequest
+	(*RestoreTreeAdminResponse)(nil),        // 21: admin.v1.RestoreTreeAdminResponse
+	(*PurgeTrashAdminRequest)(nil),          // 22: admin.v1.PurgeTrashAdminRequest
+	(*PurgeTrashAdminResponse)(nil),         // 23: admin.v1.PurgeTrashAdminResponse
+	(*ContentAuditTag)(nil),                 // 24: admin.v1.ContentAuditTag
+	(*ListContentAuditAdminRequest)(nil),    // 25: admin.v1.ListContentAuditAdminRequest
+	(*ListContentAuditAdminResponse)(nil),   // 26: admin.v1.ListContentAuditAdminResponse
+	(*BulkUpdateContentAdminRequest)(nil),   // 27: admin.v1.BulkUpdateContentAdminRequest
+	(*BulkUpdateContentAdminResponse)(nil),  // 28: admin.v1.BulkUpdateContentAdminResponse
+	(*BulkUpdateMetadataAdminRequest)(nil),  // 29: admin.v1.BulkUpdateMetadataAdminRequest
+	(*BulkUpdateMetadataAdminResponse)(nil), // 30: admin.v1.BulkUpdateMetadataAdminResponse
+	(*AuditEvent)(nil),                      // 31: admin.v1.AuditEvent
+	(*ListAuditEventsAdminRequest)(nil),     // 32: admin.v1.ListAuditEventsAdminRequest
+	(*ListAuditEventsAdminResponse)(nil),    // 33: admin.v1.ListAuditEventsAdminResponse
+	nil,                                     // 34: admin.v1.NewTagAdminRequest.MetadataEntry
+	nil,                                     // 35: admin.v1.BulkUpdateMetadataAdminRequest.SetEntry
+	(shared.TagType)(0),                     // 36: shared.v1.TagType
+	(shared.ContentRating)(0),               // 37: shared.v1.ContentRating
+	(shared.ContentDescriptorType)(0),       // 38: shared.v1.ContentDescriptorType
+	(shared.ParserType)(0),                  // 39: shared.v1.ParserType
+	(*shared.Tag)(nil),                      // 40: shared.v1.Tag
+	(*timestamppb.Timestamp)(nil),           // 41: google.protobuf.Timestamp
+	(*shared.GuideData)(nil),                // 42: shared.v1.GuideData
+	(shared.ContextType)(0),                 // 43: shared.v1.ContextType
+}
+var file_v1_admin_admin_proto_depIdxs = []int32{
+	36, // 0: admin.v1.NewTagAdminRequest.type:type_name -> shared.v1.TagType
+	37, // 1: admin.v1.NewTagAdminRequest.rating:type_name -> shared.v1.ContentRating
+	38, // 2: admin.v1.NewTagAdminRequest.descriptors:type_name -> shared.v1.ContentDescriptorType
+	39, // 3: admin.v1.NewTagAdminRequest.parser_type:type_name -> shared.v1.ParserType
+	34, // 4: admin.v1.NewTagAdminRequest.metadata:type_name -> admin.v1.NewTagAdminRequest.MetadataEntry
+	40, // 5: admin.v1.NewTagAdminResponse.tag:type_name -> shared.v1.Tag
+	41, // 6: admin.v1.KillTreeAdminResponse.expires_at:type_name -> google.protobuf.Timestamp
+	6,  // 7: admin.v1.KillImpactReport.counts:type_name -> admin.v1.ImpactCount
+	41, // 8: admin.v1.KillImpactReport.token_expires_at:type_name -> google.protobuf.Timestamp
+	7,  // 9: admin.v1.PreviewKillTreeAdminResponse.report:type_name -> admin.v1.KillImpactReport
+	7,  // 10: admin.v1.PreviewKillUserAdminResponse.report:type_name -> admin.v1.KillImpactReport
+	42, // 11: admin.v1.ImportGuideAdminRequest.guide:type_name -> shared.v1.GuideData
+	14, // 12: admin.v1.ImportChange.diffs:type_name -> admin.v1.ImportFieldDiff
+	12, // 13: admin.v1.ImportGuideAdminResponse.tags:type_name -> admin.v1.ImportCounts
+	12, // 14: admin.v1.ImportGuideAdminResponse.passages:type_name -> admin.v1.ImportCounts
+	12, // 15: admin.v1.ImportGuideAdminResponse.questions:type_name -> admin.v1.ImportCounts
+	12, // 16: admin.v1.ImportGuideAdminResponse.question_tags:type_name -> admin.v1.ImportCounts
+	15, // 17: admin.v1.ImportGuideAdminResponse.changes:type_name -> admin.v1.ImportChange
+	41, // 18: admin.v1.TrashEntry.deleted_at:type_name -> google.protobuf.Timestamp
+	41, // 19: admin.v1.TrashEntry.expires_at:type_name -> google.protobuf.Timestamp
+	17, // 20: admin.v1.ListTrashAdminResponse.entries:type_name -> admin.v1.TrashEntry
+	43, // 21: admin.v1.ContentAuditTag.context:type_name -> shared.v1.ContextType
+	37, // 22: admin.v1.ContentAuditTag.content_rating:type_name -> shared.v1.ContentRating
+	38, // 23: admin.v1.ContentAuditTag.content_descriptors:type_name -> shared.v1.ContentDescriptorType
+	43, // 24: admin.v1.ListContentAuditAdminRequest.context:type_name -> shared.v1.ContextType
+	24, // 25: admin.v1.ListContentAuditAdminResponse.tags:type_name -> admin.v1.ContentAuditTag
+	37, // 26: admin.v1.BulkUpdateContentAdminRequest.content_rating:type_name -> shared.v1.ContentRating
+	38, // 27: admin.v1.BulkUpdateContentAdminRequest.content_descriptors:type_name -> shared.v1.ContentDescriptorType
+	39, // 28: admin.v1.BulkUpdateMetadataAdminRequest.parser_type:type_name -> shared.v1.ParserType
+	35, // 29: admin.v1.BulkUpdateMetadataAdminRequest.set:type_name -> admin.v1.BulkUpdateMetadataAdminRequest.SetEntry
+	41, // 30: admin.v1.AuditEvent.created_at:type_name -> google.protobuf.Timestamp
+	41, // 31: admin.v1.ListAuditEventsAdminRequest.since:type_name -> google.protobuf.Timestamp
+	41, // 32: admin.v1.ListAuditEventsAdminRequest.until:type_name -> google.protobuf.Timestamp
+	31, // 33: admin.v1.ListAuditEventsAdminResponse.events:type_name -> admin.v1.AuditEvent
+	2,  // 34: admin.v1.AdminService.KillUser:input_type -> admin.v1.KillUserAdminRequest
+	4,  // 35: admin.v1.AdminService.KillTree:input_type -> admin.v1.KillTreeAdminRequest
+	10, // 36: admin.v1.AdminService.PreviewKillUser:input_type -> admin.v1.PreviewKillUserAdminRequest
+	8,  // 37: admin.v1.AdminService.PreviewKillTree:input_type -> admin.v1.PreviewKillTreeAdminRequest
+	13, // 38: admin.v1.AdminService.ImportGuide:input_type -> admin.v1.ImportGuideAdminRequest
+	18, // 39: admin.v1.AdminService.ListTrash:input_type -> admin.v1.ListTrashAdminRequest
+	20, // 40: admin.v1.AdminService.RestoreTree:input_type -> admin.v1.RestoreTreeAdminRequest
+	22, // 41: admin.v1.AdminService.PurgeTrash:input_type -> admin.v1.PurgeTrashAdminRequest
+	29, // 42: admin.v1.AdminService.BulkUpdateMetadata:input_type -> admin.v1.BulkUpdateMetadataAdminRequest
+	25, // 43: admin.v1.AdminService.ListContentAudit:input_type -> admin.v1.ListContentAuditAdminRequest
+	27, // 44: admin.v1.AdminService.BulkUpdateContent:input_type -> admin.v1.BulkUpdateContentAdminRequest
+	32, // 45: admin.v1.AdminService.ListAuditEvents:input_type -> admin.v1.ListAuditEventsAdminRequest
+	3,  // 46: admin.v1.AdminService.KillUser:output_type -> admin.v1.KillUserAdminResponse
+	5,  // 47: admin.v1.AdminService.KillTree:output_type -> admin.v1.KillTreeAdminResponse
+	11, // 48: admin.v1.AdminService.PreviewKillUser:output_type -> admin.v1.PreviewKillUserAdminResponse
+	9,  // 49: admin.v1.AdminService.PreviewKillTree:output_type -> admin.v1.PreviewKillTreeAdminResponse
+	16, // 50: admin.v1.AdminService.ImportGuide:output_type -> admin.v1.ImportGuideAdminResponse
+	19, // 51: admin.v1.AdminService.ListTrash:output_type -> admin.v1.ListTrashAdminResponse
+	21, // 52: admin.v1.AdminService.RestoreTree:output_type -> admin.v1.RestoreTreeAdminResponse
+	23, // 53: admin.v1.AdminService.PurgeTrash:output_type -> admin.v1.PurgeTrashAdminResponse
+	30, // 54: admin.v1.AdminService.BulkUpdateMetadata:output_type -> admin.v1.BulkUpdateMetadataAdminResponse
+	26, // 55: admin.v1.AdminService.ListContentAudit:output_type -> admin.v1.ListContentAuditAdminResponse
+	28, // 56: admin.v1.AdminService.BulkUpdateContent:output_type -> admin.v1.BulkUpdateContentAdminResponse
+	33, // 57: admin.v1.AdminService.ListAuditEvents:output_type -> admin.v1.ListAuditEventsAdminResponse
+	46, // [46:58] is the sub-list for method output_type
+	34, // [34:46] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
+}
+
+func init() { file_v1_admin_admin_proto_init() }
+func file_v1_admin_admin_proto_init() {
+	if File_v1_admin_admin_proto != nil {
+		return
+	}
+	file_v1_admin_admin_proto_msgTypes[13].OneofWrappers = []any{
+		(*ImportGuideAdminRequest_GobPayload)(nil),
+		(*ImportGuideAdminRequest_Guide)(nil),
 	}
+	file_v1_admin_admin_proto_msgTypes[24].OneofWrappers = []any{}
+	file_v1_admin_admin_proto_msgTypes[25].OneofWrappers = []any{}
+	file_v1_admin_admin_proto_msgTypes[27].OneofWrappers = []any{}
+	file_v1_admin_admin_proto_msgTypes[29].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_admin_admin_proto_rawDesc), len(file_v1_admin_admin_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
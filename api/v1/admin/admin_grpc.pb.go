@@ -19,8 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AdminService_KillUser_FullMethodName = "/admin.v1.AdminService/KillUser"
-	AdminService_KillTree_FullMethodName = "/admin.v1.AdminService/KillTree"
+	AdminService_KillUser_FullMethodName           = "/admin.v1.AdminService/KillUser"
+	AdminService_KillTree_FullMethodName           = "/admin.v1.AdminService/KillTree"
+	AdminService_PreviewKillUser_FullMethodName    = "/admin.v1.AdminService/PreviewKillUser"
+	AdminService_PreviewKillTree_FullMethodName    = "/admin.v1.AdminService/PreviewKillTree"
+	AdminService_ImportGuide_FullMethodName        = "/admin.v1.AdminService/ImportGuide"
+	AdminService_ListTrash_FullMethodName          = "/admin.v1.AdminService/ListTrash"
+	AdminService_RestoreTree_FullMethodName        = "/admin.v1.AdminService/RestoreTree"
+	AdminService_PurgeTrash_FullMethodName         = "/admin.v1.AdminService/PurgeTrash"
+	AdminService_BulkUpdateMetadata_FullMethodName = "/admin.v1.AdminService/BulkUpdateMetadata"
+	AdminService_ListContentAudit_FullMethodName   = "/admin.v1.AdminService/ListContentAudit"
+	AdminService_BulkUpdateContent_FullMethodName  = "/admin.v1.AdminService/BulkUpdateContent"
+	AdminService_ListAuditEvents_FullMethodName    = "/admin.v1.AdminService/ListAuditEvents"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -29,6 +39,16 @@ const (
 type AdminServiceClient interface {
 	KillUser(ctx context.Context, in *KillUserAdminRequest, opts ...grpc.CallOption) (*KillUserAdminResponse, error)
 	KillTree(ctx context.Context, in *KillTreeAdminRequest, opts ...grpc.CallOption) (*KillTreeAdminResponse, error)
+	PreviewKillUser(ctx context.Context, in *PreviewKillUserAdminRequest, opts ...grpc.CallOption) (*PreviewKillUserAdminResponse, error)
+	PreviewKillTree(ctx context.Context, in *PreviewKillTreeAdminRequest, opts ...grpc.CallOption) (*PreviewKillTreeAdminResponse, error)
+	ImportGuide(ctx context.Context, in *ImportGuideAdminRequest, opts ...grpc.CallOption) (*ImportGuideAdminResponse, error)
+	ListTrash(ctx context.Context, in *ListTrashAdminRequest, opts ...grpc.CallOption) (*ListTrashAdminResponse, error)
+	RestoreTree(ctx context.Context, in *RestoreTreeAdminRequest, opts ...grpc.CallOption) (*RestoreTreeAdminResponse, error)
+	PurgeTrash(ctx context.Context, in *PurgeTrashAdminRequest, opts ...grpc.CallOption) (*PurgeTrashAdminResponse, error)
+	BulkUpdateMetadata(ctx context.Context, in *BulkUpdateMetadataAdminRequest, opts ...grpc.CallOption) (*BulkUpdateMetadataAdminResponse, error)
+	ListContentAudit(ctx context.Context, in *ListContentAuditAdminRequest, opts ...grpc.CallOption) (*ListContentAuditAdminResponse, error)
+	BulkUpdateContent(ctx context.Context, in *BulkUpdateContentAdminRequest, opts ...grpc.CallOption) (*BulkUpdateContentAdminResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsAdminRequest, opts ...grpc.CallOption) (*ListAuditEventsAdminResponse, error)
 }
 
 type adminServiceClient struct {
@@ -59,12 +79,122 @@ func (c *adminServiceClient) KillTree(ctx context.Context, in *KillTreeAdminRequ
 	return out, nil
 }
 
+func (c *adminServiceClient) PreviewKillUser(ctx context.Context, in *PreviewKillUserAdminRequest, opts ...grpc.CallOption) (*PreviewKillUserAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewKillUserAdminResponse)
+	err := c.cc.Invoke(ctx, AdminService_PreviewKillUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PreviewKillTree(ctx context.Context, in *PreviewKillTreeAdminRequest, opts ...grpc.CallOption) (*PreviewKillTreeAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewKillTreeAdminResponse)
+	err := c.cc.Invoke(ctx, AdminService_PreviewKillTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ImportGuide(ctx context.Context, in *ImportGuideAdminRequest, opts ...grpc.CallOption) (*ImportGuideAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportGuideAdminResponse)
+	err := c.cc.Invoke(ctx, AdminService_ImportGuide_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListTrash(ctx context.Context, in *ListTrashAdminRequest, opts ...grpc.CallOption) (*ListTrashAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashAdminResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RestoreTree(ctx context.Context, in *RestoreTreeAdminRequest, opts ...grpc.CallOption) (*RestoreTreeAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreTreeAdminResponse)
+	err := c.cc.Invoke(ctx, AdminService_RestoreTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PurgeTrash(ctx context.Context, in *PurgeTrashAdminRequest, opts ...grpc.CallOption) (*PurgeTrashAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeTrashAdminResponse)
+	err := c.cc.Invoke(ctx, AdminService_PurgeTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) BulkUpdateMetadata(ctx context.Context, in *BulkUpdateMetadataAdminRequest, opts ...grpc.CallOption) (*BulkUpdateMetadataAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpdateMetadataAdminResponse)
+	err := c.cc.Invoke(ctx, AdminService_BulkUpdateMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListContentAudit(ctx context.Context, in *ListContentAuditAdminRequest, opts ...grpc.CallOption) (*ListContentAuditAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListContentAuditAdminResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListContentAudit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) BulkUpdateContent(ctx context.Context, in *BulkUpdateContentAdminRequest, opts ...grpc.CallOption) (*BulkUpdateContentAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpdateContentAdminResponse)
+	err := c.cc.Invoke(ctx, AdminService_BulkUpdateContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsAdminRequest, opts ...grpc.CallOption) (*ListAuditEventsAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsAdminResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility.
 type AdminServiceServer interface {
 	KillUser(context.Context, *KillUserAdminRequest) (*KillUserAdminResponse, error)
 	KillTree(context.Context, *KillTreeAdminRequest) (*KillTreeAdminResponse, error)
+	PreviewKillUser(context.Context, *PreviewKillUserAdminRequest) (*PreviewKillUserAdminResponse, error)
+	PreviewKillTree(context.Context, *PreviewKillTreeAdminRequest) (*PreviewKillTreeAdminResponse, error)
+	ImportGuide(context.Context, *ImportGuideAdminRequest) (*ImportGuideAdminResponse, error)
+	ListTrash(context.Context, *ListTrashAdminRequest) (*ListTrashAdminResponse, error)
+	RestoreTree(context.Context, *RestoreTreeAdminRequest) (*RestoreTreeAdminResponse, error)
+	PurgeTrash(context.Context, *PurgeTrashAdminRequest) (*PurgeTrashAdminResponse, error)
+	BulkUpdateMetadata(context.Context, *BulkUpdateMetadataAdminRequest) (*BulkUpdateMetadataAdminResponse, error)
+	ListContentAudit(context.Context, *ListContentAuditAdminRequest) (*ListContentAuditAdminResponse, error)
+	BulkUpdateContent(context.Context, *BulkUpdateContentAdminRequest) (*BulkUpdateContentAdminResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsAdminRequest) (*ListAuditEventsAdminResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -81,6 +211,36 @@ func (UnimplementedAdminServiceServer) KillUser(context.Context, *KillUserAdminR
 func (UnimplementedAdminServiceServer) KillTree(context.Context, *KillTreeAdminRequest) (*KillTreeAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method KillTree not implemented")
 }
+func (UnimplementedAdminServiceServer) PreviewKillUser(context.Context, *PreviewKillUserAdminRequest) (*PreviewKillUserAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewKillUser not implemented")
+}
+func (UnimplementedAdminServiceServer) PreviewKillTree(context.Context, *PreviewKillTreeAdminRequest) (*PreviewKillTreeAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewKillTree not implemented")
+}
+func (UnimplementedAdminServiceServer) ImportGuide(context.Context, *ImportGuideAdminRequest) (*ImportGuideAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportGuide not implemented")
+}
+func (UnimplementedAdminServiceServer) ListTrash(context.Context, *ListTrashAdminRequest) (*ListTrashAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedAdminServiceServer) RestoreTree(context.Context, *RestoreTreeAdminRequest) (*RestoreTreeAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTree not implemented")
+}
+func (UnimplementedAdminServiceServer) PurgeTrash(context.Context, *PurgeTrashAdminRequest) (*PurgeTrashAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTrash not implemented")
+}
+func (UnimplementedAdminServiceServer) BulkUpdateMetadata(context.Context, *BulkUpdateMetadataAdminRequest) (*BulkUpdateMetadataAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdateMetadata not implemented")
+}
+func (UnimplementedAdminServiceServer) ListContentAudit(context.Context, *ListContentAuditAdminRequest) (*ListContentAuditAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContentAudit not implemented")
+}
+func (UnimplementedAdminServiceServer) BulkUpdateContent(context.Context, *BulkUpdateContentAdminRequest) (*BulkUpdateContentAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdateContent not implemented")
+}
+func (UnimplementedAdminServiceServer) ListAuditEvents(context.Context, *ListAuditEventsAdminRequest) (*ListAuditEventsAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
 
@@ -138,6 +298,186 @@ func _AdminService_KillTree_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_PreviewKillUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewKillUserAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PreviewKillUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PreviewKillUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PreviewKillUser(ctx, req.(*PreviewKillUserAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PreviewKillTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewKillTreeAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PreviewKillTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PreviewKillTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PreviewKillTree(ctx, req.(*PreviewKillTreeAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ImportGuide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportGuideAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ImportGuide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ImportGuide_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ImportGuide(ctx, req.(*ImportGuideAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListTrash(ctx, req.(*ListTrashAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RestoreTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTreeAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RestoreTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RestoreTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RestoreTree(ctx, req.(*RestoreTreeAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PurgeTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTrashAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PurgeTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PurgeTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PurgeTrash(ctx, req.(*PurgeTrashAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_BulkUpdateMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdateMetadataAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).BulkUpdateMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_BulkUpdateMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).BulkUpdateMetadata(ctx, req.(*BulkUpdateMetadataAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListContentAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContentAuditAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListContentAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListContentAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListContentAudit(ctx, req.(*ListContentAuditAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_BulkUpdateContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdateContentAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).BulkUpdateContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_BulkUpdateContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).BulkUpdateContent(ctx, req.(*BulkUpdateContentAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +493,46 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "KillTree",
 			Handler:    _AdminService_KillTree_Handler,
 		},
+		{
+			MethodName: "PreviewKillUser",
+			Handler:    _AdminService_PreviewKillUser_Handler,
+		},
+		{
+			MethodName: "PreviewKillTree",
+			Handler:    _AdminService_PreviewKillTree_Handler,
+		},
+		{
+			MethodName: "ImportGuide",
+			Handler:    _AdminService_ImportGuide_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _AdminService_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestoreTree",
+			Handler:    _AdminService_RestoreTree_Handler,
+		},
+		{
+			MethodName: "PurgeTrash",
+			Handler:    _AdminService_PurgeTrash_Handler,
+		},
+		{
+			MethodName: "BulkUpdateMetadata",
+			Handler:    _AdminService_BulkUpdateMetadata_Handler,
+		},
+		{
+			MethodName: "ListContentAudit",
+			Handler:    _AdminService_ListContentAudit_Handler,
+		},
+		{
+			MethodName: "BulkUpdateContent",
+			Handler:    _AdminService_BulkUpdateContent_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _AdminService_ListAuditEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/admin/admin.proto",
@@ -0,0 +1,660 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: v1/announcement/announcement.proto
+
+package announcementv1
+
+import (
+	shared "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AnnouncementType int32
+
+const (
+	AnnouncementType_ANNOUNCEMENT_TYPE_UNSPECIFIED AnnouncementType = 0
+	AnnouncementType_ANNOUNCEMENT_TYPE_INFO        AnnouncementType = 1
+	AnnouncementType_ANNOUNCEMENT_TYPE_WARN        AnnouncementType = 2
+	AnnouncementType_ANNOUNCEMENT_TYPE_DANGER      AnnouncementType = 3
+)
+
+// Enum value maps for AnnouncementType.
+var (
+	AnnouncementType_name = map[int32]string{
+		0: "ANNOUNCEMENT_TYPE_UNSPECIFIED",
+		1: "ANNOUNCEMENT_TYPE_INFO",
+		2: "ANNOUNCEMENT_TYPE_WARN",
+		3: "ANNOUNCEMENT_TYPE_DANGER",
+	}
+	AnnouncementType_value = map[string]int32{
+		"ANNOUNCEMENT_TYPE_UNSPECIFIED": 0,
+		"ANNOUNCEMENT_TYPE_INFO":        1,
+		"ANNOUNCEMENT_TYPE_WARN":        2,
+		"ANNOUNCEMENT_TYPE_DANGER":      3,
+	}
+)
+
+func (x AnnouncementType) Enum() *AnnouncementType {
+	p := new(AnnouncementType)
+	*p = x
+	return p
+}
+
+func (x AnnouncementType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnnouncementType) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_announcement_announcement_proto_enumTypes[0].Descriptor()
+}
+
+func (AnnouncementType) Type() protoreflect.EnumType {
+	return &file_v1_announcement_announcement_proto_enumTypes[0]
+}
+
+func (x AnnouncementType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnnouncementType.Descriptor instead.
+func (AnnouncementType) EnumDescriptor() ([]byte, []int) {
+	return file_v1_announcement_announcement_proto_rawDescGZIP(), []int{0}
+}
+
+type Announcement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Type          AnnouncementType       `protobuf:"varint,3,opt,name=type,proto3,enum=announcement.v1.AnnouncementType" json:"type,omitempty"`
+	Dismissible   bool                   `protobuf:"varint,4,opt,name=dismissible,proto3" json:"dismissible,omitempty"`
+	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Roles         []shared.UserRole      `protobuf:"varint,6,rep,packed,name=roles,proto3,enum=shared.v1.UserRole" json:"roles,omitempty"`          // empty targets every role
+	Contexts      []shared.ContextType   `protobuf:"varint,7,rep,packed,name=contexts,proto3,enum=shared.v1.ContextType" json:"contexts,omitempty"` // empty targets every context
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	mi := &file_v1_announcement_announcement_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Announcement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcement) ProtoMessage() {}
+
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_announcement_announcement_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_v1_announcement_announcement_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Announcement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Announcement) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Announcement) GetType() AnnouncementType {
+	if x != nil {
+		return x.Type
+	}
+	return AnnouncementType_ANNOUNCEMENT_TYPE_UNSPECIFIED
+}
+
+func (x *Announcement) GetDismissible() bool {
+	if x != nil {
+		return x.Dismissible
+	}
+	return false
+}
+
+func (x *Announcement) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Announcement) GetRoles() []shared.UserRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *Announcement) GetContexts() []shared.ContextType {
+	if x != nil {
+		return x.Contexts
+	}
+	return nil
+}
+
+func (x *Announcement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Announcement) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *shared.ContextType    `protobuf:"varint,1,opt,name=context,proto3,enum=shared.v1.ContextType,oneof" json:"context,omitempty"` // the context the client is showing
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListActiveRequest) Reset() {
+	*x = ListActiveRequest{}
+	mi := &file_v1_announcement_announcement_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActiveRequest) ProtoMessage() {}
+
+func (x *ListActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_announcement_announcement_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActiveRequest.ProtoReflect.Descriptor instead.
+func (*ListActiveRequest) Descriptor() ([]byte, []int) {
+	return file_v1_announcement_announcement_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListActiveRequest) GetContext() shared.ContextType {
+	if x != nil && x.Context != nil {
+		return *x.Context
+	}
+	return shared.ContextType(0)
+}
+
+type ListAnnouncementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Announcements []*Announcement        `protobuf:"bytes,1,rep,name=announcements,proto3" json:"announcements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAnnouncementsResponse) Reset() {
+	*x = ListAnnouncementsResponse{}
+	mi := &file_v1_announcement_announcement_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnnouncementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnnouncementsResponse) ProtoMessage() {}
+
+func (x *ListAnnouncementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_announcement_announcement_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnnouncementsResponse.ProtoReflect.Descriptor instead.
+func (*ListAnnouncementsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_announcement_announcement_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListAnnouncementsResponse) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+type DismissRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DismissRequest) Reset() {
+	*x = DismissRequest{}
+	mi := &file_v1_announcement_announcement_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DismissRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissRequest) ProtoMessage() {}
+
+func (x *DismissRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_announcement_announcement_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissRequest.ProtoReflect.Descriptor instead.
+func (*DismissRequest) Descriptor() ([]byte, []int) {
+	return file_v1_announcement_announcement_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DismissRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DismissResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DismissResponse) Reset() {
+	*x = DismissResponse{}
+	mi := &file_v1_announcement_announcement_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DismissResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissResponse) ProtoMessage() {}
+
+func (x *DismissResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_announcement_announcement_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissResponse.ProtoReflect.Descriptor instead.
+func (*DismissResponse) Descriptor() ([]byte, []int) {
+	return file_v1_announcement_announcement_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DismissResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListAllRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActiveOnly    bool                   `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllRequest) Reset() {
+	*x = ListAllRequest{}
+	mi := &file_v1_announcement_announcement_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllRequest) ProtoMessage() {}
+
+func (x *ListAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_announcement_announcement_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllRequest.ProtoReflect.Descriptor instead.
+func (*ListAllRequest) Descriptor() ([]byte, []int) {
+	return file_v1_announcement_announcement_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListAllRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type CreateAnnouncementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Type          AnnouncementType       `protobuf:"varint,2,opt,name=type,proto3,enum=announcement.v1.AnnouncementType" json:"type,omitempty"`
+	Dismissible   bool                   `protobuf:"varint,3,opt,name=dismissible,proto3" json:"dismissible,omitempty"`
+	Roles         []shared.UserRole      `protobuf:"varint,4,rep,packed,name=roles,proto3,enum=shared.v1.UserRole" json:"roles,omitempty"`
+	Contexts      []shared.ContextType   `protobuf:"varint,5,rep,packed,name=contexts,proto3,enum=shared.v1.ContextType" json:"contexts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAnnouncementRequest) Reset() {
+	*x = CreateAnnouncementRequest{}
+	mi := &file_v1_announcement_announcement_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAnnouncementRequest) ProtoMessage() {}
+
+func (x *CreateAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_announcement_announcement_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*CreateAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_v1_announcement_announcement_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateAnnouncementRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateAnnouncementRequest) GetType() AnnouncementType {
+	if x != nil {
+		return x.Type
+	}
+	return AnnouncementType_ANNOUNCEMENT_TYPE_UNSPECIFIED
+}
+
+func (x *CreateAnnouncementRequest) GetDismissible() bool {
+	if x != nil {
+		return x.Dismissible
+	}
+	return false
+}
+
+func (x *CreateAnnouncementRequest) GetRoles() []shared.UserRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *CreateAnnouncementRequest) GetContexts() []shared.ContextType {
+	if x != nil {
+		return x.Contexts
+	}
+	return nil
+}
+
+type SetAnnouncementActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAnnouncementActiveRequest) Reset() {
+	*x = SetAnnouncementActiveRequest{}
+	mi := &file_v1_announcement_announcement_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAnnouncementActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAnnouncementActiveRequest) ProtoMessage() {}
+
+func (x *SetAnnouncementActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_announcement_announcement_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAnnouncementActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetAnnouncementActiveRequest) Descriptor() ([]byte, []int) {
+	return file_v1_announcement_announcement_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetAnnouncementActiveRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_v1_announcement_announcement_proto protoreflect.FileDescriptor
+
+const file_v1_announcement_announcement_proto_rawDesc = "" +
+	"\n" +
+	"\"v1/announcement/announcement.proto\x12\x0fannouncement.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14v1/shared/user.proto\x1a\x1bv1/shared/contexttype.proto\"\x83\x03\n" +
+	"\fAnnouncement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
+	"\x04type\x18\x03 \x01(\x0e2!.announcement.v1.AnnouncementTypeR\x04type\x12 \n" +
+	"\vdismissible\x18\x04 \x01(\bR\vdismissible\x12\x1b\n" +
+	"\tis_active\x18\x05 \x01(\bR\bisActive\x12)\n" +
+	"\x05roles\x18\x06 \x03(\x0e2\x13.shared.v1.UserRoleR\x05roles\x122\n" +
+	"\bcontexts\x18\a \x03(\x0e2\x16.shared.v1.ContextTypeR\bcontexts\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"V\n" +
+	"\x11ListActiveRequest\x125\n" +
+	"\acontext\x18\x01 \x01(\x0e2\x16.shared.v1.ContextTypeH\x00R\acontext\x88\x01\x01B\n" +
+	"\n" +
+	"\b_context\"`\n" +
+	"\x19ListAnnouncementsResponse\x12C\n" +
+	"\rannouncements\x18\x01 \x03(\v2\x1d.announcement.v1.AnnouncementR\rannouncements\" \n" +
+	"\x0eDismissRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"+\n" +
+	"\x0fDismissResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"1\n" +
+	"\x0eListAllRequest\x12\x1f\n" +
+	"\vactive_only\x18\x01 \x01(\bR\n" +
+	"activeOnly\"\xed\x01\n" +
+	"\x19CreateAnnouncementRequest\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x125\n" +
+	"\x04type\x18\x02 \x01(\x0e2!.announcement.v1.AnnouncementTypeR\x04type\x12 \n" +
+	"\vdismissible\x18\x03 \x01(\bR\vdismissible\x12)\n" +
+	"\x05roles\x18\x04 \x03(\x0e2\x13.shared.v1.UserRoleR\x05roles\x122\n" +
+	"\bcontexts\x18\x05 \x03(\x0e2\x16.shared.v1.ContextTypeR\bcontexts\".\n" +
+	"\x1cSetAnnouncementActiveRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id*\x8b\x01\n" +
+	"\x10AnnouncementType\x12!\n" +
+	"\x1dANNOUNCEMENT_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16ANNOUNCEMENT_TYPE_INFO\x10\x01\x12\x1a\n" +
+	"\x16ANNOUNCEMENT_TYPE_WARN\x10\x02\x12\x1c\n" +
+	"\x18ANNOUNCEMENT_TYPE_DANGER\x10\x032\xd4\x04\n" +
+	"\x13AnnouncementService\x12^\n" +
+	"\n" +
+	"ListActive\x12\".announcement.v1.ListActiveRequest\x1a*.announcement.v1.ListAnnouncementsResponse\"\x00\x12N\n" +
+	"\aDismiss\x12\x1f.announcement.v1.DismissRequest\x1a .announcement.v1.DismissResponse\"\x00\x12X\n" +
+	"\aListAll\x12\x1f.announcement.v1.ListAllRequest\x1a*.announcement.v1.ListAnnouncementsResponse\"\x00\x12a\n" +
+	"\x12CreateAnnouncement\x12*.announcement.v1.CreateAnnouncementRequest\x1a\x1d.announcement.v1.Announcement\"\x00\x12f\n" +
+	"\x14ActivateAnnouncement\x12-.announcement.v1.SetAnnouncementActiveRequest\x1a\x1d.announcement.v1.Announcement\"\x00\x12h\n" +
+	"\x16DeactivateAnnouncement\x12-.announcement.v1.SetAnnouncementActiveRequest\x1a\x1d.announcement.v1.Announcement\"\x00BPZNgithub.com/studyguides-com/study-guides-api/api/v1/announcement;announcementv1b\x06proto3"
+
+var (
+	file_v1_announcement_announcement_proto_rawDescOnce sync.Once
+	file_v1_announcement_announcement_proto_rawDescData []byte
+)
+
+func file_v1_announcement_announcement_proto_rawDescGZIP() []byte {
+	file_v1_announcement_announcement_proto_rawDescOnce.Do(func() {
+		file_v1_announcement_announcement_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_announcement_announcement_proto_rawDesc), len(file_v1_announcement_announcement_proto_rawDesc)))
+	})
+	return file_v1_announcement_announcement_proto_rawDescData
+}
+
+var file_v1_announcement_announcement_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_v1_announcement_announcement_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_v1_announcement_announcement_proto_goTypes = []any{
+	(AnnouncementType)(0),                // 0: announcement.v1.AnnouncementType
+	(*Announcement)(nil),                 // 1: announcement.v1.Announcement
+	(*ListActiveRequest)(nil),            // 2: announcement.v1.ListActiveRequest
+	(*ListAnnouncementsResponse)(nil),    // 3: announcement.v1.ListAnnouncementsResponse
+	(*DismissRequest)(nil),               // 4: announcement.v1.DismissRequest
+	(*DismissResponse)(nil),              // 5: announcement.v1.DismissResponse
+	(*ListAllRequest)(nil),               // 6: announcement.v1.ListAllRequest
+	(*CreateAnnouncementRequest)(nil),    // 7: announcement.v1.CreateAnnouncementRequest
+	(*SetAnnouncementActiveRequest)(nil), // 8: announcement.v1.SetAnnouncementActiveRequest
+	(shared.UserRole)(0),                 // 9: shared.v1.UserRole
+	(shared.ContextType)(0),              // 10: shared.v1.ContextType
+	(*timestamppb.Timestamp)(nil),        // 11: google.protobuf.Timestamp
+}
+var file_v1_announcement_announcement_proto_depIdxs = []int32{
+	0,  // 0: announcement.v1.Announcement.type:type_name -> announcement.v1.AnnouncementType
+	9,  // 1: announcement.v1.Announcement.roles:type_name -> shared.v1.UserRole
+	10, // 2: announcement.v1.Announcement.contexts:type_name -> shared.v1.ContextType
+	11, // 3: announcement.v1.Announcement.created_at:type_name -> google.protobuf.Timestamp
+	11, // 4: announcement.v1.Announcement.updated_at:type_name -> google.protobuf.Timestamp
+	10, // 5: announcement.v1.ListActiveRequest.context:type_name -> shared.v1.ContextType
+	1,  // 6: announcement.v1.ListAnnouncementsResponse.announcements:type_name -> announcement.v1.Announcement
+	0,  // 7: announcement.v1.CreateAnnouncementRequest.type:type_name -> announcement.v1.AnnouncementType
+	9,  // 8: announcement.v1.CreateAnnouncementRequest.roles:type_name -> shared.v1.UserRole
+	10, // 9: announcement.v1.CreateAnnouncementRequest.contexts:type_name -> shared.v1.ContextType
+	2,  // 10: announcement.v1.AnnouncementService.ListActive:input_type -> announcement.v1.ListActiveRequest
+	4,  // 11: announcement.v1.AnnouncementService.Dismiss:input_type -> announcement.v1.DismissRequest
+	6,  // 12: announcement.v1.AnnouncementService.ListAll:input_type -> announcement.v1.ListAllRequest
+	7,  // 13: announcement.v1.AnnouncementService.CreateAnnouncement:input_type -> announcement.v1.CreateAnnouncementRequest
+	8,  // 14: announcement.v1.AnnouncementService.ActivateAnnouncement:input_type -> announcement.v1.SetAnnouncementActiveRequest
+	8,  // 15: announcement.v1.AnnouncementService.DeactivateAnnouncement:input_type -> announcement.v1.SetAnnouncementActiveRequest
+	3,  // 16: announcement.v1.AnnouncementService.ListActive:output_type -> announcement.v1.ListAnnouncementsResponse
+	5,  // 17: announcement.v1.AnnouncementService.Dismiss:output_type -> announcement.v1.DismissResponse
+	3,  // 18: announcement.v1.AnnouncementService.ListAll:output_type -> announcement.v1.ListAnnouncementsResponse
+	1,  // 19: announcement.v1.AnnouncementService.CreateAnnouncement:output_type -> announcement.v1.Announcement
+	1,  // 20: announcement.v1.AnnouncementService.ActivateAnnouncement:output_type -> announcement.v1.Announcement
+	1,  // 21: announcement.v1.AnnouncementService.DeactivateAnnouncement:output_type -> announcement.v1.Announcement
+	16, // [16:22] is the sub-list for method output_type
+	10, // [10:16] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_v1_announcement_announcement_proto_init() }
+func file_v1_announcement_announcement_proto_init() {
+	if File_v1_announcement_announcement_proto != nil {
+		return
+	}
+	file_v1_announcement_announcement_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_announcement_announcement_proto_rawDesc), len(file_v1_announcement_announcement_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_announcement_announcement_proto_goTypes,
+		DependencyIndexes: file_v1_announcement_announcement_proto_depIdxs,
+		EnumInfos:         file_v1_announcement_announcement_proto_enumTypes,
+		MessageInfos:      file_v1_announcement_announcement_proto_msgTypes,
+	}.Build()
+	File_v1_announcement_announcement_proto = out.File
+	file_v1_announcement_announcement_proto_goTypes = nil
+	file_v1_announcement_announcement_proto_depIdxs = nil
+}
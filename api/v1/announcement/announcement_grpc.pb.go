@@ -0,0 +1,313 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: v1/announcement/announcement.proto
+
+package announcementv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AnnouncementService_ListActive_FullMethodName             = "/announcement.v1.AnnouncementService/ListActive"
+	AnnouncementService_Dismiss_FullMethodName                = "/announcement.v1.AnnouncementService/Dismiss"
+	AnnouncementService_ListAll_FullMethodName                = "/announcement.v1.AnnouncementService/ListAll"
+	AnnouncementService_CreateAnnouncement_FullMethodName     = "/announcement.v1.AnnouncementService/CreateAnnouncement"
+	AnnouncementService_ActivateAnnouncement_FullMethodName   = "/announcement.v1.AnnouncementService/ActivateAnnouncement"
+	AnnouncementService_DeactivateAnnouncement_FullMethodName = "/announcement.v1.AnnouncementService/DeactivateAnnouncement"
+)
+
+// AnnouncementServiceClient is the client API for AnnouncementService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AnnouncementServiceClient interface {
+	ListActive(ctx context.Context, in *ListActiveRequest, opts ...grpc.CallOption) (*ListAnnouncementsResponse, error)
+	Dismiss(ctx context.Context, in *DismissRequest, opts ...grpc.CallOption) (*DismissResponse, error)
+	// Admin only
+	ListAll(ctx context.Context, in *ListAllRequest, opts ...grpc.CallOption) (*ListAnnouncementsResponse, error)
+	CreateAnnouncement(ctx context.Context, in *CreateAnnouncementRequest, opts ...grpc.CallOption) (*Announcement, error)
+	ActivateAnnouncement(ctx context.Context, in *SetAnnouncementActiveRequest, opts ...grpc.CallOption) (*Announcement, error)
+	DeactivateAnnouncement(ctx context.Context, in *SetAnnouncementActiveRequest, opts ...grpc.CallOption) (*Announcement, error)
+}
+
+type announcementServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAnnouncementServiceClient(cc grpc.ClientConnInterface) AnnouncementServiceClient {
+	return &announcementServiceClient{cc}
+}
+
+func (c *announcementServiceClient) ListActive(ctx context.Context, in *ListActiveRequest, opts ...grpc.CallOption) (*ListAnnouncementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAnnouncementsResponse)
+	err := c.cc.Invoke(ctx, AnnouncementService_ListActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *announcementServiceClient) Dismiss(ctx context.Context, in *DismissRequest, opts ...grpc.CallOption) (*DismissResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DismissResponse)
+	err := c.cc.Invoke(ctx, AnnouncementService_Dismiss_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *announcementServiceClient) ListAll(ctx context.Context, in *ListAllRequest, opts ...grpc.CallOption) (*ListAnnouncementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAnnouncementsResponse)
+	err := c.cc.Invoke(ctx, AnnouncementService_ListAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *announcementServiceClient) CreateAnnouncement(ctx context.Context, in *CreateAnnouncementRequest, opts ...grpc.CallOption) (*Announcement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Announcement)
+	err := c.cc.Invoke(ctx, AnnouncementService_CreateAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *announcementServiceClient) ActivateAnnouncement(ctx context.Context, in *SetAnnouncementActiveRequest, opts ...grpc.CallOption) (*Announcement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Announcement)
+	err := c.cc.Invoke(ctx, AnnouncementService_ActivateAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *announcementServiceClient) DeactivateAnnouncement(ctx context.Context, in *SetAnnouncementActiveRequest, opts ...grpc.CallOption) (*Announcement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Announcement)
+	err := c.cc.Invoke(ctx, AnnouncementService_DeactivateAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AnnouncementServiceServer is the server API for AnnouncementService service.
+// All implementations must embed UnimplementedAnnouncementServiceServer
+// for forward compatibility.
+type AnnouncementServiceServer interface {
+	ListActive(context.Context, *ListActiveRequest) (*ListAnnouncementsResponse, error)
+	Dismiss(context.Context, *DismissRequest) (*DismissResponse, error)
+	// Admin only
+	ListAll(context.Context, *ListAllRequest) (*ListAnnouncementsResponse, error)
+	CreateAnnouncement(context.Context, *CreateAnnouncementRequest) (*Announcement, error)
+	ActivateAnnouncement(context.Context, *SetAnnouncementActiveRequest) (*Announcement, error)
+	DeactivateAnnouncement(context.Context, *SetAnnouncementActiveRequest) (*Announcement, error)
+	mustEmbedUnimplementedAnnouncementServiceServer()
+}
+
+// UnimplementedAnnouncementServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAnnouncementServiceServer struct{}
+
+func (UnimplementedAnnouncementServiceServer) ListActive(context.Context, *ListActiveRequest) (*ListAnnouncementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListActive not implemented")
+}
+func (UnimplementedAnnouncementServiceServer) Dismiss(context.Context, *DismissRequest) (*DismissResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Dismiss not implemented")
+}
+func (UnimplementedAnnouncementServiceServer) ListAll(context.Context, *ListAllRequest) (*ListAnnouncementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAll not implemented")
+}
+func (UnimplementedAnnouncementServiceServer) CreateAnnouncement(context.Context, *CreateAnnouncementRequest) (*Announcement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAnnouncement not implemented")
+}
+func (UnimplementedAnnouncementServiceServer) ActivateAnnouncement(context.Context, *SetAnnouncementActiveRequest) (*Announcement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateAnnouncement not implemented")
+}
+func (UnimplementedAnnouncementServiceServer) DeactivateAnnouncement(context.Context, *SetAnnouncementActiveRequest) (*Announcement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateAnnouncement not implemented")
+}
+func (UnimplementedAnnouncementServiceServer) mustEmbedUnimplementedAnnouncementServiceServer() {}
+func (UnimplementedAnnouncementServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeAnnouncementServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AnnouncementServiceServer will
+// result in compilation errors.
+type UnsafeAnnouncementServiceServer interface {
+	mustEmbedUnimplementedAnnouncementServiceServer()
+}
+
+func RegisterAnnouncementServiceServer(s grpc.ServiceRegistrar, srv AnnouncementServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAnnouncementServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AnnouncementService_ServiceDesc, srv)
+}
+
+func _AnnouncementService_ListActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementServiceServer).ListActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementService_ListActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementServiceServer).ListActive(ctx, req.(*ListActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnouncementService_Dismiss_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementServiceServer).Dismiss(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementService_Dismiss_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementServiceServer).Dismiss(ctx, req.(*DismissRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnouncementService_ListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementServiceServer).ListAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementService_ListAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementServiceServer).ListAll(ctx, req.(*ListAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnouncementService_CreateAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementServiceServer).CreateAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementService_CreateAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementServiceServer).CreateAnnouncement(ctx, req.(*CreateAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnouncementService_ActivateAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAnnouncementActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementServiceServer).ActivateAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementService_ActivateAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementServiceServer).ActivateAnnouncement(ctx, req.(*SetAnnouncementActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnnouncementService_DeactivateAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAnnouncementActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncementServiceServer).DeactivateAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnnouncementService_DeactivateAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncementServiceServer).DeactivateAnnouncement(ctx, req.(*SetAnnouncementActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AnnouncementService_ServiceDesc is the grpc.ServiceDesc for AnnouncementService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AnnouncementService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "announcement.v1.AnnouncementService",
+	HandlerType: (*AnnouncementServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListActive",
+			Handler:    _AnnouncementService_ListActive_Handler,
+		},
+		{
+			MethodName: "Dismiss",
+			Handler:    _AnnouncementService_Dismiss_Handler,
+		},
+		{
+			MethodName: "ListAll",
+			Handler:    _AnnouncementService_ListAll_Handler,
+		},
+		{
+			MethodName: "CreateAnnouncement",
+			Handler:    _AnnouncementService_CreateAnnouncement_Handler,
+		},
+		{
+			MethodName: "ActivateAnnouncement",
+			Handler:    _AnnouncementService_ActivateAnnouncement_Handler,
+		},
+		{
+			MethodName: "DeactivateAnnouncement",
+			Handler:    _AnnouncementService_DeactivateAnnouncement_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/announcement/announcement.proto",
+}
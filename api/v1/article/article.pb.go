@@ -0,0 +1,1009 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: v1/article/article.proto
+
+package articlev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ArticleStatus int32
+
+const (
+	ArticleStatus_ARTICLE_STATUS_UNSPECIFIED ArticleStatus = 0
+	ArticleStatus_ARTICLE_STATUS_DRAFT       ArticleStatus = 1
+	ArticleStatus_ARTICLE_STATUS_PUBLISHED   ArticleStatus = 2
+)
+
+// Enum value maps for ArticleStatus.
+var (
+	ArticleStatus_name = map[int32]string{
+		0: "ARTICLE_STATUS_UNSPECIFIED",
+		1: "ARTICLE_STATUS_DRAFT",
+		2: "ARTICLE_STATUS_PUBLISHED",
+	}
+	ArticleStatus_value = map[string]int32{
+		"ARTICLE_STATUS_UNSPECIFIED": 0,
+		"ARTICLE_STATUS_DRAFT":       1,
+		"ARTICLE_STATUS_PUBLISHED":   2,
+	}
+)
+
+func (x ArticleStatus) Enum() *ArticleStatus {
+	p := new(ArticleStatus)
+	*p = x
+	return p
+}
+
+func (x ArticleStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArticleStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_article_article_proto_enumTypes[0].Descriptor()
+}
+
+func (ArticleStatus) Type() protoreflect.EnumType {
+	return &file_v1_article_article_proto_enumTypes[0]
+}
+
+func (x ArticleStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArticleStatus.Descriptor instead.
+func (ArticleStatus) EnumDescriptor() ([]byte, []int) {
+	return file_v1_article_article_proto_rawDescGZIP(), []int{0}
+}
+
+type Article struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"` // empty in lists, get the article for its body
+	Slug          string                 `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Category      string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Status        ArticleStatus          `protobuf:"varint,8,opt,name=status,proto3,enum=article.v1.ArticleStatus" json:"status,omitempty"`
+	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=published_at,json=publishedAt,proto3,oneof" json:"published_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Article) Reset() {
+	*x = Article{}
+	mi := &file_v1_article_article_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Article) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Article) ProtoMessage() {}
+
+func (x *Article) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_article_article_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Article.ProtoReflect.Descriptor instead.
+func (*Article) Descriptor() ([]byte, []int) {
+	return file_v1_article_article_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Article) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Article) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Article) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Article) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Article) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Article) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Article) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Article) GetStatus() ArticleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ArticleStatus_ARTICLE_STATUS_UNSPECIFIED
+}
+
+func (x *Article) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *Article) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Article) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ArticleCategory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ArticleCount  int32                  `protobuf:"varint,2,opt,name=article_count,json=articleCount,proto3" json:"article_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArticleCategory) Reset() {
+	*x = ArticleCategory{}
+	mi := &file_v1_article_article_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArticleCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArticleCategory) ProtoMessage() {}
+
+func (x *ArticleCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_article_article_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArticleCategory.ProtoReflect.Descriptor instead.
+func (*ArticleCategory) Descriptor() ([]byte, []int) {
+	return file_v1_article_article_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ArticleCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ArticleCategory) GetArticleCount() int32 {
+	if x != nil {
+		return x.ArticleCount
+	}
+	return 0
+}
+
+type ListArticlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *string                `protobuf:"bytes,1,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArticlesRequest) Reset() {
+	*x = ListArticlesRequest{}
+	mi := &file_v1_article_article_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArticlesRequest) ProtoMessage() {}
+
+func (x *ListArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_article_article_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArticlesRequest.ProtoReflect.Descriptor instead.
+func (*ListArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_article_article_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListArticlesRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *ListArticlesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListArticlesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListArticlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Articles      []*Article             `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArticlesResponse) Reset() {
+	*x = ListArticlesResponse{}
+	mi := &file_v1_article_article_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArticlesResponse) ProtoMessage() {}
+
+func (x *ListArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_article_article_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArticlesResponse.ProtoReflect.Descriptor instead.
+func (*ListArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_article_article_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListArticlesResponse) GetArticles() []*Article {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+func (x *ListArticlesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArticleRequest) Reset() {
+	*x = GetArticleRequest{}
+	mi := &file_v1_article_article_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArticleRequest) ProtoMessage() {}
+
+func (x *GetArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_article_article_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArticleRequest.ProtoReflect.Descriptor instead.
+func (*GetArticleRequest) Descriptor() ([]byte, []int) {
+	return file_v1_article_article_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetArticleRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_v1_article_article_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_article_article_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_article_article_proto_rawDescGZIP(), []int{5}
+}
+
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*ArticleCategory     `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_v1_article_article_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_article_article_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_article_article_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*ArticleCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type ListAllArticlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ArticleStatus          `protobuf:"varint,1,opt,name=status,proto3,enum=article.v1.ArticleStatus" json:"status,omitempty"` // unspecified lists drafts and published articles
+	Category      *string                `protobuf:"bytes,2,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllArticlesRequest) Reset() {
+	*x = ListAllArticlesRequest{}
+	mi := &file_v1_article_article_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllArticlesRequest) ProtoMessage() {}
+
+func (x *ListAllArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_article_article_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllArticlesRequest.ProtoReflect.Descriptor instead.
+func (*ListAllArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_article_article_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListAllArticlesRequest) GetStatus() ArticleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ArticleStatus_ARTICLE_STATUS_UNSPECIFIED
+}
+
+func (x *ListAllArticlesRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *ListAllArticlesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAllArticlesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type CreateArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Slug          *string                `protobuf:"bytes,6,opt,name=slug,proto3,oneof" json:"slug,omitempty"`  // generated from the title when unset
+	Publish       bool                   `protobuf:"varint,7,opt,name=publish,proto3" json:"publish,omitempty"` // publish straight away instead of saving a draft
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateArticleRequest) Reset() {
+	*x = CreateArticleRequest{}
+	mi := &file_v1_article_article_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateArticleRequest) ProtoMessage() {}
+
+func (x *CreateArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_article_article_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateArticleRequest.ProtoReflect.Descriptor instead.
+func (*CreateArticleRequest) Descriptor() ([]byte, []int) {
+	return file_v1_article_article_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateArticleRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateArticleRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateArticleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateArticleRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateArticleRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CreateArticleRequest) GetSlug() string {
+	if x != nil && x.Slug != nil {
+		return *x.Slug
+	}
+	return ""
+}
+
+func (x *CreateArticleRequest) GetPublish() bool {
+	if x != nil {
+		return x.Publish
+	}
+	return false
+}
+
+type UpdateArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Content       *string                `protobuf:"bytes,3,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Category      *string                `protobuf:"bytes,5,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	ImageUrl      *string                `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	Slug          *string                `protobuf:"bytes,7,opt,name=slug,proto3,oneof" json:"slug,omitempty"` // changing the slug changes the article's URL
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateArticleRequest) Reset() {
+	*x = UpdateArticleRequest{}
+	mi := &file_v1_article_article_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateArticleRequest) ProtoMessage() {}
+
+func (x *UpdateArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_article_article_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateArticleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateArticleRequest) Descriptor() ([]byte, []int) {
+	return file_v1_article_article_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateArticleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateArticleRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateArticleRequest) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+func (x *UpdateArticleRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateArticleRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *UpdateArticleRequest) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+func (x *UpdateArticleRequest) GetSlug() string {
+	if x != nil && x.Slug != nil {
+		return *x.Slug
+	}
+	return ""
+}
+
+type ArticleIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArticleIdRequest) Reset() {
+	*x = ArticleIdRequest{}
+	mi := &file_v1_article_article_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArticleIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArticleIdRequest) ProtoMessage() {}
+
+func (x *ArticleIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_article_article_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArticleIdRequest.ProtoReflect.Descriptor instead.
+func (*ArticleIdRequest) Descriptor() ([]byte, []int) {
+	return file_v1_article_article_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ArticleIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteArticleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteArticleResponse) Reset() {
+	*x = DeleteArticleResponse{}
+	mi := &file_v1_article_article_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteArticleResponse) ProtoMessage() {}
+
+func (x *DeleteArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_article_article_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteArticleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteArticleResponse) Descriptor() ([]byte, []int) {
+	return file_v1_article_article_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteArticleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+var File_v1_article_article_proto protoreflect.FileDescriptor
+
+const file_v1_article_article_proto_rawDesc = "" +
+	"\n" +
+	"\x18v1/article/article.proto\x12\n" +
+	"article.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb6\x03\n" +
+	"\aArticle\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x12\n" +
+	"\x04slug\x18\x04 \x01(\tR\x04slug\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategory\x12\x1b\n" +
+	"\timage_url\x18\a \x01(\tR\bimageUrl\x121\n" +
+	"\x06status\x18\b \x01(\x0e2\x19.article.v1.ArticleStatusR\x06status\x12B\n" +
+	"\fpublished_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x00R\vpublishedAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0f\n" +
+	"\r_published_at\"J\n" +
+	"\x0fArticleCategory\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\rarticle_count\x18\x02 \x01(\x05R\farticleCount\"\x7f\n" +
+	"\x13ListArticlesRequest\x12\x1f\n" +
+	"\bcategory\x18\x01 \x01(\tH\x00R\bcategory\x88\x01\x01\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageTokenB\v\n" +
+	"\t_category\"o\n" +
+	"\x14ListArticlesResponse\x12/\n" +
+	"\barticles\x18\x01 \x03(\v2\x13.article.v1.ArticleR\barticles\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"'\n" +
+	"\x11GetArticleRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\"\x17\n" +
+	"\x15ListCategoriesRequest\"U\n" +
+	"\x16ListCategoriesResponse\x12;\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x1b.article.v1.ArticleCategoryR\n" +
+	"categories\"\xa7\x01\n" +
+	"\x16ListAllArticlesRequest\x121\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x19.article.v1.ArticleStatusR\x06status\x12\x1f\n" +
+	"\bcategory\x18\x02 \x01(\tH\x00R\bcategory\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offsetB\v\n" +
+	"\t_category\"\xdd\x01\n" +
+	"\x14CreateArticleRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12\x17\n" +
+	"\x04slug\x18\x06 \x01(\tH\x00R\x04slug\x88\x01\x01\x12\x18\n" +
+	"\apublish\x18\a \x01(\bR\apublishB\a\n" +
+	"\x05_slug\"\xad\x02\n" +
+	"\x14UpdateArticleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x1d\n" +
+	"\acontent\x18\x03 \x01(\tH\x01R\acontent\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x02R\vdescription\x88\x01\x01\x12\x1f\n" +
+	"\bcategory\x18\x05 \x01(\tH\x03R\bcategory\x88\x01\x01\x12 \n" +
+	"\timage_url\x18\x06 \x01(\tH\x04R\bimageUrl\x88\x01\x01\x12\x17\n" +
+	"\x04slug\x18\a \x01(\tH\x05R\x04slug\x88\x01\x01B\b\n" +
+	"\x06_titleB\n" +
+	"\n" +
+	"\b_contentB\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_categoryB\f\n" +
+	"\n" +
+	"_image_urlB\a\n" +
+	"\x05_slug\"\"\n" +
+	"\x10ArticleIdRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
+	"\x15DeleteArticleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess*g\n" +
+	"\rArticleStatus\x12\x1e\n" +
+	"\x1aARTICLE_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14ARTICLE_STATUS_DRAFT\x10\x01\x12\x1c\n" +
+	"\x18ARTICLE_STATUS_PUBLISHED\x10\x022\xd7\x05\n" +
+	"\x0eArticleService\x12S\n" +
+	"\fListArticles\x12\x1f.article.v1.ListArticlesRequest\x1a .article.v1.ListArticlesResponse\"\x00\x12B\n" +
+	"\n" +
+	"GetArticle\x12\x1d.article.v1.GetArticleRequest\x1a\x13.article.v1.Article\"\x00\x12Y\n" +
+	"\x0eListCategories\x12!.article.v1.ListCategoriesRequest\x1a\".article.v1.ListCategoriesResponse\"\x00\x12Y\n" +
+	"\x0fListAllArticles\x12\".article.v1.ListAllArticlesRequest\x1a .article.v1.ListArticlesResponse\"\x00\x12H\n" +
+	"\rCreateArticle\x12 .article.v1.CreateArticleRequest\x1a\x13.article.v1.Article\"\x00\x12H\n" +
+	"\rUpdateArticle\x12 .article.v1.UpdateArticleRequest\x1a\x13.article.v1.Article\"\x00\x12E\n" +
+	"\x0ePublishArticle\x12\x1c.article.v1.ArticleIdRequest\x1a\x13.article.v1.Article\"\x00\x12G\n" +
+	"\x10UnpublishArticle\x12\x1c.article.v1.ArticleIdRequest\x1a\x13.article.v1.Article\"\x00\x12R\n" +
+	"\rDeleteArticle\x12\x1c.article.v1.ArticleIdRequest\x1a!.article.v1.DeleteArticleResponse\"\x00BFZDgithub.com/studyguides-com/study-guides-api/api/v1/article;articlev1b\x06proto3"
+
+var (
+	file_v1_article_article_proto_rawDescOnce sync.Once
+	file_v1_article_article_proto_rawDescData []byte
+)
+
+func file_v1_article_article_proto_rawDescGZIP() []byte {
+	file_v1_article_article_proto_rawDescOnce.Do(func() {
+		file_v1_article_article_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_article_article_proto_rawDesc), len(file_v1_article_article_proto_rawDesc)))
+	})
+	return file_v1_article_article_proto_rawDescData
+}
+
+var file_v1_article_article_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_v1_article_article_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_v1_article_article_proto_goTypes = []any{
+	(ArticleStatus)(0),             // 0: article.v1.ArticleStatus
+	(*Article)(nil),                // 1: article.v1.Article
+	(*ArticleCategory)(nil),        // 2: article.v1.ArticleCategory
+	(*ListArticlesRequest)(nil),    // 3: article.v1.ListArticlesRequest
+	(*ListArticlesResponse)(nil),   // 4: article.v1.ListArticlesResponse
+	(*GetArticleRequest)(nil),      // 5: article.v1.GetArticleRequest
+	(*ListCategoriesRequest)(nil),  // 6: article.v1.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil), // 7: article.v1.ListCategoriesResponse
+	(*ListAllArticlesRequest)(nil), // 8: article.v1.ListAllArticlesRequest
+	(*CreateArticleRequest)(nil),   // 9: article.v1.CreateArticleRequest
+	(*UpdateArticleRequest)(nil),   // 10: article.v1.UpdateArticleRequest
+	(*ArticleIdRequest)(nil),       // 11: article.v1.ArticleIdRequest
+	(*DeleteArticleResponse)(nil),  // 12: article.v1.DeleteArticleResponse
+	(*timestamppb.Timestamp)(nil),  // 13: google.protobuf.Timestamp
+}
+var file_v1_article_article_proto_depIdxs = []int32{
+	0,  // 0: article.v1.Article.status:type_name -> article.v1.ArticleStatus
+	13, // 1: article.v1.Article.published_at:type_name -> google.protobuf.Timestamp
+	13, // 2: article.v1.Article.created_at:type_name -> google.protobuf.Timestamp
+	13, // 3: article.v1.Article.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: article.v1.ListArticlesResponse.articles:type_name -> article.v1.Article
+	2,  // 5: article.v1.ListCategoriesResponse.categories:type_name -> article.v1.ArticleCategory
+	0,  // 6: article.v1.ListAllArticlesRequest.status:type_name -> article.v1.ArticleStatus
+	3,  // 7: article.v1.ArticleService.ListArticles:input_type -> article.v1.ListArticlesRequest
+	5,  // 8: article.v1.ArticleService.GetArticle:input_type -> article.v1.GetArticleRequest
+	6,  // 9: article.v1.ArticleService.ListCategories:input_type -> article.v1.ListCategoriesRequest
+	8,  // 10: article.v1.ArticleService.ListAllArticles:input_type -> article.v1.ListAllArticlesRequest
+	9,  // 11: article.v1.ArticleService.CreateArticle:input_type -> article.v1.CreateArticleRequest
+	10, // 12: article.v1.ArticleService.UpdateArticle:input_type -> article.v1.UpdateArticleRequest
+	11, // 13: article.v1.ArticleService.PublishArticle:input_type -> article.v1.ArticleIdRequest
+	11, // 14: article.v1.ArticleService.UnpublishArticle:input_type -> article.v1.ArticleIdRequest
+	11, // 15: article.v1.ArticleService.DeleteArticle:input_type -> article.v1.ArticleIdRequest
+	4,  // 16: article.v1.ArticleService.ListArticles:output_type -> article.v1.ListArticlesResponse
+	1,  // 17: article.v1.ArticleService.GetArticle:output_type -> article.v1.Article
+	7,  // 18: article.v1.ArticleService.ListCategories:output_type -> article.v1.ListCategoriesResponse
+	4,  // 19: article.v1.ArticleService.ListAllArticles:output_type -> article.v1.ListArticlesResponse
+	1,  // 20: article.v1.ArticleService.CreateArticle:output_type -> article.v1.Article
+	1,  // 21: article.v1.ArticleService.UpdateArticle:output_type -> article.v1.Article
+	1,  // 22: article.v1.ArticleService.PublishArticle:output_type -> article.v1.Article
+	1,  // 23: article.v1.ArticleService.UnpublishArticle:output_type -> article.v1.Article
+	12, // 24: article.v1.ArticleService.DeleteArticle:output_type -> article.v1.DeleteArticleResponse
+	16, // [16:25] is the sub-list for method output_type
+	7,  // [7:16] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_v1_article_article_proto_init() }
+func file_v1_article_article_proto_init() {
+	if File_v1_article_article_proto != nil {
+		return
+	}
+	file_v1_article_article_proto_msgTypes[0].OneofWrappers = []any{}
+	file_v1_article_article_proto_msgTypes[2].OneofWrappers = []any{}
+	file_v1_article_article_proto_msgTypes[7].OneofWrappers = []any{}
+	file_v1_article_article_proto_msgTypes[8].OneofWrappers = []any{}
+	file_v1_article_article_proto_msgTypes[9].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_article_article_proto_rawDesc), len(file_v1_article_article_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_article_article_proto_goTypes,
+		DependencyIndexes: file_v1_article_article_proto_depIdxs,
+		EnumInfos:         file_v1_article_article_proto_enumTypes,
+		MessageInfos:      file_v1_article_article_proto_msgTypes,
+	}.Build()
+	File_v1_article_article_proto = out.File
+	file_v1_article_article_proto_goTypes = nil
+	file_v1_article_article_proto_depIdxs = nil
+}
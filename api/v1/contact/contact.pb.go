@@ -0,0 +1,1042 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: v1/contact/contact.proto
+
+package contactv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ContactStatus int32
+
+const (
+	ContactStatus_CONTACT_STATUS_UNSPECIFIED ContactStatus = 0
+	ContactStatus_CONTACT_STATUS_PENDING     ContactStatus = 1
+	ContactStatus_CONTACT_STATUS_RESOLVED    ContactStatus = 2
+	ContactStatus_CONTACT_STATUS_CLOSED      ContactStatus = 3
+)
+
+// Enum value maps for ContactStatus.
+var (
+	ContactStatus_name = map[int32]string{
+		0: "CONTACT_STATUS_UNSPECIFIED",
+		1: "CONTACT_STATUS_PENDING",
+		2: "CONTACT_STATUS_RESOLVED",
+		3: "CONTACT_STATUS_CLOSED",
+	}
+	ContactStatus_value = map[string]int32{
+		"CONTACT_STATUS_UNSPECIFIED": 0,
+		"CONTACT_STATUS_PENDING":     1,
+		"CONTACT_STATUS_RESOLVED":    2,
+		"CONTACT_STATUS_CLOSED":      3,
+	}
+)
+
+func (x ContactStatus) Enum() *ContactStatus {
+	p := new(ContactStatus)
+	*p = x
+	return p
+}
+
+func (x ContactStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContactStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_contact_contact_proto_enumTypes[0].Descriptor()
+}
+
+func (ContactStatus) Type() protoreflect.EnumType {
+	return &file_v1_contact_contact_proto_enumTypes[0]
+}
+
+func (x ContactStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContactStatus.Descriptor instead.
+func (ContactStatus) EnumDescriptor() ([]byte, []int) {
+	return file_v1_contact_contact_proto_rawDescGZIP(), []int{0}
+}
+
+type ContactEventType int32
+
+const (
+	ContactEventType_CONTACT_EVENT_TYPE_UNSPECIFIED    ContactEventType = 0
+	ContactEventType_CONTACT_EVENT_TYPE_SUBMITTED      ContactEventType = 1
+	ContactEventType_CONTACT_EVENT_TYPE_ASSIGNED       ContactEventType = 2
+	ContactEventType_CONTACT_EVENT_TYPE_REPLIED        ContactEventType = 3
+	ContactEventType_CONTACT_EVENT_TYPE_STATUS_CHANGED ContactEventType = 4
+)
+
+// Enum value maps for ContactEventType.
+var (
+	ContactEventType_name = map[int32]string{
+		0: "CONTACT_EVENT_TYPE_UNSPECIFIED",
+		1: "CONTACT_EVENT_TYPE_SUBMITTED",
+		2: "CONTACT_EVENT_TYPE_ASSIGNED",
+		3: "CONTACT_EVENT_TYPE_REPLIED",
+		4: "CONTACT_EVENT_TYPE_STATUS_CHANGED",
+	}
+	ContactEventType_value = map[string]int32{
+		"CONTACT_EVENT_TYPE_UNSPECIFIED":    0,
+		"CONTACT_EVENT_TYPE_SUBMITTED":      1,
+		"CONTACT_EVENT_TYPE_ASSIGNED":       2,
+		"CONTACT_EVENT_TYPE_REPLIED":        3,
+		"CONTACT_EVENT_TYPE_STATUS_CHANGED": 4,
+	}
+)
+
+func (x ContactEventType) Enum() *ContactEventType {
+	p := new(ContactEventType)
+	*p = x
+	return p
+}
+
+func (x ContactEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContactEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_contact_contact_proto_enumTypes[1].Descriptor()
+}
+
+func (ContactEventType) Type() protoreflect.EnumType {
+	return &file_v1_contact_contact_proto_enumTypes[1]
+}
+
+func (x ContactEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContactEventType.Descriptor instead.
+func (ContactEventType) EnumDescriptor() ([]byte, []int) {
+	return file_v1_contact_contact_proto_rawDescGZIP(), []int{1}
+}
+
+type Contact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         *string                `protobuf:"bytes,4,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Status        ContactStatus          `protobuf:"varint,6,opt,name=status,proto3,enum=contact.v1.ContactStatus" json:"status,omitempty"`
+	UserId        *string                `protobuf:"bytes,7,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	AssignedTo    *string                `protobuf:"bytes,8,opt,name=assigned_to,json=assignedTo,proto3,oneof" json:"assigned_to,omitempty"`
+	Spam          bool                   `protobuf:"varint,9,opt,name=spam,proto3" json:"spam,omitempty"`
+	SpamReasons   []string               `protobuf:"bytes,10,rep,name=spam_reasons,json=spamReasons,proto3" json:"spam_reasons,omitempty"` // heuristics that matched, set on some contacts that aren't spam too
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Contact) Reset() {
+	*x = Contact{}
+	mi := &file_v1_contact_contact_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Contact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contact) ProtoMessage() {}
+
+func (x *Contact) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contact_contact_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contact.ProtoReflect.Descriptor instead.
+func (*Contact) Descriptor() ([]byte, []int) {
+	return file_v1_contact_contact_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Contact) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Contact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Contact) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Contact) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *Contact) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Contact) GetStatus() ContactStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ContactStatus_CONTACT_STATUS_UNSPECIFIED
+}
+
+func (x *Contact) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *Contact) GetAssignedTo() string {
+	if x != nil && x.AssignedTo != nil {
+		return *x.AssignedTo
+	}
+	return ""
+}
+
+func (x *Contact) GetSpam() bool {
+	if x != nil {
+		return x.Spam
+	}
+	return false
+}
+
+func (x *Contact) GetSpamReasons() []string {
+	if x != nil {
+		return x.SpamReasons
+	}
+	return nil
+}
+
+func (x *Contact) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Contact) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ContactEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContactId     string                 `protobuf:"bytes,2,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	Type          ContactEventType       `protobuf:"varint,3,opt,name=type,proto3,enum=contact.v1.ContactEventType" json:"type,omitempty"`
+	ActorId       *string                `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"` // unset for the submitter
+	FromStatus    ContactStatus          `protobuf:"varint,5,opt,name=from_status,json=fromStatus,proto3,enum=contact.v1.ContactStatus" json:"from_status,omitempty"`
+	ToStatus      ContactStatus          `protobuf:"varint,6,opt,name=to_status,json=toStatus,proto3,enum=contact.v1.ContactStatus" json:"to_status,omitempty"`
+	Body          *string                `protobuf:"bytes,7,opt,name=body,proto3,oneof" json:"body,omitempty"` // reply text, status note, or the assignee for ASSIGNED
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContactEvent) Reset() {
+	*x = ContactEvent{}
+	mi := &file_v1_contact_contact_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContactEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactEvent) ProtoMessage() {}
+
+func (x *ContactEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contact_contact_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactEvent.ProtoReflect.Descriptor instead.
+func (*ContactEvent) Descriptor() ([]byte, []int) {
+	return file_v1_contact_contact_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ContactEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContactEvent) GetContactId() string {
+	if x != nil {
+		return x.ContactId
+	}
+	return ""
+}
+
+func (x *ContactEvent) GetType() ContactEventType {
+	if x != nil {
+		return x.Type
+	}
+	return ContactEventType_CONTACT_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *ContactEvent) GetActorId() string {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return ""
+}
+
+func (x *ContactEvent) GetFromStatus() ContactStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ContactStatus_CONTACT_STATUS_UNSPECIFIED
+}
+
+func (x *ContactEvent) GetToStatus() ContactStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ContactStatus_CONTACT_STATUS_UNSPECIFIED
+}
+
+func (x *ContactEvent) GetBody() string {
+	if x != nil && x.Body != nil {
+		return *x.Body
+	}
+	return ""
+}
+
+func (x *ContactEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SubmitContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         *string                `protobuf:"bytes,3,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Website       string                 `protobuf:"bytes,5,opt,name=website,proto3" json:"website,omitempty"` // honeypot, hidden from people so only bots fill it in
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitContactRequest) Reset() {
+	*x = SubmitContactRequest{}
+	mi := &file_v1_contact_contact_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitContactRequest) ProtoMessage() {}
+
+func (x *SubmitContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contact_contact_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitContactRequest.ProtoReflect.Descriptor instead.
+func (*SubmitContactRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contact_contact_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SubmitContactRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubmitContactRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SubmitContactRequest) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *SubmitContactRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SubmitContactRequest) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+type SubmitContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitContactResponse) Reset() {
+	*x = SubmitContactResponse{}
+	mi := &file_v1_contact_contact_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitContactResponse) ProtoMessage() {}
+
+func (x *SubmitContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contact_contact_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitContactResponse.ProtoReflect.Descriptor instead.
+func (*SubmitContactResponse) Descriptor() ([]byte, []int) {
+	return file_v1_contact_contact_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SubmitContactResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListContactsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ContactStatus          `protobuf:"varint,1,opt,name=status,proto3,enum=contact.v1.ContactStatus" json:"status,omitempty"` // unspecified lists every status
+	AssignedTo    *string                `protobuf:"bytes,2,opt,name=assigned_to,json=assignedTo,proto3,oneof" json:"assigned_to,omitempty"`
+	IncludeSpam   bool                   `protobuf:"varint,3,opt,name=include_spam,json=includeSpam,proto3" json:"include_spam,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContactsRequest) Reset() {
+	*x = ListContactsRequest{}
+	mi := &file_v1_contact_contact_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactsRequest) ProtoMessage() {}
+
+func (x *ListContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contact_contact_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactsRequest.ProtoReflect.Descriptor instead.
+func (*ListContactsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contact_contact_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListContactsRequest) GetStatus() ContactStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ContactStatus_CONTACT_STATUS_UNSPECIFIED
+}
+
+func (x *ListContactsRequest) GetAssignedTo() string {
+	if x != nil && x.AssignedTo != nil {
+		return *x.AssignedTo
+	}
+	return ""
+}
+
+func (x *ListContactsRequest) GetIncludeSpam() bool {
+	if x != nil {
+		return x.IncludeSpam
+	}
+	return false
+}
+
+func (x *ListContactsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListContactsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListContactsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contacts      []*Contact             `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContactsResponse) Reset() {
+	*x = ListContactsResponse{}
+	mi := &file_v1_contact_contact_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactsResponse) ProtoMessage() {}
+
+func (x *ListContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contact_contact_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactsResponse.ProtoReflect.Descriptor instead.
+func (*ListContactsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_contact_contact_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListContactsResponse) GetContacts() []*Contact {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+type GetContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContactRequest) Reset() {
+	*x = GetContactRequest{}
+	mi := &file_v1_contact_contact_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactRequest) ProtoMessage() {}
+
+func (x *GetContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contact_contact_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactRequest.ProtoReflect.Descriptor instead.
+func (*GetContactRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contact_contact_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetContactRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contact       *Contact               `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	History       []*ContactEvent        `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContactResponse) Reset() {
+	*x = GetContactResponse{}
+	mi := &file_v1_contact_contact_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactResponse) ProtoMessage() {}
+
+func (x *GetContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contact_contact_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactResponse.ProtoReflect.Descriptor instead.
+func (*GetContactResponse) Descriptor() ([]byte, []int) {
+	return file_v1_contact_contact_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetContactResponse) GetContact() *Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *GetContactResponse) GetHistory() []*ContactEvent {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type AssignContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssigneeId    string                 `protobuf:"bytes,2,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"` // empty unassigns
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignContactRequest) Reset() {
+	*x = AssignContactRequest{}
+	mi := &file_v1_contact_contact_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignContactRequest) ProtoMessage() {}
+
+func (x *AssignContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contact_contact_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignContactRequest.ProtoReflect.Descriptor instead.
+func (*AssignContactRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contact_contact_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AssignContactRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AssignContactRequest) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+type ReplyToContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplyToContactRequest) Reset() {
+	*x = ReplyToContactRequest{}
+	mi := &file_v1_contact_contact_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplyToContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyToContactRequest) ProtoMessage() {}
+
+func (x *ReplyToContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contact_contact_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyToContactRequest.ProtoReflect.Descriptor instead.
+func (*ReplyToContactRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contact_contact_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReplyToContactRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReplyToContactRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type SetContactStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetContactStatusRequest) Reset() {
+	*x = SetContactStatusRequest{}
+	mi := &file_v1_contact_contact_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetContactStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetContactStatusRequest) ProtoMessage() {}
+
+func (x *SetContactStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_contact_contact_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetContactStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetContactStatusRequest) Descriptor() ([]byte, []int) {
+	return file_v1_contact_contact_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetContactStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetContactStatusRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+var File_v1_contact_contact_proto protoreflect.FileDescriptor
+
+const file_v1_contact_contact_proto_rawDesc = "" +
+	"\n" +
+	"\x18v1/contact/contact.proto\x12\n" +
+	"contact.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc2\x03\n" +
+	"\aContact\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x19\n" +
+	"\x05phone\x18\x04 \x01(\tH\x00R\x05phone\x88\x01\x01\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x121\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x19.contact.v1.ContactStatusR\x06status\x12\x1c\n" +
+	"\auser_id\x18\a \x01(\tH\x01R\x06userId\x88\x01\x01\x12$\n" +
+	"\vassigned_to\x18\b \x01(\tH\x02R\n" +
+	"assignedTo\x88\x01\x01\x12\x12\n" +
+	"\x04spam\x18\t \x01(\bR\x04spam\x12!\n" +
+	"\fspam_reasons\x18\n" +
+	" \x03(\tR\vspamReasons\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\b\n" +
+	"\x06_phoneB\n" +
+	"\n" +
+	"\b_user_idB\x0e\n" +
+	"\f_assigned_to\"\xed\x02\n" +
+	"\fContactEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"contact_id\x18\x02 \x01(\tR\tcontactId\x120\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1c.contact.v1.ContactEventTypeR\x04type\x12\x1e\n" +
+	"\bactor_id\x18\x04 \x01(\tH\x00R\aactorId\x88\x01\x01\x12:\n" +
+	"\vfrom_status\x18\x05 \x01(\x0e2\x19.contact.v1.ContactStatusR\n" +
+	"fromStatus\x126\n" +
+	"\tto_status\x18\x06 \x01(\x0e2\x19.contact.v1.ContactStatusR\btoStatus\x12\x17\n" +
+	"\x04body\x18\a \x01(\tH\x01R\x04body\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\v\n" +
+	"\t_actor_idB\a\n" +
+	"\x05_body\"\x99\x01\n" +
+	"\x14SubmitContactRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x19\n" +
+	"\x05phone\x18\x03 \x01(\tH\x00R\x05phone\x88\x01\x01\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x18\n" +
+	"\awebsite\x18\x05 \x01(\tR\awebsiteB\b\n" +
+	"\x06_phone\"1\n" +
+	"\x15SubmitContactResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xcf\x01\n" +
+	"\x13ListContactsRequest\x121\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x19.contact.v1.ContactStatusR\x06status\x12$\n" +
+	"\vassigned_to\x18\x02 \x01(\tH\x00R\n" +
+	"assignedTo\x88\x01\x01\x12!\n" +
+	"\finclude_spam\x18\x03 \x01(\bR\vincludeSpam\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offsetB\x0e\n" +
+	"\f_assigned_to\"G\n" +
+	"\x14ListContactsResponse\x12/\n" +
+	"\bcontacts\x18\x01 \x03(\v2\x13.contact.v1.ContactR\bcontacts\"#\n" +
+	"\x11GetContactRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"w\n" +
+	"\x12GetContactResponse\x12-\n" +
+	"\acontact\x18\x01 \x01(\v2\x13.contact.v1.ContactR\acontact\x122\n" +
+	"\ahistory\x18\x02 \x03(\v2\x18.contact.v1.ContactEventR\ahistory\"G\n" +
+	"\x14AssignContactRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vassignee_id\x18\x02 \x01(\tR\n" +
+	"assigneeId\";\n" +
+	"\x15ReplyToContactRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\"=\n" +
+	"\x17SetContactStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note*\x83\x01\n" +
+	"\rContactStatus\x12\x1e\n" +
+	"\x1aCONTACT_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16CONTACT_STATUS_PENDING\x10\x01\x12\x1b\n" +
+	"\x17CONTACT_STATUS_RESOLVED\x10\x02\x12\x19\n" +
+	"\x15CONTACT_STATUS_CLOSED\x10\x03*\xc0\x01\n" +
+	"\x10ContactEventType\x12\"\n" +
+	"\x1eCONTACT_EVENT_TYPE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cCONTACT_EVENT_TYPE_SUBMITTED\x10\x01\x12\x1f\n" +
+	"\x1bCONTACT_EVENT_TYPE_ASSIGNED\x10\x02\x12\x1e\n" +
+	"\x1aCONTACT_EVENT_TYPE_REPLIED\x10\x03\x12%\n" +
+	"!CONTACT_EVENT_TYPE_STATUS_CHANGED\x10\x042\xc1\x04\n" +
+	"\x0eContactService\x12V\n" +
+	"\rSubmitContact\x12 .contact.v1.SubmitContactRequest\x1a!.contact.v1.SubmitContactResponse\"\x00\x12S\n" +
+	"\fListContacts\x12\x1f.contact.v1.ListContactsRequest\x1a .contact.v1.ListContactsResponse\"\x00\x12M\n" +
+	"\n" +
+	"GetContact\x12\x1d.contact.v1.GetContactRequest\x1a\x1e.contact.v1.GetContactResponse\"\x00\x12H\n" +
+	"\rAssignContact\x12 .contact.v1.AssignContactRequest\x1a\x13.contact.v1.Contact\"\x00\x12O\n" +
+	"\x0eReplyToContact\x12!.contact.v1.ReplyToContactRequest\x1a\x18.contact.v1.ContactEvent\"\x00\x12L\n" +
+	"\x0eResolveContact\x12#.contact.v1.SetContactStatusRequest\x1a\x13.contact.v1.Contact\"\x00\x12J\n" +
+	"\fCloseContact\x12#.contact.v1.SetContactStatusRequest\x1a\x13.contact.v1.Contact\"\x00BFZDgithub.com/studyguides-com/study-guides-api/api/v1/contact;contactv1b\x06proto3"
+
+var (
+	file_v1_contact_contact_proto_rawDescOnce sync.Once
+	file_v1_contact_contact_proto_rawDescData []byte
+)
+
+func file_v1_contact_contact_proto_rawDescGZIP() []byte {
+	file_v1_contact_contact_proto_rawDescOnce.Do(func() {
+		file_v1_contact_contact_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_contact_contact_proto_rawDesc), len(file_v1_contact_contact_proto_rawDesc)))
+	})
+	return file_v1_contact_contact_proto_rawDescData
+}
+
+var file_v1_contact_contact_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_v1_contact_contact_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_v1_contact_contact_proto_goTypes = []any{
+	(ContactStatus)(0),              // 0: contact.v1.ContactStatus
+	(ContactEventType)(0),           // 1: contact.v1.ContactEventType
+	(*Contact)(nil),                 // 2: contact.v1.Contact
+	(*ContactEvent)(nil),            // 3: contact.v1.ContactEvent
+	(*SubmitContactRequest)(nil),    // 4: contact.v1.SubmitContactRequest
+	(*SubmitContactResponse)(nil),   // 5: contact.v1.SubmitContactResponse
+	(*ListContactsRequest)(nil),     // 6: contact.v1.ListContactsRequest
+	(*ListContactsResponse)(nil),    // 7: contact.v1.ListContactsResponse
+	(*GetContactRequest)(nil),       // 8: contact.v1.GetContactRequest
+	(*GetContactResponse)(nil),      // 9: contact.v1.GetContactResponse
+	(*AssignContactRequest)(nil),    // 10: contact.v1.AssignContactRequest
+	(*ReplyToContactRequest)(nil),   // 11: contact.v1.ReplyToContactRequest
+	(*SetContactStatusRequest)(nil), // 12: contact.v1.SetContactStatusRequest
+	(*timestamppb.Timestamp)(nil),   // 13: google.protobuf.Timestamp
+}
+var file_v1_contact_contact_proto_depIdxs = []int32{
+	0,  // 0: contact.v1.Contact.status:type_name -> contact.v1.ContactStatus
+	13, // 1: contact.v1.Contact.created_at:type_name -> google.protobuf.Timestamp
+	13, // 2: contact.v1.Contact.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 3: contact.v1.ContactEvent.type:type_name -> contact.v1.ContactEventType
+	0,  // 4: contact.v1.ContactEvent.from_status:type_name -> contact.v1.ContactStatus
+	0,  // 5: contact.v1.ContactEvent.to_status:type_name -> contact.v1.ContactStatus
+	13, // 6: contact.v1.ContactEvent.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: contact.v1.ListContactsRequest.status:type_name -> contact.v1.ContactStatus
+	2,  // 8: contact.v1.ListContactsResponse.contacts:type_name -> contact.v1.Contact
+	2,  // 9: contact.v1.GetContactResponse.contact:type_name -> contact.v1.Contact
+	3,  // 10: contact.v1.GetContactResponse.history:type_name -> contact.v1.ContactEvent
+	4,  // 11: contact.v1.ContactService.SubmitContact:input_type -> contact.v1.SubmitContactRequest
+	6,  // 12: contact.v1.ContactService.ListContacts:input_type -> contact.v1.ListContactsRequest
+	8,  // 13: contact.v1.ContactService.GetContact:input_type -> contact.v1.GetContactRequest
+	10, // 14: contact.v1.ContactService.AssignContact:input_type -> contact.v1.AssignContactRequest
+	11, // 15: contact.v1.ContactService.ReplyToContact:input_type -> contact.v1.ReplyToContactRequest
+	12, // 16: contact.v1.ContactService.ResolveContact:input_type -> contact.v1.SetContactStatusRequest
+	12, // 17: contact.v1.ContactService.CloseContact:input_type -> contact.v1.SetContactStatusRequest
+	5,  // 18: contact.v1.ContactService.SubmitContact:output_type -> contact.v1.SubmitContactResponse
+	7,  // 19: contact.v1.ContactService.ListContacts:output_type -> contact.v1.ListContactsResponse
+	9,  // 20: contact.v1.ContactService.GetContact:output_type -> contact.v1.GetContactResponse
+	2,  // 21: contact.v1.ContactService.AssignContact:output_type -> contact.v1.Contact
+	3,  // 22: contact.v1.ContactService.ReplyToContact:output_type -> contact.v1.ContactEvent
+	2,  // 23: contact.v1.ContactService.ResolveContact:output_type -> contact.v1.Contact
+	2,  // 24: contact.v1.ContactService.CloseContact:output_type -> contact.v1.Contact
+	18, // [18:25] is the sub-list for method output_type
+	11, // [11:18] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_v1_contact_contact_proto_init() }
+func file_v1_contact_contact_proto_init() {
+	if File_v1_contact_contact_proto != nil {
+		return
+	}
+	file_v1_contact_contact_proto_msgTypes[0].OneofWrappers = []any{}
+	file_v1_contact_contact_proto_msgTypes[1].OneofWrappers = []any{}
+	file_v1_contact_contact_proto_msgTypes[2].OneofWrappers = []any{}
+	file_v1_contact_contact_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_contact_contact_proto_rawDesc), len(file_v1_contact_contact_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_contact_contact_proto_goTypes,
+		DependencyIndexes: file_v1_contact_contact_proto_depIdxs,
+		EnumInfos:         file_v1_contact_contact_proto_enumTypes,
+		MessageInfos:      file_v1_contact_contact_proto_msgTypes,
+	}.Build()
+	File_v1_contact_contact_proto = out.File
+	file_v1_contact_contact_proto_goTypes = nil
+	file_v1_contact_contact_proto_depIdxs = nil
+}
@@ -0,0 +1,355 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: v1/contact/contact.proto
+
+package contactv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ContactService_SubmitContact_FullMethodName  = "/contact.v1.ContactService/SubmitContact"
+	ContactService_ListContacts_FullMethodName   = "/contact.v1.ContactService/ListContacts"
+	ContactService_GetContact_FullMethodName     = "/contact.v1.ContactService/GetContact"
+	ContactService_AssignContact_FullMethodName  = "/contact.v1.ContactService/AssignContact"
+	ContactService_ReplyToContact_FullMethodName = "/contact.v1.ContactService/ReplyToContact"
+	ContactService_ResolveContact_FullMethodName = "/contact.v1.ContactService/ResolveContact"
+	ContactService_CloseContact_FullMethodName   = "/contact.v1.ContactService/CloseContact"
+)
+
+// ContactServiceClient is the client API for ContactService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ContactServiceClient interface {
+	// Public and rate limited per caller
+	SubmitContact(ctx context.Context, in *SubmitContactRequest, opts ...grpc.CallOption) (*SubmitContactResponse, error)
+	// Admin only
+	ListContacts(ctx context.Context, in *ListContactsRequest, opts ...grpc.CallOption) (*ListContactsResponse, error)
+	GetContact(ctx context.Context, in *GetContactRequest, opts ...grpc.CallOption) (*GetContactResponse, error)
+	AssignContact(ctx context.Context, in *AssignContactRequest, opts ...grpc.CallOption) (*Contact, error)
+	// Records the reply in the history, the Slack bot delivers it from the contact.replied notification
+	ReplyToContact(ctx context.Context, in *ReplyToContactRequest, opts ...grpc.CallOption) (*ContactEvent, error)
+	ResolveContact(ctx context.Context, in *SetContactStatusRequest, opts ...grpc.CallOption) (*Contact, error)
+	CloseContact(ctx context.Context, in *SetContactStatusRequest, opts ...grpc.CallOption) (*Contact, error)
+}
+
+type contactServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewContactServiceClient(cc grpc.ClientConnInterface) ContactServiceClient {
+	return &contactServiceClient{cc}
+}
+
+func (c *contactServiceClient) SubmitContact(ctx context.Context, in *SubmitContactRequest, opts ...grpc.CallOption) (*SubmitContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitContactResponse)
+	err := c.cc.Invoke(ctx, ContactService_SubmitContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactServiceClient) ListContacts(ctx context.Context, in *ListContactsRequest, opts ...grpc.CallOption) (*ListContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListContactsResponse)
+	err := c.cc.Invoke(ctx, ContactService_ListContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactServiceClient) GetContact(ctx context.Context, in *GetContactRequest, opts ...grpc.CallOption) (*GetContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetContactResponse)
+	err := c.cc.Invoke(ctx, ContactService_GetContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactServiceClient) AssignContact(ctx context.Context, in *AssignContactRequest, opts ...grpc.CallOption) (*Contact, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Contact)
+	err := c.cc.Invoke(ctx, ContactService_AssignContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactServiceClient) ReplyToContact(ctx context.Context, in *ReplyToContactRequest, opts ...grpc.CallOption) (*ContactEvent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContactEvent)
+	err := c.cc.Invoke(ctx, ContactService_ReplyToContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactServiceClient) ResolveContact(ctx context.Context, in *SetContactStatusRequest, opts ...grpc.CallOption) (*Contact, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Contact)
+	err := c.cc.Invoke(ctx, ContactService_ResolveContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactServiceClient) CloseContact(ctx context.Context, in *SetContactStatusRequest, opts ...grpc.CallOption) (*Contact, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Contact)
+	err := c.cc.Invoke(ctx, ContactService_CloseContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ContactServiceServer is the server API for ContactService service.
+// All implementations must embed UnimplementedContactServiceServer
+// for forward compatibility.
+type ContactServiceServer interface {
+	// Public and rate limited per caller
+	SubmitContact(context.Context, *SubmitContactRequest) (*SubmitContactResponse, error)
+	// Admin only
+	ListContacts(context.Context, *ListContactsRequest) (*ListContactsResponse, error)
+	GetContact(context.Context, *GetContactRequest) (*GetContactResponse, error)
+	AssignContact(context.Context, *AssignContactRequest) (*Contact, error)
+	// Records the reply in the history, the Slack bot delivers it from the contact.replied notification
+	ReplyToContact(context.Context, *ReplyToContactRequest) (*ContactEvent, error)
+	ResolveContact(context.Context, *SetContactStatusRequest) (*Contact, error)
+	CloseContact(context.Context, *SetContactStatusRequest) (*Contact, error)
+	mustEmbedUnimplementedContactServiceServer()
+}
+
+// UnimplementedContactServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedContactServiceServer struct{}
+
+func (UnimplementedContactServiceServer) SubmitContact(context.Context, *SubmitContactRequest) (*SubmitContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitContact not implemented")
+}
+func (UnimplementedContactServiceServer) ListContacts(context.Context, *ListContactsRequest) (*ListContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContacts not implemented")
+}
+func (UnimplementedContactServiceServer) GetContact(context.Context, *GetContactRequest) (*GetContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContact not implemented")
+}
+func (UnimplementedContactServiceServer) AssignContact(context.Context, *AssignContactRequest) (*Contact, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignContact not implemented")
+}
+func (UnimplementedContactServiceServer) ReplyToContact(context.Context, *ReplyToContactRequest) (*ContactEvent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyToContact not implemented")
+}
+func (UnimplementedContactServiceServer) ResolveContact(context.Context, *SetContactStatusRequest) (*Contact, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveContact not implemented")
+}
+func (UnimplementedContactServiceServer) CloseContact(context.Context, *SetContactStatusRequest) (*Contact, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseContact not implemented")
+}
+func (UnimplementedContactServiceServer) mustEmbedUnimplementedContactServiceServer() {}
+func (UnimplementedContactServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeContactServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ContactServiceServer will
+// result in compilation errors.
+type UnsafeContactServiceServer interface {
+	mustEmbedUnimplementedContactServiceServer()
+}
+
+func RegisterContactServiceServer(s grpc.ServiceRegistrar, srv ContactServiceServer) {
+	// If the following call pancis, it indicates UnimplementedContactServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ContactService_ServiceDesc, srv)
+}
+
+func _ContactService_SubmitContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactServiceServer).SubmitContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactService_SubmitContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactServiceServer).SubmitContact(ctx, req.(*SubmitContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactService_ListContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactServiceServer).ListContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactService_ListContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactServiceServer).ListContacts(ctx, req.(*ListContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactService_GetContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactServiceServer).GetContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactService_GetContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactServiceServer).GetContact(ctx, req.(*GetContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactService_AssignContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactServiceServer).AssignContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactService_AssignContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactServiceServer).AssignContact(ctx, req.(*AssignContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactService_ReplyToContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyToContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactServiceServer).ReplyToContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactService_ReplyToContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactServiceServer).ReplyToContact(ctx, req.(*ReplyToContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactService_ResolveContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetContactStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactServiceServer).ResolveContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactService_ResolveContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactServiceServer).ResolveContact(ctx, req.(*SetContactStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactService_CloseContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetContactStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactServiceServer).CloseContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactService_CloseContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactServiceServer).CloseContact(ctx, req.(*SetContactStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ContactService_ServiceDesc is the grpc.ServiceDesc for ContactService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ContactService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "contact.v1.ContactService",
+	HandlerType: (*ContactServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitContact",
+			Handler:    _ContactService_SubmitContact_Handler,
+		},
+		{
+			MethodName: "ListContacts",
+			Handler:    _ContactService_ListContacts_Handler,
+		},
+		{
+			MethodName: "GetContact",
+			Handler:    _ContactService_GetContact_Handler,
+		},
+		{
+			MethodName: "AssignContact",
+			Handler:    _ContactService_AssignContact_Handler,
+		},
+		{
+			MethodName: "ReplyToContact",
+			Handler:    _ContactService_ReplyToContact_Handler,
+		},
+		{
+			MethodName: "ResolveContact",
+			Handler:    _ContactService_ResolveContact_Handler,
+		},
+		{
+			MethodName: "CloseContact",
+			Handler:    _ContactService_CloseContact_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/contact/contact.proto",
+}
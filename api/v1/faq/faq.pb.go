@@ -0,0 +1,445 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: v1/faq/faq.proto
+
+package faqv1
+
+import (
+	shared "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListFaqsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFaqsRequest) Reset() {
+	*x = ListFaqsRequest{}
+	mi := &file_v1_faq_faq_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFaqsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFaqsRequest) ProtoMessage() {}
+
+func (x *ListFaqsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_faq_faq_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFaqsRequest.ProtoReflect.Descriptor instead.
+func (*ListFaqsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_faq_faq_proto_rawDescGZIP(), []int{0}
+}
+
+type ListFaqsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Faqs          []*shared.Faq          `protobuf:"bytes,1,rep,name=faqs,proto3" json:"faqs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFaqsResponse) Reset() {
+	*x = ListFaqsResponse{}
+	mi := &file_v1_faq_faq_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFaqsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFaqsResponse) ProtoMessage() {}
+
+func (x *ListFaqsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_faq_faq_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFaqsResponse.ProtoReflect.Descriptor instead.
+func (*ListFaqsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_faq_faq_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListFaqsResponse) GetFaqs() []*shared.Faq {
+	if x != nil {
+		return x.Faqs
+	}
+	return nil
+}
+
+type GetFaqRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFaqRequest) Reset() {
+	*x = GetFaqRequest{}
+	mi := &file_v1_faq_faq_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFaqRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFaqRequest) ProtoMessage() {}
+
+func (x *GetFaqRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_faq_faq_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFaqRequest.ProtoReflect.Descriptor instead.
+func (*GetFaqRequest) Descriptor() ([]byte, []int) {
+	return file_v1_faq_faq_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetFaqRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreateFaqRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Question      string                 `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	Answer        string                 `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFaqRequest) Reset() {
+	*x = CreateFaqRequest{}
+	mi := &file_v1_faq_faq_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFaqRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFaqRequest) ProtoMessage() {}
+
+func (x *CreateFaqRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_faq_faq_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFaqRequest.ProtoReflect.Descriptor instead.
+func (*CreateFaqRequest) Descriptor() ([]byte, []int) {
+	return file_v1_faq_faq_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateFaqRequest) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *CreateFaqRequest) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+type UpdateFaqRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Question      *string                `protobuf:"bytes,2,opt,name=question,proto3,oneof" json:"question,omitempty"`
+	Answer        *string                `protobuf:"bytes,3,opt,name=answer,proto3,oneof" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFaqRequest) Reset() {
+	*x = UpdateFaqRequest{}
+	mi := &file_v1_faq_faq_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFaqRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFaqRequest) ProtoMessage() {}
+
+func (x *UpdateFaqRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_faq_faq_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFaqRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFaqRequest) Descriptor() ([]byte, []int) {
+	return file_v1_faq_faq_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateFaqRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateFaqRequest) GetQuestion() string {
+	if x != nil && x.Question != nil {
+		return *x.Question
+	}
+	return ""
+}
+
+func (x *UpdateFaqRequest) GetAnswer() string {
+	if x != nil && x.Answer != nil {
+		return *x.Answer
+	}
+	return ""
+}
+
+type DeleteFaqRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFaqRequest) Reset() {
+	*x = DeleteFaqRequest{}
+	mi := &file_v1_faq_faq_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFaqRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFaqRequest) ProtoMessage() {}
+
+func (x *DeleteFaqRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_faq_faq_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFaqRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFaqRequest) Descriptor() ([]byte, []int) {
+	return file_v1_faq_faq_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteFaqRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteFaqResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFaqResponse) Reset() {
+	*x = DeleteFaqResponse{}
+	mi := &file_v1_faq_faq_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFaqResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFaqResponse) ProtoMessage() {}
+
+func (x *DeleteFaqResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_faq_faq_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFaqResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFaqResponse) Descriptor() ([]byte, []int) {
+	return file_v1_faq_faq_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteFaqResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+var File_v1_faq_faq_proto protoreflect.FileDescriptor
+
+const file_v1_faq_faq_proto_rawDesc = "" +
+	"\n" +
+	"\x10v1/faq/faq.proto\x12\x06faq.v1\x1a\x13v1/shared/faq.proto\"\x11\n" +
+	"\x0fListFaqsRequest\"6\n" +
+	"\x10ListFaqsResponse\x12\"\n" +
+	"\x04faqs\x18\x01 \x03(\v2\x0e.shared.v1.FaqR\x04faqs\"\x1f\n" +
+	"\rGetFaqRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"F\n" +
+	"\x10CreateFaqRequest\x12\x1a\n" +
+	"\bquestion\x18\x01 \x01(\tR\bquestion\x12\x16\n" +
+	"\x06answer\x18\x02 \x01(\tR\x06answer\"x\n" +
+	"\x10UpdateFaqRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\bquestion\x18\x02 \x01(\tH\x00R\bquestion\x88\x01\x01\x12\x1b\n" +
+	"\x06answer\x18\x03 \x01(\tH\x01R\x06answer\x88\x01\x01B\v\n" +
+	"\t_questionB\t\n" +
+	"\a_answer\"\"\n" +
+	"\x10DeleteFaqRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"-\n" +
+	"\x11DeleteFaqResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess2\xb6\x02\n" +
+	"\n" +
+	"FaqService\x12?\n" +
+	"\bListFaqs\x12\x17.faq.v1.ListFaqsRequest\x1a\x18.faq.v1.ListFaqsResponse\"\x00\x121\n" +
+	"\x06GetFaq\x12\x15.faq.v1.GetFaqRequest\x1a\x0e.shared.v1.Faq\"\x00\x127\n" +
+	"\tCreateFaq\x12\x18.faq.v1.CreateFaqRequest\x1a\x0e.shared.v1.Faq\"\x00\x127\n" +
+	"\tUpdateFaq\x12\x18.faq.v1.UpdateFaqRequest\x1a\x0e.shared.v1.Faq\"\x00\x12B\n" +
+	"\tDeleteFaq\x12\x18.faq.v1.DeleteFaqRequest\x1a\x19.faq.v1.DeleteFaqResponse\"\x00B>Z<github.com/studyguides-com/study-guides-api/api/v1/faq;faqv1b\x06proto3"
+
+var (
+	file_v1_faq_faq_proto_rawDescOnce sync.Once
+	file_v1_faq_faq_proto_rawDescData []byte
+)
+
+func file_v1_faq_faq_proto_rawDescGZIP() []byte {
+	file_v1_faq_faq_proto_rawDescOnce.Do(func() {
+		file_v1_faq_faq_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_faq_faq_proto_rawDesc), len(file_v1_faq_faq_proto_rawDesc)))
+	})
+	return file_v1_faq_faq_proto_rawDescData
+}
+
+var file_v1_faq_faq_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_v1_faq_faq_proto_goTypes = []any{
+	(*ListFaqsRequest)(nil),   // 0: faq.v1.ListFaqsRequest
+	(*ListFaqsResponse)(nil),  // 1: faq.v1.ListFaqsResponse
+	(*GetFaqRequest)(nil),     // 2: faq.v1.GetFaqRequest
+	(*CreateFaqRequest)(nil),  // 3: faq.v1.CreateFaqRequest
+	(*UpdateFaqRequest)(nil),  // 4: faq.v1.UpdateFaqRequest
+	(*DeleteFaqRequest)(nil),  // 5: faq.v1.DeleteFaqRequest
+	(*DeleteFaqResponse)(nil), // 6: faq.v1.DeleteFaqResponse
+	(*shared.Faq)(nil),        // 7: shared.v1.Faq
+}
+var file_v1_faq_faq_proto_depIdxs = []int32{
+	7, // 0: faq.v1.ListFaqsResponse.faqs:type_name -> shared.v1.Faq
+	0, // 1: faq.v1.FaqService.ListFaqs:input_type -> faq.v1.ListFaqsRequest
+	2, // 2: faq.v1.FaqService.GetFaq:input_type -> faq.v1.GetFaqRequest
+	3, // 3: faq.v1.FaqService.CreateFaq:input_type -> faq.v1.CreateFaqRequest
+	4, // 4: faq.v1.FaqService.UpdateFaq:input_type -> faq.v1.UpdateFaqRequest
+	5, // 5: faq.v1.FaqService.DeleteFaq:input_type -> faq.v1.DeleteFaqRequest
+	1, // 6: faq.v1.FaqService.ListFaqs:output_type -> faq.v1.ListFaqsResponse
+	7, // 7: faq.v1.FaqService.GetFaq:output_type -> shared.v1.Faq
+	7, // 8: faq.v1.FaqService.CreateFaq:output_type -> shared.v1.Faq
+	7, // 9: faq.v1.FaqService.UpdateFaq:output_type -> shared.v1.Faq
+	6, // 10: faq.v1.FaqService.DeleteFaq:output_type -> faq.v1.DeleteFaqResponse
+	6, // [6:11] is the sub-list for method output_type
+	1, // [1:6] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_v1_faq_faq_proto_init() }
+func file_v1_faq_faq_proto_init() {
+	if File_v1_faq_faq_proto != nil {
+		return
+	}
+	file_v1_faq_faq_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_faq_faq_proto_rawDesc), len(file_v1_faq_faq_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_faq_faq_proto_goTypes,
+		DependencyIndexes: file_v1_faq_faq_proto_depIdxs,
+		MessageInfos:      file_v1_faq_faq_proto_msgTypes,
+	}.Build()
+	File_v1_faq_faq_proto = out.File
+	file_v1_faq_faq_proto_goTypes = nil
+	file_v1_faq_faq_proto_depIdxs = nil
+}
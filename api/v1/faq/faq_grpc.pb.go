@@ -0,0 +1,276 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: v1/faq/faq.proto
+
+package faqv1
+
+import (
+	context "context"
+	shared "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FaqService_ListFaqs_FullMethodName  = "/faq.v1.FaqService/ListFaqs"
+	FaqService_GetFaq_FullMethodName    = "/faq.v1.FaqService/GetFaq"
+	FaqService_CreateFaq_FullMethodName = "/faq.v1.FaqService/CreateFaq"
+	FaqService_UpdateFaq_FullMethodName = "/faq.v1.FaqService/UpdateFaq"
+	FaqService_DeleteFaq_FullMethodName = "/faq.v1.FaqService/DeleteFaq"
+)
+
+// FaqServiceClient is the client API for FaqService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FaqServiceClient interface {
+	ListFaqs(ctx context.Context, in *ListFaqsRequest, opts ...grpc.CallOption) (*ListFaqsResponse, error)
+	GetFaq(ctx context.Context, in *GetFaqRequest, opts ...grpc.CallOption) (*shared.Faq, error)
+	// Admin only
+	CreateFaq(ctx context.Context, in *CreateFaqRequest, opts ...grpc.CallOption) (*shared.Faq, error)
+	UpdateFaq(ctx context.Context, in *UpdateFaqRequest, opts ...grpc.CallOption) (*shared.Faq, error)
+	DeleteFaq(ctx context.Context, in *DeleteFaqRequest, opts ...grpc.CallOption) (*DeleteFaqResponse, error)
+}
+
+type faqServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFaqServiceClient(cc grpc.ClientConnInterface) FaqServiceClient {
+	return &faqServiceClient{cc}
+}
+
+func (c *faqServiceClient) ListFaqs(ctx context.Context, in *ListFaqsRequest, opts ...grpc.CallOption) (*ListFaqsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFaqsResponse)
+	err := c.cc.Invoke(ctx, FaqService_ListFaqs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faqServiceClient) GetFaq(ctx context.Context, in *GetFaqRequest, opts ...grpc.CallOption) (*shared.Faq, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(shared.Faq)
+	err := c.cc.Invoke(ctx, FaqService_GetFaq_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faqServiceClient) CreateFaq(ctx context.Context, in *CreateFaqRequest, opts ...grpc.CallOption) (*shared.Faq, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(shared.Faq)
+	err := c.cc.Invoke(ctx, FaqService_CreateFaq_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faqServiceClient) UpdateFaq(ctx context.Context, in *UpdateFaqRequest, opts ...grpc.CallOption) (*shared.Faq, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(shared.Faq)
+	err := c.cc.Invoke(ctx, FaqService_UpdateFaq_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faqServiceClient) DeleteFaq(ctx context.Context, in *DeleteFaqRequest, opts ...grpc.CallOption) (*DeleteFaqResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFaqResponse)
+	err := c.cc.Invoke(ctx, FaqService_DeleteFaq_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FaqServiceServer is the server API for FaqService service.
+// All implementations must embed UnimplementedFaqServiceServer
+// for forward compatibility.
+type FaqServiceServer interface {
+	ListFaqs(context.Context, *ListFaqsRequest) (*ListFaqsResponse, error)
+	GetFaq(context.Context, *GetFaqRequest) (*shared.Faq, error)
+	// Admin only
+	CreateFaq(context.Context, *CreateFaqRequest) (*shared.Faq, error)
+	UpdateFaq(context.Context, *UpdateFaqRequest) (*shared.Faq, error)
+	DeleteFaq(context.Context, *DeleteFaqRequest) (*DeleteFaqResponse, error)
+	mustEmbedUnimplementedFaqServiceServer()
+}
+
+// UnimplementedFaqServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFaqServiceServer struct{}
+
+func (UnimplementedFaqServiceServer) ListFaqs(context.Context, *ListFaqsRequest) (*ListFaqsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFaqs not implemented")
+}
+func (UnimplementedFaqServiceServer) GetFaq(context.Context, *GetFaqRequest) (*shared.Faq, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFaq not implemented")
+}
+func (UnimplementedFaqServiceServer) CreateFaq(context.Context, *CreateFaqRequest) (*shared.Faq, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFaq not implemented")
+}
+func (UnimplementedFaqServiceServer) UpdateFaq(context.Context, *UpdateFaqRequest) (*shared.Faq, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFaq not implemented")
+}
+func (UnimplementedFaqServiceServer) DeleteFaq(context.Context, *DeleteFaqRequest) (*DeleteFaqResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFaq not implemented")
+}
+func (UnimplementedFaqServiceServer) mustEmbedUnimplementedFaqServiceServer() {}
+func (UnimplementedFaqServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeFaqServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FaqServiceServer will
+// result in compilation errors.
+type UnsafeFaqServiceServer interface {
+	mustEmbedUnimplementedFaqServiceServer()
+}
+
+func RegisterFaqServiceServer(s grpc.ServiceRegistrar, srv FaqServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFaqServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FaqService_ServiceDesc, srv)
+}
+
+func _FaqService_ListFaqs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFaqsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaqServiceServer).ListFaqs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaqService_ListFaqs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaqServiceServer).ListFaqs(ctx, req.(*ListFaqsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaqService_GetFaq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFaqRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaqServiceServer).GetFaq(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaqService_GetFaq_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaqServiceServer).GetFaq(ctx, req.(*GetFaqRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaqService_CreateFaq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFaqRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaqServiceServer).CreateFaq(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaqService_CreateFaq_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaqServiceServer).CreateFaq(ctx, req.(*CreateFaqRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaqService_UpdateFaq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFaqRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaqServiceServer).UpdateFaq(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaqService_UpdateFaq_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaqServiceServer).UpdateFaq(ctx, req.(*UpdateFaqRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FaqService_DeleteFaq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFaqRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaqServiceServer).DeleteFaq(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FaqService_DeleteFaq_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaqServiceServer).DeleteFaq(ctx, req.(*DeleteFaqRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FaqService_ServiceDesc is the grpc.ServiceDesc for FaqService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FaqService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "faq.v1.FaqService",
+	HandlerType: (*FaqServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListFaqs",
+			Handler:    _FaqService_ListFaqs_Handler,
+		},
+		{
+			MethodName: "GetFaq",
+			Handler:    _FaqService_GetFaq_Handler,
+		},
+		{
+			MethodName: "CreateFaq",
+			Handler:    _FaqService_CreateFaq_Handler,
+		},
+		{
+			MethodName: "UpdateFaq",
+			Handler:    _FaqService_UpdateFaq_Handler,
+		},
+		{
+			MethodName: "DeleteFaq",
+			Handler:    _FaqService_DeleteFaq_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/faq/faq.proto",
+}
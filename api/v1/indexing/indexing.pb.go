@@ -26,7 +26,7 @@ const (
 // Request to trigger an indexing job
 type TriggerIndexingRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Type of object to index (defaults to "Tag")
+	// Type of object to index, "Tag", "Faq" or "Question" (defaults to "Tag")
 	ObjectType string `protobuf:"bytes,1,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
 	// If true, forces complete reindex ignoring change detection
 	// If false, only indexes changed items (incremental mode)
@@ -808,7 +808,7 @@ func (x *TriggerSingleIndexingRequest) GetForce() bool {
 // Request to prune orphaned objects from the search index
 type PruneIndexRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Type of object to prune (defaults to "Tag")
+	// Type of object to prune, "Tag" or "Question" (defaults to "Tag")
 	ObjectType string `protobuf:"bytes,1,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
 	// Optional filter by tag types - empty array means no filter
 	TagTypes []shared.TagType `protobuf:"varint,2,rep,packed,name=tag_types,json=tagTypes,proto3,enum=shared.v1.TagType" json:"tag_types,omitempty"`
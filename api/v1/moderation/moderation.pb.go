@@ -0,0 +1,1255 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: v1/moderation/moderation.proto
+
+package moderationv1
+
+import (
+	shared "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ModerationTargetType int32
+
+const (
+	ModerationTargetType_MODERATION_TARGET_TYPE_UNSPECIFIED ModerationTargetType = 0
+	ModerationTargetType_MODERATION_TARGET_TYPE_QUESTION    ModerationTargetType = 1
+	ModerationTargetType_MODERATION_TARGET_TYPE_TAG         ModerationTargetType = 2
+)
+
+// Enum value maps for ModerationTargetType.
+var (
+	ModerationTargetType_name = map[int32]string{
+		0: "MODERATION_TARGET_TYPE_UNSPECIFIED",
+		1: "MODERATION_TARGET_TYPE_QUESTION",
+		2: "MODERATION_TARGET_TYPE_TAG",
+	}
+	ModerationTargetType_value = map[string]int32{
+		"MODERATION_TARGET_TYPE_UNSPECIFIED": 0,
+		"MODERATION_TARGET_TYPE_QUESTION":    1,
+		"MODERATION_TARGET_TYPE_TAG":         2,
+	}
+)
+
+func (x ModerationTargetType) Enum() *ModerationTargetType {
+	p := new(ModerationTargetType)
+	*p = x
+	return p
+}
+
+func (x ModerationTargetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModerationTargetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_moderation_moderation_proto_enumTypes[0].Descriptor()
+}
+
+func (ModerationTargetType) Type() protoreflect.EnumType {
+	return &file_v1_moderation_moderation_proto_enumTypes[0]
+}
+
+func (x ModerationTargetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModerationTargetType.Descriptor instead.
+func (ModerationTargetType) EnumDescriptor() ([]byte, []int) {
+	return file_v1_moderation_moderation_proto_rawDescGZIP(), []int{0}
+}
+
+type ModerationActionType int32
+
+const (
+	ModerationActionType_MODERATION_ACTION_TYPE_UNSPECIFIED ModerationActionType = 0
+	ModerationActionType_MODERATION_ACTION_TYPE_DISMISS     ModerationActionType = 1
+	ModerationActionType_MODERATION_ACTION_TYPE_EDIT        ModerationActionType = 2
+	ModerationActionType_MODERATION_ACTION_TYPE_UNPUBLISH   ModerationActionType = 3
+	ModerationActionType_MODERATION_ACTION_TYPE_HIDE        ModerationActionType = 4
+)
+
+// Enum value maps for ModerationActionType.
+var (
+	ModerationActionType_name = map[int32]string{
+		0: "MODERATION_ACTION_TYPE_UNSPECIFIED",
+		1: "MODERATION_ACTION_TYPE_DISMISS",
+		2: "MODERATION_ACTION_TYPE_EDIT",
+		3: "MODERATION_ACTION_TYPE_UNPUBLISH",
+		4: "MODERATION_ACTION_TYPE_HIDE",
+	}
+	ModerationActionType_value = map[string]int32{
+		"MODERATION_ACTION_TYPE_UNSPECIFIED": 0,
+		"MODERATION_ACTION_TYPE_DISMISS":     1,
+		"MODERATION_ACTION_TYPE_EDIT":        2,
+		"MODERATION_ACTION_TYPE_UNPUBLISH":   3,
+		"MODERATION_ACTION_TYPE_HIDE":        4,
+	}
+)
+
+func (x ModerationActionType) Enum() *ModerationActionType {
+	p := new(ModerationActionType)
+	*p = x
+	return p
+}
+
+func (x ModerationActionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModerationActionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_moderation_moderation_proto_enumTypes[1].Descriptor()
+}
+
+func (ModerationActionType) Type() protoreflect.EnumType {
+	return &file_v1_moderation_moderation_proto_enumTypes[1]
+}
+
+func (x ModerationActionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModerationActionType.Descriptor instead.
+func (ModerationActionType) EnumDescriptor() ([]byte, []int) {
+	return file_v1_moderation_moderation_proto_rawDescGZIP(), []int{1}
+}
+
+type ReportStatus int32
+
+const (
+	ReportStatus_REPORT_STATUS_UNSPECIFIED ReportStatus = 0
+	ReportStatus_REPORT_STATUS_OPEN        ReportStatus = 1
+	ReportStatus_REPORT_STATUS_RESOLVED    ReportStatus = 2
+)
+
+// Enum value maps for ReportStatus.
+var (
+	ReportStatus_name = map[int32]string{
+		0: "REPORT_STATUS_UNSPECIFIED",
+		1: "REPORT_STATUS_OPEN",
+		2: "REPORT_STATUS_RESOLVED",
+	}
+	ReportStatus_value = map[string]int32{
+		"REPORT_STATUS_UNSPECIFIED": 0,
+		"REPORT_STATUS_OPEN":        1,
+		"REPORT_STATUS_RESOLVED":    2,
+	}
+)
+
+func (x ReportStatus) Enum() *ReportStatus {
+	p := new(ReportStatus)
+	*p = x
+	return p
+}
+
+func (x ReportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_moderation_moderation_proto_enumTypes[2].Descriptor()
+}
+
+func (ReportStatus) Type() protoreflect.EnumType {
+	return &file_v1_moderation_moderation_proto_enumTypes[2]
+}
+
+func (x ReportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportStatus.Descriptor instead.
+func (ReportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_v1_moderation_moderation_proto_rawDescGZIP(), []int{2}
+}
+
+type ReportTypeCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportType    shared.ReportType      `protobuf:"varint,1,opt,name=report_type,json=reportType,proto3,enum=shared.v1.ReportType" json:"report_type,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportTypeCount) Reset() {
+	*x = ReportTypeCount{}
+	mi := &file_v1_moderation_moderation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportTypeCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportTypeCount) ProtoMessage() {}
+
+func (x *ReportTypeCount) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_moderation_moderation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportTypeCount.ProtoReflect.Descriptor instead.
+func (*ReportTypeCount) Descriptor() ([]byte, []int) {
+	return file_v1_moderation_moderation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReportTypeCount) GetReportType() shared.ReportType {
+	if x != nil {
+		return x.ReportType
+	}
+	return shared.ReportType(0)
+}
+
+func (x *ReportTypeCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ReportGroup struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TargetType      ModerationTargetType   `protobuf:"varint,1,opt,name=target_type,json=targetType,proto3,enum=moderation.v1.ModerationTargetType" json:"target_type,omitempty"`
+	TargetId        string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Label           string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Public          bool                   `protobuf:"varint,4,opt,name=public,proto3" json:"public,omitempty"`
+	TotalReports    int32                  `protobuf:"varint,5,opt,name=total_reports,json=totalReports,proto3" json:"total_reports,omitempty"`
+	Counts          []*ReportTypeCount     `protobuf:"bytes,6,rep,name=counts,proto3" json:"counts,omitempty"`
+	Reasons         []string               `protobuf:"bytes,7,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	FirstReportedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=first_reported_at,json=firstReportedAt,proto3" json:"first_reported_at,omitempty"`
+	LastReportedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_reported_at,json=lastReportedAt,proto3" json:"last_reported_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReportGroup) Reset() {
+	*x = ReportGroup{}
+	mi := &file_v1_moderation_moderation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportGroup) ProtoMessage() {}
+
+func (x *ReportGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_moderation_moderation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportGroup.ProtoReflect.Descriptor instead.
+func (*ReportGroup) Descriptor() ([]byte, []int) {
+	return file_v1_moderation_moderation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReportGroup) GetTargetType() ModerationTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return ModerationTargetType_MODERATION_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *ReportGroup) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ReportGroup) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ReportGroup) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *ReportGroup) GetTotalReports() int32 {
+	if x != nil {
+		return x.TotalReports
+	}
+	return 0
+}
+
+func (x *ReportGroup) GetCounts() []*ReportTypeCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *ReportGroup) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *ReportGroup) GetFirstReportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstReportedAt
+	}
+	return nil
+}
+
+func (x *ReportGroup) GetLastReportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastReportedAt
+	}
+	return nil
+}
+
+type ListOpenReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetType    ModerationTargetType   `protobuf:"varint,1,opt,name=target_type,json=targetType,proto3,enum=moderation.v1.ModerationTargetType" json:"target_type,omitempty"` // unspecified lists questions and tags
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOpenReportsRequest) Reset() {
+	*x = ListOpenReportsRequest{}
+	mi := &file_v1_moderation_moderation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOpenReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOpenReportsRequest) ProtoMessage() {}
+
+func (x *ListOpenReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_moderation_moderation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOpenReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListOpenReportsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_moderation_moderation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListOpenReportsRequest) GetTargetType() ModerationTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return ModerationTargetType_MODERATION_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *ListOpenReportsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListOpenReportsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListOpenReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*ReportGroup         `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOpenReportsResponse) Reset() {
+	*x = ListOpenReportsResponse{}
+	mi := &file_v1_moderation_moderation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOpenReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOpenReportsResponse) ProtoMessage() {}
+
+func (x *ListOpenReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_moderation_moderation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOpenReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListOpenReportsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_moderation_moderation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListOpenReportsResponse) GetGroups() []*ReportGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type ContentEdit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionText  *string                `protobuf:"bytes,1,opt,name=question_text,json=questionText,proto3,oneof" json:"question_text,omitempty"`
+	AnswerText    *string                `protobuf:"bytes,2,opt,name=answer_text,json=answerText,proto3,oneof" json:"answer_text,omitempty"`
+	LearnMore     *string                `protobuf:"bytes,3,opt,name=learn_more,json=learnMore,proto3,oneof" json:"learn_more,omitempty"`
+	Distractors   []string               `protobuf:"bytes,4,rep,name=distractors,proto3" json:"distractors,omitempty"`
+	Name          *string                `protobuf:"bytes,5,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContentEdit) Reset() {
+	*x = ContentEdit{}
+	mi := &file_v1_moderation_moderation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentEdit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentEdit) ProtoMessage() {}
+
+func (x *ContentEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_moderation_moderation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentEdit.ProtoReflect.Descriptor instead.
+func (*ContentEdit) Descriptor() ([]byte, []int) {
+	return file_v1_moderation_moderation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ContentEdit) GetQuestionText() string {
+	if x != nil && x.QuestionText != nil {
+		return *x.QuestionText
+	}
+	return ""
+}
+
+func (x *ContentEdit) GetAnswerText() string {
+	if x != nil && x.AnswerText != nil {
+		return *x.AnswerText
+	}
+	return ""
+}
+
+func (x *ContentEdit) GetLearnMore() string {
+	if x != nil && x.LearnMore != nil {
+		return *x.LearnMore
+	}
+	return ""
+}
+
+func (x *ContentEdit) GetDistractors() []string {
+	if x != nil {
+		return x.Distractors
+	}
+	return nil
+}
+
+func (x *ContentEdit) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ContentEdit) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type ModerationAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetType    ModerationTargetType   `protobuf:"varint,2,opt,name=target_type,json=targetType,proto3,enum=moderation.v1.ModerationTargetType" json:"target_type,omitempty"`
+	TargetId      string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Action        ModerationActionType   `protobuf:"varint,4,opt,name=action,proto3,enum=moderation.v1.ModerationActionType" json:"action,omitempty"`
+	ModeratorId   string                 `protobuf:"bytes,5,opt,name=moderator_id,json=moderatorId,proto3" json:"moderator_id,omitempty"`
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	ReportCount   int32                  `protobuf:"varint,7,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+	BeforeJson    string                 `protobuf:"bytes,8,opt,name=before_json,json=beforeJson,proto3" json:"before_json,omitempty"`
+	AfterJson     string                 `protobuf:"bytes,9,opt,name=after_json,json=afterJson,proto3" json:"after_json,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerationAction) Reset() {
+	*x = ModerationAction{}
+	mi := &file_v1_moderation_moderation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationAction) ProtoMessage() {}
+
+func (x *ModerationAction) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_moderation_moderation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationAction.ProtoReflect.Descriptor instead.
+func (*ModerationAction) Descriptor() ([]byte, []int) {
+	return file_v1_moderation_moderation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ModerationAction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetTargetType() ModerationTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return ModerationTargetType_MODERATION_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *ModerationAction) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetAction() ModerationActionType {
+	if x != nil {
+		return x.Action
+	}
+	return ModerationActionType_MODERATION_ACTION_TYPE_UNSPECIFIED
+}
+
+func (x *ModerationAction) GetModeratorId() string {
+	if x != nil {
+		return x.ModeratorId
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetReportCount() int32 {
+	if x != nil {
+		return x.ReportCount
+	}
+	return 0
+}
+
+func (x *ModerationAction) GetBeforeJson() string {
+	if x != nil {
+		return x.BeforeJson
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetAfterJson() string {
+	if x != nil {
+		return x.AfterJson
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ResolveReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetType    ModerationTargetType   `protobuf:"varint,1,opt,name=target_type,json=targetType,proto3,enum=moderation.v1.ModerationTargetType" json:"target_type,omitempty"`
+	TargetId      string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Action        ModerationActionType   `protobuf:"varint,3,opt,name=action,proto3,enum=moderation.v1.ModerationActionType" json:"action,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	Edit          *ContentEdit           `protobuf:"bytes,5,opt,name=edit,proto3" json:"edit,omitempty"` // required for MODERATION_ACTION_TYPE_EDIT
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveReportsRequest) Reset() {
+	*x = ResolveReportsRequest{}
+	mi := &file_v1_moderation_moderation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReportsRequest) ProtoMessage() {}
+
+func (x *ResolveReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_moderation_moderation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReportsRequest.ProtoReflect.Descriptor instead.
+func (*ResolveReportsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_moderation_moderation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ResolveReportsRequest) GetTargetType() ModerationTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return ModerationTargetType_MODERATION_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *ResolveReportsRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ResolveReportsRequest) GetAction() ModerationActionType {
+	if x != nil {
+		return x.Action
+	}
+	return ModerationActionType_MODERATION_ACTION_TYPE_UNSPECIFIED
+}
+
+func (x *ResolveReportsRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ResolveReportsRequest) GetEdit() *ContentEdit {
+	if x != nil {
+		return x.Edit
+	}
+	return nil
+}
+
+type ResolveReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        *ModerationAction      `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveReportsResponse) Reset() {
+	*x = ResolveReportsResponse{}
+	mi := &file_v1_moderation_moderation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReportsResponse) ProtoMessage() {}
+
+func (x *ResolveReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_moderation_moderation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReportsResponse.ProtoReflect.Descriptor instead.
+func (*ResolveReportsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_moderation_moderation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResolveReportsResponse) GetAction() *ModerationAction {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+type ListModerationActionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetType    ModerationTargetType   `protobuf:"varint,1,opt,name=target_type,json=targetType,proto3,enum=moderation.v1.ModerationTargetType" json:"target_type,omitempty"`
+	TargetId      string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationActionsRequest) Reset() {
+	*x = ListModerationActionsRequest{}
+	mi := &file_v1_moderation_moderation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationActionsRequest) ProtoMessage() {}
+
+func (x *ListModerationActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_moderation_moderation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationActionsRequest.ProtoReflect.Descriptor instead.
+func (*ListModerationActionsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_moderation_moderation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListModerationActionsRequest) GetTargetType() ModerationTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return ModerationTargetType_MODERATION_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *ListModerationActionsRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+type ListModerationActionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actions       []*ModerationAction    `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationActionsResponse) Reset() {
+	*x = ListModerationActionsResponse{}
+	mi := &file_v1_moderation_moderation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationActionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationActionsResponse) ProtoMessage() {}
+
+func (x *ListModerationActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_moderation_moderation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationActionsResponse.ProtoReflect.Descriptor instead.
+func (*ListModerationActionsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_moderation_moderation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListModerationActionsResponse) GetActions() []*ModerationAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type MyReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetType    ModerationTargetType   `protobuf:"varint,1,opt,name=target_type,json=targetType,proto3,enum=moderation.v1.ModerationTargetType" json:"target_type,omitempty"`
+	TargetId      string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Label         string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	ReportType    shared.ReportType      `protobuf:"varint,4,opt,name=report_type,json=reportType,proto3,enum=shared.v1.ReportType" json:"report_type,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status        ReportStatus           `protobuf:"varint,6,opt,name=status,proto3,enum=moderation.v1.ReportStatus" json:"status,omitempty"`
+	Resolution    ModerationActionType   `protobuf:"varint,7,opt,name=resolution,proto3,enum=moderation.v1.ModerationActionType" json:"resolution,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ResolvedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MyReport) Reset() {
+	*x = MyReport{}
+	mi := &file_v1_moderation_moderation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MyReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MyReport) ProtoMessage() {}
+
+func (x *MyReport) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_moderation_moderation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MyReport.ProtoReflect.Descriptor instead.
+func (*MyReport) Descriptor() ([]byte, []int) {
+	return file_v1_moderation_moderation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MyReport) GetTargetType() ModerationTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return ModerationTargetType_MODERATION_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *MyReport) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *MyReport) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *MyReport) GetReportType() shared.ReportType {
+	if x != nil {
+		return x.ReportType
+	}
+	return shared.ReportType(0)
+}
+
+func (x *MyReport) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MyReport) GetStatus() ReportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func (x *MyReport) GetResolution() ModerationActionType {
+	if x != nil {
+		return x.Resolution
+	}
+	return ModerationActionType_MODERATION_ACTION_TYPE_UNSPECIFIED
+}
+
+func (x *MyReport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MyReport) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+type ListMyReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyReportsRequest) Reset() {
+	*x = ListMyReportsRequest{}
+	mi := &file_v1_moderation_moderation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyReportsRequest) ProtoMessage() {}
+
+func (x *ListMyReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_moderation_moderation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyReportsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_moderation_moderation_proto_rawDescGZIP(), []int{11}
+}
+
+type ListMyReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reports       []*MyReport            `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyReportsResponse) Reset() {
+	*x = ListMyReportsResponse{}
+	mi := &file_v1_moderation_moderation_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyReportsResponse) ProtoMessage() {}
+
+func (x *ListMyReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_moderation_moderation_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyReportsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_moderation_moderation_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListMyReportsResponse) GetReports() []*MyReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+var File_v1_moderation_moderation_proto protoreflect.FileDescriptor
+
+const file_v1_moderation_moderation_proto_rawDesc = "" +
+	"\n" +
+	"\x1ev1/moderation/moderation.proto\x12\rmoderation.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1av1/shared/reporttype.proto\"_\n" +
+	"\x0fReportTypeCount\x126\n" +
+	"\vreport_type\x18\x01 \x01(\x0e2\x15.shared.v1.ReportTypeR\n" +
+	"reportType\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\xa3\x03\n" +
+	"\vReportGroup\x12D\n" +
+	"\vtarget_type\x18\x01 \x01(\x0e2#.moderation.v1.ModerationTargetTypeR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12\x16\n" +
+	"\x06public\x18\x04 \x01(\bR\x06public\x12#\n" +
+	"\rtotal_reports\x18\x05 \x01(\x05R\ftotalReports\x126\n" +
+	"\x06counts\x18\x06 \x03(\v2\x1e.moderation.v1.ReportTypeCountR\x06counts\x12\x18\n" +
+	"\areasons\x18\a \x03(\tR\areasons\x12F\n" +
+	"\x11first_reported_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x0ffirstReportedAt\x12D\n" +
+	"\x10last_reported_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0elastReportedAt\"\x8c\x01\n" +
+	"\x16ListOpenReportsRequest\x12D\n" +
+	"\vtarget_type\x18\x01 \x01(\x0e2#.moderation.v1.ModerationTargetTypeR\n" +
+	"targetType\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"M\n" +
+	"\x17ListOpenReportsResponse\x122\n" +
+	"\x06groups\x18\x01 \x03(\v2\x1a.moderation.v1.ReportGroupR\x06groups\"\xad\x02\n" +
+	"\vContentEdit\x12(\n" +
+	"\rquestion_text\x18\x01 \x01(\tH\x00R\fquestionText\x88\x01\x01\x12$\n" +
+	"\vanswer_text\x18\x02 \x01(\tH\x01R\n" +
+	"answerText\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"learn_more\x18\x03 \x01(\tH\x02R\tlearnMore\x88\x01\x01\x12 \n" +
+	"\vdistractors\x18\x04 \x03(\tR\vdistractors\x12\x17\n" +
+	"\x04name\x18\x05 \x01(\tH\x03R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x06 \x01(\tH\x04R\vdescription\x88\x01\x01B\x10\n" +
+	"\x0e_question_textB\x0e\n" +
+	"\f_answer_textB\r\n" +
+	"\v_learn_moreB\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_description\"\x97\x03\n" +
+	"\x10ModerationAction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12D\n" +
+	"\vtarget_type\x18\x02 \x01(\x0e2#.moderation.v1.ModerationTargetTypeR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x12;\n" +
+	"\x06action\x18\x04 \x01(\x0e2#.moderation.v1.ModerationActionTypeR\x06action\x12!\n" +
+	"\fmoderator_id\x18\x05 \x01(\tR\vmoderatorId\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x12!\n" +
+	"\freport_count\x18\a \x01(\x05R\vreportCount\x12\x1f\n" +
+	"\vbefore_json\x18\b \x01(\tR\n" +
+	"beforeJson\x12\x1d\n" +
+	"\n" +
+	"after_json\x18\t \x01(\tR\tafterJson\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xfb\x01\n" +
+	"\x15ResolveReportsRequest\x12D\n" +
+	"\vtarget_type\x18\x01 \x01(\x0e2#.moderation.v1.ModerationTargetTypeR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\x12;\n" +
+	"\x06action\x18\x03 \x01(\x0e2#.moderation.v1.ModerationActionTypeR\x06action\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12.\n" +
+	"\x04edit\x18\x05 \x01(\v2\x1a.moderation.v1.ContentEditR\x04edit\"Q\n" +
+	"\x16ResolveReportsResponse\x127\n" +
+	"\x06action\x18\x01 \x01(\v2\x1f.moderation.v1.ModerationActionR\x06action\"\x81\x01\n" +
+	"\x1cListModerationActionsRequest\x12D\n" +
+	"\vtarget_type\x18\x01 \x01(\x0e2#.moderation.v1.ModerationTargetTypeR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\"Z\n" +
+	"\x1dListModerationActionsResponse\x129\n" +
+	"\aactions\x18\x01 \x03(\v2\x1f.moderation.v1.ModerationActionR\aactions\"\xc5\x03\n" +
+	"\bMyReport\x12D\n" +
+	"\vtarget_type\x18\x01 \x01(\x0e2#.moderation.v1.ModerationTargetTypeR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x126\n" +
+	"\vreport_type\x18\x04 \x01(\x0e2\x15.shared.v1.ReportTypeR\n" +
+	"reportType\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x123\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1b.moderation.v1.ReportStatusR\x06status\x12C\n" +
+	"\n" +
+	"resolution\x18\a \x01(\x0e2#.moderation.v1.ModerationActionTypeR\n" +
+	"resolution\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vresolved_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\"\x16\n" +
+	"\x14ListMyReportsRequest\"J\n" +
+	"\x15ListMyReportsResponse\x121\n" +
+	"\areports\x18\x01 \x03(\v2\x17.moderation.v1.MyReportR\areports*\x83\x01\n" +
+	"\x14ModerationTargetType\x12&\n" +
+	"\"MODERATION_TARGET_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fMODERATION_TARGET_TYPE_QUESTION\x10\x01\x12\x1e\n" +
+	"\x1aMODERATION_TARGET_TYPE_TAG\x10\x02*\xca\x01\n" +
+	"\x14ModerationActionType\x12&\n" +
+	"\"MODERATION_ACTION_TYPE_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eMODERATION_ACTION_TYPE_DISMISS\x10\x01\x12\x1f\n" +
+	"\x1bMODERATION_ACTION_TYPE_EDIT\x10\x02\x12$\n" +
+	" MODERATION_ACTION_TYPE_UNPUBLISH\x10\x03\x12\x1f\n" +
+	"\x1bMODERATION_ACTION_TYPE_HIDE\x10\x04*a\n" +
+	"\fReportStatus\x12\x1d\n" +
+	"\x19REPORT_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12REPORT_STATUS_OPEN\x10\x01\x12\x1a\n" +
+	"\x16REPORT_STATUS_RESOLVED\x10\x022\xac\x03\n" +
+	"\x11ModerationService\x12b\n" +
+	"\x0fListOpenReports\x12%.moderation.v1.ListOpenReportsRequest\x1a&.moderation.v1.ListOpenReportsResponse\"\x00\x12_\n" +
+	"\x0eResolveReports\x12$.moderation.v1.ResolveReportsRequest\x1a%.moderation.v1.ResolveReportsResponse\"\x00\x12t\n" +
+	"\x15ListModerationActions\x12+.moderation.v1.ListModerationActionsRequest\x1a,.moderation.v1.ListModerationActionsResponse\"\x00\x12\\\n" +
+	"\rListMyReports\x12#.moderation.v1.ListMyReportsRequest\x1a$.moderation.v1.ListMyReportsResponse\"\x00BLZJgithub.com/studyguides-com/study-guides-api/api/v1/moderation;moderationv1b\x06proto3"
+
+var (
+	file_v1_moderation_moderation_proto_rawDescOnce sync.Once
+	file_v1_moderation_moderation_proto_rawDescData []byte
+)
+
+func file_v1_moderation_moderation_proto_rawDescGZIP() []byte {
+	file_v1_moderation_moderation_proto_rawDescOnce.Do(func() {
+		file_v1_moderation_moderation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_moderation_moderation_proto_rawDesc), len(file_v1_moderation_moderation_proto_rawDesc)))
+	})
+	return file_v1_moderation_moderation_proto_rawDescData
+}
+
+var file_v1_moderation_moderation_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_v1_moderation_moderation_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_v1_moderation_moderation_proto_goTypes = []any{
+	(ModerationTargetType)(0),             // 0: moderation.v1.ModerationTargetType
+	(ModerationActionType)(0),             // 1: moderation.v1.ModerationActionType
+	(ReportStatus)(0),                     // 2: moderation.v1.ReportStatus
+	(*ReportTypeCount)(nil),               // 3: moderation.v1.ReportTypeCount
+	(*ReportGroup)(nil),                   // 4: moderation.v1.ReportGroup
+	(*ListOpenReportsRequest)(nil),        // 5: moderation.v1.ListOpenReportsRequest
+	(*ListOpenReportsResponse)(nil),       // 6: moderation.v1.ListOpenReportsResponse
+	(*ContentEdit)(nil),                   // 7: moderation.v1.ContentEdit
+	(*ModerationAction)(nil),              // 8: moderation.v1.ModerationAction
+	(*ResolveReportsRequest)(nil),         // 9: moderation.v1.ResolveReportsRequest
+	(*ResolveReportsResponse)(nil),        // 10: moderation.v1.ResolveReportsResponse
+	(*ListModerationActionsRequest)(nil),  // 11: moderation.v1.ListModerationActionsRequest
+	(*ListModerationActionsResponse)(nil), // 12: moderation.v1.ListModerationActionsResponse
+	(*MyReport)(nil),                      // 13: moderation.v1.MyReport
+	(*ListMyReportsRequest)(nil),          // 14: moderation.v1.ListMyReportsRequest
+	(*ListMyReportsResponse)(nil),         // 15: moderation.v1.ListMyReportsResponse
+	(shared.ReportType)(0),                // 16: shared.v1.ReportType
+	(*timestamppb.Timestamp)(nil),         // 17: google.protobuf.Timestamp
+}
+var file_v1_moderation_moderation_proto_depIdxs = []int32{
+	16, // 0: moderation.v1.ReportTypeCount.report_type:type_name -> shared.v1.ReportType
+	0,  // 1: moderation.v1.ReportGroup.target_type:type_name -> moderation.v1.ModerationTargetType
+	3,  // 2: moderation.v1.ReportGroup.counts:type_name -> moderation.v1.ReportTypeCount
+	17, // 3: moderation.v1.ReportGroup.first_reported_at:type_name -> google.protobuf.Timestamp
+	17, // 4: moderation.v1.ReportGroup.last_reported_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: moderation.v1.ListOpenReportsRequest.target_type:type_name -> moderation.v1.ModerationTargetType
+	4,  // 6: moderation.v1.ListOpenReportsResponse.groups:type_name -> moderation.v1.ReportGroup
+	0,  // 7: moderation.v1.ModerationAction.target_type:type_name -> moderation.v1.ModerationTargetType
+	1,  // 8: moderation.v1.ModerationAction.action:type_name -> moderation.v1.ModerationActionType
+	17, // 9: moderation.v1.ModerationAction.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 10: moderation.v1.ResolveReportsRequest.target_type:type_name -> moderation.v1.ModerationTargetType
+	1,  // 11: moderation.v1.ResolveReportsRequest.action:type_name -> moderation.v1.ModerationActionType
+	7,  // 12: moderation.v1.ResolveReportsRequest.edit:type_name -> moderation.v1.ContentEdit
+	8,  // 13: moderation.v1.ResolveReportsResponse.action:type_name -> moderation.v1.ModerationAction
+	0,  // 14: moderation.v1.ListModerationActionsRequest.target_type:type_name -> moderation.v1.ModerationTargetType
+	8,  // 15: moderation.v1.ListModerationActionsResponse.actions:type_name -> moderation.v1.ModerationAction
+	0,  // 16: moderation.v1.MyReport.target_type:type_name -> moderation.v1.ModerationTargetType
+	16, // 17: moderation.v1.MyReport.report_type:type_name -> shared.v1.ReportType
+	2,  // 18: moderation.v1.MyReport.status:type_name -> moderation.v1.ReportStatus
+	1,  // 19: moderation.v1.MyReport.resolution:type_name -> moderation.v1.ModerationActionType
+	17, // 20: moderation.v1.MyReport.created_at:type_name -> google.protobuf.Timestamp
+	17, // 21: moderation.v1.MyReport.resolved_at:type_name -> google.protobuf.Timestamp
+	13, // 22: moderation.v1.ListMyReportsResponse.reports:type_name -> moderation.v1.MyReport
+	5,  // 23: moderation.v1.ModerationService.ListOpenReports:input_type -> moderation.v1.ListOpenReportsRequest
+	9,  // 24: moderation.v1.ModerationService.ResolveReports:input_type -> moderation.v1.ResolveReportsRequest
+	11, // 25: moderation.v1.ModerationService.ListModerationActions:input_type -> moderation.v1.ListModerationActionsRequest
+	14, // 26: moderation.v1.ModerationService.ListMyReports:input_type -> moderation.v1.ListMyReportsRequest
+	6,  // 27: moderation.v1.ModerationService.ListOpenReports:output_type -> moderation.v1.ListOpenReportsResponse
+	10, // 28: moderation.v1.ModerationService.ResolveReports:output_type -> moderation.v1.ResolveReportsResponse
+	12, // 29: moderation.v1.ModerationService.ListModerationActions:output_type -> moderation.v1.ListModerationActionsResponse
+	15, // 30: moderation.v1.ModerationService.ListMyReports:output_type -> moderation.v1.ListMyReportsResponse
+	27, // [27:31] is the sub-list for method output_type
+	23, // [23:27] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_v1_moderation_moderation_proto_init() }
+func file_v1_moderation_moderation_proto_init() {
+	if File_v1_moderation_moderation_proto != nil {
+		return
+	}
+	file_v1_moderation_moderation_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_moderation_moderation_proto_rawDesc), len(file_v1_moderation_moderation_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_moderation_moderation_proto_goTypes,
+		DependencyIndexes: file_v1_moderation_moderation_proto_depIdxs,
+		EnumInfos:         file_v1_moderation_moderation_proto_enumTypes,
+		MessageInfos:      file_v1_moderation_moderation_proto_msgTypes,
+	}.Build()
+	File_v1_moderation_moderation_proto = out.File
+	file_v1_moderation_moderation_proto_goTypes = nil
+	file_v1_moderation_moderation_proto_depIdxs = nil
+}
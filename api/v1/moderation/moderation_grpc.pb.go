@@ -0,0 +1,235 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: v1/moderation/moderation.proto
+
+package moderationv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ModerationService_ListOpenReports_FullMethodName       = "/moderation.v1.ModerationService/ListOpenReports"
+	ModerationService_ResolveReports_FullMethodName        = "/moderation.v1.ModerationService/ResolveReports"
+	ModerationService_ListModerationActions_FullMethodName = "/moderation.v1.ModerationService/ListModerationActions"
+	ModerationService_ListMyReports_FullMethodName         = "/moderation.v1.ModerationService/ListMyReports"
+)
+
+// ModerationServiceClient is the client API for ModerationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ModerationServiceClient interface {
+	ListOpenReports(ctx context.Context, in *ListOpenReportsRequest, opts ...grpc.CallOption) (*ListOpenReportsResponse, error)
+	ResolveReports(ctx context.Context, in *ResolveReportsRequest, opts ...grpc.CallOption) (*ResolveReportsResponse, error)
+	ListModerationActions(ctx context.Context, in *ListModerationActionsRequest, opts ...grpc.CallOption) (*ListModerationActionsResponse, error)
+	ListMyReports(ctx context.Context, in *ListMyReportsRequest, opts ...grpc.CallOption) (*ListMyReportsResponse, error)
+}
+
+type moderationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewModerationServiceClient(cc grpc.ClientConnInterface) ModerationServiceClient {
+	return &moderationServiceClient{cc}
+}
+
+func (c *moderationServiceClient) ListOpenReports(ctx context.Context, in *ListOpenReportsRequest, opts ...grpc.CallOption) (*ListOpenReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOpenReportsResponse)
+	err := c.cc.Invoke(ctx, ModerationService_ListOpenReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) ResolveReports(ctx context.Context, in *ResolveReportsRequest, opts ...grpc.CallOption) (*ResolveReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveReportsResponse)
+	err := c.cc.Invoke(ctx, ModerationService_ResolveReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) ListModerationActions(ctx context.Context, in *ListModerationActionsRequest, opts ...grpc.CallOption) (*ListModerationActionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListModerationActionsResponse)
+	err := c.cc.Invoke(ctx, ModerationService_ListModerationActions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) ListMyReports(ctx context.Context, in *ListMyReportsRequest, opts ...grpc.CallOption) (*ListMyReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyReportsResponse)
+	err := c.cc.Invoke(ctx, ModerationService_ListMyReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ModerationServiceServer is the server API for ModerationService service.
+// All implementations must embed UnimplementedModerationServiceServer
+// for forward compatibility.
+type ModerationServiceServer interface {
+	ListOpenReports(context.Context, *ListOpenReportsRequest) (*ListOpenReportsResponse, error)
+	ResolveReports(context.Context, *ResolveReportsRequest) (*ResolveReportsResponse, error)
+	ListModerationActions(context.Context, *ListModerationActionsRequest) (*ListModerationActionsResponse, error)
+	ListMyReports(context.Context, *ListMyReportsRequest) (*ListMyReportsResponse, error)
+	mustEmbedUnimplementedModerationServiceServer()
+}
+
+// UnimplementedModerationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedModerationServiceServer struct{}
+
+func (UnimplementedModerationServiceServer) ListOpenReports(context.Context, *ListOpenReportsRequest) (*ListOpenReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOpenReports not implemented")
+}
+func (UnimplementedModerationServiceServer) ResolveReports(context.Context, *ResolveReportsRequest) (*ResolveReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReports not implemented")
+}
+func (UnimplementedModerationServiceServer) ListModerationActions(context.Context, *ListModerationActionsRequest) (*ListModerationActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationActions not implemented")
+}
+func (UnimplementedModerationServiceServer) ListMyReports(context.Context, *ListMyReportsRequest) (*ListMyReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyReports not implemented")
+}
+func (UnimplementedModerationServiceServer) mustEmbedUnimplementedModerationServiceServer() {}
+func (UnimplementedModerationServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeModerationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ModerationServiceServer will
+// result in compilation errors.
+type UnsafeModerationServiceServer interface {
+	mustEmbedUnimplementedModerationServiceServer()
+}
+
+func RegisterModerationServiceServer(s grpc.ServiceRegistrar, srv ModerationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedModerationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ModerationService_ServiceDesc, srv)
+}
+
+func _ModerationService_ListOpenReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOpenReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ListOpenReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_ListOpenReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ListOpenReports(ctx, req.(*ListOpenReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_ResolveReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ResolveReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_ResolveReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ResolveReports(ctx, req.(*ResolveReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_ListModerationActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ListModerationActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_ListModerationActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ListModerationActions(ctx, req.(*ListModerationActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_ListMyReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ListMyReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_ListMyReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ListMyReports(ctx, req.(*ListMyReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ModerationService_ServiceDesc is the grpc.ServiceDesc for ModerationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ModerationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "moderation.v1.ModerationService",
+	HandlerType: (*ModerationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListOpenReports",
+			Handler:    _ModerationService_ListOpenReports_Handler,
+		},
+		{
+			MethodName: "ResolveReports",
+			Handler:    _ModerationService_ResolveReports_Handler,
+		},
+		{
+			MethodName: "ListModerationActions",
+			Handler:    _ModerationService_ListModerationActions_Handler,
+		},
+		{
+			MethodName: "ListMyReports",
+			Handler:    _ModerationService_ListMyReports_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/moderation/moderation.proto",
+}
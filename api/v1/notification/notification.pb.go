@@ -0,0 +1,349 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: v1/notification/notification.proto
+
+package notificationv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Notification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // e.g. contact.submitted, contact.replied
+	PayloadJson   string                 `protobuf:"bytes,3,opt,name=payload_json,json=payloadJson,proto3" json:"payload_json,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_v1_notification_notification_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_notification_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_v1_notification_notification_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Notification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Notification) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Notification) GetPayloadJson() string {
+	if x != nil {
+		return x.PayloadJson
+	}
+	return ""
+}
+
+func (x *Notification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListPendingNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // defaults to 50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingNotificationsRequest) Reset() {
+	*x = ListPendingNotificationsRequest{}
+	mi := &file_v1_notification_notification_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingNotificationsRequest) ProtoMessage() {}
+
+func (x *ListPendingNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_notification_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_notification_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListPendingNotificationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListPendingNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingNotificationsResponse) Reset() {
+	*x = ListPendingNotificationsResponse{}
+	mi := &file_v1_notification_notification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingNotificationsResponse) ProtoMessage() {}
+
+func (x *ListPendingNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_notification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_notification_notification_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPendingNotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+type AckNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AckNotificationsRequest) Reset() {
+	*x = AckNotificationsRequest{}
+	mi := &file_v1_notification_notification_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AckNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckNotificationsRequest) ProtoMessage() {}
+
+func (x *AckNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_notification_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*AckNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_notification_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AckNotificationsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type AckNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Acknowledged  int32                  `protobuf:"varint,1,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AckNotificationsResponse) Reset() {
+	*x = AckNotificationsResponse{}
+	mi := &file_v1_notification_notification_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AckNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckNotificationsResponse) ProtoMessage() {}
+
+func (x *AckNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_notification_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*AckNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_notification_notification_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AckNotificationsResponse) GetAcknowledged() int32 {
+	if x != nil {
+		return x.Acknowledged
+	}
+	return 0
+}
+
+var File_v1_notification_notification_proto protoreflect.FileDescriptor
+
+const file_v1_notification_notification_proto_rawDesc = "" +
+	"\n" +
+	"\"v1/notification/notification.proto\x12\x0fnotification.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x90\x01\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12!\n" +
+	"\fpayload_json\x18\x03 \x01(\tR\vpayloadJson\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"7\n" +
+	"\x1fListPendingNotificationsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"g\n" +
+	" ListPendingNotificationsResponse\x12C\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x1d.notification.v1.NotificationR\rnotifications\"+\n" +
+	"\x17AckNotificationsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\">\n" +
+	"\x18AckNotificationsResponse\x12\"\n" +
+	"\facknowledged\x18\x01 \x01(\x05R\facknowledged2\x84\x02\n" +
+	"\x13NotificationService\x12\x81\x01\n" +
+	"\x18ListPendingNotifications\x120.notification.v1.ListPendingNotificationsRequest\x1a1.notification.v1.ListPendingNotificationsResponse\"\x00\x12i\n" +
+	"\x10AckNotifications\x12(.notification.v1.AckNotificationsRequest\x1a).notification.v1.AckNotificationsResponse\"\x00BPZNgithub.com/studyguides-com/study-guides-api/api/v1/notification;notificationv1b\x06proto3"
+
+var (
+	file_v1_notification_notification_proto_rawDescOnce sync.Once
+	file_v1_notification_notification_proto_rawDescData []byte
+)
+
+func file_v1_notification_notification_proto_rawDescGZIP() []byte {
+	file_v1_notification_notification_proto_rawDescOnce.Do(func() {
+		file_v1_notification_notification_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_notification_notification_proto_rawDesc), len(file_v1_notification_notification_proto_rawDesc)))
+	})
+	return file_v1_notification_notification_proto_rawDescData
+}
+
+var file_v1_notification_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_v1_notification_notification_proto_goTypes = []any{
+	(*Notification)(nil),                     // 0: notification.v1.Notification
+	(*ListPendingNotificationsRequest)(nil),  // 1: notification.v1.ListPendingNotificationsRequest
+	(*ListPendingNotificationsResponse)(nil), // 2: notification.v1.ListPendingNotificationsResponse
+	(*AckNotificationsRequest)(nil),          // 3: notification.v1.AckNotificationsRequest
+	(*AckNotificationsResponse)(nil),         // 4: notification.v1.AckNotificationsResponse
+	(*timestamppb.Timestamp)(nil),            // 5: google.protobuf.Timestamp
+}
+var file_v1_notification_notification_proto_depIdxs = []int32{
+	5, // 0: notification.v1.Notification.created_at:type_name -> google.protobuf.Timestamp
+	0, // 1: notification.v1.ListPendingNotificationsResponse.notifications:type_name -> notification.v1.Notification
+	1, // 2: notification.v1.NotificationService.ListPendingNotifications:input_type -> notification.v1.ListPendingNotificationsRequest
+	3, // 3: notification.v1.NotificationService.AckNotifications:input_type -> notification.v1.AckNotificationsRequest
+	2, // 4: notification.v1.NotificationService.ListPendingNotifications:output_type -> notification.v1.ListPendingNotificationsResponse
+	4, // 5: notification.v1.NotificationService.AckNotifications:output_type -> notification.v1.AckNotificationsResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_v1_notification_notification_proto_init() }
+func file_v1_notification_notification_proto_init() {
+	if File_v1_notification_notification_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_notification_notification_proto_rawDesc), len(file_v1_notification_notification_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_notification_notification_proto_goTypes,
+		DependencyIndexes: file_v1_notification_notification_proto_depIdxs,
+		MessageInfos:      file_v1_notification_notification_proto_msgTypes,
+	}.Build()
+	File_v1_notification_notification_proto = out.File
+	file_v1_notification_notification_proto_goTypes = nil
+	file_v1_notification_notification_proto_depIdxs = nil
+}
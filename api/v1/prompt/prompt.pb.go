@@ -0,0 +1,985 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: v1/prompt/prompt.proto
+
+package promptv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PromptType int32
+
+const (
+	PromptType_PROMPT_TYPE_UNSPECIFIED    PromptType = 0
+	PromptType_PROMPT_TYPE_TAGGING        PromptType = 1
+	PromptType_PROMPT_TYPE_DISTRACTORS    PromptType = 2
+	PromptType_PROMPT_TYPE_LEARN_MORE     PromptType = 3
+	PromptType_PROMPT_TYPE_CONTENT_RATING PromptType = 4
+	PromptType_PROMPT_TYPE_CHAT           PromptType = 5
+)
+
+// Enum value maps for PromptType.
+var (
+	PromptType_name = map[int32]string{
+		0: "PROMPT_TYPE_UNSPECIFIED",
+		1: "PROMPT_TYPE_TAGGING",
+		2: "PROMPT_TYPE_DISTRACTORS",
+		3: "PROMPT_TYPE_LEARN_MORE",
+		4: "PROMPT_TYPE_CONTENT_RATING",
+		5: "PROMPT_TYPE_CHAT",
+	}
+	PromptType_value = map[string]int32{
+		"PROMPT_TYPE_UNSPECIFIED":    0,
+		"PROMPT_TYPE_TAGGING":        1,
+		"PROMPT_TYPE_DISTRACTORS":    2,
+		"PROMPT_TYPE_LEARN_MORE":     3,
+		"PROMPT_TYPE_CONTENT_RATING": 4,
+		"PROMPT_TYPE_CHAT":           5,
+	}
+)
+
+func (x PromptType) Enum() *PromptType {
+	p := new(PromptType)
+	*p = x
+	return p
+}
+
+func (x PromptType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PromptType) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_prompt_prompt_proto_enumTypes[0].Descriptor()
+}
+
+func (PromptType) Type() protoreflect.EnumType {
+	return &file_v1_prompt_prompt_proto_enumTypes[0]
+}
+
+func (x PromptType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PromptType.Descriptor instead.
+func (PromptType) EnumDescriptor() ([]byte, []int) {
+	return file_v1_prompt_prompt_proto_rawDescGZIP(), []int{0}
+}
+
+type Prompt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          PromptType             `protobuf:"varint,2,opt,name=type,proto3,enum=prompt.v1.PromptType" json:"type,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"` // text of the active version
+	ActiveVersion int32                  `protobuf:"varint,4,opt,name=active_version,json=activeVersion,proto3" json:"active_version,omitempty"`
+	VersionCount  int32                  `protobuf:"varint,5,opt,name=version_count,json=versionCount,proto3" json:"version_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Prompt) Reset() {
+	*x = Prompt{}
+	mi := &file_v1_prompt_prompt_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Prompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Prompt) ProtoMessage() {}
+
+func (x *Prompt) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_prompt_prompt_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Prompt.ProtoReflect.Descriptor instead.
+func (*Prompt) Descriptor() ([]byte, []int) {
+	return file_v1_prompt_prompt_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Prompt) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Prompt) GetType() PromptType {
+	if x != nil {
+		return x.Type
+	}
+	return PromptType_PROMPT_TYPE_UNSPECIFIED
+}
+
+func (x *Prompt) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Prompt) GetActiveVersion() int32 {
+	if x != nil {
+		return x.ActiveVersion
+	}
+	return 0
+}
+
+func (x *Prompt) GetVersionCount() int32 {
+	if x != nil {
+		return x.VersionCount
+	}
+	return 0
+}
+
+func (x *Prompt) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Prompt) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type PromptVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PromptId      string                 `protobuf:"bytes,2,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromptVersion) Reset() {
+	*x = PromptVersion{}
+	mi := &file_v1_prompt_prompt_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromptVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptVersion) ProtoMessage() {}
+
+func (x *PromptVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_prompt_prompt_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptVersion.ProtoReflect.Descriptor instead.
+func (*PromptVersion) Descriptor() ([]byte, []int) {
+	return file_v1_prompt_prompt_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PromptVersion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PromptVersion) GetPromptId() string {
+	if x != nil {
+		return x.PromptId
+	}
+	return ""
+}
+
+func (x *PromptVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PromptVersion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PromptVersion) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *PromptVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListPromptsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromptsRequest) Reset() {
+	*x = ListPromptsRequest{}
+	mi := &file_v1_prompt_prompt_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromptsRequest) ProtoMessage() {}
+
+func (x *ListPromptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_prompt_prompt_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromptsRequest.ProtoReflect.Descriptor instead.
+func (*ListPromptsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_prompt_prompt_proto_rawDescGZIP(), []int{2}
+}
+
+type ListPromptsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompts       []*Prompt              `protobuf:"bytes,1,rep,name=prompts,proto3" json:"prompts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromptsResponse) Reset() {
+	*x = ListPromptsResponse{}
+	mi := &file_v1_prompt_prompt_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromptsResponse) ProtoMessage() {}
+
+func (x *ListPromptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_prompt_prompt_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromptsResponse.ProtoReflect.Descriptor instead.
+func (*ListPromptsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_prompt_prompt_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPromptsResponse) GetPrompts() []*Prompt {
+	if x != nil {
+		return x.Prompts
+	}
+	return nil
+}
+
+type GetPromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          PromptType             `protobuf:"varint,1,opt,name=type,proto3,enum=prompt.v1.PromptType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPromptRequest) Reset() {
+	*x = GetPromptRequest{}
+	mi := &file_v1_prompt_prompt_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPromptRequest) ProtoMessage() {}
+
+func (x *GetPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_prompt_prompt_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPromptRequest.ProtoReflect.Descriptor instead.
+func (*GetPromptRequest) Descriptor() ([]byte, []int) {
+	return file_v1_prompt_prompt_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetPromptRequest) GetType() PromptType {
+	if x != nil {
+		return x.Type
+	}
+	return PromptType_PROMPT_TYPE_UNSPECIFIED
+}
+
+type GetPromptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompt        *Prompt                `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	Versions      []*PromptVersion       `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"` // newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPromptResponse) Reset() {
+	*x = GetPromptResponse{}
+	mi := &file_v1_prompt_prompt_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPromptResponse) ProtoMessage() {}
+
+func (x *GetPromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_prompt_prompt_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPromptResponse.ProtoReflect.Descriptor instead.
+func (*GetPromptResponse) Descriptor() ([]byte, []int) {
+	return file_v1_prompt_prompt_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPromptResponse) GetPrompt() *Prompt {
+	if x != nil {
+		return x.Prompt
+	}
+	return nil
+}
+
+func (x *GetPromptResponse) GetVersions() []*PromptVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type CreatePromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          PromptType             `protobuf:"varint,1,opt,name=type,proto3,enum=prompt.v1.PromptType" json:"type,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"` // becomes version 1 and is active immediately
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromptRequest) Reset() {
+	*x = CreatePromptRequest{}
+	mi := &file_v1_prompt_prompt_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromptRequest) ProtoMessage() {}
+
+func (x *CreatePromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_prompt_prompt_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromptRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromptRequest) Descriptor() ([]byte, []int) {
+	return file_v1_prompt_prompt_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreatePromptRequest) GetType() PromptType {
+	if x != nil {
+		return x.Type
+	}
+	return PromptType_PROMPT_TYPE_UNSPECIFIED
+}
+
+func (x *CreatePromptRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type CreatePromptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompt        *Prompt                `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromptResponse) Reset() {
+	*x = CreatePromptResponse{}
+	mi := &file_v1_prompt_prompt_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromptResponse) ProtoMessage() {}
+
+func (x *CreatePromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_prompt_prompt_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromptResponse.ProtoReflect.Descriptor instead.
+func (*CreatePromptResponse) Descriptor() ([]byte, []int) {
+	return file_v1_prompt_prompt_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreatePromptResponse) GetPrompt() *Prompt {
+	if x != nil {
+		return x.Prompt
+	}
+	return nil
+}
+
+type AddPromptVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromptId      string                 `protobuf:"bytes,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Activate      bool                   `protobuf:"varint,3,opt,name=activate,proto3" json:"activate,omitempty"` // otherwise the version is stored and activated later
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPromptVersionRequest) Reset() {
+	*x = AddPromptVersionRequest{}
+	mi := &file_v1_prompt_prompt_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPromptVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPromptVersionRequest) ProtoMessage() {}
+
+func (x *AddPromptVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_prompt_prompt_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPromptVersionRequest.ProtoReflect.Descriptor instead.
+func (*AddPromptVersionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_prompt_prompt_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AddPromptVersionRequest) GetPromptId() string {
+	if x != nil {
+		return x.PromptId
+	}
+	return ""
+}
+
+func (x *AddPromptVersionRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *AddPromptVersionRequest) GetActivate() bool {
+	if x != nil {
+		return x.Activate
+	}
+	return false
+}
+
+type AddPromptVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       *PromptVersion         `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Prompt        *Prompt                `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPromptVersionResponse) Reset() {
+	*x = AddPromptVersionResponse{}
+	mi := &file_v1_prompt_prompt_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPromptVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPromptVersionResponse) ProtoMessage() {}
+
+func (x *AddPromptVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_prompt_prompt_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPromptVersionResponse.ProtoReflect.Descriptor instead.
+func (*AddPromptVersionResponse) Descriptor() ([]byte, []int) {
+	return file_v1_prompt_prompt_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AddPromptVersionResponse) GetVersion() *PromptVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *AddPromptVersionResponse) GetPrompt() *Prompt {
+	if x != nil {
+		return x.Prompt
+	}
+	return nil
+}
+
+type ActivatePromptVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromptId      string                 `protobuf:"bytes,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivatePromptVersionRequest) Reset() {
+	*x = ActivatePromptVersionRequest{}
+	mi := &file_v1_prompt_prompt_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivatePromptVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivatePromptVersionRequest) ProtoMessage() {}
+
+func (x *ActivatePromptVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_prompt_prompt_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivatePromptVersionRequest.ProtoReflect.Descriptor instead.
+func (*ActivatePromptVersionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_prompt_prompt_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ActivatePromptVersionRequest) GetPromptId() string {
+	if x != nil {
+		return x.PromptId
+	}
+	return ""
+}
+
+func (x *ActivatePromptVersionRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type ActivatePromptVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompt        *Prompt                `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivatePromptVersionResponse) Reset() {
+	*x = ActivatePromptVersionResponse{}
+	mi := &file_v1_prompt_prompt_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivatePromptVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivatePromptVersionResponse) ProtoMessage() {}
+
+func (x *ActivatePromptVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_prompt_prompt_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivatePromptVersionResponse.ProtoReflect.Descriptor instead.
+func (*ActivatePromptVersionResponse) Descriptor() ([]byte, []int) {
+	return file_v1_prompt_prompt_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ActivatePromptVersionResponse) GetPrompt() *Prompt {
+	if x != nil {
+		return x.Prompt
+	}
+	return nil
+}
+
+type RollbackPromptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromptId      string                 `protobuf:"bytes,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackPromptRequest) Reset() {
+	*x = RollbackPromptRequest{}
+	mi := &file_v1_prompt_prompt_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackPromptRequest) ProtoMessage() {}
+
+func (x *RollbackPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_prompt_prompt_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackPromptRequest.ProtoReflect.Descriptor instead.
+func (*RollbackPromptRequest) Descriptor() ([]byte, []int) {
+	return file_v1_prompt_prompt_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RollbackPromptRequest) GetPromptId() string {
+	if x != nil {
+		return x.PromptId
+	}
+	return ""
+}
+
+type RollbackPromptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompt        *Prompt                `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackPromptResponse) Reset() {
+	*x = RollbackPromptResponse{}
+	mi := &file_v1_prompt_prompt_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackPromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackPromptResponse) ProtoMessage() {}
+
+func (x *RollbackPromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_prompt_prompt_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackPromptResponse.ProtoReflect.Descriptor instead.
+func (*RollbackPromptResponse) Descriptor() ([]byte, []int) {
+	return file_v1_prompt_prompt_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RollbackPromptResponse) GetPrompt() *Prompt {
+	if x != nil {
+		return x.Prompt
+	}
+	return nil
+}
+
+var File_v1_prompt_prompt_proto protoreflect.FileDescriptor
+
+const file_v1_prompt_prompt_proto_rawDesc = "" +
+	"\n" +
+	"\x16v1/prompt/prompt.proto\x12\tprompt.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x99\x02\n" +
+	"\x06Prompt\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x15.prompt.v1.PromptTypeR\x04type\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12%\n" +
+	"\x0eactive_version\x18\x04 \x01(\x05R\ractiveVersion\x12#\n" +
+	"\rversion_count\x18\x05 \x01(\x05R\fversionCount\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc4\x01\n" +
+	"\rPromptVersion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tprompt_id\x18\x02 \x01(\tR\bpromptId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x14\n" +
+	"\x12ListPromptsRequest\"B\n" +
+	"\x13ListPromptsResponse\x12+\n" +
+	"\aprompts\x18\x01 \x03(\v2\x11.prompt.v1.PromptR\aprompts\"=\n" +
+	"\x10GetPromptRequest\x12)\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x15.prompt.v1.PromptTypeR\x04type\"t\n" +
+	"\x11GetPromptResponse\x12)\n" +
+	"\x06prompt\x18\x01 \x01(\v2\x11.prompt.v1.PromptR\x06prompt\x124\n" +
+	"\bversions\x18\x02 \x03(\v2\x18.prompt.v1.PromptVersionR\bversions\"T\n" +
+	"\x13CreatePromptRequest\x12)\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x15.prompt.v1.PromptTypeR\x04type\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"A\n" +
+	"\x14CreatePromptResponse\x12)\n" +
+	"\x06prompt\x18\x01 \x01(\v2\x11.prompt.v1.PromptR\x06prompt\"f\n" +
+	"\x17AddPromptVersionRequest\x12\x1b\n" +
+	"\tprompt_id\x18\x01 \x01(\tR\bpromptId\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x1a\n" +
+	"\bactivate\x18\x03 \x01(\bR\bactivate\"y\n" +
+	"\x18AddPromptVersionResponse\x122\n" +
+	"\aversion\x18\x01 \x01(\v2\x18.prompt.v1.PromptVersionR\aversion\x12)\n" +
+	"\x06prompt\x18\x02 \x01(\v2\x11.prompt.v1.PromptR\x06prompt\"U\n" +
+	"\x1cActivatePromptVersionRequest\x12\x1b\n" +
+	"\tprompt_id\x18\x01 \x01(\tR\bpromptId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"J\n" +
+	"\x1dActivatePromptVersionResponse\x12)\n" +
+	"\x06prompt\x18\x01 \x01(\v2\x11.prompt.v1.PromptR\x06prompt\"4\n" +
+	"\x15RollbackPromptRequest\x12\x1b\n" +
+	"\tprompt_id\x18\x01 \x01(\tR\bpromptId\"C\n" +
+	"\x16RollbackPromptResponse\x12)\n" +
+	"\x06prompt\x18\x01 \x01(\v2\x11.prompt.v1.PromptR\x06prompt*\xb1\x01\n" +
+	"\n" +
+	"PromptType\x12\x1b\n" +
+	"\x17PROMPT_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13PROMPT_TYPE_TAGGING\x10\x01\x12\x1b\n" +
+	"\x17PROMPT_TYPE_DISTRACTORS\x10\x02\x12\x1a\n" +
+	"\x16PROMPT_TYPE_LEARN_MORE\x10\x03\x12\x1e\n" +
+	"\x1aPROMPT_TYPE_CONTENT_RATING\x10\x04\x12\x14\n" +
+	"\x10PROMPT_TYPE_CHAT\x10\x052\xa2\x04\n" +
+	"\rPromptService\x12N\n" +
+	"\vListPrompts\x12\x1d.prompt.v1.ListPromptsRequest\x1a\x1e.prompt.v1.ListPromptsResponse\"\x00\x12H\n" +
+	"\tGetPrompt\x12\x1b.prompt.v1.GetPromptRequest\x1a\x1c.prompt.v1.GetPromptResponse\"\x00\x12Q\n" +
+	"\fCreatePrompt\x12\x1e.prompt.v1.CreatePromptRequest\x1a\x1f.prompt.v1.CreatePromptResponse\"\x00\x12]\n" +
+	"\x10AddPromptVersion\x12\".prompt.v1.AddPromptVersionRequest\x1a#.prompt.v1.AddPromptVersionResponse\"\x00\x12l\n" +
+	"\x15ActivatePromptVersion\x12'.prompt.v1.ActivatePromptVersionRequest\x1a(.prompt.v1.ActivatePromptVersionResponse\"\x00\x12W\n" +
+	"\x0eRollbackPrompt\x12 .prompt.v1.RollbackPromptRequest\x1a!.prompt.v1.RollbackPromptResponse\"\x00BDZBgithub.com/studyguides-com/study-guides-api/api/v1/prompt;promptv1b\x06proto3"
+
+var (
+	file_v1_prompt_prompt_proto_rawDescOnce sync.Once
+	file_v1_prompt_prompt_proto_rawDescData []byte
+)
+
+func file_v1_prompt_prompt_proto_rawDescGZIP() []byte {
+	file_v1_prompt_prompt_proto_rawDescOnce.Do(func() {
+		file_v1_prompt_prompt_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_prompt_prompt_proto_rawDesc), len(file_v1_prompt_prompt_proto_rawDesc)))
+	})
+	return file_v1_prompt_prompt_proto_rawDescData
+}
+
+var file_v1_prompt_prompt_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_v1_prompt_prompt_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_v1_prompt_prompt_proto_goTypes = []any{
+	(PromptType)(0),                       // 0: prompt.v1.PromptType
+	(*Prompt)(nil),                        // 1: prompt.v1.Prompt
+	(*PromptVersion)(nil),                 // 2: prompt.v1.PromptVersion
+	(*ListPromptsRequest)(nil),            // 3: prompt.v1.ListPromptsRequest
+	(*ListPromptsResponse)(nil),           // 4: prompt.v1.ListPromptsResponse
+	(*GetPromptRequest)(nil),              // 5: prompt.v1.GetPromptRequest
+	(*GetPromptResponse)(nil),             // 6: prompt.v1.GetPromptResponse
+	(*CreatePromptRequest)(nil),           // 7: prompt.v1.CreatePromptRequest
+	(*CreatePromptResponse)(nil),          // 8: prompt.v1.CreatePromptResponse
+	(*AddPromptVersionRequest)(nil),       // 9: prompt.v1.AddPromptVersionRequest
+	(*AddPromptVersionResponse)(nil),      // 10: prompt.v1.AddPromptVersionResponse
+	(*ActivatePromptVersionRequest)(nil),  // 11: prompt.v1.ActivatePromptVersionRequest
+	(*ActivatePromptVersionResponse)(nil), // 12: prompt.v1.ActivatePromptVersionResponse
+	(*RollbackPromptRequest)(nil),         // 13: prompt.v1.RollbackPromptRequest
+	(*RollbackPromptResponse)(nil),        // 14: prompt.v1.RollbackPromptResponse
+	(*timestamppb.Timestamp)(nil),         // 15: google.protobuf.Timestamp
+}
+var file_v1_prompt_prompt_proto_depIdxs = []int32{
+	0,  // 0: prompt.v1.Prompt.type:type_name -> prompt.v1.PromptType
+	15, // 1: prompt.v1.Prompt.created_at:type_name -> google.protobuf.Timestamp
+	15, // 2: prompt.v1.Prompt.updated_at:type_name -> google.protobuf.Timestamp
+	15, // 3: prompt.v1.PromptVersion.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: prompt.v1.ListPromptsResponse.prompts:type_name -> prompt.v1.Prompt
+	0,  // 5: prompt.v1.GetPromptRequest.type:type_name -> prompt.v1.PromptType
+	1,  // 6: prompt.v1.GetPromptResponse.prompt:type_name -> prompt.v1.Prompt
+	2,  // 7: prompt.v1.GetPromptResponse.versions:type_name -> prompt.v1.PromptVersion
+	0,  // 8: prompt.v1.CreatePromptRequest.type:type_name -> prompt.v1.PromptType
+	1,  // 9: prompt.v1.CreatePromptResponse.prompt:type_name -> prompt.v1.Prompt
+	2,  // 10: prompt.v1.AddPromptVersionResponse.version:type_name -> prompt.v1.PromptVersion
+	1,  // 11: prompt.v1.AddPromptVersionResponse.prompt:type_name -> prompt.v1.Prompt
+	1,  // 12: prompt.v1.ActivatePromptVersionResponse.prompt:type_name -> prompt.v1.Prompt
+	1,  // 13: prompt.v1.RollbackPromptResponse.prompt:type_name -> prompt.v1.Prompt
+	3,  // 14: prompt.v1.PromptService.ListPrompts:input_type -> prompt.v1.ListPromptsRequest
+	5,  // 15: prompt.v1.PromptService.GetPrompt:input_type -> prompt.v1.GetPromptRequest
+	7,  // 16: prompt.v1.PromptService.CreatePrompt:input_type -> prompt.v1.CreatePromptRequest
+	9,  // 17: prompt.v1.PromptService.AddPromptVersion:input_type -> prompt.v1.AddPromptVersionRequest
+	11, // 18: prompt.v1.PromptService.ActivatePromptVersion:input_type -> prompt.v1.ActivatePromptVersionRequest
+	13, // 19: prompt.v1.PromptService.RollbackPrompt:input_type -> prompt.v1.RollbackPromptRequest
+	4,  // 20: prompt.v1.PromptService.ListPrompts:output_type -> prompt.v1.ListPromptsResponse
+	6,  // 21: prompt.v1.PromptService.GetPrompt:output_type -> prompt.v1.GetPromptResponse
+	8,  // 22: prompt.v1.PromptService.CreatePrompt:output_type -> prompt.v1.CreatePromptResponse
+	10, // 23: prompt.v1.PromptService.AddPromptVersion:output_type -> prompt.v1.AddPromptVersionResponse
+	12, // 24: prompt.v1.PromptService.ActivatePromptVersion:output_type -> prompt.v1.ActivatePromptVersionResponse
+	14, // 25: prompt.v1.PromptService.RollbackPrompt:output_type -> prompt.v1.RollbackPromptResponse
+	20, // [20:26] is the sub-list for method output_type
+	14, // [14:20] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_v1_prompt_prompt_proto_init() }
+func file_v1_prompt_prompt_proto_init() {
+	if File_v1_prompt_prompt_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_prompt_prompt_proto_rawDesc), len(file_v1_prompt_prompt_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_prompt_prompt_proto_goTypes,
+		DependencyIndexes: file_v1_prompt_prompt_proto_depIdxs,
+		EnumInfos:         file_v1_prompt_prompt_proto_enumTypes,
+		MessageInfos:      file_v1_prompt_prompt_proto_msgTypes,
+	}.Build()
+	File_v1_prompt_prompt_proto = out.File
+	file_v1_prompt_prompt_proto_goTypes = nil
+	file_v1_prompt_prompt_proto_depIdxs = nil
+}
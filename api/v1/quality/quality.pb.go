@@ -0,0 +1,1123 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: v1/quality/quality.proto
+
+package qualityv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LintSeverity int32
+
+const (
+	LintSeverity_LINT_SEVERITY_UNSPECIFIED LintSeverity = 0
+	LintSeverity_LINT_SEVERITY_INFO        LintSeverity = 1
+	LintSeverity_LINT_SEVERITY_WARNING     LintSeverity = 2
+	LintSeverity_LINT_SEVERITY_ERROR       LintSeverity = 3
+)
+
+// Enum value maps for LintSeverity.
+var (
+	LintSeverity_name = map[int32]string{
+		0: "LINT_SEVERITY_UNSPECIFIED",
+		1: "LINT_SEVERITY_INFO",
+		2: "LINT_SEVERITY_WARNING",
+		3: "LINT_SEVERITY_ERROR",
+	}
+	LintSeverity_value = map[string]int32{
+		"LINT_SEVERITY_UNSPECIFIED": 0,
+		"LINT_SEVERITY_INFO":        1,
+		"LINT_SEVERITY_WARNING":     2,
+		"LINT_SEVERITY_ERROR":       3,
+	}
+)
+
+func (x LintSeverity) Enum() *LintSeverity {
+	p := new(LintSeverity)
+	*p = x
+	return p
+}
+
+func (x LintSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LintSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_quality_quality_proto_enumTypes[0].Descriptor()
+}
+
+func (LintSeverity) Type() protoreflect.EnumType {
+	return &file_v1_quality_quality_proto_enumTypes[0]
+}
+
+func (x LintSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LintSeverity.Descriptor instead.
+func (LintSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_v1_quality_quality_proto_rawDescGZIP(), []int{0}
+}
+
+type QualityJob struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // Running, Completed or Failed
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	MetadataJson  string                 `protobuf:"bytes,8,opt,name=metadata_json,json=metadataJson,proto3" json:"metadata_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QualityJob) Reset() {
+	*x = QualityJob{}
+	mi := &file_v1_quality_quality_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QualityJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QualityJob) ProtoMessage() {}
+
+func (x *QualityJob) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_quality_quality_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QualityJob.ProtoReflect.Descriptor instead.
+func (*QualityJob) Descriptor() ([]byte, []int) {
+	return file_v1_quality_quality_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QualityJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QualityJob) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *QualityJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *QualityJob) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *QualityJob) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *QualityJob) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *QualityJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *QualityJob) GetMetadataJson() string {
+	if x != nil {
+		return x.MetadataJson
+	}
+	return ""
+}
+
+type GetJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
+	mi := &file_v1_quality_quality_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobRequest) ProtoMessage() {}
+
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_quality_quality_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return file_v1_quality_quality_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type GetJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *QualityJob            `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobResponse) Reset() {
+	*x = GetJobResponse{}
+	mi := &file_v1_quality_quality_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobResponse) ProtoMessage() {}
+
+func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_quality_quality_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
+func (*GetJobResponse) Descriptor() ([]byte, []int) {
+	return file_v1_quality_quality_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetJobResponse) GetJob() *QualityJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type StartDuplicateScanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScopeTagId    string                 `protobuf:"bytes,1,opt,name=scope_tag_id,json=scopeTagId,proto3" json:"scope_tag_id,omitempty"` // empty scans the whole catalog
+	Threshold     float64                `protobuf:"fixed64,2,opt,name=threshold,proto3" json:"threshold,omitempty"`                     // estimated similarity needed to cluster, defaults to 0.8
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartDuplicateScanRequest) Reset() {
+	*x = StartDuplicateScanRequest{}
+	mi := &file_v1_quality_quality_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartDuplicateScanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartDuplicateScanRequest) ProtoMessage() {}
+
+func (x *StartDuplicateScanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_quality_quality_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartDuplicateScanRequest.ProtoReflect.Descriptor instead.
+func (*StartDuplicateScanRequest) Descriptor() ([]byte, []int) {
+	return file_v1_quality_quality_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StartDuplicateScanRequest) GetScopeTagId() string {
+	if x != nil {
+		return x.ScopeTagId
+	}
+	return ""
+}
+
+func (x *StartDuplicateScanRequest) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+type StartDuplicateScanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartDuplicateScanResponse) Reset() {
+	*x = StartDuplicateScanResponse{}
+	mi := &file_v1_quality_quality_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartDuplicateScanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartDuplicateScanResponse) ProtoMessage() {}
+
+func (x *StartDuplicateScanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_quality_quality_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartDuplicateScanResponse.ProtoReflect.Descriptor instead.
+func (*StartDuplicateScanResponse) Descriptor() ([]byte, []int) {
+	return file_v1_quality_quality_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StartDuplicateScanResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type DuplicateMember struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId       string                 `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	QuestionText     string                 `protobuf:"bytes,2,opt,name=question_text,json=questionText,proto3" json:"question_text,omitempty"`
+	AnswerText       string                 `protobuf:"bytes,3,opt,name=answer_text,json=answerText,proto3" json:"answer_text,omitempty"`
+	Similarity       float64                `protobuf:"fixed64,4,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	InteractionCount int64                  `protobuf:"varint,5,opt,name=interaction_count,json=interactionCount,proto3" json:"interaction_count,omitempty"`
+	TagPaths         []string               `protobuf:"bytes,6,rep,name=tag_paths,json=tagPaths,proto3" json:"tag_paths,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DuplicateMember) Reset() {
+	*x = DuplicateMember{}
+	mi := &file_v1_quality_quality_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateMember) ProtoMessage() {}
+
+func (x *DuplicateMember) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_quality_quality_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateMember.ProtoReflect.Descriptor instead.
+func (*DuplicateMember) Descriptor() ([]byte, []int) {
+	return file_v1_quality_quality_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DuplicateMember) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *DuplicateMember) GetQuestionText() string {
+	if x != nil {
+		return x.QuestionText
+	}
+	return ""
+}
+
+func (x *DuplicateMember) GetAnswerText() string {
+	if x != nil {
+		return x.AnswerText
+	}
+	return ""
+}
+
+func (x *DuplicateMember) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *DuplicateMember) GetInteractionCount() int64 {
+	if x != nil {
+		return x.InteractionCount
+	}
+	return 0
+}
+
+func (x *DuplicateMember) GetTagPaths() []string {
+	if x != nil {
+		return x.TagPaths
+	}
+	return nil
+}
+
+type DuplicateCluster struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Similarity    float64                `protobuf:"fixed64,3,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	Members       []*DuplicateMember     `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateCluster) Reset() {
+	*x = DuplicateCluster{}
+	mi := &file_v1_quality_quality_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateCluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateCluster) ProtoMessage() {}
+
+func (x *DuplicateCluster) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_quality_quality_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateCluster.ProtoReflect.Descriptor instead.
+func (*DuplicateCluster) Descriptor() ([]byte, []int) {
+	return file_v1_quality_quality_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DuplicateCluster) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DuplicateCluster) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DuplicateCluster) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *DuplicateCluster) GetMembers() []*DuplicateMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type ListDuplicateClustersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // empty uses the latest completed scan for scope_tag_id
+	ScopeTagId    string                 `protobuf:"bytes,2,opt,name=scope_tag_id,json=scopeTagId,proto3" json:"scope_tag_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDuplicateClustersRequest) Reset() {
+	*x = ListDuplicateClustersRequest{}
+	mi := &file_v1_quality_quality_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDuplicateClustersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDuplicateClustersRequest) ProtoMessage() {}
+
+func (x *ListDuplicateClustersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_quality_quality_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDuplicateClustersRequest.ProtoReflect.Descriptor instead.
+func (*ListDuplicateClustersRequest) Descriptor() ([]byte, []int) {
+	return file_v1_quality_quality_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListDuplicateClustersRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ListDuplicateClustersRequest) GetScopeTagId() string {
+	if x != nil {
+		return x.ScopeTagId
+	}
+	return ""
+}
+
+func (x *ListDuplicateClustersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDuplicateClustersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListDuplicateClustersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Clusters      []*DuplicateCluster    `protobuf:"bytes,3,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDuplicateClustersResponse) Reset() {
+	*x = ListDuplicateClustersResponse{}
+	mi := &file_v1_quality_quality_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDuplicateClustersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDuplicateClustersResponse) ProtoMessage() {}
+
+func (x *ListDuplicateClustersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_quality_quality_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDuplicateClustersResponse.ProtoReflect.Descriptor instead.
+func (*ListDuplicateClustersResponse) Descriptor() ([]byte, []int) {
+	return file_v1_quality_quality_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListDuplicateClustersResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ListDuplicateClustersResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListDuplicateClustersResponse) GetClusters() []*DuplicateCluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+type StartLintRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartLintRequest) Reset() {
+	*x = StartLintRequest{}
+	mi := &file_v1_quality_quality_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartLintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartLintRequest) ProtoMessage() {}
+
+func (x *StartLintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_quality_quality_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartLintRequest.ProtoReflect.Descriptor instead.
+func (*StartLintRequest) Descriptor() ([]byte, []int) {
+	return file_v1_quality_quality_proto_rawDescGZIP(), []int{9}
+}
+
+type StartLintResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartLintResponse) Reset() {
+	*x = StartLintResponse{}
+	mi := &file_v1_quality_quality_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartLintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartLintResponse) ProtoMessage() {}
+
+func (x *StartLintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_quality_quality_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartLintResponse.ProtoReflect.Descriptor instead.
+func (*StartLintResponse) Descriptor() ([]byte, []int) {
+	return file_v1_quality_quality_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StartLintResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type LintFinding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	QuestionId    string                 `protobuf:"bytes,3,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	QuestionText  string                 `protobuf:"bytes,4,opt,name=question_text,json=questionText,proto3" json:"question_text,omitempty"`
+	Rule          string                 `protobuf:"bytes,5,opt,name=rule,proto3" json:"rule,omitempty"`
+	Severity      LintSeverity           `protobuf:"varint,6,opt,name=severity,proto3,enum=quality.v1.LintSeverity" json:"severity,omitempty"`
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LintFinding) Reset() {
+	*x = LintFinding{}
+	mi := &file_v1_quality_quality_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LintFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintFinding) ProtoMessage() {}
+
+func (x *LintFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_quality_quality_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintFinding.ProtoReflect.Descriptor instead.
+func (*LintFinding) Descriptor() ([]byte, []int) {
+	return file_v1_quality_quality_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LintFinding) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LintFinding) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *LintFinding) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *LintFinding) GetQuestionText() string {
+	if x != nil {
+		return x.QuestionText
+	}
+	return ""
+}
+
+func (x *LintFinding) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *LintFinding) GetSeverity() LintSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return LintSeverity_LINT_SEVERITY_UNSPECIFIED
+}
+
+func (x *LintFinding) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LintFinding) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListLintFindingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubtreeTagId  string                 `protobuf:"bytes,1,opt,name=subtree_tag_id,json=subtreeTagId,proto3" json:"subtree_tag_id,omitempty"` // only questions linked under this tag
+	Severities    []LintSeverity         `protobuf:"varint,2,rep,packed,name=severities,proto3,enum=quality.v1.LintSeverity" json:"severities,omitempty"`
+	Rule          string                 `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLintFindingsRequest) Reset() {
+	*x = ListLintFindingsRequest{}
+	mi := &file_v1_quality_quality_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLintFindingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLintFindingsRequest) ProtoMessage() {}
+
+func (x *ListLintFindingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_quality_quality_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLintFindingsRequest.ProtoReflect.Descriptor instead.
+func (*ListLintFindingsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_quality_quality_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListLintFindingsRequest) GetSubtreeTagId() string {
+	if x != nil {
+		return x.SubtreeTagId
+	}
+	return ""
+}
+
+func (x *ListLintFindingsRequest) GetSeverities() []LintSeverity {
+	if x != nil {
+		return x.Severities
+	}
+	return nil
+}
+
+func (x *ListLintFindingsRequest) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *ListLintFindingsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListLintFindingsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListLintFindingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Findings      []*LintFinding         `protobuf:"bytes,2,rep,name=findings,proto3" json:"findings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLintFindingsResponse) Reset() {
+	*x = ListLintFindingsResponse{}
+	mi := &file_v1_quality_quality_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLintFindingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLintFindingsResponse) ProtoMessage() {}
+
+func (x *ListLintFindingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_quality_quality_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLintFindingsResponse.ProtoReflect.Descriptor instead.
+func (*ListLintFindingsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_quality_quality_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListLintFindingsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListLintFindingsResponse) GetFindings() []*LintFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+var File_v1_quality_quality_proto protoreflect.FileDescriptor
+
+const file_v1_quality_quality_proto_rawDesc = "" +
+	"\n" +
+	"\x18v1/quality/quality.proto\x12\n" +
+	"quality.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9f\x02\n" +
+	"\n" +
+	"QualityJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x12#\n" +
+	"\rmetadata_json\x18\b \x01(\tR\fmetadataJson\"&\n" +
+	"\rGetJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\":\n" +
+	"\x0eGetJobResponse\x12(\n" +
+	"\x03job\x18\x01 \x01(\v2\x16.quality.v1.QualityJobR\x03job\"[\n" +
+	"\x19StartDuplicateScanRequest\x12 \n" +
+	"\fscope_tag_id\x18\x01 \x01(\tR\n" +
+	"scopeTagId\x12\x1c\n" +
+	"\tthreshold\x18\x02 \x01(\x01R\tthreshold\"3\n" +
+	"\x1aStartDuplicateScanResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\xe2\x01\n" +
+	"\x0fDuplicateMember\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\tR\n" +
+	"questionId\x12#\n" +
+	"\rquestion_text\x18\x02 \x01(\tR\fquestionText\x12\x1f\n" +
+	"\vanswer_text\x18\x03 \x01(\tR\n" +
+	"answerText\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x04 \x01(\x01R\n" +
+	"similarity\x12+\n" +
+	"\x11interaction_count\x18\x05 \x01(\x03R\x10interactionCount\x12\x1b\n" +
+	"\ttag_paths\x18\x06 \x03(\tR\btagPaths\"\x8d\x01\n" +
+	"\x10DuplicateCluster\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x03 \x01(\x01R\n" +
+	"similarity\x125\n" +
+	"\amembers\x18\x04 \x03(\v2\x1b.quality.v1.DuplicateMemberR\amembers\"\x85\x01\n" +
+	"\x1cListDuplicateClustersRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12 \n" +
+	"\fscope_tag_id\x18\x02 \x01(\tR\n" +
+	"scopeTagId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"\x86\x01\n" +
+	"\x1dListDuplicateClustersResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x128\n" +
+	"\bclusters\x18\x03 \x03(\v2\x1c.quality.v1.DuplicateClusterR\bclusters\"\x12\n" +
+	"\x10StartLintRequest\"*\n" +
+	"\x11StartLintResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\x99\x02\n" +
+	"\vLintFinding\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\x12\x1f\n" +
+	"\vquestion_id\x18\x03 \x01(\tR\n" +
+	"questionId\x12#\n" +
+	"\rquestion_text\x18\x04 \x01(\tR\fquestionText\x12\x12\n" +
+	"\x04rule\x18\x05 \x01(\tR\x04rule\x124\n" +
+	"\bseverity\x18\x06 \x01(\x0e2\x18.quality.v1.LintSeverityR\bseverity\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xbb\x01\n" +
+	"\x17ListLintFindingsRequest\x12$\n" +
+	"\x0esubtree_tag_id\x18\x01 \x01(\tR\fsubtreeTagId\x128\n" +
+	"\n" +
+	"severities\x18\x02 \x03(\x0e2\x18.quality.v1.LintSeverityR\n" +
+	"severities\x12\x12\n" +
+	"\x04rule\x18\x03 \x01(\tR\x04rule\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\"e\n" +
+	"\x18ListLintFindingsResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x123\n" +
+	"\bfindings\x18\x02 \x03(\v2\x17.quality.v1.LintFindingR\bfindings*y\n" +
+	"\fLintSeverity\x12\x1d\n" +
+	"\x19LINT_SEVERITY_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12LINT_SEVERITY_INFO\x10\x01\x12\x19\n" +
+	"\x15LINT_SEVERITY_WARNING\x10\x02\x12\x17\n" +
+	"\x13LINT_SEVERITY_ERROR\x10\x032\xd7\x03\n" +
+	"\x0eQualityService\x12A\n" +
+	"\x06GetJob\x12\x19.quality.v1.GetJobRequest\x1a\x1a.quality.v1.GetJobResponse\"\x00\x12e\n" +
+	"\x12StartDuplicateScan\x12%.quality.v1.StartDuplicateScanRequest\x1a&.quality.v1.StartDuplicateScanResponse\"\x00\x12n\n" +
+	"\x15ListDuplicateClusters\x12(.quality.v1.ListDuplicateClustersRequest\x1a).quality.v1.ListDuplicateClustersResponse\"\x00\x12J\n" +
+	"\tStartLint\x12\x1c.quality.v1.StartLintRequest\x1a\x1d.quality.v1.StartLintResponse\"\x00\x12_\n" +
+	"\x10ListLintFindings\x12#.quality.v1.ListLintFindingsRequest\x1a$.quality.v1.ListLintFindingsResponse\"\x00BFZDgithub.com/studyguides-com/study-guides-api/api/v1/quality;qualityv1b\x06proto3"
+
+var (
+	file_v1_quality_quality_proto_rawDescOnce sync.Once
+	file_v1_quality_quality_proto_rawDescData []byte
+)
+
+func file_v1_quality_quality_proto_rawDescGZIP() []byte {
+	file_v1_quality_quality_proto_rawDescOnce.Do(func() {
+		file_v1_quality_quality_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_quality_quality_proto_rawDesc), len(file_v1_quality_quality_proto_rawDesc)))
+	})
+	return file_v1_quality_quality_proto_rawDescData
+}
+
+var file_v1_quality_quality_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_v1_quality_quality_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_v1_quality_quality_proto_goTypes = []any{
+	(LintSeverity)(0),                     // 0: quality.v1.LintSeverity
+	(*QualityJob)(nil),                    // 1: quality.v1.QualityJob
+	(*GetJobRequest)(nil),                 // 2: quality.v1.GetJobRequest
+	(*GetJobResponse)(nil),                // 3: quality.v1.GetJobResponse
+	(*StartDuplicateScanRequest)(nil),     // 4: quality.v1.StartDuplicateScanRequest
+	(*StartDuplicateScanResponse)(nil),    // 5: quality.v1.StartDuplicateScanResponse
+	(*DuplicateMember)(nil),               // 6: quality.v1.DuplicateMember
+	(*DuplicateCluster)(nil),              // 7: quality.v1.DuplicateCluster
+	(*ListDuplicateClustersRequest)(nil),  // 8: quality.v1.ListDuplicateClustersRequest
+	(*ListDuplicateClustersResponse)(nil), // 9: quality.v1.ListDuplicateClustersResponse
+	(*StartLintRequest)(nil),              // 10: quality.v1.StartLintRequest
+	(*StartLintResponse)(nil),             // 11: quality.v1.StartLintResponse
+	(*LintFinding)(nil),                   // 12: quality.v1.LintFinding
+	(*ListLintFindingsRequest)(nil),       // 13: quality.v1.ListLintFindingsRequest
+	(*ListLintFindingsResponse)(nil),      // 14: quality.v1.ListLintFindingsResponse
+	(*timestamppb.Timestamp)(nil),         // 15: google.protobuf.Timestamp
+}
+var file_v1_quality_quality_proto_depIdxs = []int32{
+	15, // 0: quality.v1.QualityJob.started_at:type_name -> google.protobuf.Timestamp
+	15, // 1: quality.v1.QualityJob.completed_at:type_name -> google.protobuf.Timestamp
+	1,  // 2: quality.v1.GetJobResponse.job:type_name -> quality.v1.QualityJob
+	6,  // 3: quality.v1.DuplicateCluster.members:type_name -> quality.v1.DuplicateMember
+	7,  // 4: quality.v1.ListDuplicateClustersResponse.clusters:type_name -> quality.v1.DuplicateCluster
+	0,  // 5: quality.v1.LintFinding.severity:type_name -> quality.v1.LintSeverity
+	15, // 6: quality.v1.LintFinding.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: quality.v1.ListLintFindingsRequest.severities:type_name -> quality.v1.LintSeverity
+	12, // 8: quality.v1.ListLintFindingsResponse.findings:type_name -> quality.v1.LintFinding
+	2,  // 9: quality.v1.QualityService.GetJob:input_type -> quality.v1.GetJobRequest
+	4,  // 10: quality.v1.QualityService.StartDuplicateScan:input_type -> quality.v1.StartDuplicateScanRequest
+	8,  // 11: quality.v1.QualityService.ListDuplicateClusters:input_type -> quality.v1.ListDuplicateClustersRequest
+	10, // 12: quality.v1.QualityService.StartLint:input_type -> quality.v1.StartLintRequest
+	13, // 13: quality.v1.QualityService.ListLintFindings:input_type -> quality.v1.ListLintFindingsRequest
+	3,  // 14: quality.v1.QualityService.GetJob:output_type -> quality.v1.GetJobResponse
+	5,  // 15: quality.v1.QualityService.StartDuplicateScan:output_type -> quality.v1.StartDuplicateScanResponse
+	9,  // 16: quality.v1.QualityService.ListDuplicateClusters:output_type -> quality.v1.ListDuplicateClustersResponse
+	11, // 17: quality.v1.QualityService.StartLint:output_type -> quality.v1.StartLintResponse
+	14, // 18: quality.v1.QualityService.ListLintFindings:output_type -> quality.v1.ListLintFindingsResponse
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_v1_quality_quality_proto_init() }
+func file_v1_quality_quality_proto_init() {
+	if File_v1_quality_quality_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_quality_quality_proto_rawDesc), len(file_v1_quality_quality_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_quality_quality_proto_goTypes,
+		DependencyIndexes: file_v1_quality_quality_proto_depIdxs,
+		EnumInfos:         file_v1_quality_quality_proto_enumTypes,
+		MessageInfos:      file_v1_quality_quality_proto_msgTypes,
+	}.Build()
+	File_v1_quality_quality_proto = out.File
+	file_v1_quality_quality_proto_goTypes = nil
+	file_v1_quality_quality_proto_depIdxs = nil
+}
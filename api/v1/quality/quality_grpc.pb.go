@@ -0,0 +1,273 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: v1/quality/quality.proto
+
+package qualityv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	QualityService_GetJob_FullMethodName                = "/quality.v1.QualityService/GetJob"
+	QualityService_StartDuplicateScan_FullMethodName    = "/quality.v1.QualityService/StartDuplicateScan"
+	QualityService_ListDuplicateClusters_FullMethodName = "/quality.v1.QualityService/ListDuplicateClusters"
+	QualityService_StartLint_FullMethodName             = "/quality.v1.QualityService/StartLint"
+	QualityService_ListLintFindings_FullMethodName      = "/quality.v1.QualityService/ListLintFindings"
+)
+
+// QualityServiceClient is the client API for QualityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QualityServiceClient interface {
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error)
+	StartDuplicateScan(ctx context.Context, in *StartDuplicateScanRequest, opts ...grpc.CallOption) (*StartDuplicateScanResponse, error)
+	ListDuplicateClusters(ctx context.Context, in *ListDuplicateClustersRequest, opts ...grpc.CallOption) (*ListDuplicateClustersResponse, error)
+	StartLint(ctx context.Context, in *StartLintRequest, opts ...grpc.CallOption) (*StartLintResponse, error)
+	ListLintFindings(ctx context.Context, in *ListLintFindingsRequest, opts ...grpc.CallOption) (*ListLintFindingsResponse, error)
+}
+
+type qualityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQualityServiceClient(cc grpc.ClientConnInterface) QualityServiceClient {
+	return &qualityServiceClient{cc}
+}
+
+func (c *qualityServiceClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJobResponse)
+	err := c.cc.Invoke(ctx, QualityService_GetJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qualityServiceClient) StartDuplicateScan(ctx context.Context, in *StartDuplicateScanRequest, opts ...grpc.CallOption) (*StartDuplicateScanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartDuplicateScanResponse)
+	err := c.cc.Invoke(ctx, QualityService_StartDuplicateScan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qualityServiceClient) ListDuplicateClusters(ctx context.Context, in *ListDuplicateClustersRequest, opts ...grpc.CallOption) (*ListDuplicateClustersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDuplicateClustersResponse)
+	err := c.cc.Invoke(ctx, QualityService_ListDuplicateClusters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qualityServiceClient) StartLint(ctx context.Context, in *StartLintRequest, opts ...grpc.CallOption) (*StartLintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartLintResponse)
+	err := c.cc.Invoke(ctx, QualityService_StartLint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qualityServiceClient) ListLintFindings(ctx context.Context, in *ListLintFindingsRequest, opts ...grpc.CallOption) (*ListLintFindingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLintFindingsResponse)
+	err := c.cc.Invoke(ctx, QualityService_ListLintFindings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QualityServiceServer is the server API for QualityService service.
+// All implementations must embed UnimplementedQualityServiceServer
+// for forward compatibility.
+type QualityServiceServer interface {
+	GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error)
+	StartDuplicateScan(context.Context, *StartDuplicateScanRequest) (*StartDuplicateScanResponse, error)
+	ListDuplicateClusters(context.Context, *ListDuplicateClustersRequest) (*ListDuplicateClustersResponse, error)
+	StartLint(context.Context, *StartLintRequest) (*StartLintResponse, error)
+	ListLintFindings(context.Context, *ListLintFindingsRequest) (*ListLintFindingsResponse, error)
+	mustEmbedUnimplementedQualityServiceServer()
+}
+
+// UnimplementedQualityServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQualityServiceServer struct{}
+
+func (UnimplementedQualityServiceServer) GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedQualityServiceServer) StartDuplicateScan(context.Context, *StartDuplicateScanRequest) (*StartDuplicateScanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartDuplicateScan not implemented")
+}
+func (UnimplementedQualityServiceServer) ListDuplicateClusters(context.Context, *ListDuplicateClustersRequest) (*ListDuplicateClustersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDuplicateClusters not implemented")
+}
+func (UnimplementedQualityServiceServer) StartLint(context.Context, *StartLintRequest) (*StartLintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartLint not implemented")
+}
+func (UnimplementedQualityServiceServer) ListLintFindings(context.Context, *ListLintFindingsRequest) (*ListLintFindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLintFindings not implemented")
+}
+func (UnimplementedQualityServiceServer) mustEmbedUnimplementedQualityServiceServer() {}
+func (UnimplementedQualityServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeQualityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QualityServiceServer will
+// result in compilation errors.
+type UnsafeQualityServiceServer interface {
+	mustEmbedUnimplementedQualityServiceServer()
+}
+
+func RegisterQualityServiceServer(s grpc.ServiceRegistrar, srv QualityServiceServer) {
+	// If the following call pancis, it indicates UnimplementedQualityServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&QualityService_ServiceDesc, srv)
+}
+
+func _QualityService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QualityServiceServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QualityService_GetJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QualityServiceServer).GetJob(ctx, req.(*GetJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QualityService_StartDuplicateScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartDuplicateScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QualityServiceServer).StartDuplicateScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QualityService_StartDuplicateScan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QualityServiceServer).StartDuplicateScan(ctx, req.(*StartDuplicateScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QualityService_ListDuplicateClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDuplicateClustersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QualityServiceServer).ListDuplicateClusters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QualityService_ListDuplicateClusters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QualityServiceServer).ListDuplicateClusters(ctx, req.(*ListDuplicateClustersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QualityService_StartLint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartLintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QualityServiceServer).StartLint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QualityService_StartLint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QualityServiceServer).StartLint(ctx, req.(*StartLintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QualityService_ListLintFindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLintFindingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QualityServiceServer).ListLintFindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QualityService_ListLintFindings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QualityServiceServer).ListLintFindings(ctx, req.(*ListLintFindingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QualityService_ServiceDesc is the grpc.ServiceDesc for QualityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QualityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "quality.v1.QualityService",
+	HandlerType: (*QualityServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetJob",
+			Handler:    _QualityService_GetJob_Handler,
+		},
+		{
+			MethodName: "StartDuplicateScan",
+			Handler:    _QualityService_StartDuplicateScan_Handler,
+		},
+		{
+			MethodName: "ListDuplicateClusters",
+			Handler:    _QualityService_ListDuplicateClusters_Handler,
+		},
+		{
+			MethodName: "StartLint",
+			Handler:    _QualityService_StartLint_Handler,
+		},
+		{
+			MethodName: "ListLintFindings",
+			Handler:    _QualityService_ListLintFindings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/quality/quality.proto",
+}
@@ -25,6 +25,7 @@ const (
 type ForTagRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TagId         string                 `protobuf:"bytes,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	StudyMethod   *shared.StudyMethod    `protobuf:"varint,2,opt,name=study_method,json=studyMethod,proto3,enum=shared.v1.StudyMethod,oneof" json:"study_method,omitempty"` // Test needs a premium plan, as does recording test answers
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,9 +67,18 @@ func (x *ForTagRequest) GetTagId() string {
 	return ""
 }
 
+func (x *ForTagRequest) GetStudyMethod() shared.StudyMethod {
+	if x != nil && x.StudyMethod != nil {
+		return *x.StudyMethod
+	}
+	return shared.StudyMethod(0)
+}
+
 type QuestionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Questions     []*shared.Question     `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+	Preview       bool                   `protobuf:"varint,2,opt,name=preview,proto3" json:"preview,omitempty"`                         // cut to the preview count, the tag is premium and the caller isn't
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // questions on the tag before any preview cut
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -110,6 +120,20 @@ func (x *QuestionsResponse) GetQuestions() []*shared.Question {
 	return nil
 }
 
+func (x *QuestionsResponse) GetPreview() bool {
+	if x != nil {
+		return x.Preview
+	}
+	return false
+}
+
+func (x *QuestionsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 type QuestionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Question      *shared.Question       `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
@@ -258,15 +282,124 @@ func (x *ReportQuestionResponse) GetSuccess() bool {
 	return false
 }
 
+type ExportQuestionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagId         string                 `protobuf:"bytes,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportQuestionsRequest) Reset() {
+	*x = ExportQuestionsRequest{}
+	mi := &file_v1_question_question_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportQuestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportQuestionsRequest) ProtoMessage() {}
+
+func (x *ExportQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_question_question_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*ExportQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_question_question_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExportQuestionsRequest) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+type ExportQuestionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"` // CSV with question, answer and learn more columns
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportQuestionsResponse) Reset() {
+	*x = ExportQuestionsResponse{}
+	mi := &file_v1_question_question_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportQuestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportQuestionsResponse) ProtoMessage() {}
+
+func (x *ExportQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_question_question_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*ExportQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_question_question_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExportQuestionsResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportQuestionsResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportQuestionsResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
 var File_v1_question_question_proto protoreflect.FileDescriptor
 
 const file_v1_question_question_proto_rawDesc = "" +
 	"\n" +
-	"\x1av1/question/question.proto\x12\vquestion.v1\x1a\x18v1/shared/question.proto\x1a\x1av1/shared/reporttype.proto\"&\n" +
+	"\x1av1/question/question.proto\x12\vquestion.v1\x1a\x18v1/shared/question.proto\x1a\x1av1/shared/reporttype.proto\x1a\x1bv1/shared/studymethod.proto\"w\n" +
 	"\rForTagRequest\x12\x15\n" +
-	"\x06tag_id\x18\x01 \x01(\tR\x05tagId\"F\n" +
+	"\x06tag_id\x18\x01 \x01(\tR\x05tagId\x12>\n" +
+	"\fstudy_method\x18\x02 \x01(\x0e2\x16.shared.v1.StudyMethodH\x00R\vstudyMethod\x88\x01\x01B\x0f\n" +
+	"\r_study_method\"\x81\x01\n" +
 	"\x11QuestionsResponse\x121\n" +
-	"\tquestions\x18\x01 \x03(\v2\x13.shared.v1.QuestionR\tquestions\"C\n" +
+	"\tquestions\x18\x01 \x03(\v2\x13.shared.v1.QuestionR\tquestions\x12\x18\n" +
+	"\apreview\x18\x02 \x01(\bR\apreview\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"C\n" +
 	"\x10QuestionResponse\x12/\n" +
 	"\bquestion\x18\x01 \x01(\v2\x13.shared.v1.QuestionR\bquestion\"\x88\x01\n" +
 	"\x15ReportQuestionRequest\x12\x1f\n" +
@@ -276,10 +409,17 @@ const file_v1_question_question_proto_rawDesc = "" +
 	"reportType\x12\x16\n" +
 	"\x06reason\x18\x03 \x01(\tR\x06reason\"2\n" +
 	"\x16ReportQuestionResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xaa\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"/\n" +
+	"\x16ExportQuestionsRequest\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\tR\x05tagId\"r\n" +
+	"\x17ExportQuestionsResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent2\x88\x02\n" +
 	"\x0fQuestionService\x12D\n" +
 	"\x06ForTag\x12\x1a.question.v1.ForTagRequest\x1a\x1e.question.v1.QuestionsResponse\x12Q\n" +
-	"\x06Report\x12\".question.v1.ReportQuestionRequest\x1a#.question.v1.ReportQuestionResponseBHZFgithub.com/studyguides-com/study-guides-api/api/v1/question;questionv1b\x06proto3"
+	"\x06Report\x12\".question.v1.ReportQuestionRequest\x1a#.question.v1.ReportQuestionResponse\x12\\\n" +
+	"\x0fExportQuestions\x12#.question.v1.ExportQuestionsRequest\x1a$.question.v1.ExportQuestionsResponseBHZFgithub.com/studyguides-com/study-guides-api/api/v1/question;questionv1b\x06proto3"
 
 var (
 	file_v1_question_question_proto_rawDescOnce sync.Once
@@ -293,29 +433,35 @@ func file_v1_question_question_proto_rawDescGZIP() []byte {
 	return file_v1_question_question_proto_rawDescData
 }
 
-var file_v1_question_question_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_v1_question_question_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_v1_question_question_proto_goTypes = []any{
-	(*ForTagRequest)(nil),          // 0: question.v1.ForTagRequest
-	(*QuestionsResponse)(nil),      // 1: question.v1.QuestionsResponse
-	(*QuestionResponse)(nil),       // 2: question.v1.QuestionResponse
-	(*ReportQuestionRequest)(nil),  // 3: question.v1.ReportQuestionRequest
-	(*ReportQuestionResponse)(nil), // 4: question.v1.ReportQuestionResponse
-	(*shared.Question)(nil),        // 5: shared.v1.Question
-	(shared.ReportType)(0),         // 6: shared.v1.ReportType
+	(*ForTagRequest)(nil),           // 0: question.v1.ForTagRequest
+	(*QuestionsResponse)(nil),       // 1: question.v1.QuestionsResponse
+	(*QuestionResponse)(nil),        // 2: question.v1.QuestionResponse
+	(*ReportQuestionRequest)(nil),   // 3: question.v1.ReportQuestionRequest
+	(*ReportQuestionResponse)(nil),  // 4: question.v1.ReportQuestionResponse
+	(*ExportQuestionsRequest)(nil),  // 5: question.v1.ExportQuestionsRequest
+	(*ExportQuestionsResponse)(nil), // 6: question.v1.ExportQuestionsResponse
+	(shared.StudyMethod)(0),         // 7: shared.v1.StudyMethod
+	(*shared.Question)(nil),         // 8: shared.v1.Question
+	(shared.ReportType)(0),          // 9: shared.v1.ReportType
 }
 var file_v1_question_question_proto_depIdxs = []int32{
-	5, // 0: question.v1.QuestionsResponse.questions:type_name -> shared.v1.Question
-	5, // 1: question.v1.QuestionResponse.question:type_name -> shared.v1.Question
-	6, // 2: question.v1.ReportQuestionRequest.report_type:type_name -> shared.v1.ReportType
-	0, // 3: question.v1.QuestionService.ForTag:input_type -> question.v1.ForTagRequest
-	3, // 4: question.v1.QuestionService.Report:input_type -> question.v1.ReportQuestionRequest
-	1, // 5: question.v1.QuestionService.ForTag:output_type -> question.v1.QuestionsResponse
-	4, // 6: question.v1.QuestionService.Report:output_type -> question.v1.ReportQuestionResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	7, // 0: question.v1.ForTagRequest.study_method:type_name -> shared.v1.StudyMethod
+	8, // 1: question.v1.QuestionsResponse.questions:type_name -> shared.v1.Question
+	8, // 2: question.v1.QuestionResponse.question:type_name -> shared.v1.Question
+	9, // 3: question.v1.ReportQuestionRequest.report_type:type_name -> shared.v1.ReportType
+	0, // 4: question.v1.QuestionService.ForTag:input_type -> question.v1.ForTagRequest
+	3, // 5: question.v1.QuestionService.Report:input_type -> question.v1.ReportQuestionRequest
+	5, // 6: question.v1.QuestionService.ExportQuestions:input_type -> question.v1.ExportQuestionsRequest
+	1, // 7: question.v1.QuestionService.ForTag:output_type -> question.v1.QuestionsResponse
+	4, // 8: question.v1.QuestionService.Report:output_type -> question.v1.ReportQuestionResponse
+	6, // 9: question.v1.QuestionService.ExportQuestions:output_type -> question.v1.ExportQuestionsResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_v1_question_question_proto_init() }
@@ -323,13 +469,14 @@ func file_v1_question_question_proto_init() {
 	if File_v1_question_question_proto != nil {
 		return
 	}
+	file_v1_question_question_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_question_question_proto_rawDesc), len(file_v1_question_question_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
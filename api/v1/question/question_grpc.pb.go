@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	QuestionService_ForTag_FullMethodName = "/question.v1.QuestionService/ForTag"
-	QuestionService_Report_FullMethodName = "/question.v1.QuestionService/Report"
+	QuestionService_ForTag_FullMethodName          = "/question.v1.QuestionService/ForTag"
+	QuestionService_Report_FullMethodName          = "/question.v1.QuestionService/Report"
+	QuestionService_ExportQuestions_FullMethodName = "/question.v1.QuestionService/ExportQuestions"
 )
 
 // QuestionServiceClient is the client API for QuestionService service.
@@ -29,6 +30,8 @@ const (
 type QuestionServiceClient interface {
 	ForTag(ctx context.Context, in *ForTagRequest, opts ...grpc.CallOption) (*QuestionsResponse, error)
 	Report(ctx context.Context, in *ReportQuestionRequest, opts ...grpc.CallOption) (*ReportQuestionResponse, error)
+	// Premium only
+	ExportQuestions(ctx context.Context, in *ExportQuestionsRequest, opts ...grpc.CallOption) (*ExportQuestionsResponse, error)
 }
 
 type questionServiceClient struct {
@@ -59,12 +62,24 @@ func (c *questionServiceClient) Report(ctx context.Context, in *ReportQuestionRe
 	return out, nil
 }
 
+func (c *questionServiceClient) ExportQuestions(ctx context.Context, in *ExportQuestionsRequest, opts ...grpc.CallOption) (*ExportQuestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportQuestionsResponse)
+	err := c.cc.Invoke(ctx, QuestionService_ExportQuestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QuestionServiceServer is the server API for QuestionService service.
 // All implementations must embed UnimplementedQuestionServiceServer
 // for forward compatibility.
 type QuestionServiceServer interface {
 	ForTag(context.Context, *ForTagRequest) (*QuestionsResponse, error)
 	Report(context.Context, *ReportQuestionRequest) (*ReportQuestionResponse, error)
+	// Premium only
+	ExportQuestions(context.Context, *ExportQuestionsRequest) (*ExportQuestionsResponse, error)
 	mustEmbedUnimplementedQuestionServiceServer()
 }
 
@@ -81,6 +96,9 @@ func (UnimplementedQuestionServiceServer) ForTag(context.Context, *ForTagRequest
 func (UnimplementedQuestionServiceServer) Report(context.Context, *ReportQuestionRequest) (*ReportQuestionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Report not implemented")
 }
+func (UnimplementedQuestionServiceServer) ExportQuestions(context.Context, *ExportQuestionsRequest) (*ExportQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportQuestions not implemented")
+}
 func (UnimplementedQuestionServiceServer) mustEmbedUnimplementedQuestionServiceServer() {}
 func (UnimplementedQuestionServiceServer) testEmbeddedByValue()                         {}
 
@@ -138,6 +156,24 @@ func _QuestionService_Report_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QuestionService_ExportQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).ExportQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuestionService_ExportQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).ExportQuestions(ctx, req.(*ExportQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // QuestionService_ServiceDesc is the grpc.ServiceDesc for QuestionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +189,10 @@ var QuestionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Report",
 			Handler:    _QuestionService_Report_Handler,
 		},
+		{
+			MethodName: "ExportQuestions",
+			Handler:    _QuestionService_ExportQuestions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/question/question.proto",
@@ -10,6 +10,7 @@ import (
 	shared "github.com/studyguides-com/study-guides-api/api/v1/shared"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -758,11 +759,348 @@ func (x *MarkBundleExportedResponse) GetUpdated() bool {
 	return false
 }
 
+// PromoteBundleRequest represents a request to import a bundle into an environment
+type PromoteBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExportType    shared.ExportType      `protobuf:"varint,2,opt,name=export_type,json=exportType,proto3,enum=shared.v1.ExportType" json:"export_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoteBundleRequest) Reset() {
+	*x = PromoteBundleRequest{}
+	mi := &file_v1_roland_roland_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteBundleRequest) ProtoMessage() {}
+
+func (x *PromoteBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_roland_roland_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteBundleRequest.ProtoReflect.Descriptor instead.
+func (*PromoteBundleRequest) Descriptor() ([]byte, []int) {
+	return file_v1_roland_roland_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PromoteBundleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PromoteBundleRequest) GetExportType() shared.ExportType {
+	if x != nil {
+		return x.ExportType
+	}
+	return shared.ExportType(0)
+}
+
+// PromoteBundleResponse represents the response from promoting a bundle
+type PromoteBundleResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Promotion        *BundlePromotion       `protobuf:"bytes,1,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	TagsCreated      int32                  `protobuf:"varint,2,opt,name=tags_created,json=tagsCreated,proto3" json:"tags_created,omitempty"`
+	TagsUpdated      int32                  `protobuf:"varint,3,opt,name=tags_updated,json=tagsUpdated,proto3" json:"tags_updated,omitempty"`
+	QuestionsCreated int32                  `protobuf:"varint,4,opt,name=questions_created,json=questionsCreated,proto3" json:"questions_created,omitempty"`
+	QuestionsUpdated int32                  `protobuf:"varint,5,opt,name=questions_updated,json=questionsUpdated,proto3" json:"questions_updated,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PromoteBundleResponse) Reset() {
+	*x = PromoteBundleResponse{}
+	mi := &file_v1_roland_roland_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteBundleResponse) ProtoMessage() {}
+
+func (x *PromoteBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_roland_roland_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteBundleResponse.ProtoReflect.Descriptor instead.
+func (*PromoteBundleResponse) Descriptor() ([]byte, []int) {
+	return file_v1_roland_roland_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PromoteBundleResponse) GetPromotion() *BundlePromotion {
+	if x != nil {
+		return x.Promotion
+	}
+	return nil
+}
+
+func (x *PromoteBundleResponse) GetTagsCreated() int32 {
+	if x != nil {
+		return x.TagsCreated
+	}
+	return 0
+}
+
+func (x *PromoteBundleResponse) GetTagsUpdated() int32 {
+	if x != nil {
+		return x.TagsUpdated
+	}
+	return 0
+}
+
+func (x *PromoteBundleResponse) GetQuestionsCreated() int32 {
+	if x != nil {
+		return x.QuestionsCreated
+	}
+	return 0
+}
+
+func (x *PromoteBundleResponse) GetQuestionsUpdated() int32 {
+	if x != nil {
+		return x.QuestionsUpdated
+	}
+	return 0
+}
+
+// BundlePromotion records who promoted a bundle to which environment and when
+type BundlePromotion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BundleId      string                 `protobuf:"bytes,2,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	ShortId       string                 `protobuf:"bytes,3,opt,name=short_id,json=shortId,proto3" json:"short_id,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	ExportType    shared.ExportType      `protobuf:"varint,5,opt,name=export_type,json=exportType,proto3,enum=shared.v1.ExportType" json:"export_type,omitempty"`
+	PromotedBy    string                 `protobuf:"bytes,6,opt,name=promoted_by,json=promotedBy,proto3" json:"promoted_by,omitempty"`
+	BatchId       string                 `protobuf:"bytes,7,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	Succeeded     bool                   `protobuf:"varint,8,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Error         string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BundlePromotion) Reset() {
+	*x = BundlePromotion{}
+	mi := &file_v1_roland_roland_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundlePromotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundlePromotion) ProtoMessage() {}
+
+func (x *BundlePromotion) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_roland_roland_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundlePromotion.ProtoReflect.Descriptor instead.
+func (*BundlePromotion) Descriptor() ([]byte, []int) {
+	return file_v1_roland_roland_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BundlePromotion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BundlePromotion) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+func (x *BundlePromotion) GetShortId() string {
+	if x != nil {
+		return x.ShortId
+	}
+	return ""
+}
+
+func (x *BundlePromotion) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BundlePromotion) GetExportType() shared.ExportType {
+	if x != nil {
+		return x.ExportType
+	}
+	return shared.ExportType(0)
+}
+
+func (x *BundlePromotion) GetPromotedBy() string {
+	if x != nil {
+		return x.PromotedBy
+	}
+	return ""
+}
+
+func (x *BundlePromotion) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *BundlePromotion) GetSucceeded() bool {
+	if x != nil {
+		return x.Succeeded
+	}
+	return false
+}
+
+func (x *BundlePromotion) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BundlePromotion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListPromotionsRequest represents a request to list promotions, optionally for one bundle
+type ListPromotionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BundleId      string                 `protobuf:"bytes,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromotionsRequest) Reset() {
+	*x = ListPromotionsRequest{}
+	mi := &file_v1_roland_roland_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionsRequest) ProtoMessage() {}
+
+func (x *ListPromotionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_roland_roland_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPromotionsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_roland_roland_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListPromotionsRequest) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+// ListPromotionsResponse represents the response containing promotions
+type ListPromotionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotions    []*BundlePromotion     `protobuf:"bytes,1,rep,name=promotions,proto3" json:"promotions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromotionsResponse) Reset() {
+	*x = ListPromotionsResponse{}
+	mi := &file_v1_roland_roland_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionsResponse) ProtoMessage() {}
+
+func (x *ListPromotionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_roland_roland_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPromotionsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_roland_roland_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListPromotionsResponse) GetPromotions() []*BundlePromotion {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
 var File_v1_roland_roland_proto protoreflect.FileDescriptor
 
 const file_v1_roland_roland_proto_rawDesc = "" +
 	"\n" +
-	"\x16v1/roland/roland.proto\x12\troland.v1\x1a\x16v1/shared/bundle.proto\x1a\x1av1/shared/exporttype.proto\x1a\x1av1/shared/parsertype.proto\"T\n" +
+	"\x16v1/roland/roland.proto\x12\troland.v1\x1a\x16v1/shared/bundle.proto\x1a\x1av1/shared/exporttype.proto\x1a\x1av1/shared/parsertype.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"T\n" +
 	"\x11SaveBundleRequest\x12)\n" +
 	"\x06bundle\x18\x01 \x01(\v2\x11.shared.v1.BundleR\x06bundle\x12\x14\n" +
 	"\x05force\x18\x02 \x01(\bR\x05force\".\n" +
@@ -799,7 +1137,38 @@ const file_v1_roland_roland_proto_rawDesc = "" +
 	"\vexport_type\x18\x02 \x01(\x0e2\x15.shared.v1.ExportTypeR\n" +
 	"exportType\"6\n" +
 	"\x1aMarkBundleExportedResponse\x12\x18\n" +
-	"\aupdated\x18\x01 \x01(\bR\aupdated2\xd6\x05\n" +
+	"\aupdated\x18\x01 \x01(\bR\aupdated\"^\n" +
+	"\x14PromoteBundleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x126\n" +
+	"\vexport_type\x18\x02 \x01(\x0e2\x15.shared.v1.ExportTypeR\n" +
+	"exportType\"\xf1\x01\n" +
+	"\x15PromoteBundleResponse\x128\n" +
+	"\tpromotion\x18\x01 \x01(\v2\x1a.roland.v1.BundlePromotionR\tpromotion\x12!\n" +
+	"\ftags_created\x18\x02 \x01(\x05R\vtagsCreated\x12!\n" +
+	"\ftags_updated\x18\x03 \x01(\x05R\vtagsUpdated\x12+\n" +
+	"\x11questions_created\x18\x04 \x01(\x05R\x10questionsCreated\x12+\n" +
+	"\x11questions_updated\x18\x05 \x01(\x05R\x10questionsUpdated\"\xd2\x02\n" +
+	"\x0fBundlePromotion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tbundle_id\x18\x02 \x01(\tR\bbundleId\x12\x19\n" +
+	"\bshort_id\x18\x03 \x01(\tR\ashortId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x126\n" +
+	"\vexport_type\x18\x05 \x01(\x0e2\x15.shared.v1.ExportTypeR\n" +
+	"exportType\x12\x1f\n" +
+	"\vpromoted_by\x18\x06 \x01(\tR\n" +
+	"promotedBy\x12\x19\n" +
+	"\bbatch_id\x18\a \x01(\tR\abatchId\x12\x1c\n" +
+	"\tsucceeded\x18\b \x01(\bR\tsucceeded\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"4\n" +
+	"\x15ListPromotionsRequest\x12\x1b\n" +
+	"\tbundle_id\x18\x01 \x01(\tR\bbundleId\"T\n" +
+	"\x16ListPromotionsResponse\x12:\n" +
+	"\n" +
+	"promotions\x18\x01 \x03(\v2\x1a.roland.v1.BundlePromotionR\n" +
+	"promotions2\x81\a\n" +
 	"\rRolandService\x12I\n" +
 	"\n" +
 	"SaveBundle\x12\x1c.roland.v1.SaveBundleRequest\x1a\x1d.roland.v1.SaveBundleResponse\x12@\n" +
@@ -809,7 +1178,9 @@ const file_v1_roland_roland_proto_rawDesc = "" +
 	"\x10DeleteAllBundles\x12\".roland.v1.DeleteAllBundlesRequest\x1a#.roland.v1.DeleteAllBundlesResponse\x12[\n" +
 	"\x10DeleteBundleByID\x12\".roland.v1.DeleteBundleByIDRequest\x1a#.roland.v1.DeleteBundleByIDResponse\x12m\n" +
 	"\x16DeleteBundlesByShortID\x12(.roland.v1.DeleteBundlesByShortIDRequest\x1a).roland.v1.DeleteBundlesByShortIDResponse\x12a\n" +
-	"\x12MarkBundleExported\x12$.roland.v1.MarkBundleExportedRequest\x1a%.roland.v1.MarkBundleExportedResponseBDZBgithub.com.studyguides-com/study-guides-api/api/v1/roland;rolandv1b\x06proto3"
+	"\x12MarkBundleExported\x12$.roland.v1.MarkBundleExportedRequest\x1a%.roland.v1.MarkBundleExportedResponse\x12R\n" +
+	"\rPromoteBundle\x12\x1f.roland.v1.PromoteBundleRequest\x1a .roland.v1.PromoteBundleResponse\x12U\n" +
+	"\x0eListPromotions\x12 .roland.v1.ListPromotionsRequest\x1a!.roland.v1.ListPromotionsResponseBDZBgithub.com.studyguides-com/study-guides-api/api/v1/roland;rolandv1b\x06proto3"
 
 var (
 	file_v1_roland_roland_proto_rawDescOnce sync.Once
@@ -823,7 +1194,7 @@ func file_v1_roland_roland_proto_rawDescGZIP() []byte {
 	return file_v1_roland_roland_proto_rawDescData
 }
 
-var file_v1_roland_roland_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_v1_roland_roland_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_v1_roland_roland_proto_goTypes = []any{
 	(*SaveBundleRequest)(nil),              // 0: roland.v1.SaveBundleRequest
 	(*SaveBundleResponse)(nil),             // 1: roland.v1.SaveBundleResponse
@@ -841,37 +1212,52 @@ var file_v1_roland_roland_proto_goTypes = []any{
 	(*DeleteBundlesByShortIDResponse)(nil), // 13: roland.v1.DeleteBundlesByShortIDResponse
 	(*MarkBundleExportedRequest)(nil),      // 14: roland.v1.MarkBundleExportedRequest
 	(*MarkBundleExportedResponse)(nil),     // 15: roland.v1.MarkBundleExportedResponse
-	(*shared.Bundle)(nil),                  // 16: shared.v1.Bundle
-	(shared.ParserType)(0),                 // 17: shared.v1.ParserType
-	(shared.ExportType)(0),                 // 18: shared.v1.ExportType
+	(*PromoteBundleRequest)(nil),           // 16: roland.v1.PromoteBundleRequest
+	(*PromoteBundleResponse)(nil),          // 17: roland.v1.PromoteBundleResponse
+	(*BundlePromotion)(nil),                // 18: roland.v1.BundlePromotion
+	(*ListPromotionsRequest)(nil),          // 19: roland.v1.ListPromotionsRequest
+	(*ListPromotionsResponse)(nil),         // 20: roland.v1.ListPromotionsResponse
+	(*shared.Bundle)(nil),                  // 21: shared.v1.Bundle
+	(shared.ParserType)(0),                 // 22: shared.v1.ParserType
+	(shared.ExportType)(0),                 // 23: shared.v1.ExportType
+	(*timestamppb.Timestamp)(nil),          // 24: google.protobuf.Timestamp
 }
 var file_v1_roland_roland_proto_depIdxs = []int32{
-	16, // 0: roland.v1.SaveBundleRequest.bundle:type_name -> shared.v1.Bundle
-	16, // 1: roland.v1.BundlesResponse.bundles:type_name -> shared.v1.Bundle
-	17, // 2: roland.v1.BundlesByParserTypeRequest.parser_type:type_name -> shared.v1.ParserType
-	16, // 3: roland.v1.BundlesByParserTypeResponse.bundles:type_name -> shared.v1.Bundle
-	18, // 4: roland.v1.MarkBundleExportedRequest.export_type:type_name -> shared.v1.ExportType
-	0,  // 5: roland.v1.RolandService.SaveBundle:input_type -> roland.v1.SaveBundleRequest
-	2,  // 6: roland.v1.RolandService.Bundles:input_type -> roland.v1.BundlesRequest
-	4,  // 7: roland.v1.RolandService.BundlesByParserType:input_type -> roland.v1.BundlesByParserTypeRequest
-	6,  // 8: roland.v1.RolandService.UpdateGob:input_type -> roland.v1.UpdateGobRequest
-	8,  // 9: roland.v1.RolandService.DeleteAllBundles:input_type -> roland.v1.DeleteAllBundlesRequest
-	10, // 10: roland.v1.RolandService.DeleteBundleByID:input_type -> roland.v1.DeleteBundleByIDRequest
-	12, // 11: roland.v1.RolandService.DeleteBundlesByShortID:input_type -> roland.v1.DeleteBundlesByShortIDRequest
-	14, // 12: roland.v1.RolandService.MarkBundleExported:input_type -> roland.v1.MarkBundleExportedRequest
-	1,  // 13: roland.v1.RolandService.SaveBundle:output_type -> roland.v1.SaveBundleResponse
-	3,  // 14: roland.v1.RolandService.Bundles:output_type -> roland.v1.BundlesResponse
-	5,  // 15: roland.v1.RolandService.BundlesByParserType:output_type -> roland.v1.BundlesByParserTypeResponse
-	7,  // 16: roland.v1.RolandService.UpdateGob:output_type -> roland.v1.UpdateGobResponse
-	9,  // 17: roland.v1.RolandService.DeleteAllBundles:output_type -> roland.v1.DeleteAllBundlesResponse
-	11, // 18: roland.v1.RolandService.DeleteBundleByID:output_type -> roland.v1.DeleteBundleByIDResponse
-	13, // 19: roland.v1.RolandService.DeleteBundlesByShortID:output_type -> roland.v1.DeleteBundlesByShortIDResponse
-	15, // 20: roland.v1.RolandService.MarkBundleExported:output_type -> roland.v1.MarkBundleExportedResponse
-	13, // [13:21] is the sub-list for method output_type
-	5,  // [5:13] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	21, // 0: roland.v1.SaveBundleRequest.bundle:type_name -> shared.v1.Bundle
+	21, // 1: roland.v1.BundlesResponse.bundles:type_name -> shared.v1.Bundle
+	22, // 2: roland.v1.BundlesByParserTypeRequest.parser_type:type_name -> shared.v1.ParserType
+	21, // 3: roland.v1.BundlesByParserTypeResponse.bundles:type_name -> shared.v1.Bundle
+	23, // 4: roland.v1.MarkBundleExportedRequest.export_type:type_name -> shared.v1.ExportType
+	23, // 5: roland.v1.PromoteBundleRequest.export_type:type_name -> shared.v1.ExportType
+	18, // 6: roland.v1.PromoteBundleResponse.promotion:type_name -> roland.v1.BundlePromotion
+	23, // 7: roland.v1.BundlePromotion.export_type:type_name -> shared.v1.ExportType
+	24, // 8: roland.v1.BundlePromotion.created_at:type_name -> google.protobuf.Timestamp
+	18, // 9: roland.v1.ListPromotionsResponse.promotions:type_name -> roland.v1.BundlePromotion
+	0,  // 10: roland.v1.RolandService.SaveBundle:input_type -> roland.v1.SaveBundleRequest
+	2,  // 11: roland.v1.RolandService.Bundles:input_type -> roland.v1.BundlesRequest
+	4,  // 12: roland.v1.RolandService.BundlesByParserType:input_type -> roland.v1.BundlesByParserTypeRequest
+	6,  // 13: roland.v1.RolandService.UpdateGob:input_type -> roland.v1.UpdateGobRequest
+	8,  // 14: roland.v1.RolandService.DeleteAllBundles:input_type -> roland.v1.DeleteAllBundlesRequest
+	10, // 15: roland.v1.RolandService.DeleteBundleByID:input_type -> roland.v1.DeleteBundleByIDRequest
+	12, // 16: roland.v1.RolandService.DeleteBundlesByShortID:input_type -> roland.v1.DeleteBundlesByShortIDRequest
+	14, // 17: roland.v1.RolandService.MarkBundleExported:input_type -> roland.v1.MarkBundleExportedRequest
+	16, // 18: roland.v1.RolandService.PromoteBundle:input_type -> roland.v1.PromoteBundleRequest
+	19, // 19: roland.v1.RolandService.ListPromotions:input_type -> roland.v1.ListPromotionsRequest
+	1,  // 20: roland.v1.RolandService.SaveBundle:output_type -> roland.v1.SaveBundleResponse
+	3,  // 21: roland.v1.RolandService.Bundles:output_type -> roland.v1.BundlesResponse
+	5,  // 22: roland.v1.RolandService.BundlesByParserType:output_type -> roland.v1.BundlesByParserTypeResponse
+	7,  // 23: roland.v1.RolandService.UpdateGob:output_type -> roland.v1.UpdateGobResponse
+	9,  // 24: roland.v1.RolandService.DeleteAllBundles:output_type -> roland.v1.DeleteAllBundlesResponse
+	11, // 25: roland.v1.RolandService.DeleteBundleByID:output_type -> roland.v1.DeleteBundleByIDResponse
+	13, // 26: roland.v1.RolandService.DeleteBundlesByShortID:output_type -> roland.v1.DeleteBundlesByShortIDResponse
+	15, // 27: roland.v1.RolandService.MarkBundleExported:output_type -> roland.v1.MarkBundleExportedResponse
+	17, // 28: roland.v1.RolandService.PromoteBundle:output_type -> roland.v1.PromoteBundleResponse
+	20, // 29: roland.v1.RolandService.ListPromotions:output_type -> roland.v1.ListPromotionsResponse
+	20, // [20:30] is the sub-list for method output_type
+	10, // [10:20] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_v1_roland_roland_proto_init() }
@@ -885,7 +1271,7 @@ func file_v1_roland_roland_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_roland_roland_proto_rawDesc), len(file_v1_roland_roland_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
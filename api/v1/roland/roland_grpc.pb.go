@@ -27,6 +27,8 @@ const (
 	RolandService_DeleteBundleByID_FullMethodName       = "/roland.v1.RolandService/DeleteBundleByID"
 	RolandService_DeleteBundlesByShortID_FullMethodName = "/roland.v1.RolandService/DeleteBundlesByShortID"
 	RolandService_MarkBundleExported_FullMethodName     = "/roland.v1.RolandService/MarkBundleExported"
+	RolandService_PromoteBundle_FullMethodName          = "/roland.v1.RolandService/PromoteBundle"
+	RolandService_ListPromotions_FullMethodName         = "/roland.v1.RolandService/ListPromotions"
 )
 
 // RolandServiceClient is the client API for RolandService service.
@@ -49,6 +51,10 @@ type RolandServiceClient interface {
 	DeleteBundlesByShortID(ctx context.Context, in *DeleteBundlesByShortIDRequest, opts ...grpc.CallOption) (*DeleteBundlesByShortIDResponse, error)
 	// MarkBundleExported marks a bundle as exported to a specific environment
 	MarkBundleExported(ctx context.Context, in *MarkBundleExportedRequest, opts ...grpc.CallOption) (*MarkBundleExportedResponse, error)
+	// PromoteBundle imports a bundle into an environment's database and marks it exported
+	PromoteBundle(ctx context.Context, in *PromoteBundleRequest, opts ...grpc.CallOption) (*PromoteBundleResponse, error)
+	// ListPromotions lists recorded bundle promotions
+	ListPromotions(ctx context.Context, in *ListPromotionsRequest, opts ...grpc.CallOption) (*ListPromotionsResponse, error)
 }
 
 type rolandServiceClient struct {
@@ -139,6 +145,26 @@ func (c *rolandServiceClient) MarkBundleExported(ctx context.Context, in *MarkBu
 	return out, nil
 }
 
+func (c *rolandServiceClient) PromoteBundle(ctx context.Context, in *PromoteBundleRequest, opts ...grpc.CallOption) (*PromoteBundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromoteBundleResponse)
+	err := c.cc.Invoke(ctx, RolandService_PromoteBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rolandServiceClient) ListPromotions(ctx context.Context, in *ListPromotionsRequest, opts ...grpc.CallOption) (*ListPromotionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromotionsResponse)
+	err := c.cc.Invoke(ctx, RolandService_ListPromotions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RolandServiceServer is the server API for RolandService service.
 // All implementations must embed UnimplementedRolandServiceServer
 // for forward compatibility.
@@ -159,6 +185,10 @@ type RolandServiceServer interface {
 	DeleteBundlesByShortID(context.Context, *DeleteBundlesByShortIDRequest) (*DeleteBundlesByShortIDResponse, error)
 	// MarkBundleExported marks a bundle as exported to a specific environment
 	MarkBundleExported(context.Context, *MarkBundleExportedRequest) (*MarkBundleExportedResponse, error)
+	// PromoteBundle imports a bundle into an environment's database and marks it exported
+	PromoteBundle(context.Context, *PromoteBundleRequest) (*PromoteBundleResponse, error)
+	// ListPromotions lists recorded bundle promotions
+	ListPromotions(context.Context, *ListPromotionsRequest) (*ListPromotionsResponse, error)
 	mustEmbedUnimplementedRolandServiceServer()
 }
 
@@ -193,6 +223,12 @@ func (UnimplementedRolandServiceServer) DeleteBundlesByShortID(context.Context,
 func (UnimplementedRolandServiceServer) MarkBundleExported(context.Context, *MarkBundleExportedRequest) (*MarkBundleExportedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarkBundleExported not implemented")
 }
+func (UnimplementedRolandServiceServer) PromoteBundle(context.Context, *PromoteBundleRequest) (*PromoteBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteBundle not implemented")
+}
+func (UnimplementedRolandServiceServer) ListPromotions(context.Context, *ListPromotionsRequest) (*ListPromotionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromotions not implemented")
+}
 func (UnimplementedRolandServiceServer) mustEmbedUnimplementedRolandServiceServer() {}
 func (UnimplementedRolandServiceServer) testEmbeddedByValue()                       {}
 
@@ -358,6 +394,42 @@ func _RolandService_MarkBundleExported_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RolandService_PromoteBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolandServiceServer).PromoteBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RolandService_PromoteBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolandServiceServer).PromoteBundle(ctx, req.(*PromoteBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RolandService_ListPromotions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromotionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolandServiceServer).ListPromotions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RolandService_ListPromotions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolandServiceServer).ListPromotions(ctx, req.(*ListPromotionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RolandService_ServiceDesc is the grpc.ServiceDesc for RolandService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -397,6 +469,14 @@ var RolandService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MarkBundleExported",
 			Handler:    _RolandService_MarkBundleExported_Handler,
 		},
+		{
+			MethodName: "PromoteBundle",
+			Handler:    _RolandService_PromoteBundle_Handler,
+		},
+		{
+			MethodName: "ListPromotions",
+			Handler:    _RolandService_ListPromotions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/roland/roland.proto",
@@ -206,6 +206,190 @@ func (x *SearchUsersResponse) GetResults() []*shared.UserSearchResult {
 	return nil
 }
 
+type SearchFaqsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFaqsRequest) Reset() {
+	*x = SearchFaqsRequest{}
+	mi := &file_v1_search_search_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFaqsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFaqsRequest) ProtoMessage() {}
+
+func (x *SearchFaqsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_search_search_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFaqsRequest.ProtoReflect.Descriptor instead.
+func (*SearchFaqsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_search_search_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchFaqsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type SearchFaqsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*shared.Faq          `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFaqsResponse) Reset() {
+	*x = SearchFaqsResponse{}
+	mi := &file_v1_search_search_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFaqsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFaqsResponse) ProtoMessage() {}
+
+func (x *SearchFaqsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_search_search_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFaqsResponse.ProtoReflect.Descriptor instead.
+func (*SearchFaqsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_search_search_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchFaqsResponse) GetResults() []*shared.Faq {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type SearchQuestionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Context       shared.ContextType     `protobuf:"varint,2,opt,name=context,proto3,enum=shared.v1.ContextType" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchQuestionsRequest) Reset() {
+	*x = SearchQuestionsRequest{}
+	mi := &file_v1_search_search_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchQuestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchQuestionsRequest) ProtoMessage() {}
+
+func (x *SearchQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_search_search_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*SearchQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_search_search_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchQuestionsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchQuestionsRequest) GetContext() shared.ContextType {
+	if x != nil {
+		return x.Context
+	}
+	return shared.ContextType(0)
+}
+
+type SearchQuestionsResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Results       []*shared.QuestionSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchQuestionsResponse) Reset() {
+	*x = SearchQuestionsResponse{}
+	mi := &file_v1_search_search_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchQuestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchQuestionsResponse) ProtoMessage() {}
+
+func (x *SearchQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_search_search_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*SearchQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_search_search_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SearchQuestionsResponse) GetResults() []*shared.QuestionSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 type ListIndexesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
@@ -215,7 +399,7 @@ type ListIndexesRequest struct {
 
 func (x *ListIndexesRequest) Reset() {
 	*x = ListIndexesRequest{}
-	mi := &file_v1_search_search_proto_msgTypes[4]
+	mi := &file_v1_search_search_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -227,7 +411,7 @@ func (x *ListIndexesRequest) String() string {
 func (*ListIndexesRequest) ProtoMessage() {}
 
 func (x *ListIndexesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_search_search_proto_msgTypes[4]
+	mi := &file_v1_search_search_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -240,7 +424,7 @@ func (x *ListIndexesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIndexesRequest.ProtoReflect.Descriptor instead.
 func (*ListIndexesRequest) Descriptor() ([]byte, []int) {
-	return file_v1_search_search_proto_rawDescGZIP(), []int{4}
+	return file_v1_search_search_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListIndexesRequest) GetQuery() string {
@@ -260,7 +444,7 @@ type IndexInfo struct {
 
 func (x *IndexInfo) Reset() {
 	*x = IndexInfo{}
-	mi := &file_v1_search_search_proto_msgTypes[5]
+	mi := &file_v1_search_search_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -272,7 +456,7 @@ func (x *IndexInfo) String() string {
 func (*IndexInfo) ProtoMessage() {}
 
 func (x *IndexInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_search_search_proto_msgTypes[5]
+	mi := &file_v1_search_search_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,7 +469,7 @@ func (x *IndexInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IndexInfo.ProtoReflect.Descriptor instead.
 func (*IndexInfo) Descriptor() ([]byte, []int) {
-	return file_v1_search_search_proto_rawDescGZIP(), []int{5}
+	return file_v1_search_search_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *IndexInfo) GetName() string {
@@ -311,7 +495,7 @@ type ListIndexesResponse struct {
 
 func (x *ListIndexesResponse) Reset() {
 	*x = ListIndexesResponse{}
-	mi := &file_v1_search_search_proto_msgTypes[6]
+	mi := &file_v1_search_search_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -323,7 +507,7 @@ func (x *ListIndexesResponse) String() string {
 func (*ListIndexesResponse) ProtoMessage() {}
 
 func (x *ListIndexesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_search_search_proto_msgTypes[6]
+	mi := &file_v1_search_search_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -336,7 +520,7 @@ func (x *ListIndexesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIndexesResponse.ProtoReflect.Descriptor instead.
 func (*ListIndexesResponse) Descriptor() ([]byte, []int) {
-	return file_v1_search_search_proto_rawDescGZIP(), []int{6}
+	return file_v1_search_search_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListIndexesResponse) GetIndexes() []*IndexInfo {
@@ -350,7 +534,7 @@ var File_v1_search_search_proto protoreflect.FileDescriptor
 
 const file_v1_search_search_proto_rawDesc = "" +
 	"\n" +
-	"\x16v1/search/search.proto\x12\tsearch.v1\x1a\x1fv1/shared/tagsearchresult.proto\x1a\x1bv1/shared/contexttype.proto\x1a v1/shared/usersearchresult.proto\"[\n" +
+	"\x16v1/search/search.proto\x12\tsearch.v1\x1a\x1fv1/shared/tagsearchresult.proto\x1a\x1bv1/shared/contexttype.proto\x1a v1/shared/usersearchresult.proto\x1a\x13v1/shared/faq.proto\x1a$v1/shared/questionsearchresult.proto\"[\n" +
 	"\x11SearchTagsRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x120\n" +
 	"\acontext\x18\x02 \x01(\x0e2\x16.shared.v1.ContextTypeR\acontext\"J\n" +
@@ -359,18 +543,30 @@ const file_v1_search_search_proto_rawDesc = "" +
 	"\x12SearchUsersRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\"L\n" +
 	"\x13SearchUsersResponse\x125\n" +
-	"\aresults\x18\x01 \x03(\v2\x1b.shared.v1.UserSearchResultR\aresults\"*\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.shared.v1.UserSearchResultR\aresults\")\n" +
+	"\x11SearchFaqsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\">\n" +
+	"\x12SearchFaqsResponse\x12(\n" +
+	"\aresults\x18\x01 \x03(\v2\x0e.shared.v1.FaqR\aresults\"`\n" +
+	"\x16SearchQuestionsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x120\n" +
+	"\acontext\x18\x02 \x01(\x0e2\x16.shared.v1.ContextTypeR\acontext\"T\n" +
+	"\x17SearchQuestionsResponse\x129\n" +
+	"\aresults\x18\x01 \x03(\v2\x1f.shared.v1.QuestionSearchResultR\aresults\"*\n" +
 	"\x12ListIndexesRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\"9\n" +
 	"\tIndexInfo\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\aentries\x18\x02 \x01(\x03R\aentries\"E\n" +
 	"\x13ListIndexesResponse\x12.\n" +
-	"\aindexes\x18\x01 \x03(\v2\x14.search.v1.IndexInfoR\aindexes2\xf6\x01\n" +
+	"\aindexes\x18\x01 \x03(\v2\x14.search.v1.IndexInfoR\aindexes2\x9b\x03\n" +
 	"\rSearchService\x12I\n" +
 	"\n" +
 	"SearchTags\x12\x1c.search.v1.SearchTagsRequest\x1a\x1d.search.v1.SearchTagsResponse\x12L\n" +
-	"\vSearchUsers\x12\x1d.search.v1.SearchUsersRequest\x1a\x1e.search.v1.SearchUsersResponse\x12L\n" +
+	"\vSearchUsers\x12\x1d.search.v1.SearchUsersRequest\x1a\x1e.search.v1.SearchUsersResponse\x12I\n" +
+	"\n" +
+	"SearchFaqs\x12\x1c.search.v1.SearchFaqsRequest\x1a\x1d.search.v1.SearchFaqsResponse\x12X\n" +
+	"\x0fSearchQuestions\x12!.search.v1.SearchQuestionsRequest\x1a\".search.v1.SearchQuestionsResponse\x12L\n" +
 	"\vListIndexes\x12\x1d.search.v1.ListIndexesRequest\x1a\x1e.search.v1.ListIndexesResponseBDZBgithub.com/studyguides-com/study-guides-api/api/v1/search;searchv1b\x06proto3"
 
 var (
@@ -385,35 +581,48 @@ func file_v1_search_search_proto_rawDescGZIP() []byte {
 	return file_v1_search_search_proto_rawDescData
 }
 
-var file_v1_search_search_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_v1_search_search_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_v1_search_search_proto_goTypes = []any{
-	(*SearchTagsRequest)(nil),       // 0: search.v1.SearchTagsRequest
-	(*SearchTagsResponse)(nil),      // 1: search.v1.SearchTagsResponse
-	(*SearchUsersRequest)(nil),      // 2: search.v1.SearchUsersRequest
-	(*SearchUsersResponse)(nil),     // 3: search.v1.SearchUsersResponse
-	(*ListIndexesRequest)(nil),      // 4: search.v1.ListIndexesRequest
-	(*IndexInfo)(nil),               // 5: search.v1.IndexInfo
-	(*ListIndexesResponse)(nil),     // 6: search.v1.ListIndexesResponse
-	(shared.ContextType)(0),         // 7: shared.v1.ContextType
-	(*shared.TagSearchResult)(nil),  // 8: shared.v1.TagSearchResult
-	(*shared.UserSearchResult)(nil), // 9: shared.v1.UserSearchResult
+	(*SearchTagsRequest)(nil),           // 0: search.v1.SearchTagsRequest
+	(*SearchTagsResponse)(nil),          // 1: search.v1.SearchTagsResponse
+	(*SearchUsersRequest)(nil),          // 2: search.v1.SearchUsersRequest
+	(*SearchUsersResponse)(nil),         // 3: search.v1.SearchUsersResponse
+	(*SearchFaqsRequest)(nil),           // 4: search.v1.SearchFaqsRequest
+	(*SearchFaqsResponse)(nil),          // 5: search.v1.SearchFaqsResponse
+	(*SearchQuestionsRequest)(nil),      // 6: search.v1.SearchQuestionsRequest
+	(*SearchQuestionsResponse)(nil),     // 7: search.v1.SearchQuestionsResponse
+	(*ListIndexesRequest)(nil),          // 8: search.v1.ListIndexesRequest
+	(*IndexInfo)(nil),                   // 9: search.v1.IndexInfo
+	(*ListIndexesResponse)(nil),         // 10: search.v1.ListIndexesResponse
+	(shared.ContextType)(0),             // 11: shared.v1.ContextType
+	(*shared.TagSearchResult)(nil),      // 12: shared.v1.TagSearchResult
+	(*shared.UserSearchResult)(nil),     // 13: shared.v1.UserSearchResult
+	(*shared.Faq)(nil),                  // 14: shared.v1.Faq
+	(*shared.QuestionSearchResult)(nil), // 15: shared.v1.QuestionSearchResult
 }
 var file_v1_search_search_proto_depIdxs = []int32{
-	7, // 0: search.v1.SearchTagsRequest.context:type_name -> shared.v1.ContextType
-	8, // 1: search.v1.SearchTagsResponse.results:type_name -> shared.v1.TagSearchResult
-	9, // 2: search.v1.SearchUsersResponse.results:type_name -> shared.v1.UserSearchResult
-	5, // 3: search.v1.ListIndexesResponse.indexes:type_name -> search.v1.IndexInfo
-	0, // 4: search.v1.SearchService.SearchTags:input_type -> search.v1.SearchTagsRequest
-	2, // 5: search.v1.SearchService.SearchUsers:input_type -> search.v1.SearchUsersRequest
-	4, // 6: search.v1.SearchService.ListIndexes:input_type -> search.v1.ListIndexesRequest
-	1, // 7: search.v1.SearchService.SearchTags:output_type -> search.v1.SearchTagsResponse
-	3, // 8: search.v1.SearchService.SearchUsers:output_type -> search.v1.SearchUsersResponse
-	6, // 9: search.v1.SearchService.ListIndexes:output_type -> search.v1.ListIndexesResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	11, // 0: search.v1.SearchTagsRequest.context:type_name -> shared.v1.ContextType
+	12, // 1: search.v1.SearchTagsResponse.results:type_name -> shared.v1.TagSearchResult
+	13, // 2: search.v1.SearchUsersResponse.results:type_name -> shared.v1.UserSearchResult
+	14, // 3: search.v1.SearchFaqsResponse.results:type_name -> shared.v1.Faq
+	11, // 4: search.v1.SearchQuestionsRequest.context:type_name -> shared.v1.ContextType
+	15, // 5: search.v1.SearchQuestionsResponse.results:type_name -> shared.v1.QuestionSearchResult
+	9,  // 6: search.v1.ListIndexesResponse.indexes:type_name -> search.v1.IndexInfo
+	0,  // 7: search.v1.SearchService.SearchTags:input_type -> search.v1.SearchTagsRequest
+	2,  // 8: search.v1.SearchService.SearchUsers:input_type -> search.v1.SearchUsersRequest
+	4,  // 9: search.v1.SearchService.SearchFaqs:input_type -> search.v1.SearchFaqsRequest
+	6,  // 10: search.v1.SearchService.SearchQuestions:input_type -> search.v1.SearchQuestionsRequest
+	8,  // 11: search.v1.SearchService.ListIndexes:input_type -> search.v1.ListIndexesRequest
+	1,  // 12: search.v1.SearchService.SearchTags:output_type -> search.v1.SearchTagsResponse
+	3,  // 13: search.v1.SearchService.SearchUsers:output_type -> search.v1.SearchUsersResponse
+	5,  // 14: search.v1.SearchService.SearchFaqs:output_type -> search.v1.SearchFaqsResponse
+	7,  // 15: search.v1.SearchService.SearchQuestions:output_type -> search.v1.SearchQuestionsResponse
+	10, // 16: search.v1.SearchService.ListIndexes:output_type -> search.v1.ListIndexesResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_v1_search_search_proto_init() }
@@ -427,7 +636,7 @@ func file_v1_search_search_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_search_search_proto_rawDesc), len(file_v1_search_search_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
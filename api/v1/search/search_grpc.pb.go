@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SearchService_SearchTags_FullMethodName  = "/search.v1.SearchService/SearchTags"
-	SearchService_SearchUsers_FullMethodName = "/search.v1.SearchService/SearchUsers"
-	SearchService_ListIndexes_FullMethodName = "/search.v1.SearchService/ListIndexes"
+	SearchService_SearchTags_FullMethodName      = "/search.v1.SearchService/SearchTags"
+	SearchService_SearchUsers_FullMethodName     = "/search.v1.SearchService/SearchUsers"
+	SearchService_SearchFaqs_FullMethodName      = "/search.v1.SearchService/SearchFaqs"
+	SearchService_SearchQuestions_FullMethodName = "/search.v1.SearchService/SearchQuestions"
+	SearchService_ListIndexes_FullMethodName     = "/search.v1.SearchService/ListIndexes"
 )
 
 // SearchServiceClient is the client API for SearchService service.
@@ -30,6 +32,8 @@ const (
 type SearchServiceClient interface {
 	SearchTags(ctx context.Context, in *SearchTagsRequest, opts ...grpc.CallOption) (*SearchTagsResponse, error)
 	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
+	SearchFaqs(ctx context.Context, in *SearchFaqsRequest, opts ...grpc.CallOption) (*SearchFaqsResponse, error)
+	SearchQuestions(ctx context.Context, in *SearchQuestionsRequest, opts ...grpc.CallOption) (*SearchQuestionsResponse, error)
 	ListIndexes(ctx context.Context, in *ListIndexesRequest, opts ...grpc.CallOption) (*ListIndexesResponse, error)
 }
 
@@ -61,6 +65,26 @@ func (c *searchServiceClient) SearchUsers(ctx context.Context, in *SearchUsersRe
 	return out, nil
 }
 
+func (c *searchServiceClient) SearchFaqs(ctx context.Context, in *SearchFaqsRequest, opts ...grpc.CallOption) (*SearchFaqsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchFaqsResponse)
+	err := c.cc.Invoke(ctx, SearchService_SearchFaqs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchServiceClient) SearchQuestions(ctx context.Context, in *SearchQuestionsRequest, opts ...grpc.CallOption) (*SearchQuestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchQuestionsResponse)
+	err := c.cc.Invoke(ctx, SearchService_SearchQuestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *searchServiceClient) ListIndexes(ctx context.Context, in *ListIndexesRequest, opts ...grpc.CallOption) (*ListIndexesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListIndexesResponse)
@@ -77,6 +101,8 @@ func (c *searchServiceClient) ListIndexes(ctx context.Context, in *ListIndexesRe
 type SearchServiceServer interface {
 	SearchTags(context.Context, *SearchTagsRequest) (*SearchTagsResponse, error)
 	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
+	SearchFaqs(context.Context, *SearchFaqsRequest) (*SearchFaqsResponse, error)
+	SearchQuestions(context.Context, *SearchQuestionsRequest) (*SearchQuestionsResponse, error)
 	ListIndexes(context.Context, *ListIndexesRequest) (*ListIndexesResponse, error)
 	mustEmbedUnimplementedSearchServiceServer()
 }
@@ -94,6 +120,12 @@ func (UnimplementedSearchServiceServer) SearchTags(context.Context, *SearchTagsR
 func (UnimplementedSearchServiceServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
 }
+func (UnimplementedSearchServiceServer) SearchFaqs(context.Context, *SearchFaqsRequest) (*SearchFaqsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchFaqs not implemented")
+}
+func (UnimplementedSearchServiceServer) SearchQuestions(context.Context, *SearchQuestionsRequest) (*SearchQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchQuestions not implemented")
+}
 func (UnimplementedSearchServiceServer) ListIndexes(context.Context, *ListIndexesRequest) (*ListIndexesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListIndexes not implemented")
 }
@@ -154,6 +186,42 @@ func _SearchService_SearchUsers_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SearchService_SearchFaqs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchFaqsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).SearchFaqs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchService_SearchFaqs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).SearchFaqs(ctx, req.(*SearchFaqsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SearchService_SearchQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).SearchQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchService_SearchQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).SearchQuestions(ctx, req.(*SearchQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SearchService_ListIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListIndexesRequest)
 	if err := dec(in); err != nil {
@@ -187,6 +255,14 @@ var SearchService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchUsers",
 			Handler:    _SearchService_SearchUsers_Handler,
 		},
+		{
+			MethodName: "SearchFaqs",
+			Handler:    _SearchService_SearchFaqs_Handler,
+		},
+		{
+			MethodName: "SearchQuestions",
+			Handler:    _SearchService_SearchQuestions_Handler,
+		},
 		{
 			MethodName: "ListIndexes",
 			Handler:    _SearchService_ListIndexes_Handler,
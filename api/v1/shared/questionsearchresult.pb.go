@@ -0,0 +1,200 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: v1/shared/questionsearchresult.proto
+
+package sharedv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type QuestionSearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuestionText  string                 `protobuf:"bytes,2,opt,name=question_text,json=questionText,proto3" json:"question_text,omitempty"`
+	AnswerText    string                 `protobuf:"bytes,3,opt,name=answer_text,json=answerText,proto3" json:"answer_text,omitempty"`
+	Tags          []*TagSearchPath       `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Context       string                 `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
+	Difficulty    float64                `protobuf:"fixed64,6,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	Public        bool                   `protobuf:"varint,7,opt,name=public,proto3" json:"public,omitempty"`
+	ObjectId      string                 `protobuf:"bytes,8,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Premium       bool                   `protobuf:"varint,9,opt,name=premium,proto3" json:"premium,omitempty"` // under a premium tag, answer_text is left empty for callers without a premium plan
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionSearchResult) Reset() {
+	*x = QuestionSearchResult{}
+	mi := &file_v1_shared_questionsearchresult_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionSearchResult) ProtoMessage() {}
+
+func (x *QuestionSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_shared_questionsearchresult_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionSearchResult.ProtoReflect.Descriptor instead.
+func (*QuestionSearchResult) Descriptor() ([]byte, []int) {
+	return file_v1_shared_questionsearchresult_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QuestionSearchResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QuestionSearchResult) GetQuestionText() string {
+	if x != nil {
+		return x.QuestionText
+	}
+	return ""
+}
+
+func (x *QuestionSearchResult) GetAnswerText() string {
+	if x != nil {
+		return x.AnswerText
+	}
+	return ""
+}
+
+func (x *QuestionSearchResult) GetTags() []*TagSearchPath {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *QuestionSearchResult) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+func (x *QuestionSearchResult) GetDifficulty() float64 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *QuestionSearchResult) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *QuestionSearchResult) GetObjectId() string {
+	if x != nil {
+		return x.ObjectId
+	}
+	return ""
+}
+
+func (x *QuestionSearchResult) GetPremium() bool {
+	if x != nil {
+		return x.Premium
+	}
+	return false
+}
+
+var File_v1_shared_questionsearchresult_proto protoreflect.FileDescriptor
+
+const file_v1_shared_questionsearchresult_proto_rawDesc = "" +
+	"\n" +
+	"$v1/shared/questionsearchresult.proto\x12\tshared.v1\x1a\x1fv1/shared/tagsearchresult.proto\"\xa3\x02\n" +
+	"\x14QuestionSearchResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rquestion_text\x18\x02 \x01(\tR\fquestionText\x12\x1f\n" +
+	"\vanswer_text\x18\x03 \x01(\tR\n" +
+	"answerText\x12,\n" +
+	"\x04tags\x18\x04 \x03(\v2\x18.shared.v1.TagSearchPathR\x04tags\x12\x18\n" +
+	"\acontext\x18\x05 \x01(\tR\acontext\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\x06 \x01(\x01R\n" +
+	"difficulty\x12\x16\n" +
+	"\x06public\x18\a \x01(\bR\x06public\x12\x1b\n" +
+	"\tobject_id\x18\b \x01(\tR\bobjectId\x12\x18\n" +
+	"\apremium\x18\t \x01(\bR\apremiumBDZBgithub.com/studyguides-com/study-guides-api/api/v1/shared;sharedv1b\x06proto3"
+
+var (
+	file_v1_shared_questionsearchresult_proto_rawDescOnce sync.Once
+	file_v1_shared_questionsearchresult_proto_rawDescData []byte
+)
+
+func file_v1_shared_questionsearchresult_proto_rawDescGZIP() []byte {
+	file_v1_shared_questionsearchresult_proto_rawDescOnce.Do(func() {
+		file_v1_shared_questionsearchresult_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_shared_questionsearchresult_proto_rawDesc), len(file_v1_shared_questionsearchresult_proto_rawDesc)))
+	})
+	return file_v1_shared_questionsearchresult_proto_rawDescData
+}
+
+var file_v1_shared_questionsearchresult_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_v1_shared_questionsearchresult_proto_goTypes = []any{
+	(*QuestionSearchResult)(nil), // 0: shared.v1.QuestionSearchResult
+	(*TagSearchPath)(nil),        // 1: shared.v1.TagSearchPath
+}
+var file_v1_shared_questionsearchresult_proto_depIdxs = []int32{
+	1, // 0: shared.v1.QuestionSearchResult.tags:type_name -> shared.v1.TagSearchPath
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_v1_shared_questionsearchresult_proto_init() }
+func file_v1_shared_questionsearchresult_proto_init() {
+	if File_v1_shared_questionsearchresult_proto != nil {
+		return
+	}
+	file_v1_shared_tagsearchresult_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_shared_questionsearchresult_proto_rawDesc), len(file_v1_shared_questionsearchresult_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_shared_questionsearchresult_proto_goTypes,
+		DependencyIndexes: file_v1_shared_questionsearchresult_proto_depIdxs,
+		MessageInfos:      file_v1_shared_questionsearchresult_proto_msgTypes,
+	}.Build()
+	File_v1_shared_questionsearchresult_proto = out.File
+	file_v1_shared_questionsearchresult_proto_goTypes = nil
+	file_v1_shared_questionsearchresult_proto_depIdxs = nil
+}
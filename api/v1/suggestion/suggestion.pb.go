@@ -0,0 +1,1157 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: v1/suggestion/suggestion.proto
+
+package suggestionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SuggestionKind int32
+
+const (
+	SuggestionKind_SUGGESTION_KIND_UNSPECIFIED    SuggestionKind = 0
+	SuggestionKind_SUGGESTION_KIND_DISTRACTORS    SuggestionKind = 1
+	SuggestionKind_SUGGESTION_KIND_LEARN_MORE     SuggestionKind = 2
+	SuggestionKind_SUGGESTION_KIND_CONTENT_RATING SuggestionKind = 3
+)
+
+// Enum value maps for SuggestionKind.
+var (
+	SuggestionKind_name = map[int32]string{
+		0: "SUGGESTION_KIND_UNSPECIFIED",
+		1: "SUGGESTION_KIND_DISTRACTORS",
+		2: "SUGGESTION_KIND_LEARN_MORE",
+		3: "SUGGESTION_KIND_CONTENT_RATING",
+	}
+	SuggestionKind_value = map[string]int32{
+		"SUGGESTION_KIND_UNSPECIFIED":    0,
+		"SUGGESTION_KIND_DISTRACTORS":    1,
+		"SUGGESTION_KIND_LEARN_MORE":     2,
+		"SUGGESTION_KIND_CONTENT_RATING": 3,
+	}
+)
+
+func (x SuggestionKind) Enum() *SuggestionKind {
+	p := new(SuggestionKind)
+	*p = x
+	return p
+}
+
+func (x SuggestionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SuggestionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_suggestion_suggestion_proto_enumTypes[0].Descriptor()
+}
+
+func (SuggestionKind) Type() protoreflect.EnumType {
+	return &file_v1_suggestion_suggestion_proto_enumTypes[0]
+}
+
+func (x SuggestionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SuggestionKind.Descriptor instead.
+func (SuggestionKind) EnumDescriptor() ([]byte, []int) {
+	return file_v1_suggestion_suggestion_proto_rawDescGZIP(), []int{0}
+}
+
+type SuggestionStatus int32
+
+const (
+	SuggestionStatus_SUGGESTION_STATUS_UNSPECIFIED SuggestionStatus = 0
+	SuggestionStatus_SUGGESTION_STATUS_PENDING     SuggestionStatus = 1
+	SuggestionStatus_SUGGESTION_STATUS_APPROVED    SuggestionStatus = 2
+	SuggestionStatus_SUGGESTION_STATUS_REJECTED    SuggestionStatus = 3
+)
+
+// Enum value maps for SuggestionStatus.
+var (
+	SuggestionStatus_name = map[int32]string{
+		0: "SUGGESTION_STATUS_UNSPECIFIED",
+		1: "SUGGESTION_STATUS_PENDING",
+		2: "SUGGESTION_STATUS_APPROVED",
+		3: "SUGGESTION_STATUS_REJECTED",
+	}
+	SuggestionStatus_value = map[string]int32{
+		"SUGGESTION_STATUS_UNSPECIFIED": 0,
+		"SUGGESTION_STATUS_PENDING":     1,
+		"SUGGESTION_STATUS_APPROVED":    2,
+		"SUGGESTION_STATUS_REJECTED":    3,
+	}
+)
+
+func (x SuggestionStatus) Enum() *SuggestionStatus {
+	p := new(SuggestionStatus)
+	*p = x
+	return p
+}
+
+func (x SuggestionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SuggestionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_suggestion_suggestion_proto_enumTypes[1].Descriptor()
+}
+
+func (SuggestionStatus) Type() protoreflect.EnumType {
+	return &file_v1_suggestion_suggestion_proto_enumTypes[1]
+}
+
+func (x SuggestionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SuggestionStatus.Descriptor instead.
+func (SuggestionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_v1_suggestion_suggestion_proto_rawDescGZIP(), []int{1}
+}
+
+type Suggestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          SuggestionKind         `protobuf:"varint,2,opt,name=kind,proto3,enum=suggestion.v1.SuggestionKind" json:"kind,omitempty"`
+	TargetType    string                 `protobuf:"bytes,3,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId      string                 `protobuf:"bytes,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	JobId         string                 `protobuf:"bytes,5,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	PayloadJson   string                 `protobuf:"bytes,6,opt,name=payload_json,json=payloadJson,proto3" json:"payload_json,omitempty"`
+	ContextJson   string                 `protobuf:"bytes,7,opt,name=context_json,json=contextJson,proto3" json:"context_json,omitempty"`
+	Status        SuggestionStatus       `protobuf:"varint,8,opt,name=status,proto3,enum=suggestion.v1.SuggestionStatus" json:"status,omitempty"`
+	ReviewedBy    string                 `protobuf:"bytes,9,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewedAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Model         string                 `protobuf:"bytes,12,opt,name=model,proto3" json:"model,omitempty"`
+	PromptId      string                 `protobuf:"bytes,13,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
+	PromptVersion int32                  `protobuf:"varint,14,opt,name=prompt_version,json=promptVersion,proto3" json:"prompt_version,omitempty"`
+	Confidence    *float64               `protobuf:"fixed64,15,opt,name=confidence,proto3,oneof" json:"confidence,omitempty"`               // lowest confidence in the proposal
+	AutoApplied   bool                   `protobuf:"varint,16,opt,name=auto_applied,json=autoApplied,proto3" json:"auto_applied,omitempty"` // applied without review, status is already approved
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Suggestion) Reset() {
+	*x = Suggestion{}
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestion) ProtoMessage() {}
+
+func (x *Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return file_v1_suggestion_suggestion_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Suggestion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Suggestion) GetKind() SuggestionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SuggestionKind_SUGGESTION_KIND_UNSPECIFIED
+}
+
+func (x *Suggestion) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *Suggestion) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *Suggestion) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *Suggestion) GetPayloadJson() string {
+	if x != nil {
+		return x.PayloadJson
+	}
+	return ""
+}
+
+func (x *Suggestion) GetContextJson() string {
+	if x != nil {
+		return x.ContextJson
+	}
+	return ""
+}
+
+func (x *Suggestion) GetStatus() SuggestionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SuggestionStatus_SUGGESTION_STATUS_UNSPECIFIED
+}
+
+func (x *Suggestion) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *Suggestion) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *Suggestion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Suggestion) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *Suggestion) GetPromptId() string {
+	if x != nil {
+		return x.PromptId
+	}
+	return ""
+}
+
+func (x *Suggestion) GetPromptVersion() int32 {
+	if x != nil {
+		return x.PromptVersion
+	}
+	return 0
+}
+
+func (x *Suggestion) GetConfidence() float64 {
+	if x != nil && x.Confidence != nil {
+		return *x.Confidence
+	}
+	return 0
+}
+
+func (x *Suggestion) GetAutoApplied() bool {
+	if x != nil {
+		return x.AutoApplied
+	}
+	return false
+}
+
+type SuggestionJob struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	MetadataJson  string                 `protobuf:"bytes,8,opt,name=metadata_json,json=metadataJson,proto3" json:"metadata_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestionJob) Reset() {
+	*x = SuggestionJob{}
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestionJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestionJob) ProtoMessage() {}
+
+func (x *SuggestionJob) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestionJob.ProtoReflect.Descriptor instead.
+func (*SuggestionJob) Descriptor() ([]byte, []int) {
+	return file_v1_suggestion_suggestion_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SuggestionJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SuggestionJob) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SuggestionJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SuggestionJob) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SuggestionJob) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *SuggestionJob) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *SuggestionJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SuggestionJob) GetMetadataJson() string {
+	if x != nil {
+		return x.MetadataJson
+	}
+	return ""
+}
+
+type StartDistractorJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // questions to generate for, defaults to 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartDistractorJobRequest) Reset() {
+	*x = StartDistractorJobRequest{}
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartDistractorJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartDistractorJobRequest) ProtoMessage() {}
+
+func (x *StartDistractorJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartDistractorJobRequest.ProtoReflect.Descriptor instead.
+func (*StartDistractorJobRequest) Descriptor() ([]byte, []int) {
+	return file_v1_suggestion_suggestion_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StartDistractorJobRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type StartDistractorJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartDistractorJobResponse) Reset() {
+	*x = StartDistractorJobResponse{}
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartDistractorJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartDistractorJobResponse) ProtoMessage() {}
+
+func (x *StartDistractorJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartDistractorJobResponse.ProtoReflect.Descriptor instead.
+func (*StartDistractorJobResponse) Descriptor() ([]byte, []int) {
+	return file_v1_suggestion_suggestion_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StartDistractorJobResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type StartLearnMoreJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // questions to draft for, defaults to 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartLearnMoreJobRequest) Reset() {
+	*x = StartLearnMoreJobRequest{}
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartLearnMoreJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartLearnMoreJobRequest) ProtoMessage() {}
+
+func (x *StartLearnMoreJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartLearnMoreJobRequest.ProtoReflect.Descriptor instead.
+func (*StartLearnMoreJobRequest) Descriptor() ([]byte, []int) {
+	return file_v1_suggestion_suggestion_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StartLearnMoreJobRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type StartLearnMoreJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartLearnMoreJobResponse) Reset() {
+	*x = StartLearnMoreJobResponse{}
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartLearnMoreJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartLearnMoreJobResponse) ProtoMessage() {}
+
+func (x *StartLearnMoreJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartLearnMoreJobResponse.ProtoReflect.Descriptor instead.
+func (*StartLearnMoreJobResponse) Descriptor() ([]byte, []int) {
+	return file_v1_suggestion_suggestion_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StartLearnMoreJobResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type StartContentRatingJobRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Limit              int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`                                                        // topics to classify, defaults to 100
+	AutoApplyThreshold float64                `protobuf:"fixed64,2,opt,name=auto_apply_threshold,json=autoApplyThreshold,proto3" json:"auto_apply_threshold,omitempty"` // defaults to 0.9, above 1 sends everything to review
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *StartContentRatingJobRequest) Reset() {
+	*x = StartContentRatingJobRequest{}
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartContentRatingJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartContentRatingJobRequest) ProtoMessage() {}
+
+func (x *StartContentRatingJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartContentRatingJobRequest.ProtoReflect.Descriptor instead.
+func (*StartContentRatingJobRequest) Descriptor() ([]byte, []int) {
+	return file_v1_suggestion_suggestion_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StartContentRatingJobRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *StartContentRatingJobRequest) GetAutoApplyThreshold() float64 {
+	if x != nil {
+		return x.AutoApplyThreshold
+	}
+	return 0
+}
+
+type StartContentRatingJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartContentRatingJobResponse) Reset() {
+	*x = StartContentRatingJobResponse{}
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartContentRatingJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartContentRatingJobResponse) ProtoMessage() {}
+
+func (x *StartContentRatingJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartContentRatingJobResponse.ProtoReflect.Descriptor instead.
+func (*StartContentRatingJobResponse) Descriptor() ([]byte, []int) {
+	return file_v1_suggestion_suggestion_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StartContentRatingJobResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type GetJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobRequest) ProtoMessage() {}
+
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return file_v1_suggestion_suggestion_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type GetJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *SuggestionJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobResponse) Reset() {
+	*x = GetJobResponse{}
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobResponse) ProtoMessage() {}
+
+func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
+func (*GetJobResponse) Descriptor() ([]byte, []int) {
+	return file_v1_suggestion_suggestion_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetJobResponse) GetJob() *SuggestionJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type ListSuggestionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          SuggestionKind         `protobuf:"varint,1,opt,name=kind,proto3,enum=suggestion.v1.SuggestionKind" json:"kind,omitempty"`
+	Status        SuggestionStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=suggestion.v1.SuggestionStatus" json:"status,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuggestionsRequest) Reset() {
+	*x = ListSuggestionsRequest{}
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuggestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuggestionsRequest) ProtoMessage() {}
+
+func (x *ListSuggestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_suggestion_suggestion_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListSuggestionsRequest) GetKind() SuggestionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SuggestionKind_SUGGESTION_KIND_UNSPECIFIED
+}
+
+func (x *ListSuggestionsRequest) GetStatus() SuggestionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SuggestionStatus_SUGGESTION_STATUS_UNSPECIFIED
+}
+
+func (x *ListSuggestionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListSuggestionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListSuggestionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Suggestions   []*Suggestion          `protobuf:"bytes,2,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuggestionsResponse) Reset() {
+	*x = ListSuggestionsResponse{}
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuggestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuggestionsResponse) ProtoMessage() {}
+
+func (x *ListSuggestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuggestionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSuggestionsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_suggestion_suggestion_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListSuggestionsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListSuggestionsResponse) GetSuggestions() []*Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type ReviewSuggestionRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approve           bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	EditedPayloadJson string                 `protobuf:"bytes,3,opt,name=edited_payload_json,json=editedPayloadJson,proto3" json:"edited_payload_json,omitempty"` // replaces the payload before it is applied
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReviewSuggestionRequest) Reset() {
+	*x = ReviewSuggestionRequest{}
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewSuggestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewSuggestionRequest) ProtoMessage() {}
+
+func (x *ReviewSuggestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewSuggestionRequest.ProtoReflect.Descriptor instead.
+func (*ReviewSuggestionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_suggestion_suggestion_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReviewSuggestionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReviewSuggestionRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReviewSuggestionRequest) GetEditedPayloadJson() string {
+	if x != nil {
+		return x.EditedPayloadJson
+	}
+	return ""
+}
+
+type ReviewSuggestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestion    *Suggestion            `protobuf:"bytes,1,opt,name=suggestion,proto3" json:"suggestion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewSuggestionResponse) Reset() {
+	*x = ReviewSuggestionResponse{}
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewSuggestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewSuggestionResponse) ProtoMessage() {}
+
+func (x *ReviewSuggestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_suggestion_suggestion_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewSuggestionResponse.ProtoReflect.Descriptor instead.
+func (*ReviewSuggestionResponse) Descriptor() ([]byte, []int) {
+	return file_v1_suggestion_suggestion_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReviewSuggestionResponse) GetSuggestion() *Suggestion {
+	if x != nil {
+		return x.Suggestion
+	}
+	return nil
+}
+
+var File_v1_suggestion_suggestion_proto protoreflect.FileDescriptor
+
+const file_v1_suggestion_suggestion_proto_rawDesc = "" +
+	"\n" +
+	"\x1ev1/suggestion/suggestion.proto\x12\rsuggestion.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xed\x04\n" +
+	"\n" +
+	"Suggestion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x121\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x1d.suggestion.v1.SuggestionKindR\x04kind\x12\x1f\n" +
+	"\vtarget_type\x18\x03 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x04 \x01(\tR\btargetId\x12\x15\n" +
+	"\x06job_id\x18\x05 \x01(\tR\x05jobId\x12!\n" +
+	"\fpayload_json\x18\x06 \x01(\tR\vpayloadJson\x12!\n" +
+	"\fcontext_json\x18\a \x01(\tR\vcontextJson\x127\n" +
+	"\x06status\x18\b \x01(\x0e2\x1f.suggestion.v1.SuggestionStatusR\x06status\x12\x1f\n" +
+	"\vreviewed_by\x18\t \x01(\tR\n" +
+	"reviewedBy\x12;\n" +
+	"\vreviewed_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x14\n" +
+	"\x05model\x18\f \x01(\tR\x05model\x12\x1b\n" +
+	"\tprompt_id\x18\r \x01(\tR\bpromptId\x12%\n" +
+	"\x0eprompt_version\x18\x0e \x01(\x05R\rpromptVersion\x12#\n" +
+	"\n" +
+	"confidence\x18\x0f \x01(\x01H\x00R\n" +
+	"confidence\x88\x01\x01\x12!\n" +
+	"\fauto_applied\x18\x10 \x01(\bR\vautoAppliedB\r\n" +
+	"\v_confidence\"\xa2\x02\n" +
+	"\rSuggestionJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x12#\n" +
+	"\rmetadata_json\x18\b \x01(\tR\fmetadataJson\"1\n" +
+	"\x19StartDistractorJobRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"3\n" +
+	"\x1aStartDistractorJobResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"0\n" +
+	"\x18StartLearnMoreJobRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"2\n" +
+	"\x19StartLearnMoreJobResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"f\n" +
+	"\x1cStartContentRatingJobRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x120\n" +
+	"\x14auto_apply_threshold\x18\x02 \x01(\x01R\x12autoApplyThreshold\"6\n" +
+	"\x1dStartContentRatingJobResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"&\n" +
+	"\rGetJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"@\n" +
+	"\x0eGetJobResponse\x12.\n" +
+	"\x03job\x18\x01 \x01(\v2\x1c.suggestion.v1.SuggestionJobR\x03job\"\xb2\x01\n" +
+	"\x16ListSuggestionsRequest\x121\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1d.suggestion.v1.SuggestionKindR\x04kind\x127\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1f.suggestion.v1.SuggestionStatusR\x06status\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"l\n" +
+	"\x17ListSuggestionsResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12;\n" +
+	"\vsuggestions\x18\x02 \x03(\v2\x19.suggestion.v1.SuggestionR\vsuggestions\"s\n" +
+	"\x17ReviewSuggestionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\x12.\n" +
+	"\x13edited_payload_json\x18\x03 \x01(\tR\x11editedPayloadJson\"U\n" +
+	"\x18ReviewSuggestionResponse\x129\n" +
+	"\n" +
+	"suggestion\x18\x01 \x01(\v2\x19.suggestion.v1.SuggestionR\n" +
+	"suggestion*\x96\x01\n" +
+	"\x0eSuggestionKind\x12\x1f\n" +
+	"\x1bSUGGESTION_KIND_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bSUGGESTION_KIND_DISTRACTORS\x10\x01\x12\x1e\n" +
+	"\x1aSUGGESTION_KIND_LEARN_MORE\x10\x02\x12\"\n" +
+	"\x1eSUGGESTION_KIND_CONTENT_RATING\x10\x03*\x94\x01\n" +
+	"\x10SuggestionStatus\x12!\n" +
+	"\x1dSUGGESTION_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19SUGGESTION_STATUS_PENDING\x10\x01\x12\x1e\n" +
+	"\x1aSUGGESTION_STATUS_APPROVED\x10\x02\x12\x1e\n" +
+	"\x1aSUGGESTION_STATUS_REJECTED\x10\x032\xf4\x04\n" +
+	"\x11SuggestionService\x12k\n" +
+	"\x12StartDistractorJob\x12(.suggestion.v1.StartDistractorJobRequest\x1a).suggestion.v1.StartDistractorJobResponse\"\x00\x12h\n" +
+	"\x11StartLearnMoreJob\x12'.suggestion.v1.StartLearnMoreJobRequest\x1a(.suggestion.v1.StartLearnMoreJobResponse\"\x00\x12t\n" +
+	"\x15StartContentRatingJob\x12+.suggestion.v1.StartContentRatingJobRequest\x1a,.suggestion.v1.StartContentRatingJobResponse\"\x00\x12G\n" +
+	"\x06GetJob\x12\x1c.suggestion.v1.GetJobRequest\x1a\x1d.suggestion.v1.GetJobResponse\"\x00\x12b\n" +
+	"\x0fListSuggestions\x12%.suggestion.v1.ListSuggestionsRequest\x1a&.suggestion.v1.ListSuggestionsResponse\"\x00\x12e\n" +
+	"\x10ReviewSuggestion\x12&.suggestion.v1.ReviewSuggestionRequest\x1a'.suggestion.v1.ReviewSuggestionResponse\"\x00BLZJgithub.com/studyguides-com/study-guides-api/api/v1/suggestion;suggestionv1b\x06proto3"
+
+var (
+	file_v1_suggestion_suggestion_proto_rawDescOnce sync.Once
+	file_v1_suggestion_suggestion_proto_rawDescData []byte
+)
+
+func file_v1_suggestion_suggestion_proto_rawDescGZIP() []byte {
+	file_v1_suggestion_suggestion_proto_rawDescOnce.Do(func() {
+		file_v1_suggestion_suggestion_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_suggestion_suggestion_proto_rawDesc), len(file_v1_suggestion_suggestion_proto_rawDesc)))
+	})
+	return file_v1_suggestion_suggestion_proto_rawDescData
+}
+
+var file_v1_suggestion_suggestion_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_v1_suggestion_suggestion_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_v1_suggestion_suggestion_proto_goTypes = []any{
+	(SuggestionKind)(0),                   // 0: suggestion.v1.SuggestionKind
+	(SuggestionStatus)(0),                 // 1: suggestion.v1.SuggestionStatus
+	(*Suggestion)(nil),                    // 2: suggestion.v1.Suggestion
+	(*SuggestionJob)(nil),                 // 3: suggestion.v1.SuggestionJob
+	(*StartDistractorJobRequest)(nil),     // 4: suggestion.v1.StartDistractorJobRequest
+	(*StartDistractorJobResponse)(nil),    // 5: suggestion.v1.StartDistractorJobResponse
+	(*StartLearnMoreJobRequest)(nil),      // 6: suggestion.v1.StartLearnMoreJobRequest
+	(*StartLearnMoreJobResponse)(nil),     // 7: suggestion.v1.StartLearnMoreJobResponse
+	(*StartContentRatingJobRequest)(nil),  // 8: suggestion.v1.StartContentRatingJobRequest
+	(*StartContentRatingJobResponse)(nil), // 9: suggestion.v1.StartContentRatingJobResponse
+	(*GetJobRequest)(nil),                 // 10: suggestion.v1.GetJobRequest
+	(*GetJobResponse)(nil),                // 11: suggestion.v1.GetJobResponse
+	(*ListSuggestionsRequest)(nil),        // 12: suggestion.v1.ListSuggestionsRequest
+	(*ListSuggestionsResponse)(nil),       // 13: suggestion.v1.ListSuggestionsResponse
+	(*ReviewSuggestionRequest)(nil),       // 14: suggestion.v1.ReviewSuggestionRequest
+	(*ReviewSuggestionResponse)(nil),      // 15: suggestion.v1.ReviewSuggestionResponse
+	(*timestamppb.Timestamp)(nil),         // 16: google.protobuf.Timestamp
+}
+var file_v1_suggestion_suggestion_proto_depIdxs = []int32{
+	0,  // 0: suggestion.v1.Suggestion.kind:type_name -> suggestion.v1.SuggestionKind
+	1,  // 1: suggestion.v1.Suggestion.status:type_name -> suggestion.v1.SuggestionStatus
+	16, // 2: suggestion.v1.Suggestion.reviewed_at:type_name -> google.protobuf.Timestamp
+	16, // 3: suggestion.v1.Suggestion.created_at:type_name -> google.protobuf.Timestamp
+	16, // 4: suggestion.v1.SuggestionJob.started_at:type_name -> google.protobuf.Timestamp
+	16, // 5: suggestion.v1.SuggestionJob.completed_at:type_name -> google.protobuf.Timestamp
+	3,  // 6: suggestion.v1.GetJobResponse.job:type_name -> suggestion.v1.SuggestionJob
+	0,  // 7: suggestion.v1.ListSuggestionsRequest.kind:type_name -> suggestion.v1.SuggestionKind
+	1,  // 8: suggestion.v1.ListSuggestionsRequest.status:type_name -> suggestion.v1.SuggestionStatus
+	2,  // 9: suggestion.v1.ListSuggestionsResponse.suggestions:type_name -> suggestion.v1.Suggestion
+	2,  // 10: suggestion.v1.ReviewSuggestionResponse.suggestion:type_name -> suggestion.v1.Suggestion
+	4,  // 11: suggestion.v1.SuggestionService.StartDistractorJob:input_type -> suggestion.v1.StartDistractorJobRequest
+	6,  // 12: suggestion.v1.SuggestionService.StartLearnMoreJob:input_type -> suggestion.v1.StartLearnMoreJobRequest
+	8,  // 13: suggestion.v1.SuggestionService.StartContentRatingJob:input_type -> suggestion.v1.StartContentRatingJobRequest
+	10, // 14: suggestion.v1.SuggestionService.GetJob:input_type -> suggestion.v1.GetJobRequest
+	12, // 15: suggestion.v1.SuggestionService.ListSuggestions:input_type -> suggestion.v1.ListSuggestionsRequest
+	14, // 16: suggestion.v1.SuggestionService.ReviewSuggestion:input_type -> suggestion.v1.ReviewSuggestionRequest
+	5,  // 17: suggestion.v1.SuggestionService.StartDistractorJob:output_type -> suggestion.v1.StartDistractorJobResponse
+	7,  // 18: suggestion.v1.SuggestionService.StartLearnMoreJob:output_type -> suggestion.v1.StartLearnMoreJobResponse
+	9,  // 19: suggestion.v1.SuggestionService.StartContentRatingJob:output_type -> suggestion.v1.StartContentRatingJobResponse
+	11, // 20: suggestion.v1.SuggestionService.GetJob:output_type -> suggestion.v1.GetJobResponse
+	13, // 21: suggestion.v1.SuggestionService.ListSuggestions:output_type -> suggestion.v1.ListSuggestionsResponse
+	15, // 22: suggestion.v1.SuggestionService.ReviewSuggestion:output_type -> suggestion.v1.ReviewSuggestionResponse
+	17, // [17:23] is the sub-list for method output_type
+	11, // [11:17] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_v1_suggestion_suggestion_proto_init() }
+func file_v1_suggestion_suggestion_proto_init() {
+	if File_v1_suggestion_suggestion_proto != nil {
+		return
+	}
+	file_v1_suggestion_suggestion_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_suggestion_suggestion_proto_rawDesc), len(file_v1_suggestion_suggestion_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_suggestion_suggestion_proto_goTypes,
+		DependencyIndexes: file_v1_suggestion_suggestion_proto_depIdxs,
+		EnumInfos:         file_v1_suggestion_suggestion_proto_enumTypes,
+		MessageInfos:      file_v1_suggestion_suggestion_proto_msgTypes,
+	}.Build()
+	File_v1_suggestion_suggestion_proto = out.File
+	file_v1_suggestion_suggestion_proto_goTypes = nil
+	file_v1_suggestion_suggestion_proto_depIdxs = nil
+}
@@ -0,0 +1,311 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: v1/suggestion/suggestion.proto
+
+package suggestionv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SuggestionService_StartDistractorJob_FullMethodName    = "/suggestion.v1.SuggestionService/StartDistractorJob"
+	SuggestionService_StartLearnMoreJob_FullMethodName     = "/suggestion.v1.SuggestionService/StartLearnMoreJob"
+	SuggestionService_StartContentRatingJob_FullMethodName = "/suggestion.v1.SuggestionService/StartContentRatingJob"
+	SuggestionService_GetJob_FullMethodName                = "/suggestion.v1.SuggestionService/GetJob"
+	SuggestionService_ListSuggestions_FullMethodName       = "/suggestion.v1.SuggestionService/ListSuggestions"
+	SuggestionService_ReviewSuggestion_FullMethodName      = "/suggestion.v1.SuggestionService/ReviewSuggestion"
+)
+
+// SuggestionServiceClient is the client API for SuggestionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SuggestionServiceClient interface {
+	StartDistractorJob(ctx context.Context, in *StartDistractorJobRequest, opts ...grpc.CallOption) (*StartDistractorJobResponse, error)
+	StartLearnMoreJob(ctx context.Context, in *StartLearnMoreJobRequest, opts ...grpc.CallOption) (*StartLearnMoreJobResponse, error)
+	StartContentRatingJob(ctx context.Context, in *StartContentRatingJobRequest, opts ...grpc.CallOption) (*StartContentRatingJobResponse, error)
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error)
+	ListSuggestions(ctx context.Context, in *ListSuggestionsRequest, opts ...grpc.CallOption) (*ListSuggestionsResponse, error)
+	ReviewSuggestion(ctx context.Context, in *ReviewSuggestionRequest, opts ...grpc.CallOption) (*ReviewSuggestionResponse, error)
+}
+
+type suggestionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSuggestionServiceClient(cc grpc.ClientConnInterface) SuggestionServiceClient {
+	return &suggestionServiceClient{cc}
+}
+
+func (c *suggestionServiceClient) StartDistractorJob(ctx context.Context, in *StartDistractorJobRequest, opts ...grpc.CallOption) (*StartDistractorJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartDistractorJobResponse)
+	err := c.cc.Invoke(ctx, SuggestionService_StartDistractorJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suggestionServiceClient) StartLearnMoreJob(ctx context.Context, in *StartLearnMoreJobRequest, opts ...grpc.CallOption) (*StartLearnMoreJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartLearnMoreJobResponse)
+	err := c.cc.Invoke(ctx, SuggestionService_StartLearnMoreJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suggestionServiceClient) StartContentRatingJob(ctx context.Context, in *StartContentRatingJobRequest, opts ...grpc.CallOption) (*StartContentRatingJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartContentRatingJobResponse)
+	err := c.cc.Invoke(ctx, SuggestionService_StartContentRatingJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suggestionServiceClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJobResponse)
+	err := c.cc.Invoke(ctx, SuggestionService_GetJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suggestionServiceClient) ListSuggestions(ctx context.Context, in *ListSuggestionsRequest, opts ...grpc.CallOption) (*ListSuggestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSuggestionsResponse)
+	err := c.cc.Invoke(ctx, SuggestionService_ListSuggestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *suggestionServiceClient) ReviewSuggestion(ctx context.Context, in *ReviewSuggestionRequest, opts ...grpc.CallOption) (*ReviewSuggestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewSuggestionResponse)
+	err := c.cc.Invoke(ctx, SuggestionService_ReviewSuggestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SuggestionServiceServer is the server API for SuggestionService service.
+// All implementations must embed UnimplementedSuggestionServiceServer
+// for forward compatibility.
+type SuggestionServiceServer interface {
+	StartDistractorJob(context.Context, *StartDistractorJobRequest) (*StartDistractorJobResponse, error)
+	StartLearnMoreJob(context.Context, *StartLearnMoreJobRequest) (*StartLearnMoreJobResponse, error)
+	StartContentRatingJob(context.Context, *StartContentRatingJobRequest) (*StartContentRatingJobResponse, error)
+	GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error)
+	ListSuggestions(context.Context, *ListSuggestionsRequest) (*ListSuggestionsResponse, error)
+	ReviewSuggestion(context.Context, *ReviewSuggestionRequest) (*ReviewSuggestionResponse, error)
+	mustEmbedUnimplementedSuggestionServiceServer()
+}
+
+// UnimplementedSuggestionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSuggestionServiceServer struct{}
+
+func (UnimplementedSuggestionServiceServer) StartDistractorJob(context.Context, *StartDistractorJobRequest) (*StartDistractorJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartDistractorJob not implemented")
+}
+func (UnimplementedSuggestionServiceServer) StartLearnMoreJob(context.Context, *StartLearnMoreJobRequest) (*StartLearnMoreJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartLearnMoreJob not implemented")
+}
+func (UnimplementedSuggestionServiceServer) StartContentRatingJob(context.Context, *StartContentRatingJobRequest) (*StartContentRatingJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartContentRatingJob not implemented")
+}
+func (UnimplementedSuggestionServiceServer) GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedSuggestionServiceServer) ListSuggestions(context.Context, *ListSuggestionsRequest) (*ListSuggestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSuggestions not implemented")
+}
+func (UnimplementedSuggestionServiceServer) ReviewSuggestion(context.Context, *ReviewSuggestionRequest) (*ReviewSuggestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewSuggestion not implemented")
+}
+func (UnimplementedSuggestionServiceServer) mustEmbedUnimplementedSuggestionServiceServer() {}
+func (UnimplementedSuggestionServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeSuggestionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SuggestionServiceServer will
+// result in compilation errors.
+type UnsafeSuggestionServiceServer interface {
+	mustEmbedUnimplementedSuggestionServiceServer()
+}
+
+func RegisterSuggestionServiceServer(s grpc.ServiceRegistrar, srv SuggestionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSuggestionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SuggestionService_ServiceDesc, srv)
+}
+
+func _SuggestionService_StartDistractorJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartDistractorJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuggestionServiceServer).StartDistractorJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SuggestionService_StartDistractorJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuggestionServiceServer).StartDistractorJob(ctx, req.(*StartDistractorJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuggestionService_StartLearnMoreJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartLearnMoreJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuggestionServiceServer).StartLearnMoreJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SuggestionService_StartLearnMoreJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuggestionServiceServer).StartLearnMoreJob(ctx, req.(*StartLearnMoreJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuggestionService_StartContentRatingJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartContentRatingJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuggestionServiceServer).StartContentRatingJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SuggestionService_StartContentRatingJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuggestionServiceServer).StartContentRatingJob(ctx, req.(*StartContentRatingJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuggestionService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuggestionServiceServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SuggestionService_GetJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuggestionServiceServer).GetJob(ctx, req.(*GetJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuggestionService_ListSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuggestionServiceServer).ListSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SuggestionService_ListSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuggestionServiceServer).ListSuggestions(ctx, req.(*ListSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SuggestionService_ReviewSuggestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewSuggestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuggestionServiceServer).ReviewSuggestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SuggestionService_ReviewSuggestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuggestionServiceServer).ReviewSuggestion(ctx, req.(*ReviewSuggestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SuggestionService_ServiceDesc is the grpc.ServiceDesc for SuggestionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SuggestionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "suggestion.v1.SuggestionService",
+	HandlerType: (*SuggestionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartDistractorJob",
+			Handler:    _SuggestionService_StartDistractorJob_Handler,
+		},
+		{
+			MethodName: "StartLearnMoreJob",
+			Handler:    _SuggestionService_StartLearnMoreJob_Handler,
+		},
+		{
+			MethodName: "StartContentRatingJob",
+			Handler:    _SuggestionService_StartContentRatingJob_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _SuggestionService_GetJob_Handler,
+		},
+		{
+			MethodName: "ListSuggestions",
+			Handler:    _SuggestionService_ListSuggestions_Handler,
+		},
+		{
+			MethodName: "ReviewSuggestion",
+			Handler:    _SuggestionService_ReviewSuggestion_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/suggestion/suggestion.proto",
+}
@@ -10,6 +10,7 @@ import (
 	shared "github.com/studyguides-com/study-guides-api/api/v1/shared"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,6 +23,107 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type TagAccessLevel int32
+
+const (
+	TagAccessLevel_TAG_ACCESS_LEVEL_UNSPECIFIED TagAccessLevel = 0
+	TagAccessLevel_TAG_ACCESS_LEVEL_READ_ONLY   TagAccessLevel = 1
+	TagAccessLevel_TAG_ACCESS_LEVEL_READ_WRITE  TagAccessLevel = 2
+)
+
+// Enum value maps for TagAccessLevel.
+var (
+	TagAccessLevel_name = map[int32]string{
+		0: "TAG_ACCESS_LEVEL_UNSPECIFIED",
+		1: "TAG_ACCESS_LEVEL_READ_ONLY",
+		2: "TAG_ACCESS_LEVEL_READ_WRITE",
+	}
+	TagAccessLevel_value = map[string]int32{
+		"TAG_ACCESS_LEVEL_UNSPECIFIED": 0,
+		"TAG_ACCESS_LEVEL_READ_ONLY":   1,
+		"TAG_ACCESS_LEVEL_READ_WRITE":  2,
+	}
+)
+
+func (x TagAccessLevel) Enum() *TagAccessLevel {
+	p := new(TagAccessLevel)
+	*p = x
+	return p
+}
+
+func (x TagAccessLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagAccessLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_tag_tag_proto_enumTypes[0].Descriptor()
+}
+
+func (TagAccessLevel) Type() protoreflect.EnumType {
+	return &file_v1_tag_tag_proto_enumTypes[0]
+}
+
+func (x TagAccessLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagAccessLevel.Descriptor instead.
+func (TagAccessLevel) EnumDescriptor() ([]byte, []int) {
+	return file_v1_tag_tag_proto_rawDescGZIP(), []int{0}
+}
+
+type TagInviteStatus int32
+
+const (
+	TagInviteStatus_TAG_INVITE_STATUS_UNSPECIFIED TagInviteStatus = 0
+	TagInviteStatus_TAG_INVITE_STATUS_PENDING     TagInviteStatus = 1
+	TagInviteStatus_TAG_INVITE_STATUS_ACCEPTED    TagInviteStatus = 2
+	TagInviteStatus_TAG_INVITE_STATUS_REJECTED    TagInviteStatus = 3
+)
+
+// Enum value maps for TagInviteStatus.
+var (
+	TagInviteStatus_name = map[int32]string{
+		0: "TAG_INVITE_STATUS_UNSPECIFIED",
+		1: "TAG_INVITE_STATUS_PENDING",
+		2: "TAG_INVITE_STATUS_ACCEPTED",
+		3: "TAG_INVITE_STATUS_REJECTED",
+	}
+	TagInviteStatus_value = map[string]int32{
+		"TAG_INVITE_STATUS_UNSPECIFIED": 0,
+		"TAG_INVITE_STATUS_PENDING":     1,
+		"TAG_INVITE_STATUS_ACCEPTED":    2,
+		"TAG_INVITE_STATUS_REJECTED":    3,
+	}
+)
+
+func (x TagInviteStatus) Enum() *TagInviteStatus {
+	p := new(TagInviteStatus)
+	*p = x
+	return p
+}
+
+func (x TagInviteStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagInviteStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_tag_tag_proto_enumTypes[1].Descriptor()
+}
+
+func (TagInviteStatus) Type() protoreflect.EnumType {
+	return &file_v1_tag_tag_proto_enumTypes[1]
+}
+
+func (x TagInviteStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagInviteStatus.Descriptor instead.
+func (TagInviteStatus) EnumDescriptor() ([]byte, []int) {
+	return file_v1_tag_tag_proto_rawDescGZIP(), []int{1}
+}
+
 type GetTagRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -570,125 +672,1045 @@ func (x *UnfavoriteTagResponse) GetSuccess() bool {
 	return false
 }
 
-var File_v1_tag_tag_proto protoreflect.FileDescriptor
+type TagInvite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TagId         string                 `protobuf:"bytes,2,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	TagName       string                 `protobuf:"bytes,3,opt,name=tag_name,json=tagName,proto3" json:"tag_name,omitempty"`
+	InviterId     string                 `protobuf:"bytes,4,opt,name=inviter_id,json=inviterId,proto3" json:"inviter_id,omitempty"`
+	InviteeId     *string                `protobuf:"bytes,5,opt,name=invitee_id,json=inviteeId,proto3,oneof" json:"invitee_id,omitempty"`
+	Email         *string                `protobuf:"bytes,6,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	AccessLevel   TagAccessLevel         `protobuf:"varint,7,opt,name=access_level,json=accessLevel,proto3,enum=tag.v1.TagAccessLevel" json:"access_level,omitempty"`
+	Status        TagInviteStatus        `protobuf:"varint,8,opt,name=status,proto3,enum=tag.v1.TagInviteStatus" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_v1_tag_tag_proto_rawDesc = "" +
-	"\n" +
-	"\x10v1/tag/tag.proto\x12\x06tag.v1\x1a\x13v1/shared/tag.proto\x1a\x1av1/shared/reporttype.proto\"\x1f\n" +
-	"\rGetTagRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"r\n" +
-	"\x17ListTagsByParentRequest\x12\x1b\n" +
-	"\tparent_id\x18\x01 \x01(\tR\bparentId\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
-	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"g\n" +
-	"\x15ListTagsByTypeRequest\x12\x12\n" +
-	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
-	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageToken\"Q\n" +
-	"\x13ListRootTagsRequest\x12\x1b\n" +
-	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
-	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"^\n" +
-	"\x10ListTagsResponse\x12\"\n" +
-	"\x04tags\x18\x01 \x03(\v2\x0e.shared.v1.TagR\x04tags\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"y\n" +
-	"\x10ReportTagRequest\x12\x15\n" +
-	"\x06tag_id\x18\x01 \x01(\tR\x05tagId\x126\n" +
-	"\vreport_type\x18\x02 \x01(\x0e2\x15.shared.v1.ReportTypeR\n" +
-	"reportType\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\"-\n" +
-	"\x11ReportTagResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"+\n" +
-	"\x12FavoriteTagRequest\x12\x15\n" +
-	"\x06tag_id\x18\x01 \x01(\tR\x05tagId\"/\n" +
-	"\x13FavoriteTagResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"-\n" +
-	"\x14UnfavoriteTagRequest\x12\x15\n" +
-	"\x06tag_id\x18\x01 \x01(\tR\x05tagId\"1\n" +
-	"\x15UnfavoriteTagResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xfb\x03\n" +
-	"\n" +
-	"TagService\x121\n" +
-	"\x06GetTag\x12\x15.tag.v1.GetTagRequest\x1a\x0e.shared.v1.Tag\"\x00\x12O\n" +
-	"\x10ListTagsByParent\x12\x1f.tag.v1.ListTagsByParentRequest\x1a\x18.tag.v1.ListTagsResponse\"\x00\x12K\n" +
-	"\x0eListTagsByType\x12\x1d.tag.v1.ListTagsByTypeRequest\x1a\x18.tag.v1.ListTagsResponse\"\x00\x12G\n" +
-	"\fListRootTags\x12\x1b.tag.v1.ListRootTagsRequest\x1a\x18.tag.v1.ListTagsResponse\"\x00\x12?\n" +
-	"\x06Report\x12\x18.tag.v1.ReportTagRequest\x1a\x19.tag.v1.ReportTagResponse\"\x00\x12E\n" +
-	"\bFavorite\x12\x1a.tag.v1.FavoriteTagRequest\x1a\x1b.tag.v1.FavoriteTagResponse\"\x00\x12K\n" +
-	"\n" +
-	"Unfavorite\x12\x1c.tag.v1.UnfavoriteTagRequest\x1a\x1d.tag.v1.UnfavoriteTagResponse\"\x00B>Z<github.com/studyguides-com/study-guides-api/api/v1/tag;tagv1b\x06proto3"
+func (x *TagInvite) Reset() {
+	*x = TagInvite{}
+	mi := &file_v1_tag_tag_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-var (
-	file_v1_tag_tag_proto_rawDescOnce sync.Once
-	file_v1_tag_tag_proto_rawDescData []byte
-)
+func (x *TagInvite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_v1_tag_tag_proto_rawDescGZIP() []byte {
-	file_v1_tag_tag_proto_rawDescOnce.Do(func() {
-		file_v1_tag_tag_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_tag_tag_proto_rawDesc), len(file_v1_tag_tag_proto_rawDesc)))
-	})
-	return file_v1_tag_tag_proto_rawDescData
+func (*TagInvite) ProtoMessage() {}
+
+func (x *TagInvite) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tag_tag_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_v1_tag_tag_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
-var file_v1_tag_tag_proto_goTypes = []any{
-	(*GetTagRequest)(nil),           // 0: tag.v1.GetTagRequest
-	(*ListTagsByParentRequest)(nil), // 1: tag.v1.ListTagsByParentRequest
-	(*ListTagsByTypeRequest)(nil),   // 2: tag.v1.ListTagsByTypeRequest
-	(*ListRootTagsRequest)(nil),     // 3: tag.v1.ListRootTagsRequest
-	(*ListTagsResponse)(nil),        // 4: tag.v1.ListTagsResponse
-	(*ReportTagRequest)(nil),        // 5: tag.v1.ReportTagRequest
-	(*ReportTagResponse)(nil),       // 6: tag.v1.ReportTagResponse
-	(*FavoriteTagRequest)(nil),      // 7: tag.v1.FavoriteTagRequest
-	(*FavoriteTagResponse)(nil),     // 8: tag.v1.FavoriteTagResponse
-	(*UnfavoriteTagRequest)(nil),    // 9: tag.v1.UnfavoriteTagRequest
-	(*UnfavoriteTagResponse)(nil),   // 10: tag.v1.UnfavoriteTagResponse
-	(*shared.Tag)(nil),              // 11: shared.v1.Tag
-	(shared.ReportType)(0),          // 12: shared.v1.ReportType
+// Deprecated: Use TagInvite.ProtoReflect.Descriptor instead.
+func (*TagInvite) Descriptor() ([]byte, []int) {
+	return file_v1_tag_tag_proto_rawDescGZIP(), []int{11}
 }
-var file_v1_tag_tag_proto_depIdxs = []int32{
-	11, // 0: tag.v1.ListTagsResponse.tags:type_name -> shared.v1.Tag
-	12, // 1: tag.v1.ReportTagRequest.report_type:type_name -> shared.v1.ReportType
-	0,  // 2: tag.v1.TagService.GetTag:input_type -> tag.v1.GetTagRequest
-	1,  // 3: tag.v1.TagService.ListTagsByParent:input_type -> tag.v1.ListTagsByParentRequest
-	2,  // 4: tag.v1.TagService.ListTagsByType:input_type -> tag.v1.ListTagsByTypeRequest
-	3,  // 5: tag.v1.TagService.ListRootTags:input_type -> tag.v1.ListRootTagsRequest
-	5,  // 6: tag.v1.TagService.Report:input_type -> tag.v1.ReportTagRequest
-	7,  // 7: tag.v1.TagService.Favorite:input_type -> tag.v1.FavoriteTagRequest
-	9,  // 8: tag.v1.TagService.Unfavorite:input_type -> tag.v1.UnfavoriteTagRequest
-	11, // 9: tag.v1.TagService.GetTag:output_type -> shared.v1.Tag
-	4,  // 10: tag.v1.TagService.ListTagsByParent:output_type -> tag.v1.ListTagsResponse
-	4,  // 11: tag.v1.TagService.ListTagsByType:output_type -> tag.v1.ListTagsResponse
-	4,  // 12: tag.v1.TagService.ListRootTags:output_type -> tag.v1.ListTagsResponse
-	6,  // 13: tag.v1.TagService.Report:output_type -> tag.v1.ReportTagResponse
-	8,  // 14: tag.v1.TagService.Favorite:output_type -> tag.v1.FavoriteTagResponse
-	10, // 15: tag.v1.TagService.Unfavorite:output_type -> tag.v1.UnfavoriteTagResponse
-	9,  // [9:16] is the sub-list for method output_type
-	2,  // [2:9] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+
+func (x *TagInvite) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func init() { file_v1_tag_tag_proto_init() }
-func file_v1_tag_tag_proto_init() {
-	if File_v1_tag_tag_proto != nil {
-		return
+func (x *TagInvite) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+func (x *TagInvite) GetTagName() string {
+	if x != nil {
+		return x.TagName
+	}
+	return ""
+}
+
+func (x *TagInvite) GetInviterId() string {
+	if x != nil {
+		return x.InviterId
+	}
+	return ""
+}
+
+func (x *TagInvite) GetInviteeId() string {
+	if x != nil && x.InviteeId != nil {
+		return *x.InviteeId
+	}
+	return ""
+}
+
+func (x *TagInvite) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *TagInvite) GetAccessLevel() TagAccessLevel {
+	if x != nil {
+		return x.AccessLevel
+	}
+	return TagAccessLevel_TAG_ACCESS_LEVEL_UNSPECIFIED
+}
+
+func (x *TagInvite) GetStatus() TagInviteStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TagInviteStatus_TAG_INVITE_STATUS_UNSPECIFIED
+}
+
+func (x *TagInvite) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TagInvite) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type TagCollaborator struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Email         *string                `protobuf:"bytes,3,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	AccessLevel   TagAccessLevel         `protobuf:"varint,4,opt,name=access_level,json=accessLevel,proto3,enum=tag.v1.TagAccessLevel" json:"access_level,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagCollaborator) Reset() {
+	*x = TagCollaborator{}
+	mi := &file_v1_tag_tag_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagCollaborator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagCollaborator) ProtoMessage() {}
+
+func (x *TagCollaborator) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tag_tag_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagCollaborator.ProtoReflect.Descriptor instead.
+func (*TagCollaborator) Descriptor() ([]byte, []int) {
+	return file_v1_tag_tag_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TagCollaborator) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TagCollaborator) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *TagCollaborator) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *TagCollaborator) GetAccessLevel() TagAccessLevel {
+	if x != nil {
+		return x.AccessLevel
+	}
+	return TagAccessLevel_TAG_ACCESS_LEVEL_UNSPECIFIED
+}
+
+func (x *TagCollaborator) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Sharing a tag shares its whole subtree
+type InviteToTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	TagId string                 `protobuf:"bytes,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	// Types that are valid to be assigned to Invitee:
+	//
+	//	*InviteToTagRequest_UserId
+	//	*InviteToTagRequest_Email
+	Invitee       isInviteToTagRequest_Invitee `protobuf_oneof:"invitee"`
+	AccessLevel   TagAccessLevel               `protobuf:"varint,4,opt,name=access_level,json=accessLevel,proto3,enum=tag.v1.TagAccessLevel" json:"access_level,omitempty"` // defaults to read only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteToTagRequest) Reset() {
+	*x = InviteToTagRequest{}
+	mi := &file_v1_tag_tag_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteToTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToTagRequest) ProtoMessage() {}
+
+func (x *InviteToTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tag_tag_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToTagRequest.ProtoReflect.Descriptor instead.
+func (*InviteToTagRequest) Descriptor() ([]byte, []int) {
+	return file_v1_tag_tag_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *InviteToTagRequest) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+func (x *InviteToTagRequest) GetInvitee() isInviteToTagRequest_Invitee {
+	if x != nil {
+		return x.Invitee
+	}
+	return nil
+}
+
+func (x *InviteToTagRequest) GetUserId() string {
+	if x != nil {
+		if x, ok := x.Invitee.(*InviteToTagRequest_UserId); ok {
+			return x.UserId
+		}
+	}
+	return ""
+}
+
+func (x *InviteToTagRequest) GetEmail() string {
+	if x != nil {
+		if x, ok := x.Invitee.(*InviteToTagRequest_Email); ok {
+			return x.Email
+		}
+	}
+	return ""
+}
+
+func (x *InviteToTagRequest) GetAccessLevel() TagAccessLevel {
+	if x != nil {
+		return x.AccessLevel
+	}
+	return TagAccessLevel_TAG_ACCESS_LEVEL_UNSPECIFIED
+}
+
+type isInviteToTagRequest_Invitee interface {
+	isInviteToTagRequest_Invitee()
+}
+
+type InviteToTagRequest_UserId struct {
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3,oneof"`
+}
+
+type InviteToTagRequest_Email struct {
+	Email string `protobuf:"bytes,3,opt,name=email,proto3,oneof"`
+}
+
+func (*InviteToTagRequest_UserId) isInviteToTagRequest_Invitee() {}
+
+func (*InviteToTagRequest_Email) isInviteToTagRequest_Invitee() {}
+
+type AcceptTagInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteId      string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptTagInviteRequest) Reset() {
+	*x = AcceptTagInviteRequest{}
+	mi := &file_v1_tag_tag_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptTagInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptTagInviteRequest) ProtoMessage() {}
+
+func (x *AcceptTagInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tag_tag_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptTagInviteRequest.ProtoReflect.Descriptor instead.
+func (*AcceptTagInviteRequest) Descriptor() ([]byte, []int) {
+	return file_v1_tag_tag_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AcceptTagInviteRequest) GetInviteId() string {
+	if x != nil {
+		return x.InviteId
+	}
+	return ""
+}
+
+type DeclineTagInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteId      string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineTagInviteRequest) Reset() {
+	*x = DeclineTagInviteRequest{}
+	mi := &file_v1_tag_tag_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineTagInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineTagInviteRequest) ProtoMessage() {}
+
+func (x *DeclineTagInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tag_tag_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineTagInviteRequest.ProtoReflect.Descriptor instead.
+func (*DeclineTagInviteRequest) Descriptor() ([]byte, []int) {
+	return file_v1_tag_tag_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeclineTagInviteRequest) GetInviteId() string {
+	if x != nil {
+		return x.InviteId
+	}
+	return ""
+}
+
+type RevokeTagAccessRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	TagId string                 `protobuf:"bytes,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*RevokeTagAccessRequest_UserId
+	//	*RevokeTagAccessRequest_InviteId
+	Target        isRevokeTagAccessRequest_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTagAccessRequest) Reset() {
+	*x = RevokeTagAccessRequest{}
+	mi := &file_v1_tag_tag_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTagAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTagAccessRequest) ProtoMessage() {}
+
+func (x *RevokeTagAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tag_tag_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTagAccessRequest.ProtoReflect.Descriptor instead.
+func (*RevokeTagAccessRequest) Descriptor() ([]byte, []int) {
+	return file_v1_tag_tag_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RevokeTagAccessRequest) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+func (x *RevokeTagAccessRequest) GetTarget() isRevokeTagAccessRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *RevokeTagAccessRequest) GetUserId() string {
+	if x != nil {
+		if x, ok := x.Target.(*RevokeTagAccessRequest_UserId); ok {
+			return x.UserId
+		}
+	}
+	return ""
+}
+
+func (x *RevokeTagAccessRequest) GetInviteId() string {
+	if x != nil {
+		if x, ok := x.Target.(*RevokeTagAccessRequest_InviteId); ok {
+			return x.InviteId
+		}
+	}
+	return ""
+}
+
+type isRevokeTagAccessRequest_Target interface {
+	isRevokeTagAccessRequest_Target()
+}
+
+type RevokeTagAccessRequest_UserId struct {
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3,oneof"` // removes a collaborator
+}
+
+type RevokeTagAccessRequest_InviteId struct {
+	InviteId string `protobuf:"bytes,3,opt,name=invite_id,json=inviteId,proto3,oneof"` // withdraws a pending invite
+}
+
+func (*RevokeTagAccessRequest_UserId) isRevokeTagAccessRequest_Target() {}
+
+func (*RevokeTagAccessRequest_InviteId) isRevokeTagAccessRequest_Target() {}
+
+type RevokeTagAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTagAccessResponse) Reset() {
+	*x = RevokeTagAccessResponse{}
+	mi := &file_v1_tag_tag_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTagAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTagAccessResponse) ProtoMessage() {}
+
+func (x *RevokeTagAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tag_tag_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTagAccessResponse.ProtoReflect.Descriptor instead.
+func (*RevokeTagAccessResponse) Descriptor() ([]byte, []int) {
+	return file_v1_tag_tag_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RevokeTagAccessResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListTagCollaboratorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagId         string                 `protobuf:"bytes,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagCollaboratorsRequest) Reset() {
+	*x = ListTagCollaboratorsRequest{}
+	mi := &file_v1_tag_tag_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagCollaboratorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagCollaboratorsRequest) ProtoMessage() {}
+
+func (x *ListTagCollaboratorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tag_tag_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagCollaboratorsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagCollaboratorsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_tag_tag_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListTagCollaboratorsRequest) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+type ListTagCollaboratorsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Collaborators  []*TagCollaborator     `protobuf:"bytes,1,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	PendingInvites []*TagInvite           `protobuf:"bytes,2,rep,name=pending_invites,json=pendingInvites,proto3" json:"pending_invites,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListTagCollaboratorsResponse) Reset() {
+	*x = ListTagCollaboratorsResponse{}
+	mi := &file_v1_tag_tag_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagCollaboratorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagCollaboratorsResponse) ProtoMessage() {}
+
+func (x *ListTagCollaboratorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tag_tag_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagCollaboratorsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagCollaboratorsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_tag_tag_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListTagCollaboratorsResponse) GetCollaborators() []*TagCollaborator {
+	if x != nil {
+		return x.Collaborators
+	}
+	return nil
+}
+
+func (x *ListTagCollaboratorsResponse) GetPendingInvites() []*TagInvite {
+	if x != nil {
+		return x.PendingInvites
+	}
+	return nil
+}
+
+type UpdateTagAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagId         string                 `protobuf:"bytes,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccessLevel   TagAccessLevel         `protobuf:"varint,3,opt,name=access_level,json=accessLevel,proto3,enum=tag.v1.TagAccessLevel" json:"access_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTagAccessRequest) Reset() {
+	*x = UpdateTagAccessRequest{}
+	mi := &file_v1_tag_tag_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTagAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagAccessRequest) ProtoMessage() {}
+
+func (x *UpdateTagAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tag_tag_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagAccessRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTagAccessRequest) Descriptor() ([]byte, []int) {
+	return file_v1_tag_tag_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateTagAccessRequest) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+func (x *UpdateTagAccessRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateTagAccessRequest) GetAccessLevel() TagAccessLevel {
+	if x != nil {
+		return x.AccessLevel
+	}
+	return TagAccessLevel_TAG_ACCESS_LEVEL_UNSPECIFIED
+}
+
+type UpdateTagAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTagAccessResponse) Reset() {
+	*x = UpdateTagAccessResponse{}
+	mi := &file_v1_tag_tag_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTagAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagAccessResponse) ProtoMessage() {}
+
+func (x *UpdateTagAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tag_tag_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagAccessResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTagAccessResponse) Descriptor() ([]byte, []int) {
+	return file_v1_tag_tag_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateTagAccessResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListMyTagInvitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyTagInvitesRequest) Reset() {
+	*x = ListMyTagInvitesRequest{}
+	mi := &file_v1_tag_tag_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyTagInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyTagInvitesRequest) ProtoMessage() {}
+
+func (x *ListMyTagInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tag_tag_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyTagInvitesRequest.ProtoReflect.Descriptor instead.
+func (*ListMyTagInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_tag_tag_proto_rawDescGZIP(), []int{22}
+}
+
+type ListTagInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*TagInvite           `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagInvitesResponse) Reset() {
+	*x = ListTagInvitesResponse{}
+	mi := &file_v1_tag_tag_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagInvitesResponse) ProtoMessage() {}
+
+func (x *ListTagInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tag_tag_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagInvitesResponse.ProtoReflect.Descriptor instead.
+func (*ListTagInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_tag_tag_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListTagInvitesResponse) GetInvites() []*TagInvite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+var File_v1_tag_tag_proto protoreflect.FileDescriptor
+
+const file_v1_tag_tag_proto_rawDesc = "" +
+	"\n" +
+	"\x10v1/tag/tag.proto\x12\x06tag.v1\x1a\x13v1/shared/tag.proto\x1a\x1av1/shared/reporttype.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x1f\n" +
+	"\rGetTagRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"r\n" +
+	"\x17ListTagsByParentRequest\x12\x1b\n" +
+	"\tparent_id\x18\x01 \x01(\tR\bparentId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"g\n" +
+	"\x15ListTagsByTypeRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"Q\n" +
+	"\x13ListRootTagsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"^\n" +
+	"\x10ListTagsResponse\x12\"\n" +
+	"\x04tags\x18\x01 \x03(\v2\x0e.shared.v1.TagR\x04tags\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"y\n" +
+	"\x10ReportTagRequest\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\tR\x05tagId\x126\n" +
+	"\vreport_type\x18\x02 \x01(\x0e2\x15.shared.v1.ReportTypeR\n" +
+	"reportType\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"-\n" +
+	"\x11ReportTagResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"+\n" +
+	"\x12FavoriteTagRequest\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\tR\x05tagId\"/\n" +
+	"\x13FavoriteTagResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"-\n" +
+	"\x14UnfavoriteTagRequest\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\tR\x05tagId\"1\n" +
+	"\x15UnfavoriteTagResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa6\x03\n" +
+	"\tTagInvite\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06tag_id\x18\x02 \x01(\tR\x05tagId\x12\x19\n" +
+	"\btag_name\x18\x03 \x01(\tR\atagName\x12\x1d\n" +
+	"\n" +
+	"inviter_id\x18\x04 \x01(\tR\tinviterId\x12\"\n" +
+	"\n" +
+	"invitee_id\x18\x05 \x01(\tH\x00R\tinviteeId\x88\x01\x01\x12\x19\n" +
+	"\x05email\x18\x06 \x01(\tH\x01R\x05email\x88\x01\x01\x129\n" +
+	"\faccess_level\x18\a \x01(\x0e2\x16.tag.v1.TagAccessLevelR\vaccessLevel\x12/\n" +
+	"\x06status\x18\b \x01(\x0e2\x17.tag.v1.TagInviteStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAtB\r\n" +
+	"\v_invitee_idB\b\n" +
+	"\x06_email\"\xe7\x01\n" +
+	"\x0fTagCollaborator\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x19\n" +
+	"\x05email\x18\x03 \x01(\tH\x01R\x05email\x88\x01\x01\x129\n" +
+	"\faccess_level\x18\x04 \x01(\x0e2\x16.tag.v1.TagAccessLevelR\vaccessLevel\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_email\"\xa4\x01\n" +
+	"\x12InviteToTagRequest\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\tR\x05tagId\x12\x19\n" +
+	"\auser_id\x18\x02 \x01(\tH\x00R\x06userId\x12\x16\n" +
+	"\x05email\x18\x03 \x01(\tH\x00R\x05email\x129\n" +
+	"\faccess_level\x18\x04 \x01(\x0e2\x16.tag.v1.TagAccessLevelR\vaccessLevelB\t\n" +
+	"\ainvitee\"5\n" +
+	"\x16AcceptTagInviteRequest\x12\x1b\n" +
+	"\tinvite_id\x18\x01 \x01(\tR\binviteId\"6\n" +
+	"\x17DeclineTagInviteRequest\x12\x1b\n" +
+	"\tinvite_id\x18\x01 \x01(\tR\binviteId\"s\n" +
+	"\x16RevokeTagAccessRequest\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\tR\x05tagId\x12\x19\n" +
+	"\auser_id\x18\x02 \x01(\tH\x00R\x06userId\x12\x1d\n" +
+	"\tinvite_id\x18\x03 \x01(\tH\x00R\binviteIdB\b\n" +
+	"\x06target\"3\n" +
+	"\x17RevokeTagAccessResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"4\n" +
+	"\x1bListTagCollaboratorsRequest\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\tR\x05tagId\"\x99\x01\n" +
+	"\x1cListTagCollaboratorsResponse\x12=\n" +
+	"\rcollaborators\x18\x01 \x03(\v2\x17.tag.v1.TagCollaboratorR\rcollaborators\x12:\n" +
+	"\x0fpending_invites\x18\x02 \x03(\v2\x11.tag.v1.TagInviteR\x0ependingInvites\"\x83\x01\n" +
+	"\x16UpdateTagAccessRequest\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\tR\x05tagId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x129\n" +
+	"\faccess_level\x18\x03 \x01(\x0e2\x16.tag.v1.TagAccessLevelR\vaccessLevel\"3\n" +
+	"\x17UpdateTagAccessResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x19\n" +
+	"\x17ListMyTagInvitesRequest\"E\n" +
+	"\x16ListTagInvitesResponse\x12+\n" +
+	"\ainvites\x18\x01 \x03(\v2\x11.tag.v1.TagInviteR\ainvites*s\n" +
+	"\x0eTagAccessLevel\x12 \n" +
+	"\x1cTAG_ACCESS_LEVEL_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aTAG_ACCESS_LEVEL_READ_ONLY\x10\x01\x12\x1f\n" +
+	"\x1bTAG_ACCESS_LEVEL_READ_WRITE\x10\x02*\x93\x01\n" +
+	"\x0fTagInviteStatus\x12!\n" +
+	"\x1dTAG_INVITE_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19TAG_INVITE_STATUS_PENDING\x10\x01\x12\x1e\n" +
+	"\x1aTAG_INVITE_STATUS_ACCEPTED\x10\x02\x12\x1e\n" +
+	"\x1aTAG_INVITE_STATUS_REJECTED\x10\x032\xb5\b\n" +
+	"\n" +
+	"TagService\x121\n" +
+	"\x06GetTag\x12\x15.tag.v1.GetTagRequest\x1a\x0e.shared.v1.Tag\"\x00\x12O\n" +
+	"\x10ListTagsByParent\x12\x1f.tag.v1.ListTagsByParentRequest\x1a\x18.tag.v1.ListTagsResponse\"\x00\x12K\n" +
+	"\x0eListTagsByType\x12\x1d.tag.v1.ListTagsByTypeRequest\x1a\x18.tag.v1.ListTagsResponse\"\x00\x12G\n" +
+	"\fListRootTags\x12\x1b.tag.v1.ListRootTagsRequest\x1a\x18.tag.v1.ListTagsResponse\"\x00\x12?\n" +
+	"\x06Report\x12\x18.tag.v1.ReportTagRequest\x1a\x19.tag.v1.ReportTagResponse\"\x00\x12E\n" +
+	"\bFavorite\x12\x1a.tag.v1.FavoriteTagRequest\x1a\x1b.tag.v1.FavoriteTagResponse\"\x00\x12K\n" +
+	"\n" +
+	"Unfavorite\x12\x1c.tag.v1.UnfavoriteTagRequest\x1a\x1d.tag.v1.UnfavoriteTagResponse\"\x00\x12>\n" +
+	"\vInviteToTag\x12\x1a.tag.v1.InviteToTagRequest\x1a\x11.tag.v1.TagInvite\"\x00\x12F\n" +
+	"\x0fAcceptTagInvite\x12\x1e.tag.v1.AcceptTagInviteRequest\x1a\x11.tag.v1.TagInvite\"\x00\x12H\n" +
+	"\x10DeclineTagInvite\x12\x1f.tag.v1.DeclineTagInviteRequest\x1a\x11.tag.v1.TagInvite\"\x00\x12T\n" +
+	"\x0fRevokeTagAccess\x12\x1e.tag.v1.RevokeTagAccessRequest\x1a\x1f.tag.v1.RevokeTagAccessResponse\"\x00\x12c\n" +
+	"\x14ListTagCollaborators\x12#.tag.v1.ListTagCollaboratorsRequest\x1a$.tag.v1.ListTagCollaboratorsResponse\"\x00\x12T\n" +
+	"\x0fUpdateTagAccess\x12\x1e.tag.v1.UpdateTagAccessRequest\x1a\x1f.tag.v1.UpdateTagAccessResponse\"\x00\x12U\n" +
+	"\x10ListMyTagInvites\x12\x1f.tag.v1.ListMyTagInvitesRequest\x1a\x1e.tag.v1.ListTagInvitesResponse\"\x00B>Z<github.com/studyguides-com/study-guides-api/api/v1/tag;tagv1b\x06proto3"
+
+var (
+	file_v1_tag_tag_proto_rawDescOnce sync.Once
+	file_v1_tag_tag_proto_rawDescData []byte
+)
+
+func file_v1_tag_tag_proto_rawDescGZIP() []byte {
+	file_v1_tag_tag_proto_rawDescOnce.Do(func() {
+		file_v1_tag_tag_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_tag_tag_proto_rawDesc), len(file_v1_tag_tag_proto_rawDesc)))
+	})
+	return file_v1_tag_tag_proto_rawDescData
+}
+
+var file_v1_tag_tag_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_v1_tag_tag_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_v1_tag_tag_proto_goTypes = []any{
+	(TagAccessLevel)(0),                  // 0: tag.v1.TagAccessLevel
+	(TagInviteStatus)(0),                 // 1: tag.v1.TagInviteStatus
+	(*GetTagRequest)(nil),                // 2: tag.v1.GetTagRequest
+	(*ListTagsByParentRequest)(nil),      // 3: tag.v1.ListTagsByParentRequest
+	(*ListTagsByTypeRequest)(nil),        // 4: tag.v1.ListTagsByTypeRequest
+	(*ListRootTagsRequest)(nil),          // 5: tag.v1.ListRootTagsRequest
+	(*ListTagsResponse)(nil),             // 6: tag.v1.ListTagsResponse
+	(*ReportTagRequest)(nil),             // 7: tag.v1.ReportTagRequest
+	(*ReportTagResponse)(nil),            // 8: tag.v1.ReportTagResponse
+	(*FavoriteTagRequest)(nil),           // 9: tag.v1.FavoriteTagRequest
+	(*FavoriteTagResponse)(nil),          // 10: tag.v1.FavoriteTagResponse
+	(*UnfavoriteTagRequest)(nil),         // 11: tag.v1.UnfavoriteTagRequest
+	(*UnfavoriteTagResponse)(nil),        // 12: tag.v1.UnfavoriteTagResponse
+	(*TagInvite)(nil),                    // 13: tag.v1.TagInvite
+	(*TagCollaborator)(nil),              // 14: tag.v1.TagCollaborator
+	(*InviteToTagRequest)(nil),           // 15: tag.v1.InviteToTagRequest
+	(*AcceptTagInviteRequest)(nil),       // 16: tag.v1.AcceptTagInviteRequest
+	(*DeclineTagInviteRequest)(nil),      // 17: tag.v1.DeclineTagInviteRequest
+	(*RevokeTagAccessRequest)(nil),       // 18: tag.v1.RevokeTagAccessRequest
+	(*RevokeTagAccessResponse)(nil),      // 19: tag.v1.RevokeTagAccessResponse
+	(*ListTagCollaboratorsRequest)(nil),  // 20: tag.v1.ListTagCollaboratorsRequest
+	(*ListTagCollaboratorsResponse)(nil), // 21: tag.v1.ListTagCollaboratorsResponse
+	(*UpdateTagAccessRequest)(nil),       // 22: tag.v1.UpdateTagAccessRequest
+	(*UpdateTagAccessResponse)(nil),      // 23: tag.v1.UpdateTagAccessResponse
+	(*ListMyTagInvitesRequest)(nil),      // 24: tag.v1.ListMyTagInvitesRequest
+	(*ListTagInvitesResponse)(nil),       // 25: tag.v1.ListTagInvitesResponse
+	(*shared.Tag)(nil),                   // 26: shared.v1.Tag
+	(shared.ReportType)(0),               // 27: shared.v1.ReportType
+	(*timestamppb.Timestamp)(nil),        // 28: google.protobuf.Timestamp
+}
+var file_v1_tag_tag_proto_depIdxs = []int32{
+	26, // 0: tag.v1.ListTagsResponse.tags:type_name -> shared.v1.Tag
+	27, // 1: tag.v1.ReportTagRequest.report_type:type_name -> shared.v1.ReportType
+	0,  // 2: tag.v1.TagInvite.access_level:type_name -> tag.v1.TagAccessLevel
+	1,  // 3: tag.v1.TagInvite.status:type_name -> tag.v1.TagInviteStatus
+	28, // 4: tag.v1.TagInvite.created_at:type_name -> google.protobuf.Timestamp
+	28, // 5: tag.v1.TagInvite.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 6: tag.v1.TagCollaborator.access_level:type_name -> tag.v1.TagAccessLevel
+	28, // 7: tag.v1.TagCollaborator.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 8: tag.v1.InviteToTagRequest.access_level:type_name -> tag.v1.TagAccessLevel
+	14, // 9: tag.v1.ListTagCollaboratorsResponse.collaborators:type_name -> tag.v1.TagCollaborator
+	13, // 10: tag.v1.ListTagCollaboratorsResponse.pending_invites:type_name -> tag.v1.TagInvite
+	0,  // 11: tag.v1.UpdateTagAccessRequest.access_level:type_name -> tag.v1.TagAccessLevel
+	13, // 12: tag.v1.ListTagInvitesResponse.invites:type_name -> tag.v1.TagInvite
+	2,  // 13: tag.v1.TagService.GetTag:input_type -> tag.v1.GetTagRequest
+	3,  // 14: tag.v1.TagService.ListTagsByParent:input_type -> tag.v1.ListTagsByParentRequest
+	4,  // 15: tag.v1.TagService.ListTagsByType:input_type -> tag.v1.ListTagsByTypeRequest
+	5,  // 16: tag.v1.TagService.ListRootTags:input_type -> tag.v1.ListRootTagsRequest
+	7,  // 17: tag.v1.TagService.Report:input_type -> tag.v1.ReportTagRequest
+	9,  // 18: tag.v1.TagService.Favorite:input_type -> tag.v1.FavoriteTagRequest
+	11, // 19: tag.v1.TagService.Unfavorite:input_type -> tag.v1.UnfavoriteTagRequest
+	15, // 20: tag.v1.TagService.InviteToTag:input_type -> tag.v1.InviteToTagRequest
+	16, // 21: tag.v1.TagService.AcceptTagInvite:input_type -> tag.v1.AcceptTagInviteRequest
+	17, // 22: tag.v1.TagService.DeclineTagInvite:input_type -> tag.v1.DeclineTagInviteRequest
+	18, // 23: tag.v1.TagService.RevokeTagAccess:input_type -> tag.v1.RevokeTagAccessRequest
+	20, // 24: tag.v1.TagService.ListTagCollaborators:input_type -> tag.v1.ListTagCollaboratorsRequest
+	22, // 25: tag.v1.TagService.UpdateTagAccess:input_type -> tag.v1.UpdateTagAccessRequest
+	24, // 26: tag.v1.TagService.ListMyTagInvites:input_type -> tag.v1.ListMyTagInvitesRequest
+	26, // 27: tag.v1.TagService.GetTag:output_type -> shared.v1.Tag
+	6,  // 28: tag.v1.TagService.ListTagsByParent:output_type -> tag.v1.ListTagsResponse
+	6,  // 29: tag.v1.TagService.ListTagsByType:output_type -> tag.v1.ListTagsResponse
+	6,  // 30: tag.v1.TagService.ListRootTags:output_type -> tag.v1.ListTagsResponse
+	8,  // 31: tag.v1.TagService.Report:output_type -> tag.v1.ReportTagResponse
+	10, // 32: tag.v1.TagService.Favorite:output_type -> tag.v1.FavoriteTagResponse
+	12, // 33: tag.v1.TagService.Unfavorite:output_type -> tag.v1.UnfavoriteTagResponse
+	13, // 34: tag.v1.TagService.InviteToTag:output_type -> tag.v1.TagInvite
+	13, // 35: tag.v1.TagService.AcceptTagInvite:output_type -> tag.v1.TagInvite
+	13, // 36: tag.v1.TagService.DeclineTagInvite:output_type -> tag.v1.TagInvite
+	19, // 37: tag.v1.TagService.RevokeTagAccess:output_type -> tag.v1.RevokeTagAccessResponse
+	21, // 38: tag.v1.TagService.ListTagCollaborators:output_type -> tag.v1.ListTagCollaboratorsResponse
+	23, // 39: tag.v1.TagService.UpdateTagAccess:output_type -> tag.v1.UpdateTagAccessResponse
+	25, // 40: tag.v1.TagService.ListMyTagInvites:output_type -> tag.v1.ListTagInvitesResponse
+	27, // [27:41] is the sub-list for method output_type
+	13, // [13:27] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_v1_tag_tag_proto_init() }
+func file_v1_tag_tag_proto_init() {
+	if File_v1_tag_tag_proto != nil {
+		return
+	}
+	file_v1_tag_tag_proto_msgTypes[11].OneofWrappers = []any{}
+	file_v1_tag_tag_proto_msgTypes[12].OneofWrappers = []any{}
+	file_v1_tag_tag_proto_msgTypes[13].OneofWrappers = []any{
+		(*InviteToTagRequest_UserId)(nil),
+		(*InviteToTagRequest_Email)(nil),
+	}
+	file_v1_tag_tag_proto_msgTypes[16].OneofWrappers = []any{
+		(*RevokeTagAccessRequest_UserId)(nil),
+		(*RevokeTagAccessRequest_InviteId)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_tag_tag_proto_rawDesc), len(file_v1_tag_tag_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      2,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_v1_tag_tag_proto_goTypes,
 		DependencyIndexes: file_v1_tag_tag_proto_depIdxs,
+		EnumInfos:         file_v1_tag_tag_proto_enumTypes,
 		MessageInfos:      file_v1_tag_tag_proto_msgTypes,
 	}.Build()
 	File_v1_tag_tag_proto = out.File
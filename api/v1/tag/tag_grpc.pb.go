@@ -20,13 +20,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TagService_GetTag_FullMethodName           = "/tag.v1.TagService/GetTag"
-	TagService_ListTagsByParent_FullMethodName = "/tag.v1.TagService/ListTagsByParent"
-	TagService_ListTagsByType_FullMethodName   = "/tag.v1.TagService/ListTagsByType"
-	TagService_ListRootTags_FullMethodName     = "/tag.v1.TagService/ListRootTags"
-	TagService_Report_FullMethodName           = "/tag.v1.TagService/Report"
-	TagService_Favorite_FullMethodName         = "/tag.v1.TagService/Favorite"
-	TagService_Unfavorite_FullMethodName       = "/tag.v1.TagService/Unfavorite"
+	TagService_GetTag_FullMethodName               = "/tag.v1.TagService/GetTag"
+	TagService_ListTagsByParent_FullMethodName     = "/tag.v1.TagService/ListTagsByParent"
+	TagService_ListTagsByType_FullMethodName       = "/tag.v1.TagService/ListTagsByType"
+	TagService_ListRootTags_FullMethodName         = "/tag.v1.TagService/ListRootTags"
+	TagService_Report_FullMethodName               = "/tag.v1.TagService/Report"
+	TagService_Favorite_FullMethodName             = "/tag.v1.TagService/Favorite"
+	TagService_Unfavorite_FullMethodName           = "/tag.v1.TagService/Unfavorite"
+	TagService_InviteToTag_FullMethodName          = "/tag.v1.TagService/InviteToTag"
+	TagService_AcceptTagInvite_FullMethodName      = "/tag.v1.TagService/AcceptTagInvite"
+	TagService_DeclineTagInvite_FullMethodName     = "/tag.v1.TagService/DeclineTagInvite"
+	TagService_RevokeTagAccess_FullMethodName      = "/tag.v1.TagService/RevokeTagAccess"
+	TagService_ListTagCollaborators_FullMethodName = "/tag.v1.TagService/ListTagCollaborators"
+	TagService_UpdateTagAccess_FullMethodName      = "/tag.v1.TagService/UpdateTagAccess"
+	TagService_ListMyTagInvites_FullMethodName     = "/tag.v1.TagService/ListMyTagInvites"
 )
 
 // TagServiceClient is the client API for TagService service.
@@ -40,6 +47,13 @@ type TagServiceClient interface {
 	Report(ctx context.Context, in *ReportTagRequest, opts ...grpc.CallOption) (*ReportTagResponse, error)
 	Favorite(ctx context.Context, in *FavoriteTagRequest, opts ...grpc.CallOption) (*FavoriteTagResponse, error)
 	Unfavorite(ctx context.Context, in *UnfavoriteTagRequest, opts ...grpc.CallOption) (*UnfavoriteTagResponse, error)
+	InviteToTag(ctx context.Context, in *InviteToTagRequest, opts ...grpc.CallOption) (*TagInvite, error)
+	AcceptTagInvite(ctx context.Context, in *AcceptTagInviteRequest, opts ...grpc.CallOption) (*TagInvite, error)
+	DeclineTagInvite(ctx context.Context, in *DeclineTagInviteRequest, opts ...grpc.CallOption) (*TagInvite, error)
+	RevokeTagAccess(ctx context.Context, in *RevokeTagAccessRequest, opts ...grpc.CallOption) (*RevokeTagAccessResponse, error)
+	ListTagCollaborators(ctx context.Context, in *ListTagCollaboratorsRequest, opts ...grpc.CallOption) (*ListTagCollaboratorsResponse, error)
+	UpdateTagAccess(ctx context.Context, in *UpdateTagAccessRequest, opts ...grpc.CallOption) (*UpdateTagAccessResponse, error)
+	ListMyTagInvites(ctx context.Context, in *ListMyTagInvitesRequest, opts ...grpc.CallOption) (*ListTagInvitesResponse, error)
 }
 
 type tagServiceClient struct {
@@ -120,6 +134,76 @@ func (c *tagServiceClient) Unfavorite(ctx context.Context, in *UnfavoriteTagRequ
 	return out, nil
 }
 
+func (c *tagServiceClient) InviteToTag(ctx context.Context, in *InviteToTagRequest, opts ...grpc.CallOption) (*TagInvite, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagInvite)
+	err := c.cc.Invoke(ctx, TagService_InviteToTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) AcceptTagInvite(ctx context.Context, in *AcceptTagInviteRequest, opts ...grpc.CallOption) (*TagInvite, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagInvite)
+	err := c.cc.Invoke(ctx, TagService_AcceptTagInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) DeclineTagInvite(ctx context.Context, in *DeclineTagInviteRequest, opts ...grpc.CallOption) (*TagInvite, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagInvite)
+	err := c.cc.Invoke(ctx, TagService_DeclineTagInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) RevokeTagAccess(ctx context.Context, in *RevokeTagAccessRequest, opts ...grpc.CallOption) (*RevokeTagAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeTagAccessResponse)
+	err := c.cc.Invoke(ctx, TagService_RevokeTagAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) ListTagCollaborators(ctx context.Context, in *ListTagCollaboratorsRequest, opts ...grpc.CallOption) (*ListTagCollaboratorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagCollaboratorsResponse)
+	err := c.cc.Invoke(ctx, TagService_ListTagCollaborators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) UpdateTagAccess(ctx context.Context, in *UpdateTagAccessRequest, opts ...grpc.CallOption) (*UpdateTagAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTagAccessResponse)
+	err := c.cc.Invoke(ctx, TagService_UpdateTagAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) ListMyTagInvites(ctx context.Context, in *ListMyTagInvitesRequest, opts ...grpc.CallOption) (*ListTagInvitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagInvitesResponse)
+	err := c.cc.Invoke(ctx, TagService_ListMyTagInvites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TagServiceServer is the server API for TagService service.
 // All implementations must embed UnimplementedTagServiceServer
 // for forward compatibility.
@@ -131,6 +215,13 @@ type TagServiceServer interface {
 	Report(context.Context, *ReportTagRequest) (*ReportTagResponse, error)
 	Favorite(context.Context, *FavoriteTagRequest) (*FavoriteTagResponse, error)
 	Unfavorite(context.Context, *UnfavoriteTagRequest) (*UnfavoriteTagResponse, error)
+	InviteToTag(context.Context, *InviteToTagRequest) (*TagInvite, error)
+	AcceptTagInvite(context.Context, *AcceptTagInviteRequest) (*TagInvite, error)
+	DeclineTagInvite(context.Context, *DeclineTagInviteRequest) (*TagInvite, error)
+	RevokeTagAccess(context.Context, *RevokeTagAccessRequest) (*RevokeTagAccessResponse, error)
+	ListTagCollaborators(context.Context, *ListTagCollaboratorsRequest) (*ListTagCollaboratorsResponse, error)
+	UpdateTagAccess(context.Context, *UpdateTagAccessRequest) (*UpdateTagAccessResponse, error)
+	ListMyTagInvites(context.Context, *ListMyTagInvitesRequest) (*ListTagInvitesResponse, error)
 	mustEmbedUnimplementedTagServiceServer()
 }
 
@@ -162,6 +253,27 @@ func (UnimplementedTagServiceServer) Favorite(context.Context, *FavoriteTagReque
 func (UnimplementedTagServiceServer) Unfavorite(context.Context, *UnfavoriteTagRequest) (*UnfavoriteTagResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unfavorite not implemented")
 }
+func (UnimplementedTagServiceServer) InviteToTag(context.Context, *InviteToTagRequest) (*TagInvite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteToTag not implemented")
+}
+func (UnimplementedTagServiceServer) AcceptTagInvite(context.Context, *AcceptTagInviteRequest) (*TagInvite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptTagInvite not implemented")
+}
+func (UnimplementedTagServiceServer) DeclineTagInvite(context.Context, *DeclineTagInviteRequest) (*TagInvite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineTagInvite not implemented")
+}
+func (UnimplementedTagServiceServer) RevokeTagAccess(context.Context, *RevokeTagAccessRequest) (*RevokeTagAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeTagAccess not implemented")
+}
+func (UnimplementedTagServiceServer) ListTagCollaborators(context.Context, *ListTagCollaboratorsRequest) (*ListTagCollaboratorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTagCollaborators not implemented")
+}
+func (UnimplementedTagServiceServer) UpdateTagAccess(context.Context, *UpdateTagAccessRequest) (*UpdateTagAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTagAccess not implemented")
+}
+func (UnimplementedTagServiceServer) ListMyTagInvites(context.Context, *ListMyTagInvitesRequest) (*ListTagInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyTagInvites not implemented")
+}
 func (UnimplementedTagServiceServer) mustEmbedUnimplementedTagServiceServer() {}
 func (UnimplementedTagServiceServer) testEmbeddedByValue()                    {}
 
@@ -309,6 +421,132 @@ func _TagService_Unfavorite_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TagService_InviteToTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteToTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).InviteToTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_InviteToTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).InviteToTag(ctx, req.(*InviteToTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_AcceptTagInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptTagInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).AcceptTagInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_AcceptTagInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).AcceptTagInvite(ctx, req.(*AcceptTagInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_DeclineTagInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineTagInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).DeclineTagInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_DeclineTagInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).DeclineTagInvite(ctx, req.(*DeclineTagInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_RevokeTagAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTagAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).RevokeTagAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_RevokeTagAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).RevokeTagAccess(ctx, req.(*RevokeTagAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_ListTagCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagCollaboratorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).ListTagCollaborators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_ListTagCollaborators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).ListTagCollaborators(ctx, req.(*ListTagCollaboratorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_UpdateTagAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).UpdateTagAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_UpdateTagAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).UpdateTagAccess(ctx, req.(*UpdateTagAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_ListMyTagInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyTagInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).ListMyTagInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_ListMyTagInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).ListMyTagInvites(ctx, req.(*ListMyTagInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TagService_ServiceDesc is the grpc.ServiceDesc for TagService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -344,6 +582,34 @@ var TagService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Unfavorite",
 			Handler:    _TagService_Unfavorite_Handler,
 		},
+		{
+			MethodName: "InviteToTag",
+			Handler:    _TagService_InviteToTag_Handler,
+		},
+		{
+			MethodName: "AcceptTagInvite",
+			Handler:    _TagService_AcceptTagInvite_Handler,
+		},
+		{
+			MethodName: "DeclineTagInvite",
+			Handler:    _TagService_DeclineTagInvite_Handler,
+		},
+		{
+			MethodName: "RevokeTagAccess",
+			Handler:    _TagService_RevokeTagAccess_Handler,
+		},
+		{
+			MethodName: "ListTagCollaborators",
+			Handler:    _TagService_ListTagCollaborators_Handler,
+		},
+		{
+			MethodName: "UpdateTagAccess",
+			Handler:    _TagService_UpdateTagAccess_Handler,
+		},
+		{
+			MethodName: "ListMyTagInvites",
+			Handler:    _TagService_ListMyTagInvites_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/tag/tag.proto",
@@ -10,6 +10,7 @@ import (
 	shared "github.com/studyguides-com/study-guides-api/api/v1/shared"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,6 +23,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Plan int32
+
+const (
+	Plan_PLAN_UNSPECIFIED Plan = 0
+	Plan_PLAN_FREE        Plan = 1
+	Plan_PLAN_PREMIUM     Plan = 2
+)
+
+// Enum value maps for Plan.
+var (
+	Plan_name = map[int32]string{
+		0: "PLAN_UNSPECIFIED",
+		1: "PLAN_FREE",
+		2: "PLAN_PREMIUM",
+	}
+	Plan_value = map[string]int32{
+		"PLAN_UNSPECIFIED": 0,
+		"PLAN_FREE":        1,
+		"PLAN_PREMIUM":     2,
+	}
+)
+
+func (x Plan) Enum() *Plan {
+	p := new(Plan)
+	*p = x
+	return p
+}
+
+func (x Plan) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Plan) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_user_user_proto_enumTypes[0].Descriptor()
+}
+
+func (Plan) Type() protoreflect.EnumType {
+	return &file_v1_user_user_proto_enumTypes[0]
+}
+
+func (x Plan) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Plan.Descriptor instead.
+func (Plan) EnumDescriptor() ([]byte, []int) {
+	return file_v1_user_user_proto_rawDescGZIP(), []int{0}
+}
+
 type ProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -234,11 +284,115 @@ func (x *UserResponse) GetUser() *shared.User {
 	return nil
 }
 
+type EntitlementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntitlementsRequest) Reset() {
+	*x = EntitlementsRequest{}
+	mi := &file_v1_user_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntitlementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntitlementsRequest) ProtoMessage() {}
+
+func (x *EntitlementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntitlementsRequest.ProtoReflect.Descriptor instead.
+func (*EntitlementsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_user_user_proto_rawDescGZIP(), []int{5}
+}
+
+type EntitlementsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Plan             Plan                   `protobuf:"varint,1,opt,name=plan,proto3,enum=user.v1.Plan" json:"plan,omitempty"`
+	Features         []string               `protobuf:"bytes,2,rep,name=features,proto3" json:"features,omitempty"`                                               // premium features the caller can use, e.g. test_mode
+	SubscriptionType *string                `protobuf:"bytes,3,opt,name=subscription_type,json=subscriptionType,proto3,oneof" json:"subscription_type,omitempty"` // Free, Monthly or Yearly
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *EntitlementsResponse) Reset() {
+	*x = EntitlementsResponse{}
+	mi := &file_v1_user_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntitlementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntitlementsResponse) ProtoMessage() {}
+
+func (x *EntitlementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_user_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntitlementsResponse.ProtoReflect.Descriptor instead.
+func (*EntitlementsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_user_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EntitlementsResponse) GetPlan() Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return Plan_PLAN_UNSPECIFIED
+}
+
+func (x *EntitlementsResponse) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *EntitlementsResponse) GetSubscriptionType() string {
+	if x != nil && x.SubscriptionType != nil {
+		return *x.SubscriptionType
+	}
+	return ""
+}
+
+func (x *EntitlementsResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 var File_v1_user_user_proto protoreflect.FileDescriptor
 
 const file_v1_user_user_proto_rawDesc = "" +
 	"\n" +
-	"\x12v1/user/user.proto\x12\auser.v1\x1a\x14v1/shared/user.proto\"\x10\n" +
+	"\x12v1/user/user.proto\x12\auser.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14v1/shared/user.proto\"\x10\n" +
 	"\x0eProfileRequest\"6\n" +
 	"\x0fProfileResponse\x12#\n" +
 	"\x04user\x18\x01 \x01(\v2\x0f.shared.v1.UserR\x04user\"*\n" +
@@ -247,11 +401,25 @@ const file_v1_user_user_proto_rawDesc = "" +
 	"\x12UserByEmailRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\"3\n" +
 	"\fUserResponse\x12#\n" +
-	"\x04user\x18\x01 \x01(\v2\x0f.shared.v1.UserR\x04user2\xcb\x01\n" +
+	"\x04user\x18\x01 \x01(\v2\x0f.shared.v1.UserR\x04user\"\x15\n" +
+	"\x13EntitlementsRequest\"\xec\x01\n" +
+	"\x14EntitlementsResponse\x12!\n" +
+	"\x04plan\x18\x01 \x01(\x0e2\r.user.v1.PlanR\x04plan\x12\x1a\n" +
+	"\bfeatures\x18\x02 \x03(\tR\bfeatures\x120\n" +
+	"\x11subscription_type\x18\x03 \x01(\tH\x00R\x10subscriptionType\x88\x01\x01\x12>\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\texpiresAt\x88\x01\x01B\x14\n" +
+	"\x12_subscription_typeB\r\n" +
+	"\v_expires_at*=\n" +
+	"\x04Plan\x12\x14\n" +
+	"\x10PLAN_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tPLAN_FREE\x10\x01\x12\x10\n" +
+	"\fPLAN_PREMIUM\x10\x022\x98\x02\n" +
 	"\vUserService\x12<\n" +
 	"\aProfile\x12\x17.user.v1.ProfileRequest\x1a\x18.user.v1.ProfileResponse\x12;\n" +
 	"\bUserByID\x12\x18.user.v1.UserByIDRequest\x1a\x15.user.v1.UserResponse\x12A\n" +
-	"\vUserByEmail\x12\x1b.user.v1.UserByEmailRequest\x1a\x15.user.v1.UserResponseB@Z>github.com/studyguides-com/study-guides-api/api/v1/user;userv1b\x06proto3"
+	"\vUserByEmail\x12\x1b.user.v1.UserByEmailRequest\x1a\x15.user.v1.UserResponse\x12K\n" +
+	"\fEntitlements\x12\x1c.user.v1.EntitlementsRequest\x1a\x1d.user.v1.EntitlementsResponseB@Z>github.com/studyguides-com/study-guides-api/api/v1/user;userv1b\x06proto3"
 
 var (
 	file_v1_user_user_proto_rawDescOnce sync.Once
@@ -265,29 +433,38 @@ func file_v1_user_user_proto_rawDescGZIP() []byte {
 	return file_v1_user_user_proto_rawDescData
 }
 
-var file_v1_user_user_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_v1_user_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_v1_user_user_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_v1_user_user_proto_goTypes = []any{
-	(*ProfileRequest)(nil),     // 0: user.v1.ProfileRequest
-	(*ProfileResponse)(nil),    // 1: user.v1.ProfileResponse
-	(*UserByIDRequest)(nil),    // 2: user.v1.UserByIDRequest
-	(*UserByEmailRequest)(nil), // 3: user.v1.UserByEmailRequest
-	(*UserResponse)(nil),       // 4: user.v1.UserResponse
-	(*shared.User)(nil),        // 5: shared.v1.User
+	(Plan)(0),                     // 0: user.v1.Plan
+	(*ProfileRequest)(nil),        // 1: user.v1.ProfileRequest
+	(*ProfileResponse)(nil),       // 2: user.v1.ProfileResponse
+	(*UserByIDRequest)(nil),       // 3: user.v1.UserByIDRequest
+	(*UserByEmailRequest)(nil),    // 4: user.v1.UserByEmailRequest
+	(*UserResponse)(nil),          // 5: user.v1.UserResponse
+	(*EntitlementsRequest)(nil),   // 6: user.v1.EntitlementsRequest
+	(*EntitlementsResponse)(nil),  // 7: user.v1.EntitlementsResponse
+	(*shared.User)(nil),           // 8: shared.v1.User
+	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
 }
 var file_v1_user_user_proto_depIdxs = []int32{
-	5, // 0: user.v1.ProfileResponse.user:type_name -> shared.v1.User
-	5, // 1: user.v1.UserResponse.user:type_name -> shared.v1.User
-	0, // 2: user.v1.UserService.Profile:input_type -> user.v1.ProfileRequest
-	2, // 3: user.v1.UserService.UserByID:input_type -> user.v1.UserByIDRequest
-	3, // 4: user.v1.UserService.UserByEmail:input_type -> user.v1.UserByEmailRequest
-	1, // 5: user.v1.UserService.Profile:output_type -> user.v1.ProfileResponse
-	4, // 6: user.v1.UserService.UserByID:output_type -> user.v1.UserResponse
-	4, // 7: user.v1.UserService.UserByEmail:output_type -> user.v1.UserResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	8, // 0: user.v1.ProfileResponse.user:type_name -> shared.v1.User
+	8, // 1: user.v1.UserResponse.user:type_name -> shared.v1.User
+	0, // 2: user.v1.EntitlementsResponse.plan:type_name -> user.v1.Plan
+	9, // 3: user.v1.EntitlementsResponse.expires_at:type_name -> google.protobuf.Timestamp
+	1, // 4: user.v1.UserService.Profile:input_type -> user.v1.ProfileRequest
+	3, // 5: user.v1.UserService.UserByID:input_type -> user.v1.UserByIDRequest
+	4, // 6: user.v1.UserService.UserByEmail:input_type -> user.v1.UserByEmailRequest
+	6, // 7: user.v1.UserService.Entitlements:input_type -> user.v1.EntitlementsRequest
+	2, // 8: user.v1.UserService.Profile:output_type -> user.v1.ProfileResponse
+	5, // 9: user.v1.UserService.UserByID:output_type -> user.v1.UserResponse
+	5, // 10: user.v1.UserService.UserByEmail:output_type -> user.v1.UserResponse
+	7, // 11: user.v1.UserService.Entitlements:output_type -> user.v1.EntitlementsResponse
+	8, // [8:12] is the sub-list for method output_type
+	4, // [4:8] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_v1_user_user_proto_init() }
@@ -295,18 +472,20 @@ func file_v1_user_user_proto_init() {
 	if File_v1_user_user_proto != nil {
 		return
 	}
+	file_v1_user_user_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_user_user_proto_rawDesc), len(file_v1_user_user_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      1,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_v1_user_user_proto_goTypes,
 		DependencyIndexes: file_v1_user_user_proto_depIdxs,
+		EnumInfos:         file_v1_user_user_proto_enumTypes,
 		MessageInfos:      file_v1_user_user_proto_msgTypes,
 	}.Build()
 	File_v1_user_user_proto = out.File
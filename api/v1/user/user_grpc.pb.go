@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_Profile_FullMethodName     = "/user.v1.UserService/Profile"
-	UserService_UserByID_FullMethodName    = "/user.v1.UserService/UserByID"
-	UserService_UserByEmail_FullMethodName = "/user.v1.UserService/UserByEmail"
+	UserService_Profile_FullMethodName      = "/user.v1.UserService/Profile"
+	UserService_UserByID_FullMethodName     = "/user.v1.UserService/UserByID"
+	UserService_UserByEmail_FullMethodName  = "/user.v1.UserService/UserByEmail"
+	UserService_Entitlements_FullMethodName = "/user.v1.UserService/Entitlements"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -31,6 +32,7 @@ type UserServiceClient interface {
 	Profile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
 	UserByID(ctx context.Context, in *UserByIDRequest, opts ...grpc.CallOption) (*UserResponse, error)
 	UserByEmail(ctx context.Context, in *UserByEmailRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	Entitlements(ctx context.Context, in *EntitlementsRequest, opts ...grpc.CallOption) (*EntitlementsResponse, error)
 }
 
 type userServiceClient struct {
@@ -71,6 +73,16 @@ func (c *userServiceClient) UserByEmail(ctx context.Context, in *UserByEmailRequ
 	return out, nil
 }
 
+func (c *userServiceClient) Entitlements(ctx context.Context, in *EntitlementsRequest, opts ...grpc.CallOption) (*EntitlementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EntitlementsResponse)
+	err := c.cc.Invoke(ctx, UserService_Entitlements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -78,6 +90,7 @@ type UserServiceServer interface {
 	Profile(context.Context, *ProfileRequest) (*ProfileResponse, error)
 	UserByID(context.Context, *UserByIDRequest) (*UserResponse, error)
 	UserByEmail(context.Context, *UserByEmailRequest) (*UserResponse, error)
+	Entitlements(context.Context, *EntitlementsRequest) (*EntitlementsResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -97,6 +110,9 @@ func (UnimplementedUserServiceServer) UserByID(context.Context, *UserByIDRequest
 func (UnimplementedUserServiceServer) UserByEmail(context.Context, *UserByEmailRequest) (*UserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserByEmail not implemented")
 }
+func (UnimplementedUserServiceServer) Entitlements(context.Context, *EntitlementsRequest) (*EntitlementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Entitlements not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -172,6 +188,24 @@ func _UserService_UserByEmail_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_Entitlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntitlementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Entitlements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Entitlements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Entitlements(ctx, req.(*EntitlementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +225,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UserByEmail",
 			Handler:    _UserService_UserByEmail_Handler,
 		},
+		{
+			MethodName: "Entitlements",
+			Handler:    _UserService_Entitlements_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/user/user.proto",
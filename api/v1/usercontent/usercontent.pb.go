@@ -0,0 +1,835 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: v1/usercontent/usercontent.proto
+
+package usercontentv1
+
+import (
+	shared "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Users author UserFolder -> UserStudyGuide -> UserTopic trees with questions on
+// the topics. Everything is private to its owner until shared.
+type CreateTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          shared.TagType         `protobuf:"varint,1,opt,name=type,proto3,enum=shared.v1.TagType" json:"type,omitempty"`       // UserFolder, UserStudyGuide or UserTopic
+	ParentId      *string                `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"` // required for study guides and topics
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTagRequest) Reset() {
+	*x = CreateTagRequest{}
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagRequest) ProtoMessage() {}
+
+func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagRequest.ProtoReflect.Descriptor instead.
+func (*CreateTagRequest) Descriptor() ([]byte, []int) {
+	return file_v1_usercontent_usercontent_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateTagRequest) GetType() shared.TagType {
+	if x != nil {
+		return x.Type
+	}
+	return shared.TagType(0)
+}
+
+func (x *CreateTagRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTagRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type UpdateTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTagRequest) Reset() {
+	*x = UpdateTagRequest{}
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagRequest) ProtoMessage() {}
+
+func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTagRequest) Descriptor() ([]byte, []int) {
+	return file_v1_usercontent_usercontent_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateTagRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTagRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTagRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type DeleteTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // deletes the whole subtree
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagRequest) Reset() {
+	*x = DeleteTagRequest{}
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagRequest) ProtoMessage() {}
+
+func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+	return file_v1_usercontent_usercontent_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeleteTagRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagResponse) Reset() {
+	*x = DeleteTagResponse{}
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagResponse) ProtoMessage() {}
+
+func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTagResponse) Descriptor() ([]byte, []int) {
+	return file_v1_usercontent_usercontent_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteTagResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type Distractors struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Distractors) Reset() {
+	*x = Distractors{}
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Distractors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Distractors) ProtoMessage() {}
+
+func (x *Distractors) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Distractors.ProtoReflect.Descriptor instead.
+func (*Distractors) Descriptor() ([]byte, []int) {
+	return file_v1_usercontent_usercontent_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Distractors) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type CreateQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagId         string                 `protobuf:"bytes,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"` // a UserTopic
+	QuestionText  string                 `protobuf:"bytes,2,opt,name=question_text,json=questionText,proto3" json:"question_text,omitempty"`
+	AnswerText    string                 `protobuf:"bytes,3,opt,name=answer_text,json=answerText,proto3" json:"answer_text,omitempty"`
+	LearnMore     *string                `protobuf:"bytes,4,opt,name=learn_more,json=learnMore,proto3,oneof" json:"learn_more,omitempty"`
+	Distractors   []string               `protobuf:"bytes,5,rep,name=distractors,proto3" json:"distractors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQuestionRequest) Reset() {
+	*x = CreateQuestionRequest{}
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuestionRequest) ProtoMessage() {}
+
+func (x *CreateQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuestionRequest.ProtoReflect.Descriptor instead.
+func (*CreateQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_usercontent_usercontent_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateQuestionRequest) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+func (x *CreateQuestionRequest) GetQuestionText() string {
+	if x != nil {
+		return x.QuestionText
+	}
+	return ""
+}
+
+func (x *CreateQuestionRequest) GetAnswerText() string {
+	if x != nil {
+		return x.AnswerText
+	}
+	return ""
+}
+
+func (x *CreateQuestionRequest) GetLearnMore() string {
+	if x != nil && x.LearnMore != nil {
+		return *x.LearnMore
+	}
+	return ""
+}
+
+func (x *CreateQuestionRequest) GetDistractors() []string {
+	if x != nil {
+		return x.Distractors
+	}
+	return nil
+}
+
+type UpdateQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuestionText  *string                `protobuf:"bytes,2,opt,name=question_text,json=questionText,proto3,oneof" json:"question_text,omitempty"`
+	AnswerText    *string                `protobuf:"bytes,3,opt,name=answer_text,json=answerText,proto3,oneof" json:"answer_text,omitempty"`
+	LearnMore     *string                `protobuf:"bytes,4,opt,name=learn_more,json=learnMore,proto3,oneof" json:"learn_more,omitempty"`
+	Distractors   *Distractors           `protobuf:"bytes,5,opt,name=distractors,proto3,oneof" json:"distractors,omitempty"` // replaces the list when set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateQuestionRequest) Reset() {
+	*x = UpdateQuestionRequest{}
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQuestionRequest) ProtoMessage() {}
+
+func (x *UpdateQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQuestionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_usercontent_usercontent_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateQuestionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateQuestionRequest) GetQuestionText() string {
+	if x != nil && x.QuestionText != nil {
+		return *x.QuestionText
+	}
+	return ""
+}
+
+func (x *UpdateQuestionRequest) GetAnswerText() string {
+	if x != nil && x.AnswerText != nil {
+		return *x.AnswerText
+	}
+	return ""
+}
+
+func (x *UpdateQuestionRequest) GetLearnMore() string {
+	if x != nil && x.LearnMore != nil {
+		return *x.LearnMore
+	}
+	return ""
+}
+
+func (x *UpdateQuestionRequest) GetDistractors() *Distractors {
+	if x != nil {
+		return x.Distractors
+	}
+	return nil
+}
+
+type DeleteQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQuestionRequest) Reset() {
+	*x = DeleteQuestionRequest{}
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuestionRequest) ProtoMessage() {}
+
+func (x *DeleteQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuestionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_v1_usercontent_usercontent_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteQuestionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteQuestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQuestionResponse) Reset() {
+	*x = DeleteQuestionResponse{}
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuestionResponse) ProtoMessage() {}
+
+func (x *DeleteQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuestionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_v1_usercontent_usercontent_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteQuestionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type Quota struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Used          int32                  `protobuf:"varint,1,opt,name=used,proto3" json:"used,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Quota) Reset() {
+	*x = Quota{}
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quota) ProtoMessage() {}
+
+func (x *Quota) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quota.ProtoReflect.Descriptor instead.
+func (*Quota) Descriptor() ([]byte, []int) {
+	return file_v1_usercontent_usercontent_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Quota) GetUsed() int32 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *Quota) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageRequest) ProtoMessage() {}
+
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_v1_usercontent_usercontent_proto_rawDescGZIP(), []int{10}
+}
+
+type GetUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folders       *Quota                 `protobuf:"bytes,1,opt,name=folders,proto3" json:"folders,omitempty"`
+	StudyGuides   *Quota                 `protobuf:"bytes,2,opt,name=study_guides,json=studyGuides,proto3" json:"study_guides,omitempty"`
+	Topics        *Quota                 `protobuf:"bytes,3,opt,name=topics,proto3" json:"topics,omitempty"`
+	Questions     *Quota                 `protobuf:"bytes,4,opt,name=questions,proto3" json:"questions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageResponse) Reset() {
+	*x = GetUsageResponse{}
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageResponse) ProtoMessage() {}
+
+func (x *GetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_usercontent_usercontent_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageResponse) Descriptor() ([]byte, []int) {
+	return file_v1_usercontent_usercontent_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetUsageResponse) GetFolders() *Quota {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+func (x *GetUsageResponse) GetStudyGuides() *Quota {
+	if x != nil {
+		return x.StudyGuides
+	}
+	return nil
+}
+
+func (x *GetUsageResponse) GetTopics() *Quota {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *GetUsageResponse) GetQuestions() *Quota {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+var File_v1_usercontent_usercontent_proto protoreflect.FileDescriptor
+
+const file_v1_usercontent_usercontent_proto_rawDesc = "" +
+	"\n" +
+	" v1/usercontent/usercontent.proto\x12\x0eusercontent.v1\x1a\x13v1/shared/tag.proto\x1a\x17v1/shared/tagtype.proto\x1a\x18v1/shared/question.proto\"\xb5\x01\n" +
+	"\x10CreateTagRequest\x12&\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x12.shared.v1.TagTypeR\x04type\x12 \n" +
+	"\tparent_id\x18\x02 \x01(\tH\x00R\bparentId\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01B\f\n" +
+	"\n" +
+	"_parent_idB\x0e\n" +
+	"\f_description\"{\n" +
+	"\x10UpdateTagRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_description\"\"\n" +
+	"\x10DeleteTagRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"-\n" +
+	"\x11DeleteTagResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"%\n" +
+	"\vDistractors\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"\xc9\x01\n" +
+	"\x15CreateQuestionRequest\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\tR\x05tagId\x12#\n" +
+	"\rquestion_text\x18\x02 \x01(\tR\fquestionText\x12\x1f\n" +
+	"\vanswer_text\x18\x03 \x01(\tR\n" +
+	"answerText\x12\"\n" +
+	"\n" +
+	"learn_more\x18\x04 \x01(\tH\x00R\tlearnMore\x88\x01\x01\x12 \n" +
+	"\vdistractors\x18\x05 \x03(\tR\vdistractorsB\r\n" +
+	"\v_learn_more\"\xa0\x02\n" +
+	"\x15UpdateQuestionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
+	"\rquestion_text\x18\x02 \x01(\tH\x00R\fquestionText\x88\x01\x01\x12$\n" +
+	"\vanswer_text\x18\x03 \x01(\tH\x01R\n" +
+	"answerText\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"learn_more\x18\x04 \x01(\tH\x02R\tlearnMore\x88\x01\x01\x12B\n" +
+	"\vdistractors\x18\x05 \x01(\v2\x1b.usercontent.v1.DistractorsH\x03R\vdistractors\x88\x01\x01B\x10\n" +
+	"\x0e_question_textB\x0e\n" +
+	"\f_answer_textB\r\n" +
+	"\v_learn_moreB\x0e\n" +
+	"\f_distractors\"'\n" +
+	"\x15DeleteQuestionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"2\n" +
+	"\x16DeleteQuestionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"1\n" +
+	"\x05Quota\x12\x12\n" +
+	"\x04used\x18\x01 \x01(\x05R\x04used\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x11\n" +
+	"\x0fGetUsageRequest\"\xe1\x01\n" +
+	"\x10GetUsageResponse\x12/\n" +
+	"\afolders\x18\x01 \x01(\v2\x15.usercontent.v1.QuotaR\afolders\x128\n" +
+	"\fstudy_guides\x18\x02 \x01(\v2\x15.usercontent.v1.QuotaR\vstudyGuides\x12-\n" +
+	"\x06topics\x18\x03 \x01(\v2\x15.usercontent.v1.QuotaR\x06topics\x123\n" +
+	"\tquestions\x18\x04 \x01(\v2\x15.usercontent.v1.QuotaR\tquestions2\xbe\x04\n" +
+	"\x12UserContentService\x12?\n" +
+	"\tCreateTag\x12 .usercontent.v1.CreateTagRequest\x1a\x0e.shared.v1.Tag\"\x00\x12?\n" +
+	"\tUpdateTag\x12 .usercontent.v1.UpdateTagRequest\x1a\x0e.shared.v1.Tag\"\x00\x12R\n" +
+	"\tDeleteTag\x12 .usercontent.v1.DeleteTagRequest\x1a!.usercontent.v1.DeleteTagResponse\"\x00\x12N\n" +
+	"\x0eCreateQuestion\x12%.usercontent.v1.CreateQuestionRequest\x1a\x13.shared.v1.Question\"\x00\x12N\n" +
+	"\x0eUpdateQuestion\x12%.usercontent.v1.UpdateQuestionRequest\x1a\x13.shared.v1.Question\"\x00\x12a\n" +
+	"\x0eDeleteQuestion\x12%.usercontent.v1.DeleteQuestionRequest\x1a&.usercontent.v1.DeleteQuestionResponse\"\x00\x12O\n" +
+	"\bGetUsage\x12\x1f.usercontent.v1.GetUsageRequest\x1a .usercontent.v1.GetUsageResponse\"\x00BNZLgithub.com/studyguides-com/study-guides-api/api/v1/usercontent;usercontentv1b\x06proto3"
+
+var (
+	file_v1_usercontent_usercontent_proto_rawDescOnce sync.Once
+	file_v1_usercontent_usercontent_proto_rawDescData []byte
+)
+
+func file_v1_usercontent_usercontent_proto_rawDescGZIP() []byte {
+	file_v1_usercontent_usercontent_proto_rawDescOnce.Do(func() {
+		file_v1_usercontent_usercontent_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_usercontent_usercontent_proto_rawDesc), len(file_v1_usercontent_usercontent_proto_rawDesc)))
+	})
+	return file_v1_usercontent_usercontent_proto_rawDescData
+}
+
+var file_v1_usercontent_usercontent_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_v1_usercontent_usercontent_proto_goTypes = []any{
+	(*CreateTagRequest)(nil),       // 0: usercontent.v1.CreateTagRequest
+	(*UpdateTagRequest)(nil),       // 1: usercontent.v1.UpdateTagRequest
+	(*DeleteTagRequest)(nil),       // 2: usercontent.v1.DeleteTagRequest
+	(*DeleteTagResponse)(nil),      // 3: usercontent.v1.DeleteTagResponse
+	(*Distractors)(nil),            // 4: usercontent.v1.Distractors
+	(*CreateQuestionRequest)(nil),  // 5: usercontent.v1.CreateQuestionRequest
+	(*UpdateQuestionRequest)(nil),  // 6: usercontent.v1.UpdateQuestionRequest
+	(*DeleteQuestionRequest)(nil),  // 7: usercontent.v1.DeleteQuestionRequest
+	(*DeleteQuestionResponse)(nil), // 8: usercontent.v1.DeleteQuestionResponse
+	(*Quota)(nil),                  // 9: usercontent.v1.Quota
+	(*GetUsageRequest)(nil),        // 10: usercontent.v1.GetUsageRequest
+	(*GetUsageResponse)(nil),       // 11: usercontent.v1.GetUsageResponse
+	(shared.TagType)(0),            // 12: shared.v1.TagType
+	(*shared.Tag)(nil),             // 13: shared.v1.Tag
+	(*shared.Question)(nil),        // 14: shared.v1.Question
+}
+var file_v1_usercontent_usercontent_proto_depIdxs = []int32{
+	12, // 0: usercontent.v1.CreateTagRequest.type:type_name -> shared.v1.TagType
+	4,  // 1: usercontent.v1.UpdateQuestionRequest.distractors:type_name -> usercontent.v1.Distractors
+	9,  // 2: usercontent.v1.GetUsageResponse.folders:type_name -> usercontent.v1.Quota
+	9,  // 3: usercontent.v1.GetUsageResponse.study_guides:type_name -> usercontent.v1.Quota
+	9,  // 4: usercontent.v1.GetUsageResponse.topics:type_name -> usercontent.v1.Quota
+	9,  // 5: usercontent.v1.GetUsageResponse.questions:type_name -> usercontent.v1.Quota
+	0,  // 6: usercontent.v1.UserContentService.CreateTag:input_type -> usercontent.v1.CreateTagRequest
+	1,  // 7: usercontent.v1.UserContentService.UpdateTag:input_type -> usercontent.v1.UpdateTagRequest
+	2,  // 8: usercontent.v1.UserContentService.DeleteTag:input_type -> usercontent.v1.DeleteTagRequest
+	5,  // 9: usercontent.v1.UserContentService.CreateQuestion:input_type -> usercontent.v1.CreateQuestionRequest
+	6,  // 10: usercontent.v1.UserContentService.UpdateQuestion:input_type -> usercontent.v1.UpdateQuestionRequest
+	7,  // 11: usercontent.v1.UserContentService.DeleteQuestion:input_type -> usercontent.v1.DeleteQuestionRequest
+	10, // 12: usercontent.v1.UserContentService.GetUsage:input_type -> usercontent.v1.GetUsageRequest
+	13, // 13: usercontent.v1.UserContentService.CreateTag:output_type -> shared.v1.Tag
+	13, // 14: usercontent.v1.UserContentService.UpdateTag:output_type -> shared.v1.Tag
+	3,  // 15: usercontent.v1.UserContentService.DeleteTag:output_type -> usercontent.v1.DeleteTagResponse
+	14, // 16: usercontent.v1.UserContentService.CreateQuestion:output_type -> shared.v1.Question
+	14, // 17: usercontent.v1.UserContentService.UpdateQuestion:output_type -> shared.v1.Question
+	8,  // 18: usercontent.v1.UserContentService.DeleteQuestion:output_type -> usercontent.v1.DeleteQuestionResponse
+	11, // 19: usercontent.v1.UserContentService.GetUsage:output_type -> usercontent.v1.GetUsageResponse
+	13, // [13:20] is the sub-list for method output_type
+	6,  // [6:13] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_v1_usercontent_usercontent_proto_init() }
+func file_v1_usercontent_usercontent_proto_init() {
+	if File_v1_usercontent_usercontent_proto != nil {
+		return
+	}
+	file_v1_usercontent_usercontent_proto_msgTypes[0].OneofWrappers = []any{}
+	file_v1_usercontent_usercontent_proto_msgTypes[1].OneofWrappers = []any{}
+	file_v1_usercontent_usercontent_proto_msgTypes[5].OneofWrappers = []any{}
+	file_v1_usercontent_usercontent_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_usercontent_usercontent_proto_rawDesc), len(file_v1_usercontent_usercontent_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_usercontent_usercontent_proto_goTypes,
+		DependencyIndexes: file_v1_usercontent_usercontent_proto_depIdxs,
+		MessageInfos:      file_v1_usercontent_usercontent_proto_msgTypes,
+	}.Build()
+	File_v1_usercontent_usercontent_proto = out.File
+	file_v1_usercontent_usercontent_proto_goTypes = nil
+	file_v1_usercontent_usercontent_proto_depIdxs = nil
+}
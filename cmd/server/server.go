@@ -8,15 +8,27 @@ import (
 	"strings"
 
 	adminpb "github.com/studyguides-com/study-guides-api/api/v1/admin"
+	announcementpb "github.com/studyguides-com/study-guides-api/api/v1/announcement"
+	articlepb "github.com/studyguides-com/study-guides-api/api/v1/article"
 	chatpb "github.com/studyguides-com/study-guides-api/api/v1/chat"
+	contactpb "github.com/studyguides-com/study-guides-api/api/v1/contact"
 	devopspb "github.com/studyguides-com/study-guides-api/api/v1/devops"
+	faqpb "github.com/studyguides-com/study-guides-api/api/v1/faq"
 	healthpb "github.com/studyguides-com/study-guides-api/api/v1/health"
 	indexingpb "github.com/studyguides-com/study-guides-api/api/v1/indexing"
+	moderationpb "github.com/studyguides-com/study-guides-api/api/v1/moderation"
+	notificationpb "github.com/studyguides-com/study-guides-api/api/v1/notification"
+	promptpb "github.com/studyguides-com/study-guides-api/api/v1/prompt"
+	qualitypb "github.com/studyguides-com/study-guides-api/api/v1/quality"
 	questionpb "github.com/studyguides-com/study-guides-api/api/v1/question"
+	rolandpb "github.com/studyguides-com/study-guides-api/api/v1/roland"
 	searchpb "github.com/studyguides-com/study-guides-api/api/v1/search"
+	suggestionpb "github.com/studyguides-com/study-guides-api/api/v1/suggestion"
 	tagpb "github.com/studyguides-com/study-guides-api/api/v1/tag"
 	userpb "github.com/studyguides-com/study-guides-api/api/v1/user"
+	usercontentpb "github.com/studyguides-com/study-guides-api/api/v1/usercontent"
 	"github.com/studyguides-com/study-guides-api/internal/lib/ai"
+	"github.com/studyguides-com/study-guides-api/internal/lib/prompts"
 	"github.com/studyguides-com/study-guides-api/internal/lib/webrouter"
 	"github.com/studyguides-com/study-guides-api/internal/store"
 
@@ -54,6 +66,14 @@ func (s *Server) Start(appStore store.Store) {
 				parseEnvAsRate("RATE_LIMIT_USER_PER_SECOND", 1.0),
 				parseEnvAsInt("RATE_LIMIT_USER_BURST", 5),
 			),
+			middleware.MethodRateLimitUnaryInterceptor(
+				parseEnvAsRate("RATE_LIMIT_CONTACT_PER_SECOND", 0.01),
+				parseEnvAsInt("RATE_LIMIT_CONTACT_BURST", 3),
+				parseEnvAsInt("TRUSTED_PROXY_HOPS", 1),
+				[]string{contactpb.ContactService_SubmitContact_FullMethodName},
+			),
+			middleware.EntitlementUnaryInterceptor(appStore.EntitlementStore()),
+			middleware.AuditUnaryInterceptor(appStore.AuditStore(), auditedMethods),
 		),
 	)
 
@@ -104,6 +124,54 @@ func (s *Server) Start(appStore store.Store) {
 	}
 }
 
+// auditedMethods are the destructive and content-changing RPCs recorded in the audit log
+var auditedMethods = []string{
+	adminpb.AdminService_KillUser_FullMethodName,
+	adminpb.AdminService_KillTree_FullMethodName,
+	adminpb.AdminService_ImportGuide_FullMethodName,
+	adminpb.AdminService_RestoreTree_FullMethodName,
+	adminpb.AdminService_PurgeTrash_FullMethodName,
+	adminpb.AdminService_BulkUpdateContent_FullMethodName,
+	adminpb.AdminService_BulkUpdateMetadata_FullMethodName,
+	announcementpb.AnnouncementService_CreateAnnouncement_FullMethodName,
+	announcementpb.AnnouncementService_ActivateAnnouncement_FullMethodName,
+	announcementpb.AnnouncementService_DeactivateAnnouncement_FullMethodName,
+	articlepb.ArticleService_CreateArticle_FullMethodName,
+	articlepb.ArticleService_UpdateArticle_FullMethodName,
+	articlepb.ArticleService_PublishArticle_FullMethodName,
+	articlepb.ArticleService_UnpublishArticle_FullMethodName,
+	articlepb.ArticleService_DeleteArticle_FullMethodName,
+	contactpb.ContactService_AssignContact_FullMethodName,
+	contactpb.ContactService_ReplyToContact_FullMethodName,
+	contactpb.ContactService_ResolveContact_FullMethodName,
+	contactpb.ContactService_CloseContact_FullMethodName,
+	devopspb.DevopsService_Deploy_FullMethodName,
+	devopspb.DevopsService_Rollback_FullMethodName,
+	faqpb.FaqService_CreateFaq_FullMethodName,
+	faqpb.FaqService_UpdateFaq_FullMethodName,
+	faqpb.FaqService_DeleteFaq_FullMethodName,
+	indexingpb.IndexingService_TriggerIndexing_FullMethodName,
+	indexingpb.IndexingService_TriggerTagIndexing_FullMethodName,
+	indexingpb.IndexingService_TriggerSingleIndexing_FullMethodName,
+	indexingpb.IndexingService_PruneIndex_FullMethodName,
+	moderationpb.ModerationService_ResolveReports_FullMethodName,
+	promptpb.PromptService_CreatePrompt_FullMethodName,
+	promptpb.PromptService_AddPromptVersion_FullMethodName,
+	promptpb.PromptService_ActivatePromptVersion_FullMethodName,
+	promptpb.PromptService_RollbackPrompt_FullMethodName,
+	rolandpb.RolandService_DeleteAllBundles_FullMethodName,
+	rolandpb.RolandService_DeleteBundleByID_FullMethodName,
+	rolandpb.RolandService_DeleteBundlesByShortID_FullMethodName,
+	rolandpb.RolandService_PromoteBundle_FullMethodName,
+	suggestionpb.SuggestionService_StartDistractorJob_FullMethodName,
+	suggestionpb.SuggestionService_StartLearnMoreJob_FullMethodName,
+	suggestionpb.SuggestionService_StartContentRatingJob_FullMethodName,
+	suggestionpb.SuggestionService_ReviewSuggestion_FullMethodName,
+	tagpb.TagService_RevokeTagAccess_FullMethodName,
+	tagpb.TagService_UpdateTagAccess_FullMethodName,
+	usercontentpb.UserContentService_DeleteTag_FullMethodName,
+}
+
 // registerServices registers all gRPC services
 func (s *Server) registerServices(appStore store.Store) {
 	// Register Health Service
@@ -121,13 +189,45 @@ func (s *Server) registerServices(appStore store.Store) {
 	// Register Question Service
 	questionpb.RegisterQuestionServiceServer(s.grpcServer, services.NewQuestionService(appStore))
 
+	// Register User Content Service
+	usercontentpb.RegisterUserContentServiceServer(s.grpcServer, services.NewUserContentService(appStore))
+
+	// Register Announcement Service
+	announcementpb.RegisterAnnouncementServiceServer(s.grpcServer, services.NewAnnouncementService(appStore))
+
+	// Register FAQ Service
+	faqpb.RegisterFaqServiceServer(s.grpcServer, services.NewFaqService(appStore))
+
+	// Register Article Service, published articles are also served as pages by the web router
+	articlepb.RegisterArticleServiceServer(s.grpcServer, services.NewArticleService(appStore))
+
+	// Register Contact Service, submissions notify the Slack bot through the Notification Service
+	contactpb.RegisterContactServiceServer(s.grpcServer, services.NewContactService(appStore))
+	notificationpb.RegisterNotificationServiceServer(s.grpcServer, services.NewNotificationService(appStore))
+
 	// Register Chat Service with MCP system
 	ai := ai.NewClient(os.Getenv("OPENAI_API_KEY"), os.Getenv("OPENAI_MODEL"))
-	chatpb.RegisterChatServiceServer(s.grpcServer, services.NewChatService(appStore, ai))
+	promptRegistry := prompts.NewRegistry(appStore.PromptStore())
+	chatpb.RegisterChatServiceServer(s.grpcServer, services.NewChatService(appStore, ai, promptRegistry))
 
 	// Register Admin Service
 	adminpb.RegisterAdminServiceServer(s.grpcServer, services.NewAdminService(appStore))
 
+	// Register Moderation Service
+	moderationpb.RegisterModerationServiceServer(s.grpcServer, services.NewModerationService(appStore))
+
+	// Register Quality Service
+	qualitypb.RegisterQualityServiceServer(s.grpcServer, services.NewQualityService(appStore))
+
+	// Register Suggestion Service, AI generated content goes through review before it is applied
+	suggestionpb.RegisterSuggestionServiceServer(s.grpcServer, services.NewSuggestionService(appStore, ai, promptRegistry))
+
+	// Register Prompt Service, the registry every AI caller loads its system prompt from
+	promptpb.RegisterPromptServiceServer(s.grpcServer, services.NewPromptService(appStore, promptRegistry))
+
+	// Register Roland Service
+	rolandpb.RegisterRolandServiceServer(s.grpcServer, services.NewRolandService(appStore))
+
 	// Register Devops Service
 	devopspb.RegisterDevopsServiceServer(s.grpcServer, services.NewDevopsService(appStore))
 
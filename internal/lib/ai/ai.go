@@ -18,6 +18,9 @@ type AiClient interface {
 
 	// ChatCompletionWithHistory generates a chat completion with conversation history
 	ChatCompletionWithHistory(ctx context.Context, systemPrompt string, messages []openai.ChatCompletionMessage, tools []openai.Tool, toolChoice *openai.ToolChoice) (string, error)
+
+	// Model returns the name of the model completions are generated with
+	Model() string
 }
 
 type OpenAiClient struct {
@@ -34,6 +37,11 @@ func NewClient(apiKey string, model string) *OpenAiClient {
 	}
 }
 
+// Model returns the configured OpenAI model
+func (c *OpenAiClient) Model() string {
+	return c.model
+}
+
 // ChatCompletion generates a chat completion using the OpenAI API
 func (c *OpenAiClient) ChatCompletion(ctx context.Context, systemPrompt, userPrompt string) (string, error) {
 	// Validate prompts
@@ -0,0 +1,32 @@
+// Package aijobs generates catalog content with the AI client and queues it for review.
+package aijobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/studyguides-com/study-guides-api/internal/lib/prompts"
+)
+
+// PromptSource resolves system prompts from the prompt registry and records which version each call used
+type PromptSource interface {
+	Prompt(ctx context.Context, promptType, fallback string) *prompts.Resolved
+	RecordUsage(ctx context.Context, resolved *prompts.Resolved, model, caller string, jobID *string)
+}
+
+// parseJSONResponse decodes a JSON object from a model reply, tolerating code fences and surrounding prose
+func parseJSONResponse(reply string, v interface{}) error {
+	start := strings.Index(reply, "{")
+	end := strings.LastIndex(reply, "}")
+	if start < 0 || end < start {
+		return fmt.Errorf("no JSON object in reply")
+	}
+	return json.Unmarshal([]byte(reply[start:end+1]), v)
+}
+
+// sameText compares answers the way a student would read them
+func sameText(a, b string) bool {
+	return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+}
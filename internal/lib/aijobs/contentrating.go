@@ -0,0 +1,289 @@
+package aijobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	"github.com/studyguides-com/study-guides-api/internal/lib/ai"
+	"github.com/studyguides-com/study-guides-api/internal/store/admin"
+	promptstore "github.com/studyguides-com/study-guides-api/internal/store/prompt"
+	"github.com/studyguides-com/study-guides-api/internal/store/suggestion"
+)
+
+// DefaultAutoApplyThreshold is the confidence at or above which a rating is applied without review
+const DefaultAutoApplyThreshold = 0.9
+
+// sampleQuestions is how many questions from a topic the classifier sees
+const sampleQuestions = 15
+
+// defaultContentRatingPrompt is used until a ContentRating prompt is stored in the registry
+const defaultContentRatingPrompt = `You rate study topics for age appropriateness the way an app store rates content.
+Judge the topic from its name, description and sample questions. Academic treatment of a subject is not mature content
+on its own; rate by what a student will actually read. Meta tags are short lowercase keywords that help students find the topic.
+Be conservative with confidence: use 0.9 or above only when the rating is obvious.`
+
+// contentRatingFormat is appended to the stored prompt so replies always parse, whatever the prompt says
+const contentRatingFormat = `Respond with a JSON object:
+{"contentRating": "...", "contentRatingConfidence": 0.0,
+ "contentDescriptors": [{"value": "...", "confidence": 0.0}],
+ "metaTags": [{"value": "...", "confidence": 0.0}]}
+Confidences are between 0 and 1. contentRating is one of: %s.
+contentDescriptors are chosen from: %s. Return every descriptor and meta tag that applies, including current ones.`
+
+// ContentRatingStore is the part of the suggestion store the classifier needs
+type ContentRatingStore interface {
+	ContentRatingCandidates(ctx context.Context, sampleSize int, limit int) ([]*suggestion.ContentRatingCandidate, error)
+	CreateSuggestion(ctx context.Context, suggestion *suggestion.Suggestion) error
+}
+
+// ContentWriter saves rating data on tags
+type ContentWriter interface {
+	BulkUpdateContent(ctx context.Context, update *admin.ContentUpdate) ([]string, error)
+}
+
+// ScoredValue is a proposed value with the model's confidence in it
+type ScoredValue struct {
+	Value      string  `json:"value"`
+	Confidence float64 `json:"confidence"`
+}
+
+// ContentRatingPayload is the suggestion payload for KindContentRating
+type ContentRatingPayload struct {
+	ContentRating           string        `json:"contentRating"`
+	ContentRatingConfidence float64       `json:"contentRatingConfidence"`
+	ContentDescriptors      []ScoredValue `json:"contentDescriptors"`
+	MetaTags                []ScoredValue `json:"metaTags"`
+}
+
+// minConfidence is the weakest confidence in the proposal, the whole proposal is only as sure as its least sure part
+func (p *ContentRatingPayload) minConfidence() float64 {
+	confidence := p.ContentRatingConfidence
+	for _, v := range append(append([]ScoredValue{}, p.ContentDescriptors...), p.MetaTags...) {
+		if v.Confidence < confidence {
+			confidence = v.Confidence
+		}
+	}
+	return confidence
+}
+
+// update converts the proposal into a tag update
+func (p *ContentRatingPayload) update(tagID string) *admin.ContentUpdate {
+	rating := p.ContentRating
+	update := &admin.ContentUpdate{
+		TagIDs:                []string{tagID},
+		ContentRating:         &rating,
+		ContentDescriptors:    []string{},
+		SetContentDescriptors: true,
+		MetaTags:              []string{},
+		SetMetaTags:           true,
+	}
+	for _, d := range p.ContentDescriptors {
+		update.ContentDescriptors = append(update.ContentDescriptors, d.Value)
+	}
+	for _, m := range p.MetaTags {
+		update.MetaTags = append(update.MetaTags, m.Value)
+	}
+	return update
+}
+
+// ContentRatingContext is what the model was shown, kept on the suggestion for reviewers
+type ContentRatingContext struct {
+	Name            string   `json:"name"`
+	Description     string   `json:"description,omitempty"`
+	SampleQuestions []string `json:"sampleQuestions,omitempty"`
+}
+
+type ContentRatingClassifier struct {
+	ai        ai.AiClient
+	store     ContentRatingStore
+	content   ContentWriter
+	prompts   PromptSource
+	threshold float64
+}
+
+// NewContentRatingClassifier creates a classifier that applies proposals at or above threshold.
+// A threshold above 1 sends everything to review.
+func NewContentRatingClassifier(ai ai.AiClient, store ContentRatingStore, content ContentWriter, prompts PromptSource, threshold float64) *ContentRatingClassifier {
+	return &ContentRatingClassifier{
+		ai:        ai,
+		store:     store,
+		content:   content,
+		prompts:   prompts,
+		threshold: threshold,
+	}
+}
+
+// Run classifies up to limit RatingPending topics. Confident proposals are applied and recorded as
+// approved suggestions, the rest are queued for review.
+func (c *ContentRatingClassifier) Run(ctx context.Context, jobID string, limit int) (*RunResult, error) {
+	candidates, err := c.store.ContentRatingCandidates(ctx, sampleQuestions, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	prompt := c.prompts.Prompt(ctx, promptstore.TypeContentRating, defaultContentRatingPrompt)
+	model := c.ai.Model()
+	result := &RunResult{Candidates: len(candidates)}
+	for _, candidate := range candidates {
+		proposal, err := c.classify(ctx, prompt.Text, candidate)
+		c.prompts.RecordUsage(ctx, prompt, model, suggestion.JobTypeContentRating, &jobID)
+		if err != nil {
+			log.Printf("Content rating failed for tag %s: %v", candidate.TagID, err)
+			result.Failed++
+			continue
+		}
+
+		payload, err := json.Marshal(proposal)
+		if err != nil {
+			return nil, err
+		}
+		grounding := ContentRatingContext{
+			Name:            candidate.Name,
+			Description:     deref(candidate.Description),
+			SampleQuestions: candidate.SampleQuestions,
+		}
+		contextJSON, err := json.Marshal(grounding)
+		if err != nil {
+			return nil, err
+		}
+
+		confidence := proposal.minConfidence()
+		autoApply := confidence >= c.threshold
+		if autoApply {
+			if _, err := c.content.BulkUpdateContent(ctx, proposal.update(candidate.TagID)); err != nil {
+				return nil, err
+			}
+		}
+
+		err = c.store.CreateSuggestion(ctx, &suggestion.Suggestion{
+			Kind:          suggestion.KindContentRating,
+			TargetType:    suggestion.TargetTag,
+			TargetID:      candidate.TagID,
+			JobID:         &jobID,
+			Payload:       payload,
+			Context:       contextJSON,
+			Model:         &model,
+			PromptID:      prompt.PromptID,
+			PromptVersion: &prompt.Version,
+			Confidence:    &confidence,
+			AutoApplied:   autoApply,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if autoApply {
+			result.AutoApplied++
+		} else {
+			result.Suggested++
+		}
+	}
+	return result, nil
+}
+
+// classify asks the model for a rating and keeps only values the catalog accepts
+func (c *ContentRatingClassifier) classify(ctx context.Context, systemPrompt string, candidate *suggestion.ContentRatingCandidate) (*ContentRatingPayload, error) {
+	var prompt strings.Builder
+	fmt.Fprintf(&prompt, "Topic: %s\n", candidate.Name)
+	if candidate.Description != nil && *candidate.Description != "" {
+		fmt.Fprintf(&prompt, "Description: %s\n", *candidate.Description)
+	}
+	if len(candidate.ContentDescriptors) > 0 {
+		fmt.Fprintf(&prompt, "Current content descriptors: %s\n", strings.Join(candidate.ContentDescriptors, ", "))
+	}
+	if len(candidate.MetaTags) > 0 {
+		fmt.Fprintf(&prompt, "Current meta tags: %s\n", strings.Join(candidate.MetaTags, ", "))
+	}
+	if len(candidate.SampleQuestions) > 0 {
+		prompt.WriteString("Sample questions:\n")
+		for _, q := range candidate.SampleQuestions {
+			fmt.Fprintf(&prompt, "- %s\n", q)
+		}
+	}
+	fmt.Fprintf(&prompt, contentRatingFormat, strings.Join(ratingNames(), ", "), strings.Join(descriptorNames(), ", "))
+
+	reply, err := c.ai.ChatCompletion(ctx, systemPrompt, prompt.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed ContentRatingPayload
+	if err := parseJSONResponse(reply, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse reply: %w", err)
+	}
+	return normalizeContentRating(&parsed)
+}
+
+// normalizeContentRating validates a proposal and drops unknown descriptors and duplicate or blank values
+func normalizeContentRating(p *ContentRatingPayload) (*ContentRatingPayload, error) {
+	if !isAssignableRating(p.ContentRating) {
+		return nil, fmt.Errorf("invalid content rating %q", p.ContentRating)
+	}
+
+	normalized := &ContentRatingPayload{
+		ContentRating:           p.ContentRating,
+		ContentRatingConfidence: p.ContentRatingConfidence,
+		ContentDescriptors:      []ScoredValue{},
+		MetaTags:                []ScoredValue{},
+	}
+	var seen []string
+	for _, d := range p.ContentDescriptors {
+		value, ok := sharedpb.ContentDescriptorType_value[d.Value]
+		if !ok || value == int32(sharedpb.ContentDescriptorType_CONTENT_DESCRIPTOR_TYPE_UNSPECIFIED) || containsText(seen, d.Value) {
+			continue
+		}
+		seen = append(seen, d.Value)
+		normalized.ContentDescriptors = append(normalized.ContentDescriptors, d)
+	}
+	seen = nil
+	for _, m := range p.MetaTags {
+		m.Value = strings.TrimSpace(m.Value)
+		if m.Value == "" || containsText(seen, m.Value) {
+			continue
+		}
+		seen = append(seen, m.Value)
+		normalized.MetaTags = append(normalized.MetaTags, m)
+	}
+	return normalized, nil
+}
+
+// ApplyContentRating writes the rating from an approved suggestion to its topic
+func ApplyContentRating(ctx context.Context, content ContentWriter, s *suggestion.Suggestion) error {
+	var payload ContentRatingPayload
+	if err := json.Unmarshal(s.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid content rating payload: %w", err)
+	}
+	normalized, err := normalizeContentRating(&payload)
+	if err != nil {
+		return err
+	}
+	_, err = content.BulkUpdateContent(ctx, normalized.update(s.TargetID))
+	return err
+}
+
+// isAssignableRating reports whether a rating name can be set on a topic
+func isAssignableRating(rating string) bool {
+	value, ok := sharedpb.ContentRating_value[rating]
+	return ok && value != int32(sharedpb.ContentRating_Unspecified) && value != int32(sharedpb.ContentRating_RatingPending)
+}
+
+func ratingNames() []string {
+	var names []string
+	for i := int32(0); i < int32(len(sharedpb.ContentRating_name)); i++ {
+		if name := sharedpb.ContentRating_name[i]; isAssignableRating(name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func descriptorNames() []string {
+	var names []string
+	for i := int32(1); i < int32(len(sharedpb.ContentDescriptorType_name)); i++ {
+		names = append(names, sharedpb.ContentDescriptorType_name[i])
+	}
+	return names
+}
@@ -0,0 +1,198 @@
+package aijobs
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/studyguides-com/study-guides-api/internal/store/admin"
+	"github.com/studyguides-com/study-guides-api/internal/store/suggestion"
+)
+
+type fakeContentRatingStore struct {
+	candidates  []*suggestion.ContentRatingCandidate
+	suggestions []*suggestion.Suggestion
+}
+
+func (f *fakeContentRatingStore) ContentRatingCandidates(ctx context.Context, sampleSize int, limit int) ([]*suggestion.ContentRatingCandidate, error) {
+	return f.candidates, nil
+}
+
+func (f *fakeContentRatingStore) CreateSuggestion(ctx context.Context, s *suggestion.Suggestion) error {
+	f.suggestions = append(f.suggestions, s)
+	return nil
+}
+
+type fakeContentWriter struct {
+	updates []*admin.ContentUpdate
+}
+
+func (f *fakeContentWriter) BulkUpdateContent(ctx context.Context, update *admin.ContentUpdate) ([]string, error) {
+	f.updates = append(f.updates, update)
+	return update.TagIDs, nil
+}
+
+func TestContentRatingClassifierRun(t *testing.T) {
+	store := &fakeContentRatingStore{
+		candidates: []*suggestion.ContentRatingCandidate{
+			{TagID: "t1", Name: "Fractions", SampleQuestions: []string{"What is 1/2 + 1/4?"}},
+			{TagID: "t2", Name: "Prohibition"},
+			{TagID: "t3", Name: "Anatomy"},
+		},
+	}
+	client := &fakeAiClient{replies: map[string]string{
+		"Fractions": `{"contentRating": "Everyone", "contentRatingConfidence": 0.97,
+			"contentDescriptors": [], "metaTags": [{"value": "math", "confidence": 0.95}]}`,
+		// One unsure meta tag sends the whole proposal to review
+		"Prohibition": "```json\n" + `{"contentRating": "Teen", "contentRatingConfidence": 0.95,
+			"contentDescriptors": [{"value": "CONTENT_DESCRIPTOR_TYPE_ALCOHOL_REFERENCE", "confidence": 0.92}],
+			"metaTags": [{"value": "history", "confidence": 0.5}]}` + "\n```",
+		"Anatomy": `{"contentRating": "PG", "contentRatingConfidence": 0.99}`,
+	}}
+	content := &fakeContentWriter{}
+	promptSource := &fakePromptSource{}
+
+	result, err := NewContentRatingClassifier(client, store, content, promptSource, DefaultAutoApplyThreshold).Run(context.Background(), "job1", 10)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if result.Candidates != 3 || result.AutoApplied != 1 || result.Suggested != 1 || result.Failed != 1 {
+		t.Errorf("Run() = %+v, want 3 candidates, 1 auto-applied, 1 suggested, 1 failed", result)
+	}
+	if promptSource.recorded != 3 {
+		t.Errorf("recorded %d prompt usages, want one per model call", promptSource.recorded)
+	}
+
+	if len(content.updates) != 1 {
+		t.Fatalf("got %d content updates, want 1", len(content.updates))
+	}
+	update := content.updates[0]
+	if !reflect.DeepEqual(update.TagIDs, []string{"t1"}) || update.ContentRating == nil || *update.ContentRating != "Everyone" {
+		t.Errorf("unexpected update %+v", update)
+	}
+	if !update.SetContentDescriptors || len(update.ContentDescriptors) != 0 {
+		t.Errorf("update descriptors = %v, set %v, want cleared", update.ContentDescriptors, update.SetContentDescriptors)
+	}
+	if !update.SetMetaTags || !reflect.DeepEqual(update.MetaTags, []string{"math"}) {
+		t.Errorf("update meta tags = %v, set %v", update.MetaTags, update.SetMetaTags)
+	}
+
+	if len(store.suggestions) != 2 {
+		t.Fatalf("got %d suggestions, want 2", len(store.suggestions))
+	}
+	applied, queued := store.suggestions[0], store.suggestions[1]
+	if applied.TargetID != "t1" || !applied.AutoApplied || applied.Confidence == nil || *applied.Confidence != 0.95 {
+		t.Errorf("unexpected auto-applied suggestion %+v", applied)
+	}
+	if queued.TargetID != "t2" || queued.AutoApplied || queued.Confidence == nil || *queued.Confidence != 0.5 {
+		t.Errorf("unexpected queued suggestion %+v", queued)
+	}
+	if queued.Kind != suggestion.KindContentRating || queued.TargetType != suggestion.TargetTag {
+		t.Errorf("queued suggestion kind %s target %s", queued.Kind, queued.TargetType)
+	}
+}
+
+func TestNormalizeContentRating(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload ContentRatingPayload
+		want    *ContentRatingPayload
+		wantErr bool
+	}{
+		{
+			name: "drops unknown and duplicate values",
+			payload: ContentRatingPayload{
+				ContentRating:           "Teen",
+				ContentRatingConfidence: 0.8,
+				ContentDescriptors: []ScoredValue{
+					{Value: "CONTENT_DESCRIPTOR_TYPE_LANGUAGE", Confidence: 0.9},
+					{Value: "CONTENT_DESCRIPTOR_TYPE_UNSPECIFIED", Confidence: 0.9},
+					{Value: "VIOLENCE", Confidence: 0.9},
+					{Value: "CONTENT_DESCRIPTOR_TYPE_LANGUAGE", Confidence: 0.4},
+				},
+				MetaTags: []ScoredValue{
+					{Value: " history ", Confidence: 0.9},
+					{Value: "", Confidence: 0.9},
+					{Value: "History", Confidence: 0.3},
+				},
+			},
+			want: &ContentRatingPayload{
+				ContentRating:           "Teen",
+				ContentRatingConfidence: 0.8,
+				ContentDescriptors:      []ScoredValue{{Value: "CONTENT_DESCRIPTOR_TYPE_LANGUAGE", Confidence: 0.9}},
+				MetaTags:                []ScoredValue{{Value: "history", Confidence: 0.9}},
+			},
+		},
+		{
+			name:    "rejects an unknown rating",
+			payload: ContentRatingPayload{ContentRating: "PG"},
+			wantErr: true,
+		},
+		{
+			name:    "rejects the pending rating",
+			payload: ContentRatingPayload{ContentRating: "RatingPending"},
+			wantErr: true,
+		},
+		{
+			name:    "rejects the unspecified rating",
+			payload: ContentRatingPayload{ContentRating: "Unspecified"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeContentRating(&tt.payload)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("normalizeContentRating() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("normalizeContentRating() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyContentRating(t *testing.T) {
+	content := &fakeContentWriter{}
+	s := &suggestion.Suggestion{
+		TargetID: "t1",
+		Payload: mustJSON(t, ContentRatingPayload{
+			ContentRating:      "Mature",
+			ContentDescriptors: []ScoredValue{{Value: "CONTENT_DESCRIPTOR_TYPE_DRUG_REFERENCE"}, {Value: "BOGUS"}},
+			MetaTags:           []ScoredValue{{Value: "pharmacology"}},
+		}),
+	}
+
+	if err := ApplyContentRating(context.Background(), content, s); err != nil {
+		t.Fatalf("ApplyContentRating() error = %v", err)
+	}
+	if len(content.updates) != 1 {
+		t.Fatalf("got %d content updates, want 1", len(content.updates))
+	}
+	update := content.updates[0]
+	if *update.ContentRating != "Mature" || !reflect.DeepEqual(update.ContentDescriptors, []string{"CONTENT_DESCRIPTOR_TYPE_DRUG_REFERENCE"}) ||
+		!reflect.DeepEqual(update.MetaTags, []string{"pharmacology"}) {
+		t.Errorf("unexpected update %+v", update)
+	}
+
+	// An edited payload with a rating the catalog rejects is not applied
+	s.Payload = []byte(`{"contentRating": "RatingPending"}`)
+	if err := ApplyContentRating(context.Background(), content, s); err == nil {
+		t.Error("ApplyContentRating() with a pending rating succeeded")
+	}
+	if len(content.updates) != 1 {
+		t.Errorf("rejected payload was applied")
+	}
+}
+
+func mustJSON(t *testing.T, v interface{}) []byte {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	return data
+}
@@ -0,0 +1,223 @@
+package aijobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	"github.com/studyguides-com/study-guides-api/internal/lib/ai"
+	promptstore "github.com/studyguides-com/study-guides-api/internal/store/prompt"
+	"github.com/studyguides-com/study-guides-api/internal/store/suggestion"
+)
+
+// MinDistractors is how many distractors multiple-choice mode needs
+const MinDistractors = 3
+
+// defaultDistractorPrompt is used until a Distractors prompt is stored in the registry
+const defaultDistractorPrompt = `You write distractors, plausible but wrong answers, for multiple-choice study questions.
+Distractors must be clearly incorrect, match the style and length of the correct answer, and must not repeat each other.
+Prefer answers from the same topic when they fit. Respond with a JSON object: {"distractors": ["...", "..."]}`
+
+// DistractorStore is the part of the suggestion store the distractor job needs
+type DistractorStore interface {
+	DistractorCandidates(ctx context.Context, minDistractors int, limit int) ([]*suggestion.DistractorCandidate, error)
+	CreateSuggestion(ctx context.Context, suggestion *suggestion.Suggestion) error
+}
+
+// QuestionWriter loads a question and changes single columns when an approved suggestion is applied
+type QuestionWriter interface {
+	Question(ctx context.Context, id string) (*sharedpb.Question, error)
+	UpdateDistractors(ctx context.Context, id string, distractors []string) error
+	UpdateLearnMore(ctx context.Context, id, learnMore string) error
+}
+
+// DistractorPayload is the suggestion payload for KindDistractors
+type DistractorPayload struct {
+	Distractors []string `json:"distractors"`
+}
+
+// DistractorContext is what the model was shown, kept on the suggestion for reviewers
+type DistractorContext struct {
+	Existing       []string `json:"existing"`
+	TopicName      string   `json:"topicName,omitempty"`
+	SiblingAnswers []string `json:"siblingAnswers,omitempty"`
+}
+
+// RunResult summarizes a generation run
+type RunResult struct {
+	Candidates  int
+	Suggested   int
+	AutoApplied int
+	Failed      int
+}
+
+// Results converts a run summary into job metadata
+func (r *RunResult) Results() map[string]interface{} {
+	return map[string]interface{}{
+		"candidates":  r.Candidates,
+		"suggested":   r.Suggested,
+		"autoApplied": r.AutoApplied,
+		"failed":      r.Failed,
+	}
+}
+
+type DistractorGenerator struct {
+	ai      ai.AiClient
+	store   DistractorStore
+	prompts PromptSource
+}
+
+func NewDistractorGenerator(ai ai.AiClient, store DistractorStore, prompts PromptSource) *DistractorGenerator {
+	return &DistractorGenerator{
+		ai:      ai,
+		store:   store,
+		prompts: prompts,
+	}
+}
+
+// Run queues distractor suggestions for up to limit questions. A question the model fails on is
+// counted and skipped, only store errors stop the run.
+func (g *DistractorGenerator) Run(ctx context.Context, jobID string, limit int) (*RunResult, error) {
+	candidates, err := g.store.DistractorCandidates(ctx, MinDistractors, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	prompt := g.prompts.Prompt(ctx, promptstore.TypeDistractors, defaultDistractorPrompt)
+	model := g.ai.Model()
+	result := &RunResult{Candidates: len(candidates)}
+	for _, candidate := range candidates {
+		distractors, err := g.generate(ctx, prompt.Text, candidate)
+		g.prompts.RecordUsage(ctx, prompt, model, suggestion.JobTypeDistractors, &jobID)
+		if err != nil {
+			log.Printf("Distractor generation failed for question %s: %v", candidate.QuestionID, err)
+			result.Failed++
+			continue
+		}
+
+		payload, err := json.Marshal(DistractorPayload{Distractors: distractors})
+		if err != nil {
+			return nil, err
+		}
+		grounding := DistractorContext{
+			Existing:       candidate.Distractors,
+			SiblingAnswers: candidate.SiblingAnswers,
+		}
+		if candidate.TopicName != nil {
+			grounding.TopicName = *candidate.TopicName
+		}
+		contextJSON, err := json.Marshal(grounding)
+		if err != nil {
+			return nil, err
+		}
+
+		err = g.store.CreateSuggestion(ctx, &suggestion.Suggestion{
+			Kind:          suggestion.KindDistractors,
+			TargetType:    suggestion.TargetQuestion,
+			TargetID:      candidate.QuestionID,
+			JobID:         &jobID,
+			Payload:       payload,
+			Context:       contextJSON,
+			Model:         &model,
+			PromptID:      prompt.PromptID,
+			PromptVersion: &prompt.Version,
+		})
+		if err != nil {
+			return nil, err
+		}
+		result.Suggested++
+	}
+	return result, nil
+}
+
+// generate asks the model for the missing distractors and drops any that are unusable
+func (g *DistractorGenerator) generate(ctx context.Context, systemPrompt string, candidate *suggestion.DistractorCandidate) ([]string, error) {
+	needed := MinDistractors - len(candidate.Distractors)
+	if needed < 1 {
+		needed = 1
+	}
+
+	var prompt strings.Builder
+	fmt.Fprintf(&prompt, "Question: %s\nCorrect answer: %s\n", candidate.QuestionText, candidate.AnswerText)
+	if candidate.TopicName != nil {
+		fmt.Fprintf(&prompt, "Topic: %s\n", *candidate.TopicName)
+	}
+	if len(candidate.Distractors) > 0 {
+		fmt.Fprintf(&prompt, "Existing distractors, do not repeat: %s\n", strings.Join(candidate.Distractors, "; "))
+	}
+	if len(candidate.SiblingAnswers) > 0 {
+		fmt.Fprintf(&prompt, "Answers to other questions in this topic: %s\n", strings.Join(candidate.SiblingAnswers, "; "))
+	}
+	fmt.Fprintf(&prompt, "Write %d new distractors.", needed)
+
+	reply, err := g.ai.ChatCompletion(ctx, systemPrompt, prompt.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed DistractorPayload
+	if err := parseJSONResponse(reply, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse reply: %w", err)
+	}
+
+	taken := append([]string{candidate.AnswerText}, candidate.Distractors...)
+	var distractors []string
+	for _, d := range parsed.Distractors {
+		d = strings.TrimSpace(d)
+		if d == "" || containsText(taken, d) {
+			continue
+		}
+		taken = append(taken, d)
+		distractors = append(distractors, d)
+		if len(distractors) == needed {
+			break
+		}
+	}
+	if len(distractors) == 0 {
+		return nil, fmt.Errorf("no usable distractors in reply")
+	}
+	return distractors, nil
+}
+
+// ApplyDistractors adds the distractors from an approved suggestion to its question
+func ApplyDistractors(ctx context.Context, questions QuestionWriter, s *suggestion.Suggestion) error {
+	var payload DistractorPayload
+	if err := json.Unmarshal(s.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid distractor payload: %w", err)
+	}
+
+	question, err := questions.Question(ctx, s.TargetID)
+	if err != nil {
+		return err
+	}
+
+	taken := append([]string{question.AnswerText}, question.Distractors...)
+	distractors := question.Distractors
+	added := false
+	for _, d := range payload.Distractors {
+		d = strings.TrimSpace(d)
+		if d == "" || containsText(taken, d) {
+			continue
+		}
+		taken = append(taken, d)
+		distractors = append(distractors, d)
+		added = true
+	}
+	if !added {
+		return nil
+	}
+
+	return questions.UpdateDistractors(ctx, question.Id, distractors)
+}
+
+func containsText(values []string, text string) bool {
+	for _, v := range values {
+		if sameText(v, text) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+package aijobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sashabaranov/go-openai"
+
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	"github.com/studyguides-com/study-guides-api/internal/lib/prompts"
+	"github.com/studyguides-com/study-guides-api/internal/store/suggestion"
+)
+
+// fakeAiClient answers ChatCompletion from a map keyed by a substring of the user prompt
+type fakeAiClient struct {
+	replies map[string]string
+	prompts []string
+}
+
+func (f *fakeAiClient) ChatCompletion(ctx context.Context, systemPrompt, userPrompt string) (string, error) {
+	f.prompts = append(f.prompts, userPrompt)
+	for key, reply := range f.replies {
+		if strings.Contains(userPrompt, key) {
+			return reply, nil
+		}
+	}
+	return "", errors.New("no reply configured")
+}
+
+func (f *fakeAiClient) ChatCompletionWithTools(ctx context.Context, systemPrompt, userPrompt string, tools []openai.Tool, toolChoice *openai.ToolChoice) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+func (f *fakeAiClient) ChatCompletionWithHistory(ctx context.Context, systemPrompt string, messages []openai.ChatCompletionMessage, tools []openai.Tool, toolChoice *openai.ToolChoice) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+func (f *fakeAiClient) Model() string {
+	return "fake-model"
+}
+
+// fakePromptSource always serves the fallback and counts recorded calls
+type fakePromptSource struct {
+	recorded int
+}
+
+func (f *fakePromptSource) Prompt(ctx context.Context, promptType, fallback string) *prompts.Resolved {
+	return &prompts.Resolved{Type: promptType, Text: fallback}
+}
+
+func (f *fakePromptSource) RecordUsage(ctx context.Context, resolved *prompts.Resolved, model, caller string, jobID *string) {
+	f.recorded++
+}
+
+type fakeDistractorStore struct {
+	candidates  []*suggestion.DistractorCandidate
+	suggestions []*suggestion.Suggestion
+}
+
+func (f *fakeDistractorStore) DistractorCandidates(ctx context.Context, minDistractors int, limit int) ([]*suggestion.DistractorCandidate, error) {
+	return f.candidates, nil
+}
+
+func (f *fakeDistractorStore) CreateSuggestion(ctx context.Context, s *suggestion.Suggestion) error {
+	f.suggestions = append(f.suggestions, s)
+	return nil
+}
+
+type fakeQuestionWriter struct {
+	question    *sharedpb.Question
+	distractors []string
+	learnMore   string
+}
+
+func (f *fakeQuestionWriter) Question(ctx context.Context, id string) (*sharedpb.Question, error) {
+	return f.question, nil
+}
+
+func (f *fakeQuestionWriter) UpdateDistractors(ctx context.Context, id string, distractors []string) error {
+	f.distractors = distractors
+	return nil
+}
+
+func (f *fakeQuestionWriter) UpdateLearnMore(ctx context.Context, id, learnMore string) error {
+	f.learnMore = learnMore
+	return nil
+}
+
+func TestDistractorGeneratorRun(t *testing.T) {
+	topic := "Geography"
+	store := &fakeDistractorStore{
+		candidates: []*suggestion.DistractorCandidate{
+			{
+				QuestionID:     "q1",
+				QuestionText:   "What is the capital of France?",
+				AnswerText:     "Paris",
+				Distractors:    []string{"Lyon"},
+				TopicName:      &topic,
+				SiblingAnswers: []string{"Berlin", "Madrid"},
+			},
+			{
+				QuestionID:   "q2",
+				QuestionText: "What is the capital of Spain?",
+				AnswerText:   "Madrid",
+			},
+		},
+	}
+	client := &fakeAiClient{replies: map[string]string{
+		// Repeats the answer and an existing distractor, both must be dropped
+		"France": "```json\n{\"distractors\": [\"paris\", \"Berlin\", \"LYON\", \"Marseille\", \"Nice\"]}\n```",
+		"Spain":  "not json",
+	}}
+
+	promptSource := &fakePromptSource{}
+
+	result, err := NewDistractorGenerator(client, store, promptSource).Run(context.Background(), "job1", 10)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if result.Candidates != 2 || result.Suggested != 1 || result.Failed != 1 {
+		t.Errorf("Run() = %+v, want 2 candidates, 1 suggested, 1 failed", result)
+	}
+	if promptSource.recorded != 2 {
+		t.Errorf("recorded %d prompt usages, want one per model call", promptSource.recorded)
+	}
+	if !strings.Contains(client.prompts[0], "Berlin; Madrid") {
+		t.Errorf("prompt does not include sibling answers: %q", client.prompts[0])
+	}
+	if len(store.suggestions) != 1 {
+		t.Fatalf("got %d suggestions, want 1", len(store.suggestions))
+	}
+
+	s := store.suggestions[0]
+	if s.Kind != suggestion.KindDistractors || s.TargetID != "q1" || s.JobID == nil || *s.JobID != "job1" {
+		t.Errorf("unexpected suggestion %+v", s)
+	}
+	var payload DistractorPayload
+	if err := json.Unmarshal(s.Payload, &payload); err != nil {
+		t.Fatalf("invalid payload: %v", err)
+	}
+	want := []string{"Berlin", "Marseille"}
+	if !reflect.DeepEqual(payload.Distractors, want) {
+		t.Errorf("payload distractors = %v, want %v", payload.Distractors, want)
+	}
+}
+
+func TestApplyDistractors(t *testing.T) {
+	questions := &fakeQuestionWriter{question: &sharedpb.Question{
+		Id:          "q1",
+		AnswerText:  "Paris",
+		Distractors: []string{"Lyon"},
+	}}
+	s := &suggestion.Suggestion{
+		TargetID: "q1",
+		Payload:  []byte(`{"distractors": ["Berlin", "lyon", "Madrid"]}`),
+	}
+
+	if err := ApplyDistractors(context.Background(), questions, s); err != nil {
+		t.Fatalf("ApplyDistractors() error = %v", err)
+	}
+	want := []string{"Lyon", "Berlin", "Madrid"}
+	if !reflect.DeepEqual(questions.distractors, want) {
+		t.Errorf("saved distractors = %v, want %v", questions.distractors, want)
+	}
+}
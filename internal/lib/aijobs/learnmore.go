@@ -0,0 +1,151 @@
+package aijobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/studyguides-com/study-guides-api/internal/lib/ai"
+	promptstore "github.com/studyguides-com/study-guides-api/internal/store/prompt"
+	"github.com/studyguides-com/study-guides-api/internal/store/suggestion"
+)
+
+// LearnMoreStore is the part of the suggestion store the learnMore job needs
+type LearnMoreStore interface {
+	LearnMoreCandidates(ctx context.Context, limit int) ([]*suggestion.LearnMoreCandidate, error)
+	CreateSuggestion(ctx context.Context, suggestion *suggestion.Suggestion) error
+}
+
+// defaultLearnMorePrompt is used until a LearnMore prompt is stored in the registry
+const defaultLearnMorePrompt = `You write short "learn more" explanations for study questions.
+Explain why the correct answer is right in two to four sentences, using only facts supported by the passage and subject given.
+Write plain text for a student, without restating the question or adding headings.`
+
+// LearnMorePayload is the suggestion payload for KindLearnMore
+type LearnMorePayload struct {
+	LearnMore string `json:"learnMore"`
+}
+
+// LearnMoreContext is the grounding the model was shown, kept on the suggestion for reviewers
+type LearnMoreContext struct {
+	TagPath      string `json:"tagPath,omitempty"`
+	PassageTitle string `json:"passageTitle,omitempty"`
+	PassageBody  string `json:"passageBody,omitempty"`
+}
+
+type LearnMoreGenerator struct {
+	ai      ai.AiClient
+	store   LearnMoreStore
+	prompts PromptSource
+}
+
+func NewLearnMoreGenerator(ai ai.AiClient, store LearnMoreStore, prompts PromptSource) *LearnMoreGenerator {
+	return &LearnMoreGenerator{
+		ai:      ai,
+		store:   store,
+		prompts: prompts,
+	}
+}
+
+// Run queues learnMore drafts for up to limit questions. The system prompt is resolved once so every
+// draft in a run records the same prompt version.
+func (g *LearnMoreGenerator) Run(ctx context.Context, jobID string, limit int) (*RunResult, error) {
+	candidates, err := g.store.LearnMoreCandidates(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	prompt := g.prompts.Prompt(ctx, promptstore.TypeLearnMore, defaultLearnMorePrompt)
+	model := g.ai.Model()
+	result := &RunResult{Candidates: len(candidates)}
+	for _, candidate := range candidates {
+		grounding := LearnMoreContext{
+			TagPath:      deref(candidate.TagPath),
+			PassageTitle: deref(candidate.PassageTitle),
+			PassageBody:  deref(candidate.PassageBody),
+		}
+
+		learnMore, err := g.generate(ctx, prompt.Text, candidate, grounding)
+		g.prompts.RecordUsage(ctx, prompt, model, suggestion.JobTypeLearnMore, &jobID)
+		if err != nil {
+			log.Printf("LearnMore generation failed for question %s: %v", candidate.QuestionID, err)
+			result.Failed++
+			continue
+		}
+
+		payload, err := json.Marshal(LearnMorePayload{LearnMore: learnMore})
+		if err != nil {
+			return nil, err
+		}
+		contextJSON, err := json.Marshal(grounding)
+		if err != nil {
+			return nil, err
+		}
+
+		err = g.store.CreateSuggestion(ctx, &suggestion.Suggestion{
+			Kind:          suggestion.KindLearnMore,
+			TargetType:    suggestion.TargetQuestion,
+			TargetID:      candidate.QuestionID,
+			JobID:         &jobID,
+			Payload:       payload,
+			Context:       contextJSON,
+			Model:         &model,
+			PromptID:      prompt.PromptID,
+			PromptVersion: &prompt.Version,
+		})
+		if err != nil {
+			return nil, err
+		}
+		result.Suggested++
+	}
+	return result, nil
+}
+
+// generate asks the model for an explanation grounded in the question's passage and tag path
+func (g *LearnMoreGenerator) generate(ctx context.Context, systemPrompt string, candidate *suggestion.LearnMoreCandidate, grounding LearnMoreContext) (string, error) {
+	var prompt strings.Builder
+	fmt.Fprintf(&prompt, "Question: %s\nCorrect answer: %s\n", candidate.QuestionText, candidate.AnswerText)
+	if grounding.TagPath != "" {
+		fmt.Fprintf(&prompt, "Subject: %s\n", grounding.TagPath)
+	}
+	if grounding.PassageBody != "" {
+		if grounding.PassageTitle != "" {
+			fmt.Fprintf(&prompt, "Passage title: %s\n", grounding.PassageTitle)
+		}
+		fmt.Fprintf(&prompt, "Passage:\n%s\n", grounding.PassageBody)
+	}
+
+	reply, err := g.ai.ChatCompletion(ctx, systemPrompt, prompt.String())
+	if err != nil {
+		return "", err
+	}
+
+	learnMore := strings.TrimSpace(reply)
+	if learnMore == "" {
+		return "", fmt.Errorf("empty reply")
+	}
+	return learnMore, nil
+}
+
+// ApplyLearnMore writes the explanation from an approved suggestion to its question
+func ApplyLearnMore(ctx context.Context, questions QuestionWriter, s *suggestion.Suggestion) error {
+	var payload LearnMorePayload
+	if err := json.Unmarshal(s.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid learnMore payload: %w", err)
+	}
+	learnMore := strings.TrimSpace(payload.LearnMore)
+	if learnMore == "" {
+		return fmt.Errorf("learnMore payload is empty")
+	}
+
+	return questions.UpdateLearnMore(ctx, s.TargetID, learnMore)
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
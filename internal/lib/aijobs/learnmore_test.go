@@ -0,0 +1,120 @@
+package aijobs
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/studyguides-com/study-guides-api/internal/store/suggestion"
+)
+
+type fakeLearnMoreStore struct {
+	candidates  []*suggestion.LearnMoreCandidate
+	suggestions []*suggestion.Suggestion
+}
+
+func (f *fakeLearnMoreStore) LearnMoreCandidates(ctx context.Context, limit int) ([]*suggestion.LearnMoreCandidate, error) {
+	return f.candidates, nil
+}
+
+func (f *fakeLearnMoreStore) CreateSuggestion(ctx context.Context, s *suggestion.Suggestion) error {
+	f.suggestions = append(f.suggestions, s)
+	return nil
+}
+
+func TestLearnMoreGeneratorRun(t *testing.T) {
+	tagPath := "Geography > Europe"
+	passageTitle := "Rivers of France"
+	passageBody := "The Seine flows through Paris."
+	store := &fakeLearnMoreStore{
+		candidates: []*suggestion.LearnMoreCandidate{
+			{
+				QuestionID:   "q1",
+				QuestionText: "Which river flows through Paris?",
+				AnswerText:   "The Seine",
+				PassageTitle: &passageTitle,
+				PassageBody:  &passageBody,
+				TagPath:      &tagPath,
+			},
+			{
+				QuestionID:   "q2",
+				QuestionText: "What is the capital of Spain?",
+				AnswerText:   "Madrid",
+			},
+		},
+	}
+	client := &fakeAiClient{replies: map[string]string{
+		"Paris?": "  The Seine runs through the centre of Paris.\n",
+		// A blank reply must not become a suggestion
+		"Spain": "   ",
+	}}
+	promptSource := &fakePromptSource{}
+
+	result, err := NewLearnMoreGenerator(client, store, promptSource).Run(context.Background(), "job1", 10)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if result.Candidates != 2 || result.Suggested != 1 || result.Failed != 1 {
+		t.Errorf("Run() = %+v, want 2 candidates, 1 suggested, 1 failed", result)
+	}
+	if promptSource.recorded != 2 {
+		t.Errorf("recorded %d prompt usages, want one per model call", promptSource.recorded)
+	}
+	for _, want := range []string{"Subject: Geography > Europe", "Passage title: Rivers of France", "The Seine flows through Paris."} {
+		if !strings.Contains(client.prompts[0], want) {
+			t.Errorf("prompt does not include %q: %q", want, client.prompts[0])
+		}
+	}
+	if strings.Contains(client.prompts[1], "Passage") {
+		t.Errorf("prompt without a passage mentions one: %q", client.prompts[1])
+	}
+	if len(store.suggestions) != 1 {
+		t.Fatalf("got %d suggestions, want 1", len(store.suggestions))
+	}
+
+	s := store.suggestions[0]
+	if s.Kind != suggestion.KindLearnMore || s.TargetID != "q1" || s.JobID == nil || *s.JobID != "job1" {
+		t.Errorf("unexpected suggestion %+v", s)
+	}
+	var payload LearnMorePayload
+	if err := json.Unmarshal(s.Payload, &payload); err != nil {
+		t.Fatalf("invalid payload: %v", err)
+	}
+	if payload.LearnMore != "The Seine runs through the centre of Paris." {
+		t.Errorf("payload learnMore = %q", payload.LearnMore)
+	}
+	var grounding LearnMoreContext
+	if err := json.Unmarshal(s.Context, &grounding); err != nil {
+		t.Fatalf("invalid context: %v", err)
+	}
+	if grounding.TagPath != tagPath || grounding.PassageTitle != passageTitle || grounding.PassageBody != passageBody {
+		t.Errorf("context = %+v", grounding)
+	}
+}
+
+func TestApplyLearnMore(t *testing.T) {
+	questions := &fakeQuestionWriter{}
+	s := &suggestion.Suggestion{
+		TargetID: "q1",
+		Payload:  []byte(`{"learnMore": "  Paris sits on the Seine. "}`),
+	}
+
+	if err := ApplyLearnMore(context.Background(), questions, s); err != nil {
+		t.Fatalf("ApplyLearnMore() error = %v", err)
+	}
+	if questions.learnMore != "Paris sits on the Seine." {
+		t.Errorf("saved learnMore = %q", questions.learnMore)
+	}
+
+	// A reviewer blanking the explanation must not clear the question's learnMore
+	questions = &fakeQuestionWriter{}
+	s.Payload = []byte(`{"learnMore": "  "}`)
+	if err := ApplyLearnMore(context.Background(), questions, s); err == nil {
+		t.Error("ApplyLearnMore() with an empty payload succeeded")
+	}
+	if questions.learnMore != "" {
+		t.Errorf("empty payload saved learnMore %q", questions.learnMore)
+	}
+}
@@ -0,0 +1,111 @@
+// Package prompts resolves the system prompt for each AI caller from the prompt store, with a short-lived
+// cache and a fallback to the caller's built-in default.
+package prompts
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/studyguides-com/study-guides-api/internal/store/prompt"
+)
+
+// CacheTTL bounds how long an activated version takes to reach other server instances
+const CacheTTL = 5 * time.Minute
+
+// Resolved is the prompt text a caller should use and where it came from
+type Resolved struct {
+	Type     string
+	Text     string
+	PromptID *string // nil when Text is the built-in default
+	Version  int32   // 0 when Text is the built-in default
+}
+
+type cached struct {
+	prompt  *prompt.Prompt // nil when no prompt is stored for the type
+	expires time.Time
+}
+
+type Registry struct {
+	store prompt.PromptStore
+
+	mu    sync.Mutex
+	cache map[string]cached
+}
+
+func NewRegistry(store prompt.PromptStore) *Registry {
+	return &Registry{
+		store: store,
+		cache: make(map[string]cached),
+	}
+}
+
+// Prompt returns the active prompt for a type, or fallback when none is stored or the store can't be read
+func (r *Registry) Prompt(ctx context.Context, promptType, fallback string) *Resolved {
+	stored := r.load(ctx, promptType)
+	if stored == nil || stored.Text == "" {
+		return &Resolved{Type: promptType, Text: fallback}
+	}
+	id := stored.ID
+	return &Resolved{
+		Type:     promptType,
+		Text:     stored.Text,
+		PromptID: &id,
+		Version:  stored.Version,
+	}
+}
+
+// Invalidate drops the cached prompt for a type after it changes
+func (r *Registry) Invalidate(promptType string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.cache, promptType)
+}
+
+// RecordUsage logs which prompt version an AI call ran with. Failures are logged, never returned,
+// so bookkeeping can't fail the call itself.
+func (r *Registry) RecordUsage(ctx context.Context, resolved *Resolved, model, caller string, jobID *string) {
+	err := r.store.RecordUsage(ctx, &prompt.Usage{
+		Type:     resolved.Type,
+		PromptID: resolved.PromptID,
+		Version:  resolved.Version,
+		Model:    model,
+		Caller:   caller,
+		JobID:    jobID,
+	})
+	if err != nil {
+		log.Printf("Error recording %s prompt usage for %s: %v", resolved.Type, caller, err)
+	}
+}
+
+func (r *Registry) load(ctx context.Context, promptType string) *prompt.Prompt {
+	r.mu.Lock()
+	entry, ok := r.cache[promptType]
+	r.mu.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		return entry.prompt
+	}
+
+	stored, err := r.store.Prompt(ctx, promptType)
+	if err != nil {
+		if !isNotFound(err) {
+			// Keep serving what we had rather than dropping to the default on a transient error
+			log.Printf("Error loading %s prompt: %v", promptType, err)
+			return entry.prompt
+		}
+		stored = nil
+	}
+
+	r.mu.Lock()
+	r.cache[promptType] = cached{prompt: stored, expires: time.Now().Add(CacheTTL)}
+	r.mu.Unlock()
+	return stored
+}
+
+func isNotFound(err error) bool {
+	return status.Code(err) == codes.NotFound
+}
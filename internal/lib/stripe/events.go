@@ -0,0 +1,181 @@
+package stripe
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/studyguides-com/study-guides-api/internal/store/billing"
+	"github.com/studyguides-com/study-guides-api/internal/store/entitlement"
+)
+
+// Event types the webhook acts on, everything else is acknowledged and ignored
+const (
+	EventSubscriptionCreated     = "customer.subscription.created"
+	EventSubscriptionUpdated     = "customer.subscription.updated"
+	EventSubscriptionDeleted     = "customer.subscription.deleted"
+	EventCheckoutSessionComplete = "checkout.session.completed"
+)
+
+// Metadata keys our checkout sessions and subscriptions carry
+const (
+	metadataUserID           = "userId"
+	metadataSubscriptionType = "subscriptionType"
+)
+
+// Event is the envelope of a webhook delivery
+type Event struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Created int64  `json:"created"`
+	Data    struct {
+		Object json.RawMessage `json:"object"`
+	} `json:"data"`
+}
+
+// Subscription is the part of a Stripe subscription object we read
+type Subscription struct {
+	ID         string            `json:"id"`
+	Customer   string            `json:"customer"`
+	Status     string            `json:"status"`
+	StartDate  int64             `json:"start_date"`
+	CancelAt   *int64            `json:"cancel_at"`
+	CanceledAt *int64            `json:"canceled_at"`
+	EndedAt    *int64            `json:"ended_at"`
+	Metadata   map[string]string `json:"metadata"`
+	Items      struct {
+		Data []struct {
+			Price struct {
+				Recurring *struct {
+					Interval string `json:"interval"`
+				} `json:"recurring"`
+			} `json:"price"`
+		} `json:"data"`
+	} `json:"items"`
+}
+
+// CheckoutSession is the part of a Stripe checkout session object we read
+type CheckoutSession struct {
+	ID                string            `json:"id"`
+	Mode              string            `json:"mode"`
+	Customer          string            `json:"customer"`
+	Subscription      string            `json:"subscription"`
+	ClientReferenceID string            `json:"client_reference_id"` // our user ID
+	Metadata          map[string]string `json:"metadata"`
+}
+
+// ParseEvent decodes a verified webhook payload
+func ParseEvent(payload []byte) (*Event, error) {
+	var event Event
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("stripe: invalid event payload: %w", err)
+	}
+	if event.ID == "" || event.Type == "" {
+		return nil, fmt.Errorf("stripe: event is missing id or type")
+	}
+	return &event, nil
+}
+
+// Handled reports whether the webhook acts on an event type
+func Handled(eventType string) bool {
+	switch eventType {
+	case EventSubscriptionCreated, EventSubscriptionUpdated, EventSubscriptionDeleted, EventCheckoutSessionComplete:
+		return true
+	}
+	return false
+}
+
+// SubscriptionEvent turns a handled event into the change it makes to our subscriptions
+func (e *Event) SubscriptionEvent() (*billing.SubscriptionEvent, error) {
+	change := &billing.SubscriptionEvent{
+		EventID:      e.ID,
+		EventType:    e.Type,
+		EventCreated: time.Unix(e.Created, 0),
+	}
+
+	switch e.Type {
+	case EventSubscriptionCreated, EventSubscriptionUpdated, EventSubscriptionDeleted:
+		var sub Subscription
+		if err := json.Unmarshal(e.Data.Object, &sub); err != nil {
+			return nil, fmt.Errorf("stripe: invalid subscription in %s: %w", e.ID, err)
+		}
+		if sub.ID == "" || sub.Customer == "" {
+			return nil, fmt.Errorf("stripe: subscription in %s is missing id or customer", e.ID)
+		}
+		change.CustomerID = sub.Customer
+		change.UserID = sub.Metadata[metadataUserID]
+		change.Subscription = &billing.SubscriptionUpdate{
+			StripeSubscriptionID: sub.ID,
+			Status:               subscriptionStatus(sub.Status),
+			Type:                 sub.subscriptionType(),
+			StartDate:            time.Unix(sub.StartDate, 0),
+			EndDate:              sub.endDate(),
+		}
+
+	case EventCheckoutSessionComplete:
+		var session CheckoutSession
+		if err := json.Unmarshal(e.Data.Object, &session); err != nil {
+			return nil, fmt.Errorf("stripe: invalid checkout session in %s: %w", e.ID, err)
+		}
+		if session.Customer == "" {
+			return nil, fmt.Errorf("stripe: checkout session in %s has no customer", e.ID)
+		}
+		change.CustomerID = session.Customer
+		change.UserID = session.ClientReferenceID
+		if change.UserID == "" {
+			change.UserID = session.Metadata[metadataUserID]
+		}
+		// The session only names the subscription, its own events carry the details. A provisional row
+		// gives access right away and is replaced as soon as one of them arrives.
+		if session.Mode == "subscription" && session.Subscription != "" {
+			subscriptionType := session.Metadata[metadataSubscriptionType]
+			if subscriptionType != entitlement.TypeYearly {
+				subscriptionType = entitlement.TypeMonthly
+			}
+			change.Subscription = &billing.SubscriptionUpdate{
+				StripeSubscriptionID: session.Subscription,
+				Status:               entitlement.StatusActive,
+				Type:                 subscriptionType,
+				StartDate:            change.EventCreated,
+				Provisional:          true,
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("stripe: event type %s is not handled", e.Type)
+	}
+	return change, nil
+}
+
+// subscriptionStatus maps Stripe's statuses onto ours. Past due keeps access while Stripe retries the payment.
+func subscriptionStatus(status string) string {
+	switch status {
+	case "active", "trialing", "past_due":
+		return entitlement.StatusActive
+	}
+	return entitlement.StatusCancelled
+}
+
+func (s *Subscription) subscriptionType() string {
+	for _, item := range s.Items.Data {
+		if item.Price.Recurring != nil && item.Price.Recurring.Interval == "year" {
+			return entitlement.TypeYearly
+		}
+	}
+	return entitlement.TypeMonthly
+}
+
+// endDate is when access stops: when it ended, or when a scheduled cancellation takes effect
+func (s *Subscription) endDate() *time.Time {
+	candidates := []*int64{s.EndedAt, s.CancelAt}
+	if s.Status == "canceled" {
+		candidates = append(candidates, s.CanceledAt)
+	}
+	for _, ts := range candidates {
+		if ts != nil && *ts > 0 {
+			end := time.Unix(*ts, 0)
+			return &end
+		}
+	}
+	return nil
+}
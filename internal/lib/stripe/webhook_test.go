@@ -0,0 +1,146 @@
+package stripe
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/studyguides-com/study-guides-api/internal/store/entitlement"
+)
+
+const testSecret = "whsec_test_fixture_secret"
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	payload, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("read fixture %s: %v", name, err)
+	}
+	return payload
+}
+
+func TestVerifySignature(t *testing.T) {
+	payload := readFixture(t, "subscription_created.json")
+	now := time.Unix(1760000000, 0)
+	valid := SignPayload(payload, testSecret, now)
+
+	tests := []struct {
+		name    string
+		payload []byte
+		header  string
+		want    error
+	}{
+		{name: "valid", payload: payload, header: valid},
+		{name: "rolled secret", payload: payload, header: valid + ",v1=" + SignPayload(payload, "whsec_old", now)[len("t=1760000000,v1="):]},
+		{name: "missing header", payload: payload, header: "", want: ErrMissingSignature},
+		{name: "malformed header", payload: payload, header: "garbage", want: ErrInvalidHeader},
+		{name: "no v1 signature", payload: payload, header: "t=1760000000", want: ErrInvalidHeader},
+		{name: "wrong secret", payload: payload, header: SignPayload(payload, "whsec_other", now), want: ErrSignatureMismatch},
+		{name: "tampered payload", payload: append([]byte(" "), payload...), header: valid, want: ErrSignatureMismatch},
+		{name: "stale timestamp", payload: payload, header: SignPayload(payload, testSecret, now.Add(-10*time.Minute)), want: ErrTimestampTooOld},
+		{name: "future timestamp", payload: payload, header: SignPayload(payload, testSecret, now.Add(10*time.Minute)), want: ErrTimestampTooOld},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifySignature(tt.payload, tt.header, testSecret, now, DefaultTolerance)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("VerifySignature() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubscriptionEvent(t *testing.T) {
+	periodEnd := time.Unix(1762000000, 0)
+
+	tests := []struct {
+		fixture      string
+		userID       string
+		customerID   string
+		subscription string
+		status       string
+		subType      string
+		endDate      *time.Time
+		provisional  bool
+	}{
+		{
+			fixture:      "subscription_created.json",
+			userID:       "user_fixture_1",
+			customerID:   "cus_QxTest0001",
+			subscription: "sub_1PzYearly0001",
+			status:       entitlement.StatusActive,
+			subType:      entitlement.TypeYearly,
+		},
+		{
+			fixture:      "subscription_updated_cancel_at_period_end.json",
+			customerID:   "cus_QxTest0002",
+			subscription: "sub_1PzMonthly0002",
+			status:       entitlement.StatusActive,
+			subType:      entitlement.TypeMonthly,
+			endDate:      &periodEnd,
+		},
+		{
+			fixture:      "subscription_deleted.json",
+			customerID:   "cus_QxTest0002",
+			subscription: "sub_1PzMonthly0002",
+			status:       entitlement.StatusCancelled,
+			subType:      entitlement.TypeMonthly,
+			endDate:      &periodEnd,
+		},
+		{
+			fixture:      "checkout_session_completed.json",
+			userID:       "user_fixture_4",
+			customerID:   "cus_QxTest0004",
+			subscription: "sub_1PzYearly0004",
+			status:       entitlement.StatusActive,
+			subType:      entitlement.TypeYearly,
+			provisional:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			event, err := ParseEvent(readFixture(t, tt.fixture))
+			if err != nil {
+				t.Fatalf("ParseEvent() error = %v", err)
+			}
+			if !Handled(event.Type) {
+				t.Fatalf("Handled(%q) = false", event.Type)
+			}
+			change, err := event.SubscriptionEvent()
+			if err != nil {
+				t.Fatalf("SubscriptionEvent() error = %v", err)
+			}
+
+			if change.EventID != event.ID || change.UserID != tt.userID || change.CustomerID != tt.customerID {
+				t.Errorf("got event %s user %q customer %q", change.EventID, change.UserID, change.CustomerID)
+			}
+			sub := change.Subscription
+			if sub == nil {
+				t.Fatal("expected a subscription update")
+			}
+			if sub.StripeSubscriptionID != tt.subscription || sub.Status != tt.status || sub.Type != tt.subType || sub.Provisional != tt.provisional {
+				t.Errorf("got subscription %+v", sub)
+			}
+			if (sub.EndDate == nil) != (tt.endDate == nil) || (sub.EndDate != nil && !sub.EndDate.Equal(*tt.endDate)) {
+				t.Errorf("EndDate = %v, want %v", sub.EndDate, tt.endDate)
+			}
+		})
+	}
+}
+
+func TestUnhandledEvent(t *testing.T) {
+	event, err := ParseEvent(readFixture(t, "invoice_paid.json"))
+	if err != nil {
+		t.Fatalf("ParseEvent() error = %v", err)
+	}
+	if Handled(event.Type) {
+		t.Errorf("Handled(%q) = true", event.Type)
+	}
+	if _, err := event.SubscriptionEvent(); err == nil {
+		t.Error("SubscriptionEvent() on an unhandled event should fail")
+	}
+}
@@ -0,0 +1,234 @@
+package routes
+
+import (
+	"encoding/xml"
+	"html/template"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/studyguides-com/study-guides-api/internal/lib/webrouter/utils"
+	"github.com/studyguides-com/study-guides-api/internal/store/article"
+)
+
+const (
+	articlesPerPage = 20
+	// sitemapLimit is the most URLs one sitemap file may hold
+	sitemapLimit = 50000
+	// articleCacheControl lets crawlers and CDNs hold pages briefly, edits show up within minutes
+	articleCacheControl = "public, max-age=300"
+)
+
+// ArticlesHandler lists published articles, filtered by ?category= and paged by ?page=
+type ArticlesHandler struct {
+	templates *template.Template
+	articles  article.ArticleStore
+}
+
+// NewArticlesHandler creates a new article list handler
+func NewArticlesHandler(templates *template.Template, articles article.ArticleStore) *ArticlesHandler {
+	return &ArticlesHandler{
+		templates: templates,
+		articles:  articles,
+	}
+}
+
+// Handle responds to article list requests
+func (h *ArticlesHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	published := article.StatusPublished
+	filter := &article.ArticleFilter{
+		Status: &published,
+		Limit:  articlesPerPage + 1,
+		Offset: (page - 1) * articlesPerPage,
+	}
+	category := r.URL.Query().Get("category")
+	if category != "" {
+		filter.Category = &category
+	}
+
+	articles, err := h.articles.ListArticles(r.Context(), filter)
+	if err != nil {
+		log.Printf("Error listing articles: %v", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	categories, err := h.articles.ListCategories(r.Context())
+	if err != nil {
+		log.Printf("Error listing article categories: %v", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	hasNext := len(articles) > articlesPerPage
+	if hasNext {
+		articles = articles[:articlesPerPage]
+	}
+
+	title := "Articles"
+	if category != "" {
+		title = category + " Articles"
+	}
+	data := map[string]interface{}{
+		"Title":        title,
+		"Articles":     articles,
+		"Categories":   categories,
+		"Category":     category,
+		"CanonicalURL": utils.AbsoluteURL(articlesPageURL(category, page)),
+	}
+	if page > 1 {
+		data["PrevURL"] = articlesPageURL(category, page-1)
+	}
+	if hasNext {
+		data["NextURL"] = articlesPageURL(category, page+1)
+	}
+
+	// Add environment data
+	data = utils.MergeWithEnvData(data, r)
+
+	w.Header().Set("Cache-Control", articleCacheControl)
+	if err := h.templates.ExecuteTemplate(w, "articles.html", data); err != nil {
+		log.Printf("Error executing template: %v", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// ArticleHandler renders a published article at /articles/{slug}
+type ArticleHandler struct {
+	templates *template.Template
+	articles  article.ArticleStore
+	notFound  RouteHandler
+}
+
+// NewArticleHandler creates a new article page handler
+func NewArticleHandler(templates *template.Template, articles article.ArticleStore, notFound RouteHandler) *ArticleHandler {
+	return &ArticleHandler{
+		templates: templates,
+		articles:  articles,
+		notFound:  notFound,
+	}
+}
+
+// Handle responds to article page requests. Drafts are served as not found.
+func (h *ArticleHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	slug := strings.TrimPrefix(r.URL.Path, "/articles/")
+	if !article.ValidSlug(slug) {
+		h.notFound.Handle(w, r)
+		return
+	}
+
+	a, err := h.articles.GetArticleBySlug(r.Context(), slug)
+	if err != nil || a.Status != article.StatusPublished {
+		h.notFound.Handle(w, r)
+		return
+	}
+
+	data := map[string]interface{}{
+		"Title":        a.Title,
+		"Article":      a,
+		"Paragraphs":   articleParagraphs(a.Content),
+		"CanonicalURL": utils.AbsoluteURL("/articles/"+a.Slug),
+	}
+	if a.PublishedAt != nil {
+		data["PublishedAt"] = a.PublishedAt.Format("January 2, 2006")
+		data["PublishedISO"] = a.PublishedAt.Format(time.RFC3339)
+	}
+
+	// Add environment data
+	data = utils.MergeWithEnvData(data, r)
+
+	w.Header().Set("Cache-Control", articleCacheControl)
+	if err := h.templates.ExecuteTemplate(w, "article.html", data); err != nil {
+		log.Printf("Error executing template: %v", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// SitemapHandler lists the article pages for crawlers
+type SitemapHandler struct {
+	articles article.ArticleStore
+}
+
+// NewSitemapHandler creates a new sitemap handler
+func NewSitemapHandler(articles article.ArticleStore) *SitemapHandler {
+	return &SitemapHandler{
+		articles: articles,
+	}
+}
+
+type sitemapURLSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// Handle responds to sitemap requests
+func (h *SitemapHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	published := article.StatusPublished
+	articles, err := h.articles.ListArticles(r.Context(), &article.ArticleFilter{
+		Status: &published,
+		Limit:  sitemapLimit - 1,
+	})
+	if err != nil {
+		log.Printf("Error listing articles for sitemap: %v", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	sitemap := sitemapURLSet{
+		Xmlns: "http://www.sitemaps.org/schemas/sitemap/0.9",
+		URLs:  []sitemapURL{{Loc: utils.AbsoluteURL("/articles")}},
+	}
+	for _, a := range articles {
+		sitemap.URLs = append(sitemap.URLs, sitemapURL{
+			Loc:     utils.AbsoluteURL("/articles/"+a.Slug),
+			LastMod: a.UpdatedAt.UTC().Format("2006-01-02"),
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.Header().Set("Cache-Control", articleCacheControl)
+	w.Write([]byte(xml.Header))
+	if err := xml.NewEncoder(w).Encode(sitemap); err != nil {
+		log.Printf("Error encoding sitemap: %v", err)
+	}
+}
+
+// articlesPageURL links to a page of the article list
+func articlesPageURL(category string, page int) string {
+	query := url.Values{}
+	if category != "" {
+		query.Set("category", category)
+	}
+	if page > 1 {
+		query.Set("page", strconv.Itoa(page))
+	}
+	if len(query) == 0 {
+		return "/articles"
+	}
+	return "/articles?" + query.Encode()
+}
+
+// articleParagraphs splits content on blank lines. Content is plain text, the template escapes it.
+func articleParagraphs(content string) []string {
+	var paragraphs []string
+	for _, block := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n\n") {
+		if block = strings.TrimSpace(block); block != "" {
+			paragraphs = append(paragraphs, block)
+		}
+	}
+	return paragraphs
+}
@@ -2,6 +2,7 @@ package routes
 
 import (
 	"net/http"
+	"strings"
 )
 
 // RouteHandler defines the interface for route handlers
@@ -13,6 +14,7 @@ type RouteHandler interface {
 type Route struct {
 	Path    string
 	Handler RouteHandler
+	Prefix  bool // matches every path under Path, e.g. /articles/ for /articles/{slug}
 }
 
 // Routes holds all registered routes
@@ -35,17 +37,33 @@ func (r *Routes) Register(path string, handler RouteHandler) {
 	})
 }
 
+// RegisterPrefix adds a route for every path starting with prefix
+func (r *Routes) RegisterPrefix(prefix string, handler RouteHandler) {
+	r.routes = append(r.routes, Route{
+		Path:    prefix,
+		Handler: handler,
+		Prefix:  true,
+	})
+}
+
 // GetRoutes returns all registered routes
 func (r *Routes) GetRoutes() []Route {
 	return r.routes
 }
 
-// FindRoute finds a route that matches the given path
+// FindRoute finds a route that matches the given path, exact routes win over the longest matching prefix
 func (r *Routes) FindRoute(path string) (RouteHandler, bool) {
-	for _, route := range r.routes {
-		if route.Path == path {
+	var match *Route
+	for i, route := range r.routes {
+		if !route.Prefix && route.Path == path {
 			return route.Handler, true
 		}
+		if route.Prefix && strings.HasPrefix(path, route.Path) && (match == nil || len(route.Path) > len(match.Path)) {
+			match = &r.routes[i]
+		}
+	}
+	if match != nil {
+		return match.Handler, true
 	}
 	return nil, false
 } 
\ No newline at end of file
@@ -0,0 +1,98 @@
+package routes
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/studyguides-com/study-guides-api/internal/lib/stripe"
+	"github.com/studyguides-com/study-guides-api/internal/store/billing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxStripePayloadBytes caps a webhook body, Stripe events are a few KB
+const maxStripePayloadBytes = 1 << 20
+
+// StripeWebhookHandler receives Stripe webhook events and keeps Subscription rows in sync with them
+type StripeWebhookHandler struct {
+	secret string
+	store  billing.BillingStore
+}
+
+// NewStripeWebhookHandler creates a new Stripe webhook handler. Without a secret every delivery is refused.
+func NewStripeWebhookHandler(secret string, store billing.BillingStore) *StripeWebhookHandler {
+	return &StripeWebhookHandler{
+		secret: secret,
+		store:  store,
+	}
+}
+
+// Handle verifies the signature, then applies subscription and checkout events once per event ID.
+// Anything but a 2xx makes Stripe retry the delivery, so only failures a retry could fix return one.
+func (h *StripeWebhookHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if h.secret == "" {
+		log.Printf("Stripe webhook received but STRIPE_WEBHOOK_SECRET is not set")
+		http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxStripePayloadBytes))
+	if err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+	if err := stripe.VerifySignature(payload, r.Header.Get(stripe.SignatureHeader), h.secret, time.Now(), stripe.DefaultTolerance); err != nil {
+		log.Printf("Rejected Stripe webhook: %v", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	event, err := stripe.ParseEvent(payload)
+	if err != nil {
+		log.Printf("Rejected Stripe webhook: %v", err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+	if !stripe.Handled(event.Type) {
+		writeWebhookResponse(w, map[string]interface{}{"received": true})
+		return
+	}
+
+	change, err := event.SubscriptionEvent()
+	if err != nil {
+		// A malformed object won't get better on retry
+		log.Printf("Skipping Stripe event %s: %v", event.ID, err)
+		writeWebhookResponse(w, map[string]interface{}{"received": true})
+		return
+	}
+
+	applied, err := h.store.ApplySubscriptionEvent(r.Context(), change)
+	if err != nil {
+		log.Printf("Error applying Stripe event %s (%s): %v", event.ID, event.Type, err)
+		if status.Code(err) == codes.NotFound {
+			// The checkout that links the customer may still be on its way
+			http.Error(w, "Customer Not Linked", http.StatusUnprocessableEntity)
+			return
+		}
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	if !applied {
+		log.Printf("Stripe event %s was already processed", event.ID)
+	}
+	writeWebhookResponse(w, map[string]interface{}{"received": true, "duplicate": !applied})
+}
+
+func writeWebhookResponse(w http.ResponseWriter, response map[string]interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
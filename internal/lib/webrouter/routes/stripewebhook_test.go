@@ -0,0 +1,118 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/studyguides-com/study-guides-api/internal/lib/stripe"
+	"github.com/studyguides-com/study-guides-api/internal/store/billing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const webhookSecret = "whsec_test_fixture_secret"
+
+// fakeBillingStore remembers applied event IDs the way the StripeEvent table does
+type fakeBillingStore struct {
+	seen    map[string]bool
+	applied []*billing.SubscriptionEvent
+	err     error
+}
+
+func (f *fakeBillingStore) ApplySubscriptionEvent(ctx context.Context, event *billing.SubscriptionEvent) (bool, error) {
+	if f.err != nil {
+		return false, f.err
+	}
+	if f.seen[event.EventID] {
+		return false, nil
+	}
+	f.seen[event.EventID] = true
+	f.applied = append(f.applied, event)
+	return true, nil
+}
+
+func deliver(t *testing.T, handler *StripeWebhookHandler, fixture, secret string) (*httptest.ResponseRecorder, map[string]interface{}) {
+	t.Helper()
+	payload, err := os.ReadFile(filepath.Join("..", "..", "stripe", "testdata", fixture))
+	if err != nil {
+		t.Fatalf("read fixture %s: %v", fixture, err)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/stripe", bytes.NewReader(payload))
+	req.Header.Set(stripe.SignatureHeader, stripe.SignPayload(payload, secret, time.Now()))
+	rec := httptest.NewRecorder()
+	handler.Handle(rec, req)
+
+	var body map[string]interface{}
+	if rec.Code == http.StatusOK {
+		if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+	}
+	return rec, body
+}
+
+func TestStripeWebhookAppliesEventsOnce(t *testing.T) {
+	store := &fakeBillingStore{seen: map[string]bool{}}
+	handler := NewStripeWebhookHandler(webhookSecret, store)
+
+	rec, body := deliver(t, handler, "subscription_created.json", webhookSecret)
+	if rec.Code != http.StatusOK || body["duplicate"] != false {
+		t.Fatalf("first delivery: status %d body %v", rec.Code, body)
+	}
+	rec, body = deliver(t, handler, "subscription_created.json", webhookSecret)
+	if rec.Code != http.StatusOK || body["duplicate"] != true {
+		t.Fatalf("redelivery: status %d body %v", rec.Code, body)
+	}
+	if len(store.applied) != 1 || store.applied[0].Subscription.StripeSubscriptionID != "sub_1PzYearly0001" {
+		t.Errorf("applied = %+v", store.applied)
+	}
+}
+
+func TestStripeWebhookRejectsBadSignature(t *testing.T) {
+	store := &fakeBillingStore{seen: map[string]bool{}}
+	handler := NewStripeWebhookHandler(webhookSecret, store)
+
+	rec, _ := deliver(t, handler, "checkout_session_completed.json", "whsec_someone_else")
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	if len(store.applied) != 0 {
+		t.Errorf("unsigned event was applied: %+v", store.applied)
+	}
+}
+
+func TestStripeWebhookIgnoresUnhandledEvents(t *testing.T) {
+	store := &fakeBillingStore{seen: map[string]bool{}}
+	handler := NewStripeWebhookHandler(webhookSecret, store)
+
+	rec, _ := deliver(t, handler, "invoice_paid.json", webhookSecret)
+	if rec.Code != http.StatusOK || len(store.applied) != 0 {
+		t.Errorf("status %d, applied %+v", rec.Code, store.applied)
+	}
+}
+
+func TestStripeWebhookRetriesUnlinkedCustomers(t *testing.T) {
+	store := &fakeBillingStore{err: status.Error(codes.NotFound, "no user is linked to stripe customer cus_QxTest0002")}
+	handler := NewStripeWebhookHandler(webhookSecret, store)
+
+	rec, _ := deliver(t, handler, "subscription_deleted.json", webhookSecret)
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusUnprocessableEntity)
+	}
+}
+
+func TestStripeWebhookWithoutSecret(t *testing.T) {
+	handler := NewStripeWebhookHandler("", &fakeBillingStore{seen: map[string]bool{}})
+
+	rec, _ := deliver(t, handler, "subscription_created.json", webhookSecret)
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusServiceUnavailable)
+	}
+}
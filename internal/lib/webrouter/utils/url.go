@@ -0,0 +1,19 @@
+package utils
+
+import (
+	"os"
+	"strings"
+)
+
+// defaultBaseURL is used when PUBLIC_BASE_URL is not set
+const defaultBaseURL = "https://api.studyguides.com"
+
+// AbsoluteURL builds a link to path on the configured public base URL, used for canonical links and sitemaps.
+// The request host is never used, cached pages must not echo a client supplied Host or X-Forwarded-Proto.
+func AbsoluteURL(path string) string {
+	base := strings.TrimSuffix(os.Getenv("PUBLIC_BASE_URL"), "/")
+	if base == "" {
+		base = defaultBaseURL
+	}
+	return base + path
+}
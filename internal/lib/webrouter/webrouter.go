@@ -4,20 +4,22 @@ import (
 	"html/template"
 	"log"
 	"net/http"
+	"os"
 	"strings"
 
 	"github.com/studyguides-com/study-guides-api/internal/lib/webrouter/routes"
+	"github.com/studyguides-com/study-guides-api/internal/store"
 )
 
 // WebRouter handles HTTP routes for web pages and API endpoints
 type WebRouter struct {
 	templates *template.Template
-	store     interface{} // You can type this more specifically if needed
+	store     store.Store
 	routes    *routes.Routes
 }
 
 // NewWebRouter creates a new web router instance
-func NewWebRouter(store interface{}) *WebRouter {
+func NewWebRouter(store store.Store) *WebRouter {
 	router := &WebRouter{
 		store:  store,
 		routes: routes.NewRoutes(),
@@ -53,8 +55,17 @@ func (wr *WebRouter) registerRoutes() {
 	// Register home page
 	wr.routes.Register("/", routes.NewHomeHandler(wr.templates))
 	
+	// Register article pages and the sitemap crawlers find them through
+	notFound := routes.NewNotFoundHandler(wr.templates)
+	wr.routes.Register("/articles", routes.NewArticlesHandler(wr.templates, wr.store.ArticleStore()))
+	wr.routes.RegisterPrefix("/articles/", routes.NewArticleHandler(wr.templates, wr.store.ArticleStore(), notFound))
+	wr.routes.Register("/sitemap.xml", routes.NewSitemapHandler(wr.store.ArticleStore()))
+
+	// Register the Stripe webhook, it keeps Subscription rows in sync
+	wr.routes.Register("/webhooks/stripe", routes.NewStripeWebhookHandler(os.Getenv("STRIPE_WEBHOOK_SECRET"), wr.store.BillingStore()))
+
 	// Register 404 handler (this will be used as fallback)
-	wr.routes.Register("*", routes.NewNotFoundHandler(wr.templates))
+	wr.routes.Register("*", notFound)
 }
 
 // ServeHTTP handles all HTTP requests and routes them appropriately
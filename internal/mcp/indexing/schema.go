@@ -26,17 +26,21 @@ Examples:
 - "prune tags" → {"filter": {"triggerPruning": true, "objectType": "Tag"}}
 - "clean algolia index" → {"filter": {"triggerPruning": true, "objectType": "Tag"}}
 - "remove orphaned tags" → {"filter": {"triggerPruning": true, "objectType": "Tag"}}
+- "reindex questions" → {"filter": {"triggerReindex": true, "objectType": "Question"}}
+- "prune questions" → {"filter": {"triggerPruning": true, "objectType": "Question"}}
 - "check indexing status" → {"filter": {"status": "running"}}
 - "check indexing jobs" → {"filter": {}}
 
 Available object types:
 - Tag: Index tag data to Algolia search
+- Faq: Index FAQs to Algolia search
+- Question: Index question text and answers to Algolia search
 
 Options:
 - triggerReindex: Set to true to start a new indexing job
 - triggerPruning: Set to true to remove orphaned objects from search index
 - force: Set to true to reindex even if content hasn't changed
-- objectType: Specify "Tag" to index tags (required for triggering)
+- objectType: Specify "Tag", "Faq" or "Question" (required for triggering and pruning)
 - status: Filter by job status ("running", "complete", "failed")
 
 Note: Indexing runs in the background and may take several minutes to complete.`,
@@ -55,7 +59,7 @@ func GetAIPromptAdditions() string {
 Indexing Operations:
 - When user asks to "reindex", "index", "sync to algolia", use indexing_find with triggerReindex:true
 - When user asks to "prune", "clean index", "remove orphaned", use indexing_find with triggerPruning:true
-- Always specify objectType:"Tag" when triggering indexing or pruning
+- Always specify objectType when triggering indexing or pruning, "Tag" unless the user asks about FAQs or questions
 - Use force:true when user mentions "force" or "even if unchanged"
 - Indexing and pruning jobs run in background and may take several minutes
 - Suggest checking status after starting operations
@@ -66,6 +70,8 @@ Indexing Trigger Phrases:
 - "algolia sync", "sync to search" → triggerReindex:true, objectType:"Tag"
 - "prune tags", "prune index", "clean algolia" → triggerPruning:true, objectType:"Tag"
 - "remove orphaned tags", "clean search index" → triggerPruning:true, objectType:"Tag"
+- "reindex questions", "index questions" → triggerReindex:true, objectType:"Question"
+- "prune questions" → triggerPruning:true, objectType:"Question"
 - "check indexing", "indexing status" → status filter or empty filter
 `
 }
\ No newline at end of file
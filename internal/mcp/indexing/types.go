@@ -9,7 +9,7 @@ const ResourceName = "indexing"
 type IndexingFilter struct {
 	TriggerReindex bool    `json:"triggerReindex,omitempty"`
 	TriggerPruning bool    `json:"triggerPruning,omitempty"` // Remove orphaned objects from index
-	ObjectType     *string `json:"objectType,omitempty"`     // "Tag", "User", "Contact", "FAQ"
+	ObjectType     *string `json:"objectType,omitempty"`     // "Tag", "Faq", "Question"
 	Force          *bool   `json:"force,omitempty"`          // Bypass hash comparison
 	Status         *string `json:"status,omitempty"`          // Filter by job status
 	JobID          *string `json:"jobId,omitempty"`           // Get specific job
@@ -46,6 +46,8 @@ const (
 func AllObjectTypes() []string {
 	return []string{
 		"Tag",
-		// Future: "User", "Contact", "FAQ"
+		"Faq",
+		"Question",
+		// Future: "User", "Contact"
 	}
 }
\ No newline at end of file
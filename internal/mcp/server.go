@@ -7,6 +7,8 @@ import (
 
 	"github.com/sashabaranov/go-openai"
 	"github.com/studyguides-com/study-guides-api/internal/lib/ai"
+	"github.com/studyguides-com/study-guides-api/internal/lib/prompts"
+	promptstore "github.com/studyguides-com/study-guides-api/internal/store/prompt"
 )
 
 // MCPProcessor handles Model Context Protocol request processing
@@ -16,6 +18,7 @@ type MCPProcessor struct {
 	toolGen      *SimpleToolGenerator
 	aiClient     ai.AiClient
 	systemPrompt string
+	prompts      *prompts.Registry
 }
 
 // NewMCPProcessor creates a new MCP processor
@@ -43,6 +46,20 @@ func (s *MCPProcessor) SetSystemPrompt(prompt string) {
 	s.systemPrompt = prompt
 }
 
+// SetPromptRegistry loads the system prompt from the registry, the prompt set with SetSystemPrompt
+// becomes the fallback when no Chat prompt is stored
+func (s *MCPProcessor) SetPromptRegistry(registry *prompts.Registry) {
+	s.prompts = registry
+}
+
+// resolveSystemPrompt picks the registry's Chat prompt when one is configured
+func (s *MCPProcessor) resolveSystemPrompt(ctx context.Context) *prompts.Resolved {
+	if s.prompts == nil {
+		return &prompts.Resolved{Type: promptstore.TypeChat, Text: s.systemPrompt}
+	}
+	return s.prompts.Prompt(ctx, promptstore.TypeChat, s.systemPrompt)
+}
+
 // ProcessRequest processes a natural language request through the AI pipeline
 func (s *MCPProcessor) ProcessRequest(ctx context.Context, userPrompt string) (*Response, error) {
 	// Generate tools from registered repositories
@@ -62,8 +79,12 @@ func (s *MCPProcessor) ProcessRequest(ctx context.Context, userPrompt string) (*
 	}
 
 	// Use AI to select and call appropriate tool
-	fmt.Printf("🧠 DEBUG: Calling AI with system prompt length: %d chars\n", len(s.systemPrompt))
-	rawResponse, err := s.aiClient.ChatCompletionWithTools(ctx, s.systemPrompt, userPrompt, tools, nil)
+	systemPrompt := s.resolveSystemPrompt(ctx)
+	fmt.Printf("🧠 DEBUG: Calling AI with system prompt version %d, length: %d chars\n", systemPrompt.Version, len(systemPrompt.Text))
+	rawResponse, err := s.aiClient.ChatCompletionWithTools(ctx, systemPrompt.Text, userPrompt, tools, nil)
+	if s.prompts != nil {
+		s.prompts.RecordUsage(ctx, systemPrompt, s.aiClient.Model(), "chat", nil)
+	}
 	if err != nil {
 		fmt.Printf("❌ DEBUG: AI completion error: %v\n", err)
 		return &Response{
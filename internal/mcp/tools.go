@@ -68,8 +68,8 @@ func (g *SimpleToolGenerator) generateFindTool(resource string) openai.Tool {
 		}
 		baseProperties["objectType"] = map[string]interface{}{
 			"type":        "string",
-			"description": "Type of object to index (Tag, User, Contact, FAQ)",
-			"enum":        []string{"Tag"},
+			"description": "Type of object to index (Tag, Faq, Question)",
+			"enum":        []string{"Tag", "Faq", "Question"},
 		}
 		baseProperties["force"] = map[string]interface{}{
 			"type":        "boolean",
@@ -0,0 +1,188 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/studyguides-com/study-guides-api/internal/store/audit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const auditCaptureKey contextKey = "auditCapture"
+
+// maxAuditSummaryBytes caps a stored request or response summary, imports can carry whole guides
+const maxAuditSummaryBytes = 8 * 1024
+
+// auditWriteTimeout bounds the audit insert, it runs after the call and must not hang the response
+const auditWriteTimeout = 5 * time.Second
+
+// auditCapture collects what a handler reports about its mutation while the call runs
+type auditCapture struct {
+	mu      sync.Mutex
+	targets []string
+	before  interface{}
+	after   interface{}
+}
+
+// AuditTargets adds IDs the call acted on beyond the ones in its request. It does nothing outside an audited call.
+func AuditTargets(ctx context.Context, ids ...string) {
+	if capture, ok := ctx.Value(auditCaptureKey).(*auditCapture); ok {
+		capture.mu.Lock()
+		capture.targets = append(capture.targets, ids...)
+		capture.mu.Unlock()
+	}
+}
+
+// AuditBefore records the state a mutation is about to change. It does nothing outside an audited call.
+func AuditBefore(ctx context.Context, snapshot interface{}) {
+	if capture, ok := ctx.Value(auditCaptureKey).(*auditCapture); ok {
+		capture.mu.Lock()
+		capture.before = snapshot
+		capture.mu.Unlock()
+	}
+}
+
+// AuditAfter records the state a mutation left behind. It does nothing outside an audited call.
+func AuditAfter(ctx context.Context, snapshot interface{}) {
+	if capture, ok := ctx.Value(auditCaptureKey).(*auditCapture); ok {
+		capture.mu.Lock()
+		capture.after = snapshot
+		capture.mu.Unlock()
+	}
+}
+
+// AuditUnaryInterceptor records every call to the given full method names in the audit store, including
+// calls that fail. It must run after AuthUnaryInterceptor so the actor is known.
+func AuditUnaryInterceptor(store audit.AuditStore, methods []string) grpc.UnaryServerInterceptor {
+	audited := make(map[string]bool, len(methods))
+	for _, m := range methods {
+		audited[m] = true
+	}
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !audited[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		capture := &auditCapture{}
+		started := time.Now()
+		resp, err := handler(context.WithValue(ctx, auditCaptureKey, capture), req)
+
+		event := &audit.Event{
+			Method:     info.FullMethod,
+			Outcome:    status.Code(err).String(),
+			DurationMs: int32(time.Since(started).Milliseconds()),
+		}
+		if userID, ok := UserIDFromContext(ctx); ok {
+			event.ActorID = &userID
+		}
+		if roles, ok := UserRolesFromContext(ctx); ok {
+			for _, role := range roles {
+				event.Roles = append(event.Roles, role.String())
+			}
+		}
+		if err != nil {
+			message := status.Convert(err).Message()
+			event.ErrorMessage = &message
+		}
+		if msg, ok := req.(proto.Message); ok {
+			event.TargetIDs = requestTargetIDs(msg.ProtoReflect())
+			event.Request = protoSummary(msg)
+		}
+
+		capture.mu.Lock()
+		event.TargetIDs = append(event.TargetIDs, capture.targets...)
+		event.Before = auditSnapshot(capture.before)
+		event.After = auditSnapshot(capture.after)
+		capture.mu.Unlock()
+
+		// Handlers without an explicit snapshot still leave their response, e.g. the job a trigger started
+		if event.After == nil && err == nil {
+			if msg, ok := resp.(proto.Message); ok {
+				event.After = protoSummary(msg)
+			}
+		}
+
+		// The caller's context may already be cancelled, the record must still be written
+		writeCtx, cancel := context.WithTimeout(context.Background(), auditWriteTimeout)
+		defer cancel()
+		if recordErr := store.Record(writeCtx, event); recordErr != nil {
+			log.Printf("Error recording audit event for %s: %v", info.FullMethod, recordErr)
+		}
+
+		return resp, err
+	}
+}
+
+// requestTargetIDs collects top-level id, *_id and *_ids string fields from a request
+func requestTargetIDs(msg protoreflect.Message) []string {
+	var ids []string
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.StringKind {
+			return true
+		}
+		name := string(fd.Name())
+		switch {
+		case fd.IsList() && strings.HasSuffix(name, "_ids"):
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				ids = append(ids, list.Get(i).String())
+			}
+		case !fd.IsList() && (name == "id" || strings.HasSuffix(name, "_id")):
+			if id := v.String(); id != "" {
+				ids = append(ids, id)
+			}
+		}
+		return true
+	})
+	return ids
+}
+
+// protoSummary renders a request or response message as JSON, replacing it with a marker when it is too large to keep
+func protoSummary(msg proto.Message) []byte {
+	data, err := protojson.Marshal(msg)
+	if err != nil {
+		return nil
+	}
+	if len(data) > maxAuditSummaryBytes {
+		summary, _ := json.Marshal(map[string]interface{}{
+			"truncated": true,
+			"bytes":     len(data),
+			"prefix":    string(data[:maxAuditSummaryBytes]),
+		})
+		return summary
+	}
+	return data
+}
+
+// auditSnapshot encodes a before or after snapshot, protos keep their JSON field names
+func auditSnapshot(snapshot interface{}) []byte {
+	if snapshot == nil {
+		return nil
+	}
+	var data []byte
+	var err error
+	if msg, ok := snapshot.(proto.Message); ok {
+		data, err = protojson.Marshal(msg)
+	} else {
+		data, err = json.Marshal(snapshot)
+	}
+	if err != nil {
+		log.Printf("Error encoding audit snapshot: %v", err)
+		return nil
+	}
+	return data
+}
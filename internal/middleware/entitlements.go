@@ -0,0 +1,189 @@
+package middleware
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	"github.com/studyguides-com/study-guides-api/internal/store/entitlement"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const entitlementsKey contextKey = "entitlements"
+
+// entitlementCacheTTL is how long a resolved plan is reused, subscription changes show up within it
+const entitlementCacheTTL = time.Minute
+
+// Plan is what the caller's subscriptions add up to
+type Plan string
+
+const (
+	PlanFree    Plan = "free"
+	PlanPremium Plan = "premium"
+)
+
+// Feature is something a plan can unlock
+type Feature string
+
+const (
+	FeatureTestMode         Feature = "test_mode"
+	FeatureFullQuestionSets Feature = "full_question_sets" // past the preview on premium tags
+	FeatureExports          Feature = "exports"
+)
+
+// PremiumFeatures are the features only the premium plan unlocks
+var PremiumFeatures = []Feature{FeatureTestMode, FeatureFullQuestionSets, FeatureExports}
+
+// Entitlements is the caller's plan and the subscription it came from
+type Entitlements struct {
+	Plan             Plan
+	SubscriptionType string     // empty without a subscription
+	ExpiresAt        *time.Time // nil for open-ended subscriptions
+}
+
+var freeEntitlements = &Entitlements{Plan: PlanFree}
+
+type cachedEntitlements struct {
+	entitlements *Entitlements
+	expires      time.Time
+}
+
+type entitlementCache struct {
+	mu        sync.Mutex
+	entries   map[string]cachedEntitlements
+	lastSweep time.Time
+}
+
+func (c *entitlementCache) get(userID string) (*Entitlements, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[userID]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(c.entries, userID)
+		return nil, false
+	}
+	return entry.entitlements, true
+}
+
+func (c *entitlementCache) put(userID string, entitlements *Entitlements) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Drop the entries of users who haven't come back, a read only evicts its own entry
+	now := time.Now()
+	if now.Sub(c.lastSweep) > entitlementCacheTTL {
+		for id, entry := range c.entries {
+			if now.After(entry.expires) {
+				delete(c.entries, id)
+			}
+		}
+		c.lastSweep = now
+	}
+
+	c.entries[userID] = cachedEntitlements{entitlements: entitlements, expires: now.Add(entitlementCacheTTL)}
+}
+
+// EntitlementUnaryInterceptor resolves the caller's plan from their subscriptions for GetSessionDetails.
+// It must run after AuthUnaryInterceptor. Lookup failures fall back to the free plan rather than failing the call.
+func EntitlementUnaryInterceptor(store entitlement.EntitlementStore) grpc.UnaryServerInterceptor {
+	cache := &entitlementCache{entries: make(map[string]cachedEntitlements), lastSweep: time.Now()}
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		userID, ok := UserIDFromContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		entitlements, ok := cache.get(userID)
+		if !ok {
+			subscription, err := store.ActiveSubscription(ctx, userID)
+			if err != nil {
+				log.Printf("Error resolving entitlements for user %s: %v", userID, err)
+				return handler(context.WithValue(ctx, entitlementsKey, freeEntitlements), req)
+			}
+			entitlements = entitlementsFor(subscription)
+			cache.put(userID, entitlements)
+		}
+		return handler(context.WithValue(ctx, entitlementsKey, entitlements), req)
+	}
+}
+
+// EntitlementsFromContext returns the caller's entitlements, the free plan when none were resolved
+func EntitlementsFromContext(ctx context.Context) *Entitlements {
+	if entitlements, ok := ctx.Value(entitlementsKey).(*Entitlements); ok {
+		return entitlements
+	}
+	return freeEntitlements
+}
+
+func entitlementsFor(subscription *entitlement.Subscription) *Entitlements {
+	if subscription == nil {
+		return freeEntitlements
+	}
+	plan := PlanPremium
+	if subscription.Type == entitlement.TypeFree {
+		plan = PlanFree
+	}
+	return &Entitlements{
+		Plan:             plan,
+		SubscriptionType: subscription.Type,
+		ExpiresAt:        subscription.EndDate,
+	}
+}
+
+// Entitled reports whether the caller may use a feature. Admins and testers can use everything.
+func (s *SessionDetails) Entitled(feature Feature) bool {
+	if s.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) || s.HasRole(sharedpb.UserRole_USER_ROLE_TESTER) {
+		return true
+	}
+	if s.Entitlements != nil && s.Entitlements.Plan == PlanPremium {
+		return true
+	}
+	for _, premium := range PremiumFeatures {
+		if premium == feature {
+			return false
+		}
+	}
+	return true
+}
+
+// RequireEntitlement returns PermissionDenied when the caller can't use a feature. The error carries an
+// ErrorInfo with reason PREMIUM_REQUIRED and the feature, current plan and required plan, so clients can
+// show an upgrade prompt instead of a generic error.
+func (s *SessionDetails) RequireEntitlement(feature Feature) error {
+	if s.Entitled(feature) {
+		return nil
+	}
+
+	plan := PlanFree
+	if s.Entitlements != nil {
+		plan = s.Entitlements.Plan
+	}
+	st := status.Newf(codes.PermissionDenied, "%s requires a premium subscription", feature)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: "PREMIUM_REQUIRED",
+		Domain: "studyguides.com",
+		Metadata: map[string]string{
+			"feature":       string(feature),
+			"plan":          string(plan),
+			"required_plan": string(PlanPremium),
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
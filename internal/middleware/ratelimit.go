@@ -3,27 +3,51 @@ package middleware
 
 import (
 	"context"
+	"net"
+	"strings"
 	"sync"
+	"time"
 
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
+// minLimiterIdle is the shortest time a limiter is kept after its last use
+const minLimiterIdle = time.Minute
+
+type limiterEntry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
 type limiterStore struct {
-	mu       sync.Mutex
-	limiters map[string]*rate.Limiter
-	r        rate.Limit
-	burst    int
+	mu        sync.Mutex
+	limiters  map[string]*limiterEntry
+	r         rate.Limit
+	burst     int
+	idle      time.Duration
+	lastSweep time.Time
 }
 
 func newLimiterStore(r rate.Limit, burst int) *limiterStore {
+	// A limiter left alone until its bucket refills behaves like a new one, so it can be
+	// dropped then without giving its key any extra allowance
+	idle := minLimiterIdle
+	if r > 0 && r != rate.Inf {
+		if refill := time.Duration(float64(burst) / float64(r) * float64(time.Second)); refill > idle {
+			idle = refill
+		}
+	}
 	return &limiterStore{
-		limiters: make(map[string]*rate.Limiter),
-		r:        r,
-		burst:    burst,
+		limiters:  make(map[string]*limiterEntry),
+		r:         r,
+		burst:     burst,
+		idle:      idle,
+		lastSweep: time.Now(),
 	}
 }
 
@@ -31,12 +55,23 @@ func (s *limiterStore) getLimiter(key string) *rate.Limiter {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	limiter, ok := s.limiters[key]
+	now := time.Now()
+	if now.Sub(s.lastSweep) > s.idle {
+		for k, entry := range s.limiters {
+			if now.Sub(entry.lastSeen) > s.idle {
+				delete(s.limiters, k)
+			}
+		}
+		s.lastSweep = now
+	}
+
+	entry, ok := s.limiters[key]
 	if !ok {
-		limiter = rate.NewLimiter(s.r, s.burst)
-		s.limiters[key] = limiter
+		entry = &limiterEntry{limiter: rate.NewLimiter(s.r, s.burst)}
+		s.limiters[key] = entry
 	}
-	return limiter
+	entry.lastSeen = now
+	return entry.limiter
 }
 
 func RateLimitUnaryInterceptor(r rate.Limit, burst int) grpc.UnaryServerInterceptor {
@@ -69,3 +104,61 @@ func RateLimitUnaryInterceptor(r rate.Limit, burst int) grpc.UnaryServerIntercep
 		return handler(ctx, req)
 	}
 }
+
+// MethodRateLimitUnaryInterceptor applies a stricter limit to a few public methods, like contact
+// submissions. Anonymous callers are keyed by client IP rather than connection, so reconnecting
+// doesn't reset their allowance. trustedProxies is how many proxies in front of the server
+// append to X-Forwarded-For.
+func MethodRateLimitUnaryInterceptor(r rate.Limit, burst int, trustedProxies int, methods []string) grpc.UnaryServerInterceptor {
+	limited := make(map[string]*limiterStore, len(methods))
+	for _, method := range methods {
+		limited[method] = newLimiterStore(r, burst)
+	}
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		store, ok := limited[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		key := clientIP(ctx, trustedProxies)
+		if userID, ok := UserIDFromContext(ctx); ok {
+			key = userID
+		}
+		if !store.getLimiter(key).Allow() {
+			return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// clientIP takes the X-Forwarded-For hop appended by the outermost trusted proxy, falling
+// back to the peer address. Hops left of it are set by the client and can't be trusted.
+func clientIP(ctx context.Context, trustedProxies int) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok && trustedProxies > 0 {
+		var hops []string
+		for _, forwarded := range md.Get("x-forwarded-for") {
+			for _, hop := range strings.Split(forwarded, ",") {
+				hops = append(hops, strings.TrimSpace(hop))
+			}
+		}
+		if len(hops) >= trustedProxies {
+			if ip := hops[len(hops)-trustedProxies]; ip != "" {
+				return ip
+			}
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+	return "unknown"
+}
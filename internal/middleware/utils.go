@@ -8,9 +8,10 @@ import (
 
 // SessionDetails contains information about the current user session
 type SessionDetails struct {
-	UserID    *string
-	UserRoles *[]sharedpb.UserRole
-	IsAuth    bool
+	UserID       *string
+	UserRoles    *[]sharedpb.UserRole
+	IsAuth       bool
+	Entitlements *Entitlements
 }
 
 func UserIDFromContext(ctx context.Context) (string, bool) {
@@ -30,9 +31,10 @@ func GetSessionDetails(ctx context.Context) *SessionDetails {
 
 	// Always return a valid SessionDetails, even if no JWT
 	return &SessionDetails{
-		UserID:    &userID,    // Will be empty string if not found
-		UserRoles: &userRoles, // Will be empty slice if not found
-		IsAuth:    ok,         // true if we got a valid userID from context
+		UserID:       &userID,                      // Will be empty string if not found
+		UserRoles:    &userRoles,                   // Will be empty slice if not found
+		IsAuth:       ok,                           // true if we got a valid userID from context
+		Entitlements: EntitlementsFromContext(ctx), // free plan unless a subscription was found
 	}
 }
 
@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	adminpb "github.com/studyguides-com/study-guides-api/api/v1/admin"
 	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
 	"github.com/studyguides-com/study-guides-api/internal/middleware"
 	"github.com/studyguides-com/study-guides-api/internal/store"
+	"github.com/studyguides-com/study-guides-api/internal/store/admin"
+	"github.com/studyguides-com/study-guides-api/internal/store/audit"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type AdminService struct {
@@ -58,12 +62,27 @@ func (s *AdminService) KillUser(ctx context.Context, req *adminpb.KillUserAdminR
 
 		log.Printf("KillUser request from user %s for email %s", *session.UserID, req.Email)
 
+		if req.ConfirmationToken == "" {
+			return nil, status.Error(codes.InvalidArgument, "confirmation_token is required, call PreviewKillUser first")
+		}
+		// Claiming the token up front keeps it single-use, it is released if the kill fails
+		if err := s.store.AdminStore().ConsumeKillConfirmation(ctx, req.ConfirmationToken, admin.KillActionUser, req.Email, *session.UserID); err != nil {
+			return nil, err
+		}
+
+		// Snapshot what is about to be deleted for the audit log
+		if impact, err := s.store.AdminStore().KillUserImpact(ctx, req.Email); err == nil {
+			middleware.AuditBefore(ctx, impact)
+		}
+
 		// Call the store method to kill the user
 		ok, err := s.store.UserStore().KillUser(ctx, req.Email)
 		if err != nil {
 			log.Printf("Error killing user %s: %v", req.Email, err)
+			s.releaseKillConfirmation(ctx, req.ConfirmationToken)
 			return nil, status.Error(codes.Internal, "failed to kill user")
 		}
+		middleware.AuditAfter(ctx, map[string]interface{}{"deleted": ok})
 
 		return &adminpb.KillUserAdminResponse{
 			Ok: ok,
@@ -90,10 +109,25 @@ func (s *AdminService) KillTree(ctx context.Context, req *adminpb.KillTreeAdminR
 
 		log.Printf("KillTree request from user %s for id %s", *session.UserID, req.Id)
 
-		// Call the admin store method to kill the tree
-		deletedIds, err := s.store.AdminStore().KillTree(ctx, req.Id)
+		if req.ConfirmationToken == "" {
+			return nil, status.Error(codes.InvalidArgument, "confirmation_token is required, call PreviewKillTree first")
+		}
+		// Claiming the token up front keeps it single-use, it is released if the kill fails
+		if err := s.store.AdminStore().ConsumeKillConfirmation(ctx, req.ConfirmationToken, admin.KillActionTree, req.Id, *session.UserID); err != nil {
+			return nil, err
+		}
+
+		// Snapshot what is about to be deleted for the audit log
+		if impact, err := s.store.AdminStore().KillTreeImpact(ctx, req.Id); err == nil {
+			middleware.AuditBefore(ctx, impact)
+		}
+
+		// Call the admin store method to move the tree to trash
+		retention := time.Duration(req.RetentionDays) * 24 * time.Hour
+		entry, err := s.store.AdminStore().KillTree(ctx, req.Id, *session.UserID, retention)
 		if err != nil {
 			log.Printf("Error killing tree %s: %v", req.Id, err)
+			s.releaseKillConfirmation(ctx, req.ConfirmationToken)
 			// Preserve the original error details for the client
 			if _, ok := status.FromError(err); ok {
 				// If it's already a gRPC error, preserve the code and message
@@ -103,8 +137,13 @@ func (s *AdminService) KillTree(ctx context.Context, req *adminpb.KillTreeAdminR
 			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to kill tree %s: %v", req.Id, err))
 		}
 
+		middleware.AuditTargets(ctx, entry.TagIDs...)
+		middleware.AuditAfter(ctx, entry)
+
 		return &adminpb.KillTreeAdminResponse{
-			DeletedIds: deletedIds,
+			DeletedIds: entry.TagIDs,
+			TrashId:    entry.ID,
+			ExpiresAt:  timestamppb.New(entry.ExpiresAt),
 		}, nil
 	})
 	if err != nil {
@@ -112,3 +151,565 @@ func (s *AdminService) KillTree(ctx context.Context, req *adminpb.KillTreeAdminR
 	}
 	return resp.(*adminpb.KillTreeAdminResponse), nil
 }
+
+func (s *AdminService) PreviewKillUser(ctx context.Context, req *adminpb.PreviewKillUserAdminRequest) (*adminpb.PreviewKillUserAdminResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if session.UserID == nil {
+			log.Printf("PreviewKillUser request from anonymous user")
+			return nil, status.Error(codes.Unauthenticated, "authentication required")
+		}
+
+		// Check for admin role
+		if !session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+			log.Printf("PreviewKillUser request from non-admin user %s", *session.UserID)
+			return nil, status.Error(codes.PermissionDenied, "admin role required")
+		}
+
+		log.Printf("PreviewKillUser request from user %s for email %s", *session.UserID, req.Email)
+
+		impact, err := s.store.AdminStore().KillUserImpact(ctx, req.Email)
+		if err != nil {
+			log.Printf("Error previewing kill user %s: %v", req.Email, err)
+			return nil, err
+		}
+
+		confirmation, err := s.store.AdminStore().CreateKillConfirmation(ctx, admin.KillActionUser, req.Email, *session.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &adminpb.PreviewKillUserAdminResponse{
+			Report: newKillImpactReport(impact, confirmation),
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*adminpb.PreviewKillUserAdminResponse), nil
+}
+
+func (s *AdminService) PreviewKillTree(ctx context.Context, req *adminpb.PreviewKillTreeAdminRequest) (*adminpb.PreviewKillTreeAdminResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if session.UserID == nil {
+			log.Printf("PreviewKillTree request from anonymous user")
+			return nil, status.Error(codes.Unauthenticated, "authentication required")
+		}
+
+		// Check for admin role
+		if !session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+			log.Printf("PreviewKillTree request from non-admin user %s", *session.UserID)
+			return nil, status.Error(codes.PermissionDenied, "admin role required")
+		}
+
+		log.Printf("PreviewKillTree request from user %s for id %s", *session.UserID, req.Id)
+
+		impact, err := s.store.AdminStore().KillTreeImpact(ctx, req.Id)
+		if err != nil {
+			log.Printf("Error previewing kill tree %s: %v", req.Id, err)
+			return nil, err
+		}
+
+		confirmation, err := s.store.AdminStore().CreateKillConfirmation(ctx, admin.KillActionTree, req.Id, *session.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &adminpb.PreviewKillTreeAdminResponse{
+			Report: newKillImpactReport(impact, confirmation),
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*adminpb.PreviewKillTreeAdminResponse), nil
+}
+
+// releaseKillConfirmation makes a claimed token usable again after the kill it was claimed for failed
+func (s *AdminService) releaseKillConfirmation(ctx context.Context, token string) {
+	if err := s.store.AdminStore().ReleaseKillConfirmation(ctx, token); err != nil {
+		log.Printf("Error releasing kill confirmation: %v", err)
+	}
+}
+
+// newKillImpactReport converts a store impact report and its confirmation token to the API type
+func newKillImpactReport(impact *admin.KillImpact, confirmation *admin.KillConfirmation) *adminpb.KillImpactReport {
+	counts := make([]*adminpb.ImpactCount, 0, len(impact.Counts))
+	for _, c := range impact.Counts {
+		counts = append(counts, &adminpb.ImpactCount{
+			Table:  c.Table,
+			Detail: c.Detail,
+			Count:  c.Count,
+		})
+	}
+
+	return &adminpb.KillImpactReport{
+		Counts:            counts,
+		TopLevelNames:     impact.TopLevelNames,
+		ConfirmationToken: confirmation.Token,
+		TokenExpiresAt:    timestamppb.New(confirmation.ExpiresAt),
+	}
+}
+
+func (s *AdminService) ImportGuide(ctx context.Context, req *adminpb.ImportGuideAdminRequest) (*adminpb.ImportGuideAdminResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if session.UserID == nil {
+			log.Printf("ImportGuide request from anonymous user")
+			return nil, status.Error(codes.Unauthenticated, "authentication required")
+		}
+
+		// Check for admin role
+		if !session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+			log.Printf("ImportGuide request from non-admin user %s", *session.UserID)
+			return nil, status.Error(codes.PermissionDenied, "admin role required")
+		}
+
+		guide, err := guideFromImportRequest(req)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Printf("ImportGuide request from user %s for guide %s (%d sections, dry run %t)", *session.UserID, guide.Title, len(guide.Sections), req.DryRun)
+
+		summary, err := s.store.AdminStore().ImportGuide(ctx, guide, req.FileName, req.DryRun)
+		if err != nil {
+			log.Printf("Error importing guide %s: %v", guide.Title, err)
+			if _, ok := status.FromError(err); ok {
+				return nil, err
+			}
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to import guide %s: %v", guide.Title, err))
+		}
+
+		return &adminpb.ImportGuideAdminResponse{
+			BatchId:      summary.BatchID,
+			FileId:       summary.FileID,
+			Tags:         newImportCounts(summary.Tags),
+			Passages:     newImportCounts(summary.Passages),
+			Questions:    newImportCounts(summary.Questions),
+			QuestionTags: newImportCounts(summary.QuestionTags),
+			Changes:      newImportChanges(summary.Changes),
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*adminpb.ImportGuideAdminResponse), nil
+}
+
+func newImportChanges(changes []admin.ImportChange) []*adminpb.ImportChange {
+	result := make([]*adminpb.ImportChange, 0, len(changes))
+	for _, change := range changes {
+		diffs := make([]*adminpb.ImportFieldDiff, 0, len(change.Diffs))
+		for _, diff := range change.Diffs {
+			diffs = append(diffs, &adminpb.ImportFieldDiff{
+				Field:    diff.Field,
+				OldValue: diff.Old,
+				NewValue: diff.New,
+			})
+		}
+		result = append(result, &adminpb.ImportChange{
+			ObjectType:  change.ObjectType,
+			Hash:        change.Hash,
+			Label:       change.Label,
+			Action:      change.Action,
+			Diffs:       diffs,
+			OtherTagIds: change.OtherTagIDs,
+		})
+	}
+	return result
+}
+
+// guideFromImportRequest returns the guide sent directly or decoded from a gob payload
+func guideFromImportRequest(req *adminpb.ImportGuideAdminRequest) (*sharedpb.GuideData, error) {
+	switch payload := req.Payload.(type) {
+	case *adminpb.ImportGuideAdminRequest_Guide:
+		return payload.Guide, nil
+	case *adminpb.ImportGuideAdminRequest_GobPayload:
+		return admin.DecodeGuide(payload.GobPayload)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "gob_payload or guide is required")
+	}
+}
+
+func newImportCounts(counts admin.ImportCounts) *adminpb.ImportCounts {
+	return &adminpb.ImportCounts{
+		Created:   counts.Created,
+		Updated:   counts.Updated,
+		Unchanged: counts.Unchanged,
+	}
+}
+
+func (s *AdminService) ListTrash(ctx context.Context, req *adminpb.ListTrashAdminRequest) (*adminpb.ListTrashAdminResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if session.UserID == nil {
+			log.Printf("ListTrash request from anonymous user")
+			return nil, status.Error(codes.Unauthenticated, "authentication required")
+		}
+
+		// Check for admin role
+		if !session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+			log.Printf("ListTrash request from non-admin user %s", *session.UserID)
+			return nil, status.Error(codes.PermissionDenied, "admin role required")
+		}
+
+		log.Printf("ListTrash request from user %s", *session.UserID)
+
+		entries, err := s.store.AdminStore().ListTrash(ctx, req.IncludeExpired)
+		if err != nil {
+			log.Printf("Error listing trash: %v", err)
+			return nil, err
+		}
+
+		result := make([]*adminpb.TrashEntry, 0, len(entries))
+		for _, entry := range entries {
+			deletedBy := ""
+			if entry.DeletedBy != nil {
+				deletedBy = *entry.DeletedBy
+			}
+			result = append(result, &adminpb.TrashEntry{
+				Id:          entry.ID,
+				RootTagId:   entry.RootTagID,
+				RootTagName: entry.RootTagName,
+				TagCount:    int32(len(entry.TagIDs)),
+				DeletedBy:   deletedBy,
+				DeletedAt:   timestamppb.New(entry.DeletedAt),
+				ExpiresAt:   timestamppb.New(entry.ExpiresAt),
+			})
+		}
+
+		return &adminpb.ListTrashAdminResponse{
+			Entries: result,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*adminpb.ListTrashAdminResponse), nil
+}
+
+func (s *AdminService) RestoreTree(ctx context.Context, req *adminpb.RestoreTreeAdminRequest) (*adminpb.RestoreTreeAdminResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if session.UserID == nil {
+			log.Printf("RestoreTree request from anonymous user")
+			return nil, status.Error(codes.Unauthenticated, "authentication required")
+		}
+
+		// Check for admin role
+		if !session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+			log.Printf("RestoreTree request from non-admin user %s", *session.UserID)
+			return nil, status.Error(codes.PermissionDenied, "admin role required")
+		}
+
+		if req.TrashId == "" {
+			return nil, status.Error(codes.InvalidArgument, "trash_id is required")
+		}
+
+		log.Printf("RestoreTree request from user %s for trash %s", *session.UserID, req.TrashId)
+
+		restoredIds, err := s.store.AdminStore().RestoreTree(ctx, req.TrashId, *session.UserID)
+		if err != nil {
+			log.Printf("Error restoring trash %s: %v", req.TrashId, err)
+			if _, ok := status.FromError(err); ok {
+				return nil, err
+			}
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to restore trash %s: %v", req.TrashId, err))
+		}
+
+		middleware.AuditTargets(ctx, restoredIds...)
+		middleware.AuditAfter(ctx, map[string]interface{}{"restoredIds": restoredIds})
+
+		return &adminpb.RestoreTreeAdminResponse{
+			RestoredIds: restoredIds,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*adminpb.RestoreTreeAdminResponse), nil
+}
+
+func (s *AdminService) PurgeTrash(ctx context.Context, req *adminpb.PurgeTrashAdminRequest) (*adminpb.PurgeTrashAdminResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if session.UserID == nil {
+			log.Printf("PurgeTrash request from anonymous user")
+			return nil, status.Error(codes.Unauthenticated, "authentication required")
+		}
+
+		// Check for admin role
+		if !session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+			log.Printf("PurgeTrash request from non-admin user %s", *session.UserID)
+			return nil, status.Error(codes.PermissionDenied, "admin role required")
+		}
+
+		log.Printf("PurgeTrash request from user %s for trash %q", *session.UserID, req.TrashId)
+
+		purged, err := s.store.AdminStore().PurgeTrash(ctx, req.TrashId)
+		if err != nil {
+			log.Printf("Error purging trash: %v", err)
+			return nil, err
+		}
+
+		middleware.AuditAfter(ctx, map[string]interface{}{"purged": purged})
+
+		return &adminpb.PurgeTrashAdminResponse{
+			Purged: int32(purged),
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*adminpb.PurgeTrashAdminResponse), nil
+}
+
+func (s *AdminService) ListContentAudit(ctx context.Context, req *adminpb.ListContentAuditAdminRequest) (*adminpb.ListContentAuditAdminResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if session.UserID == nil {
+			log.Printf("ListContentAudit request from anonymous user")
+			return nil, status.Error(codes.Unauthenticated, "authentication required")
+		}
+
+		// Check for admin role
+		if !session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+			log.Printf("ListContentAudit request from non-admin user %s", *session.UserID)
+			return nil, status.Error(codes.PermissionDenied, "admin role required")
+		}
+
+		log.Printf("ListContentAudit request from user %s", *session.UserID)
+
+		filter := &admin.ContentAuditFilter{
+			MissingContentRating:      req.MissingContentRating,
+			MissingContentDescriptors: req.MissingContentDescriptors,
+			MissingMetaTags:           req.MissingMetaTags,
+			AncestorID:                req.AncestorId,
+			Limit:                     int(req.Limit),
+			Offset:                    int(req.Offset),
+		}
+		if filter.Limit <= 0 {
+			filter.Limit = 100
+		}
+		if req.Context != nil {
+			contextName := req.Context.String()
+			filter.Context = &contextName
+		}
+
+		rows, total, err := s.store.AdminStore().ListContentAudit(ctx, filter)
+		if err != nil {
+			log.Printf("Error listing content audit: %v", err)
+			return nil, err
+		}
+
+		tags := make([]*adminpb.ContentAuditTag, 0, len(rows))
+		for _, row := range rows {
+			tag := &adminpb.ContentAuditTag{
+				Id:            row.ID,
+				Name:          row.Name,
+				ContentRating: sharedpb.ContentRating(sharedpb.ContentRating_value[row.ContentRating]),
+				MetaTags:      row.MetaTags,
+			}
+			if row.Context != nil {
+				contextType := sharedpb.ContextType(sharedpb.ContextType_value[*row.Context])
+				tag.Context = &contextType
+			}
+			if row.ParentName != nil {
+				tag.ParentName = *row.ParentName
+			}
+			for _, d := range row.ContentDescriptors {
+				if descriptor, ok := sharedpb.ContentDescriptorType_value[d]; ok {
+					tag.ContentDescriptors = append(tag.ContentDescriptors, sharedpb.ContentDescriptorType(descriptor))
+				}
+			}
+			tags = append(tags, tag)
+		}
+
+		return &adminpb.ListContentAuditAdminResponse{
+			Tags:  tags,
+			Total: total,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*adminpb.ListContentAuditAdminResponse), nil
+}
+
+func (s *AdminService) BulkUpdateContent(ctx context.Context, req *adminpb.BulkUpdateContentAdminRequest) (*adminpb.BulkUpdateContentAdminResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if session.UserID == nil {
+			log.Printf("BulkUpdateContent request from anonymous user")
+			return nil, status.Error(codes.Unauthenticated, "authentication required")
+		}
+
+		// Check for admin role
+		if !session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+			log.Printf("BulkUpdateContent request from non-admin user %s", *session.UserID)
+			return nil, status.Error(codes.PermissionDenied, "admin role required")
+		}
+
+		log.Printf("BulkUpdateContent request from user %s for %d tags", *session.UserID, len(req.TagIds))
+
+		update := &admin.ContentUpdate{
+			TagIDs:                req.TagIds,
+			SetContentDescriptors: req.SetContentDescriptors,
+			MetaTags:              req.MetaTags,
+			SetMetaTags:           req.SetMetaTags,
+		}
+		if req.ContentRating != nil {
+			if *req.ContentRating == sharedpb.ContentRating_Unspecified {
+				return nil, status.Error(codes.InvalidArgument, "content_rating cannot be unspecified")
+			}
+			rating := req.ContentRating.String()
+			update.ContentRating = &rating
+		}
+		for _, d := range req.ContentDescriptors {
+			if d == sharedpb.ContentDescriptorType_CONTENT_DESCRIPTOR_TYPE_UNSPECIFIED {
+				continue
+			}
+			update.ContentDescriptors = append(update.ContentDescriptors, d.String())
+		}
+
+		updatedIDs, err := s.store.AdminStore().BulkUpdateContent(ctx, update)
+		if err != nil {
+			log.Printf("Error bulk updating content: %v", err)
+			return nil, err
+		}
+
+		middleware.AuditTargets(ctx, updatedIDs...)
+		middleware.AuditAfter(ctx, update)
+
+		return &adminpb.BulkUpdateContentAdminResponse{
+			UpdatedIds: updatedIDs,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*adminpb.BulkUpdateContentAdminResponse), nil
+}
+
+func (s *AdminService) BulkUpdateMetadata(ctx context.Context, req *adminpb.BulkUpdateMetadataAdminRequest) (*adminpb.BulkUpdateMetadataAdminResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if session.UserID == nil {
+			log.Printf("BulkUpdateMetadata request from anonymous user")
+			return nil, status.Error(codes.Unauthenticated, "authentication required")
+		}
+
+		// Check for admin role
+		if !session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+			log.Printf("BulkUpdateMetadata request from non-admin user %s", *session.UserID)
+			return nil, status.Error(codes.PermissionDenied, "admin role required")
+		}
+
+		log.Printf("BulkUpdateMetadata request from user %s for %s (dry run %v)", *session.UserID, req.ObjectType, req.DryRun)
+
+		filter := &admin.MetadataFilter{
+			ObjectType: req.ObjectType,
+			SubtreeID:  req.SubtreeId,
+			BatchID:    req.BatchId,
+			Key:        req.Key,
+			Value:      req.Value,
+		}
+		if req.ParserType != nil {
+			filter.ParserType = req.ParserType.String()
+		}
+		patch := &admin.MetadataPatch{
+			MergePatch: []byte(req.MergePatch),
+			Set:        req.Set,
+			Unset:      req.Unset,
+		}
+
+		result, err := s.store.AdminStore().BulkUpdateMetadata(ctx, filter, patch, req.DryRun)
+		if err != nil {
+			log.Printf("Error bulk updating metadata: %v", err)
+			return nil, err
+		}
+
+		if !req.DryRun {
+			middleware.AuditTargets(ctx, result.AffectedIDs...)
+		}
+
+		return &adminpb.BulkUpdateMetadataAdminResponse{
+			Matched:     int32(result.Matched),
+			AffectedIds: result.AffectedIDs,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*adminpb.BulkUpdateMetadataAdminResponse), nil
+}
+
+func (s *AdminService) ListAuditEvents(ctx context.Context, req *adminpb.ListAuditEventsAdminRequest) (*adminpb.ListAuditEventsAdminResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if session.UserID == nil {
+			log.Printf("ListAuditEvents request from anonymous user")
+			return nil, status.Error(codes.Unauthenticated, "authentication required")
+		}
+
+		// Check for admin role
+		if !session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+			log.Printf("ListAuditEvents request from non-admin user %s", *session.UserID)
+			return nil, status.Error(codes.PermissionDenied, "admin role required")
+		}
+
+		log.Printf("ListAuditEvents request from user %s", *session.UserID)
+
+		filter := &audit.Filter{
+			ActorID:  req.ActorId,
+			Method:   req.Method,
+			TargetID: req.TargetId,
+			Outcome:  req.Outcome,
+			Limit:    int(req.Limit),
+			Offset:   int(req.Offset),
+		}
+		if filter.Limit <= 0 {
+			filter.Limit = 100
+		}
+		if req.Since != nil {
+			since := req.Since.AsTime()
+			filter.Since = &since
+		}
+		if req.Until != nil {
+			until := req.Until.AsTime()
+			filter.Until = &until
+		}
+
+		events, total, err := s.store.AuditStore().ListEvents(ctx, filter)
+		if err != nil {
+			log.Printf("Error listing audit events: %v", err)
+			return nil, err
+		}
+
+		response := &adminpb.ListAuditEventsAdminResponse{
+			Total: total,
+		}
+		for _, event := range events {
+			response.Events = append(response.Events, newAuditEvent(event))
+		}
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*adminpb.ListAuditEventsAdminResponse), nil
+}
+
+func newAuditEvent(event *audit.Event) *adminpb.AuditEvent {
+	result := &adminpb.AuditEvent{
+		Id:          event.ID,
+		Roles:       event.Roles,
+		Method:      event.Method,
+		TargetIds:   event.TargetIDs,
+		RequestJson: string(event.Request),
+		Outcome:     event.Outcome,
+		BeforeJson:  string(event.Before),
+		AfterJson:   string(event.After),
+		DurationMs:  event.DurationMs,
+		CreatedAt:   timestamppb.New(event.CreatedAt),
+	}
+	if event.ActorID != nil {
+		result.ActorId = *event.ActorID
+	}
+	if event.ErrorMessage != nil {
+		result.ErrorMessage = *event.ErrorMessage
+	}
+	return result
+}
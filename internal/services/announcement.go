@@ -0,0 +1,243 @@
+package services
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	announcementpb "github.com/studyguides-com/study-guides-api/api/v1/announcement"
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	"github.com/studyguides-com/study-guides-api/internal/middleware"
+	"github.com/studyguides-com/study-guides-api/internal/store"
+	"github.com/studyguides-com/study-guides-api/internal/store/announcement"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var announcementTypes = map[announcementpb.AnnouncementType]string{
+	announcementpb.AnnouncementType_ANNOUNCEMENT_TYPE_INFO:   announcement.TypeInfo,
+	announcementpb.AnnouncementType_ANNOUNCEMENT_TYPE_WARN:   announcement.TypeWarn,
+	announcementpb.AnnouncementType_ANNOUNCEMENT_TYPE_DANGER: announcement.TypeDanger,
+}
+
+type AnnouncementService struct {
+	announcementpb.UnimplementedAnnouncementServiceServer
+	store store.Store
+}
+
+func NewAnnouncementService(store store.Store) *AnnouncementService {
+	return &AnnouncementService{
+		store: store,
+	}
+}
+
+// checkAnnouncementAccess limits managing announcements to admins
+func checkAnnouncementAccess(session *middleware.SessionDetails, method string) error {
+	if !session.IsAuth {
+		log.Printf("%s request from anonymous user", method)
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if !session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+		log.Printf("%s request from non-admin user %s", method, *session.UserID)
+		return status.Error(codes.PermissionDenied, "admin role required")
+	}
+	log.Printf("%s request from user %s", method, *session.UserID)
+	return nil
+}
+
+// ListActive is open to anonymous callers, who only see untargeted announcements and can't dismiss
+func (s *AnnouncementService) ListActive(ctx context.Context, req *announcementpb.ListActiveRequest) (*announcementpb.ListAnnouncementsResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		active, err := s.store.AnnouncementStore().ListActive(ctx, sessionUserID(session))
+		if err != nil {
+			return nil, err
+		}
+
+		response := &announcementpb.ListAnnouncementsResponse{}
+		for _, a := range active {
+			if announcementTargets(a, session, req.Context) {
+				response.Announcements = append(response.Announcements, newAnnouncement(a))
+			}
+		}
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*announcementpb.ListAnnouncementsResponse), nil
+}
+
+func (s *AnnouncementService) Dismiss(ctx context.Context, req *announcementpb.DismissRequest) (*announcementpb.DismissResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if !session.IsAuth {
+			return nil, status.Error(codes.Unauthenticated, "user must be authenticated to dismiss announcements")
+		}
+		if req.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, "id is required")
+		}
+
+		if err := s.store.AnnouncementStore().Dismiss(ctx, req.Id, *session.UserID); err != nil {
+			return nil, err
+		}
+		return &announcementpb.DismissResponse{
+			Success: true,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*announcementpb.DismissResponse), nil
+}
+
+func (s *AnnouncementService) ListAll(ctx context.Context, req *announcementpb.ListAllRequest) (*announcementpb.ListAnnouncementsResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkAnnouncementAccess(session, "ListAll"); err != nil {
+			return nil, err
+		}
+
+		all, err := s.store.AnnouncementStore().ListAnnouncements(ctx, req.ActiveOnly)
+		if err != nil {
+			return nil, err
+		}
+
+		response := &announcementpb.ListAnnouncementsResponse{}
+		for _, a := range all {
+			response.Announcements = append(response.Announcements, newAnnouncement(a))
+		}
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*announcementpb.ListAnnouncementsResponse), nil
+}
+
+func (s *AnnouncementService) CreateAnnouncement(ctx context.Context, req *announcementpb.CreateAnnouncementRequest) (*announcementpb.Announcement, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkAnnouncementAccess(session, "CreateAnnouncement"); err != nil {
+			return nil, err
+		}
+
+		message := strings.TrimSpace(req.Message)
+		if message == "" {
+			return nil, status.Error(codes.InvalidArgument, "message is required")
+		}
+		announcementType, ok := announcementTypes[req.Type]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "type is required")
+		}
+
+		draft := &announcement.NewAnnouncement{
+			Message:     message,
+			Type:        announcementType,
+			Dismissible: req.Dismissible,
+		}
+		for _, role := range req.Roles {
+			if role == sharedpb.UserRole_USER_ROLE_UNSPECIFIED {
+				return nil, status.Error(codes.InvalidArgument, "roles can't include USER_ROLE_UNSPECIFIED")
+			}
+			draft.Roles = append(draft.Roles, role.String())
+		}
+		for _, contextType := range req.Contexts {
+			if contextType == sharedpb.ContextType_All {
+				return nil, status.Error(codes.InvalidArgument, "leave contexts empty to target all of them")
+			}
+			draft.Contexts = append(draft.Contexts, contextType.String())
+		}
+
+		created, err := s.store.AnnouncementStore().CreateAnnouncement(ctx, draft)
+		if err != nil {
+			return nil, err
+		}
+		return newAnnouncement(created), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*announcementpb.Announcement), nil
+}
+
+func (s *AnnouncementService) ActivateAnnouncement(ctx context.Context, req *announcementpb.SetAnnouncementActiveRequest) (*announcementpb.Announcement, error) {
+	return s.setActive(ctx, req.Id, true, "ActivateAnnouncement")
+}
+
+func (s *AnnouncementService) DeactivateAnnouncement(ctx context.Context, req *announcementpb.SetAnnouncementActiveRequest) (*announcementpb.Announcement, error) {
+	return s.setActive(ctx, req.Id, false, "DeactivateAnnouncement")
+}
+
+func (s *AnnouncementService) setActive(ctx context.Context, id string, active bool, method string) (*announcementpb.Announcement, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkAnnouncementAccess(session, method); err != nil {
+			return nil, err
+		}
+		if id == "" {
+			return nil, status.Error(codes.InvalidArgument, "id is required")
+		}
+
+		updated, err := s.store.AnnouncementStore().SetActive(ctx, id, active)
+		if err != nil {
+			return nil, err
+		}
+		return newAnnouncement(updated), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*announcementpb.Announcement), nil
+}
+
+// announcementTargets reports whether an announcement is aimed at the caller's roles and the client's context
+func announcementTargets(a *announcement.Announcement, session *middleware.SessionDetails, contextType *sharedpb.ContextType) bool {
+	if len(a.Roles) > 0 {
+		matched := false
+		for _, role := range a.Roles {
+			if session.HasRole(sharedpb.UserRole(sharedpb.UserRole_value[role])) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if len(a.Contexts) > 0 {
+		if contextType == nil {
+			return false
+		}
+		for _, name := range a.Contexts {
+			if name == contextType.String() {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func newAnnouncement(a *announcement.Announcement) *announcementpb.Announcement {
+	response := &announcementpb.Announcement{
+		Id:          a.ID,
+		Message:     a.Message,
+		Dismissible: a.Dismissible,
+		IsActive:    a.IsActive,
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+		UpdatedAt:   timestamppb.New(a.UpdatedAt),
+	}
+	for pbType, name := range announcementTypes {
+		if name == a.Type {
+			response.Type = pbType
+		}
+	}
+	for _, role := range a.Roles {
+		if value, ok := sharedpb.UserRole_value[role]; ok {
+			response.Roles = append(response.Roles, sharedpb.UserRole(value))
+		}
+	}
+	for _, name := range a.Contexts {
+		if value, ok := sharedpb.ContextType_value[name]; ok {
+			response.Contexts = append(response.Contexts, sharedpb.ContextType(value))
+		}
+	}
+	return response
+}
@@ -0,0 +1,344 @@
+package services
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"strings"
+
+	articlepb "github.com/studyguides-com/study-guides-api/api/v1/article"
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	"github.com/studyguides-com/study-guides-api/internal/middleware"
+	"github.com/studyguides-com/study-guides-api/internal/store"
+	"github.com/studyguides-com/study-guides-api/internal/store/article"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultArticlePageSize = 20
+	maxArticlePageSize     = 100
+	defaultArticlesLimit   = 50
+)
+
+var articleStatuses = map[articlepb.ArticleStatus]string{
+	articlepb.ArticleStatus_ARTICLE_STATUS_DRAFT:     article.StatusDraft,
+	articlepb.ArticleStatus_ARTICLE_STATUS_PUBLISHED: article.StatusPublished,
+}
+
+type ArticleService struct {
+	articlepb.UnimplementedArticleServiceServer
+	store store.Store
+}
+
+func NewArticleService(store store.Store) *ArticleService {
+	return &ArticleService{
+		store: store,
+	}
+}
+
+// checkArticleAccess limits managing articles to admins
+func checkArticleAccess(session *middleware.SessionDetails, method string) error {
+	if !session.IsAuth {
+		log.Printf("%s request from anonymous user", method)
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if !session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+		log.Printf("%s request from non-admin user %s", method, *session.UserID)
+		return status.Error(codes.PermissionDenied, "admin role required")
+	}
+	log.Printf("%s request from user %s", method, *session.UserID)
+	return nil
+}
+
+// ListArticles pages through published articles, the page token is the offset of the next page
+func (s *ArticleService) ListArticles(ctx context.Context, req *articlepb.ListArticlesRequest) (*articlepb.ListArticlesResponse, error) {
+	resp, err := PublicBaseHandler(ctx, func(ctx context.Context) (interface{}, error) {
+		pageSize := int(req.PageSize)
+		if pageSize <= 0 {
+			pageSize = defaultArticlePageSize
+		}
+		if pageSize > maxArticlePageSize {
+			pageSize = maxArticlePageSize
+		}
+		offset := 0
+		if req.PageToken != "" {
+			var err error
+			if offset, err = strconv.Atoi(req.PageToken); err != nil || offset < 0 {
+				return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+			}
+		}
+
+		published := article.StatusPublished
+		articles, err := s.store.ArticleStore().ListArticles(ctx, &article.ArticleFilter{
+			Status:   &published,
+			Category: req.Category,
+			Limit:    pageSize + 1,
+			Offset:   offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		response := &articlepb.ListArticlesResponse{}
+		if len(articles) > pageSize {
+			articles = articles[:pageSize]
+			response.NextPageToken = strconv.Itoa(offset + pageSize)
+		}
+		for _, a := range articles {
+			response.Articles = append(response.Articles, newArticle(a))
+		}
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*articlepb.ListArticlesResponse), nil
+}
+
+func (s *ArticleService) GetArticle(ctx context.Context, req *articlepb.GetArticleRequest) (*articlepb.Article, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if req.Slug == "" {
+			return nil, status.Error(codes.InvalidArgument, "slug is required")
+		}
+
+		a, err := s.store.ArticleStore().GetArticleBySlug(ctx, req.Slug)
+		if err != nil {
+			return nil, err
+		}
+		// Drafts look missing to everyone but admins
+		if a.Status != article.StatusPublished && !session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+			return nil, status.Errorf(codes.NotFound, "article %s not found", req.Slug)
+		}
+		return newArticle(a), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*articlepb.Article), nil
+}
+
+func (s *ArticleService) ListCategories(ctx context.Context, req *articlepb.ListCategoriesRequest) (*articlepb.ListCategoriesResponse, error) {
+	resp, err := PublicBaseHandler(ctx, func(ctx context.Context) (interface{}, error) {
+		categories, err := s.store.ArticleStore().ListCategories(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		response := &articlepb.ListCategoriesResponse{}
+		for _, c := range categories {
+			response.Categories = append(response.Categories, &articlepb.ArticleCategory{
+				Name:         c.Name,
+				ArticleCount: int32(c.ArticleCount),
+			})
+		}
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*articlepb.ListCategoriesResponse), nil
+}
+
+func (s *ArticleService) ListAllArticles(ctx context.Context, req *articlepb.ListAllArticlesRequest) (*articlepb.ListArticlesResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkArticleAccess(session, "ListAllArticles"); err != nil {
+			return nil, err
+		}
+
+		filter := &article.ArticleFilter{
+			Category: req.Category,
+			Limit:    int(req.Limit),
+			Offset:   int(req.Offset),
+		}
+		if articleStatus, ok := articleStatuses[req.Status]; ok {
+			filter.Status = &articleStatus
+		}
+		if filter.Limit <= 0 {
+			filter.Limit = defaultArticlesLimit
+		}
+
+		articles, err := s.store.ArticleStore().ListArticles(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		response := &articlepb.ListArticlesResponse{}
+		for _, a := range articles {
+			response.Articles = append(response.Articles, newArticle(a))
+		}
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*articlepb.ListArticlesResponse), nil
+}
+
+func (s *ArticleService) CreateArticle(ctx context.Context, req *articlepb.CreateArticleRequest) (*articlepb.Article, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkArticleAccess(session, "CreateArticle"); err != nil {
+			return nil, err
+		}
+
+		draft := &article.NewArticle{
+			Title:       strings.TrimSpace(req.Title),
+			Content:     req.Content,
+			Description: strings.TrimSpace(req.Description),
+			Category:    strings.TrimSpace(req.Category),
+			ImageURL:    strings.TrimSpace(req.ImageUrl),
+			Publish:     req.Publish,
+			CreatedBy:   *session.UserID,
+		}
+		if draft.Title == "" {
+			return nil, status.Error(codes.InvalidArgument, "title is required")
+		}
+		if strings.TrimSpace(draft.Content) == "" {
+			return nil, status.Error(codes.InvalidArgument, "content is required")
+		}
+		if draft.Category == "" {
+			return nil, status.Error(codes.InvalidArgument, "category is required")
+		}
+		if req.Slug != nil {
+			if !article.ValidSlug(*req.Slug) {
+				return nil, status.Error(codes.InvalidArgument, "slug must be lowercase letters and digits separated by dashes")
+			}
+			draft.Slug = *req.Slug
+		}
+
+		created, err := s.store.ArticleStore().CreateArticle(ctx, draft)
+		if err != nil {
+			return nil, err
+		}
+		return newArticle(created), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*articlepb.Article), nil
+}
+
+func (s *ArticleService) UpdateArticle(ctx context.Context, req *articlepb.UpdateArticleRequest) (*articlepb.Article, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkArticleAccess(session, "UpdateArticle"); err != nil {
+			return nil, err
+		}
+		if req.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, "id is required")
+		}
+		if req.Title != nil && strings.TrimSpace(*req.Title) == "" {
+			return nil, status.Error(codes.InvalidArgument, "title can't be empty")
+		}
+		if req.Content != nil && strings.TrimSpace(*req.Content) == "" {
+			return nil, status.Error(codes.InvalidArgument, "content can't be empty")
+		}
+		if req.Category != nil && strings.TrimSpace(*req.Category) == "" {
+			return nil, status.Error(codes.InvalidArgument, "category can't be empty")
+		}
+		if req.Slug != nil && !article.ValidSlug(*req.Slug) {
+			return nil, status.Error(codes.InvalidArgument, "slug must be lowercase letters and digits separated by dashes")
+		}
+
+		updated, err := s.store.ArticleStore().UpdateArticle(ctx, req.Id, &article.ArticleUpdate{
+			Title:       trimmed(req.Title),
+			Content:     req.Content,
+			Slug:        req.Slug,
+			Description: trimmed(req.Description),
+			Category:    trimmed(req.Category),
+			ImageURL:    trimmed(req.ImageUrl),
+			UpdatedBy:   *session.UserID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return newArticle(updated), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*articlepb.Article), nil
+}
+
+func (s *ArticleService) PublishArticle(ctx context.Context, req *articlepb.ArticleIdRequest) (*articlepb.Article, error) {
+	return s.setStatus(ctx, req.Id, article.StatusPublished, "PublishArticle")
+}
+
+func (s *ArticleService) UnpublishArticle(ctx context.Context, req *articlepb.ArticleIdRequest) (*articlepb.Article, error) {
+	return s.setStatus(ctx, req.Id, article.StatusDraft, "UnpublishArticle")
+}
+
+func (s *ArticleService) setStatus(ctx context.Context, id, toStatus, method string) (*articlepb.Article, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkArticleAccess(session, method); err != nil {
+			return nil, err
+		}
+		if id == "" {
+			return nil, status.Error(codes.InvalidArgument, "id is required")
+		}
+
+		updated, err := s.store.ArticleStore().SetStatus(ctx, id, toStatus, *session.UserID)
+		if err != nil {
+			return nil, err
+		}
+		return newArticle(updated), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*articlepb.Article), nil
+}
+
+func (s *ArticleService) DeleteArticle(ctx context.Context, req *articlepb.ArticleIdRequest) (*articlepb.DeleteArticleResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkArticleAccess(session, "DeleteArticle"); err != nil {
+			return nil, err
+		}
+		if req.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, "id is required")
+		}
+
+		if err := s.store.ArticleStore().DeleteArticle(ctx, req.Id); err != nil {
+			return nil, err
+		}
+		return &articlepb.DeleteArticleResponse{
+			Success: true,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*articlepb.DeleteArticleResponse), nil
+}
+
+// trimmed trims an optional field, keeping nil as nil
+func trimmed(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	t := strings.TrimSpace(*value)
+	return &t
+}
+
+func newArticle(a *article.Article) *articlepb.Article {
+	response := &articlepb.Article{
+		Id:          a.ID,
+		Title:       a.Title,
+		Content:     a.Content,
+		Slug:        a.Slug,
+		Description: a.Description,
+		Category:    a.Category,
+		ImageUrl:    a.ImageURL,
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+		UpdatedAt:   timestamppb.New(a.UpdatedAt),
+	}
+	for pbStatus, name := range articleStatuses {
+		if name == a.Status {
+			response.Status = pbStatus
+		}
+	}
+	if a.PublishedAt != nil {
+		response.PublishedAt = timestamppb.New(*a.PublishedAt)
+	}
+	return response
+}
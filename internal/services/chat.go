@@ -8,6 +8,7 @@ import (
 
 	chatpb "github.com/studyguides-com/study-guides-api/api/v1/chat"
 	"github.com/studyguides-com/study-guides-api/internal/lib/ai"
+	"github.com/studyguides-com/study-guides-api/internal/lib/prompts"
 	"github.com/studyguides-com/study-guides-api/internal/mcp"
 	"github.com/studyguides-com/study-guides-api/internal/mcp/indexing"
 	"github.com/studyguides-com/study-guides-api/internal/mcp/kpi"
@@ -205,9 +206,10 @@ type ChatService struct {
 	store        store.Store
 }
 
-func NewChatService(store store.Store, ai ai.AiClient) *ChatService {
-	// Create MCP processor
+func NewChatService(store store.Store, ai ai.AiClient, prompts *prompts.Registry) *ChatService {
+	// Create MCP processor, the system prompt comes from the prompt registry when one is stored
 	mcpProcessor := mcp.NewMCPProcessor(ai)
+	mcpProcessor.SetPromptRegistry(prompts)
 	
 	// Register tag repository
 	tagRepo := tag.NewTagRepositoryAdapter(store.TagStore())
@@ -0,0 +1,364 @@
+package services
+
+import (
+	"context"
+	"log"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+
+	contactpb "github.com/studyguides-com/study-guides-api/api/v1/contact"
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	"github.com/studyguides-com/study-guides-api/internal/middleware"
+	"github.com/studyguides-com/study-guides-api/internal/store"
+	"github.com/studyguides-com/study-guides-api/internal/store/contact"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultContactsLimit    = 50
+	maxContactNameLength    = 200
+	maxContactMessageLength = 5000
+)
+
+var contactStatuses = map[contactpb.ContactStatus]string{
+	contactpb.ContactStatus_CONTACT_STATUS_PENDING:  contact.StatusPending,
+	contactpb.ContactStatus_CONTACT_STATUS_RESOLVED: contact.StatusResolved,
+	contactpb.ContactStatus_CONTACT_STATUS_CLOSED:   contact.StatusClosed,
+}
+
+var contactEventTypes = map[string]contactpb.ContactEventType{
+	contact.EventSubmitted:     contactpb.ContactEventType_CONTACT_EVENT_TYPE_SUBMITTED,
+	contact.EventAssigned:      contactpb.ContactEventType_CONTACT_EVENT_TYPE_ASSIGNED,
+	contact.EventReplied:       contactpb.ContactEventType_CONTACT_EVENT_TYPE_REPLIED,
+	contact.EventStatusChanged: contactpb.ContactEventType_CONTACT_EVENT_TYPE_STATUS_CHANGED,
+}
+
+// Spam heuristics. A filled honeypot is spam on its own, otherwise it takes two signals.
+var (
+	contactLinkPattern   = regexp.MustCompile(`(?i)https?://|www\.`)
+	contactSpamKeywords  = []string{"casino", "viagra", "crypto", "bitcoin", "backlinks", "seo services", "loan offer", "forex"}
+	disposableEmailHosts = map[string]bool{
+		"mailinator.com": true, "guerrillamail.com": true, "10minutemail.com": true,
+		"tempmail.com": true, "yopmail.com": true, "trashmail.com": true,
+	}
+)
+
+const (
+	contactMaxLinks        = 2
+	contactBurstWindow     = time.Hour
+	contactBurstPerEmail   = 3
+	contactDuplicateWindow = 24 * time.Hour
+)
+
+type ContactService struct {
+	contactpb.UnimplementedContactServiceServer
+	store store.Store
+}
+
+func NewContactService(store store.Store) *ContactService {
+	return &ContactService{
+		store: store,
+	}
+}
+
+// checkContactAccess limits triaging contacts to admins
+func checkContactAccess(session *middleware.SessionDetails, method string) error {
+	if !session.IsAuth {
+		log.Printf("%s request from anonymous user", method)
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if !session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+		log.Printf("%s request from non-admin user %s", method, *session.UserID)
+		return status.Error(codes.PermissionDenied, "admin role required")
+	}
+	log.Printf("%s request from user %s", method, *session.UserID)
+	return nil
+}
+
+// SubmitContact is open to anonymous callers. Spam is saved for review but reported as a success
+// so senders can't probe the heuristics.
+func (s *ContactService) SubmitContact(ctx context.Context, req *contactpb.SubmitContactRequest) (*contactpb.SubmitContactResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		name := strings.TrimSpace(req.Name)
+		message := strings.TrimSpace(req.Message)
+		if name == "" {
+			return nil, status.Error(codes.InvalidArgument, "name is required")
+		}
+		if message == "" {
+			return nil, status.Error(codes.InvalidArgument, "message is required")
+		}
+		if len(name) > maxContactNameLength {
+			return nil, status.Errorf(codes.InvalidArgument, "name must be at most %d characters", maxContactNameLength)
+		}
+		if len(message) > maxContactMessageLength {
+			return nil, status.Errorf(codes.InvalidArgument, "message must be at most %d characters", maxContactMessageLength)
+		}
+		address, err := mail.ParseAddress(strings.TrimSpace(req.Email))
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "a valid email is required")
+		}
+
+		draft := &contact.NewContact{
+			Name:    name,
+			Email:   address.Address,
+			Message: message,
+		}
+		if req.Phone != nil && strings.TrimSpace(*req.Phone) != "" {
+			phone := strings.TrimSpace(*req.Phone)
+			draft.Phone = &phone
+		}
+		if session.IsAuth {
+			draft.UserID = session.UserID
+		}
+
+		draft.Spam, draft.SpamReasons, err = s.contactSpamCheck(ctx, draft, req.Website)
+		if err != nil {
+			return nil, err
+		}
+
+		created, err := s.store.ContactStore().CreateContact(ctx, draft)
+		if err != nil {
+			return nil, err
+		}
+		if created.Spam {
+			log.Printf("SubmitContact flagged contact %s as spam: %s", created.ID, strings.Join(created.SpamReasons, ", "))
+		}
+		return &contactpb.SubmitContactResponse{
+			Success: true,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*contactpb.SubmitContactResponse), nil
+}
+
+func (s *ContactService) ListContacts(ctx context.Context, req *contactpb.ListContactsRequest) (*contactpb.ListContactsResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkContactAccess(session, "ListContacts"); err != nil {
+			return nil, err
+		}
+
+		filter := &contact.ContactFilter{
+			AssignedTo:  req.AssignedTo,
+			IncludeSpam: req.IncludeSpam,
+			Limit:       int(req.Limit),
+			Offset:      int(req.Offset),
+		}
+		if contactStatus, ok := contactStatuses[req.Status]; ok {
+			filter.Status = &contactStatus
+		}
+		if filter.Limit <= 0 {
+			filter.Limit = defaultContactsLimit
+		}
+
+		contacts, err := s.store.ContactStore().ListContacts(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		response := &contactpb.ListContactsResponse{}
+		for _, c := range contacts {
+			response.Contacts = append(response.Contacts, newContact(c))
+		}
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*contactpb.ListContactsResponse), nil
+}
+
+func (s *ContactService) GetContact(ctx context.Context, req *contactpb.GetContactRequest) (*contactpb.GetContactResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkContactAccess(session, "GetContact"); err != nil {
+			return nil, err
+		}
+		if req.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, "id is required")
+		}
+
+		c, err := s.store.ContactStore().GetContact(ctx, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		events, err := s.store.ContactStore().ListEvents(ctx, req.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		response := &contactpb.GetContactResponse{
+			Contact: newContact(c),
+		}
+		for _, e := range events {
+			response.History = append(response.History, newContactEvent(e))
+		}
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*contactpb.GetContactResponse), nil
+}
+
+func (s *ContactService) AssignContact(ctx context.Context, req *contactpb.AssignContactRequest) (*contactpb.Contact, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkContactAccess(session, "AssignContact"); err != nil {
+			return nil, err
+		}
+		if req.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, "id is required")
+		}
+
+		updated, err := s.store.ContactStore().Assign(ctx, req.Id, *session.UserID, strings.TrimSpace(req.AssigneeId))
+		if err != nil {
+			return nil, err
+		}
+		return newContact(updated), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*contactpb.Contact), nil
+}
+
+func (s *ContactService) ReplyToContact(ctx context.Context, req *contactpb.ReplyToContactRequest) (*contactpb.ContactEvent, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkContactAccess(session, "ReplyToContact"); err != nil {
+			return nil, err
+		}
+		if req.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, "id is required")
+		}
+		body := strings.TrimSpace(req.Body)
+		if body == "" {
+			return nil, status.Error(codes.InvalidArgument, "body is required")
+		}
+
+		event, err := s.store.ContactStore().Reply(ctx, req.Id, *session.UserID, body)
+		if err != nil {
+			return nil, err
+		}
+		return newContactEvent(event), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*contactpb.ContactEvent), nil
+}
+
+func (s *ContactService) ResolveContact(ctx context.Context, req *contactpb.SetContactStatusRequest) (*contactpb.Contact, error) {
+	return s.setStatus(ctx, req, contact.StatusResolved, "ResolveContact")
+}
+
+func (s *ContactService) CloseContact(ctx context.Context, req *contactpb.SetContactStatusRequest) (*contactpb.Contact, error) {
+	return s.setStatus(ctx, req, contact.StatusClosed, "CloseContact")
+}
+
+func (s *ContactService) setStatus(ctx context.Context, req *contactpb.SetContactStatusRequest, toStatus, method string) (*contactpb.Contact, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkContactAccess(session, method); err != nil {
+			return nil, err
+		}
+		if req.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, "id is required")
+		}
+
+		updated, err := s.store.ContactStore().SetStatus(ctx, req.Id, *session.UserID, toStatus, strings.TrimSpace(req.Note))
+		if err != nil {
+			return nil, err
+		}
+		return newContact(updated), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*contactpb.Contact), nil
+}
+
+// contactSpamCheck runs the spam heuristics and returns every reason that matched
+func (s *ContactService) contactSpamCheck(ctx context.Context, c *contact.NewContact, honeypot string) (bool, []string, error) {
+	var reasons []string
+	if strings.TrimSpace(honeypot) != "" {
+		reasons = append(reasons, "honeypot")
+	}
+	if links := len(contactLinkPattern.FindAllString(c.Message, -1)); links > contactMaxLinks {
+		reasons = append(reasons, "links")
+	}
+	if contactLinkPattern.MatchString(c.Name) {
+		reasons = append(reasons, "link in name")
+	}
+	lower := strings.ToLower(c.Message)
+	for _, keyword := range contactSpamKeywords {
+		if strings.Contains(lower, keyword) {
+			reasons = append(reasons, "keyword")
+			break
+		}
+	}
+	if at := strings.LastIndex(c.Email, "@"); at >= 0 && disposableEmailHosts[strings.ToLower(c.Email[at+1:])] {
+		reasons = append(reasons, "disposable email")
+	}
+
+	now := time.Now()
+	recent, err := s.store.ContactStore().CountRecent(ctx, c.Email, c.Message, now.Add(-contactBurstWindow), now.Add(-contactDuplicateWindow))
+	if err != nil {
+		return false, nil, err
+	}
+	if recent.SameEmail >= contactBurstPerEmail {
+		reasons = append(reasons, "burst")
+	}
+	if recent.SameMessage > 0 {
+		reasons = append(reasons, "duplicate")
+	}
+
+	spam := strings.TrimSpace(honeypot) != "" || len(reasons) >= 2
+	return spam, reasons, nil
+}
+
+func newContact(c *contact.Contact) *contactpb.Contact {
+	return &contactpb.Contact{
+		Id:          c.ID,
+		Name:        c.Name,
+		Email:       c.Email,
+		Phone:       c.Phone,
+		Message:     c.Message,
+		Status:      contactStatusToProto(c.Status),
+		UserId:      c.UserID,
+		AssignedTo:  c.AssignedTo,
+		Spam:        c.Spam,
+		SpamReasons: c.SpamReasons,
+		CreatedAt:   timestamppb.New(c.CreatedAt),
+		UpdatedAt:   timestamppb.New(c.UpdatedAt),
+	}
+}
+
+func newContactEvent(e *contact.Event) *contactpb.ContactEvent {
+	response := &contactpb.ContactEvent{
+		Id:        e.ID,
+		ContactId: e.ContactID,
+		Type:      contactEventTypes[e.Type],
+		ActorId:   e.ActorID,
+		Body:      e.Body,
+		CreatedAt: timestamppb.New(e.CreatedAt),
+	}
+	if e.FromStatus != nil {
+		response.FromStatus = contactStatusToProto(*e.FromStatus)
+	}
+	if e.ToStatus != nil {
+		response.ToStatus = contactStatusToProto(*e.ToStatus)
+	}
+	return response
+}
+
+func contactStatusToProto(name string) contactpb.ContactStatus {
+	for pbStatus, statusName := range contactStatuses {
+		if statusName == name {
+			return pbStatus
+		}
+	}
+	return contactpb.ContactStatus_CONTACT_STATUS_UNSPECIFIED
+}
@@ -32,6 +32,7 @@ func (s *DevopsService) Deploy(ctx context.Context, req *devopspb.DeployRequest)
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
+		middleware.AuditTargets(ctx, deploymentID)
 		return &devopspb.DeployResponse{DeploymentId: deploymentID}, nil
 	})
 	if err != nil {
@@ -51,6 +52,7 @@ func (s *DevopsService) Rollback(ctx context.Context, req *devopspb.RollbackRequ
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
+		middleware.AuditTargets(ctx, deploymentID)
 		return &devopspb.RollbackResponse{DeploymentId: deploymentID}, nil
 	})
 	if err != nil {
@@ -0,0 +1,172 @@
+package services
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	faqpb "github.com/studyguides-com/study-guides-api/api/v1/faq"
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	"github.com/studyguides-com/study-guides-api/internal/middleware"
+	"github.com/studyguides-com/study-guides-api/internal/store"
+	"github.com/studyguides-com/study-guides-api/internal/store/faq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type FaqService struct {
+	faqpb.UnimplementedFaqServiceServer
+	store store.Store
+}
+
+func NewFaqService(store store.Store) *FaqService {
+	return &FaqService{
+		store: store,
+	}
+}
+
+// checkFaqAccess limits editing FAQs to admins
+func checkFaqAccess(session *middleware.SessionDetails, method string) error {
+	if !session.IsAuth {
+		log.Printf("%s request from anonymous user", method)
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if !session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+		log.Printf("%s request from non-admin user %s", method, *session.UserID)
+		return status.Error(codes.PermissionDenied, "admin role required")
+	}
+	log.Printf("%s request from user %s", method, *session.UserID)
+	return nil
+}
+
+func (s *FaqService) ListFaqs(ctx context.Context, req *faqpb.ListFaqsRequest) (*faqpb.ListFaqsResponse, error) {
+	resp, err := PublicBaseHandler(ctx, func(ctx context.Context) (interface{}, error) {
+		faqs, err := s.store.FaqStore().ListFaqs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		response := &faqpb.ListFaqsResponse{}
+		for _, f := range faqs {
+			response.Faqs = append(response.Faqs, newFaq(f))
+		}
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*faqpb.ListFaqsResponse), nil
+}
+
+func (s *FaqService) GetFaq(ctx context.Context, req *faqpb.GetFaqRequest) (*sharedpb.Faq, error) {
+	resp, err := PublicBaseHandler(ctx, func(ctx context.Context) (interface{}, error) {
+		if req.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, "id is required")
+		}
+		f, err := s.store.FaqStore().GetFaq(ctx, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		return newFaq(f), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*sharedpb.Faq), nil
+}
+
+func (s *FaqService) CreateFaq(ctx context.Context, req *faqpb.CreateFaqRequest) (*sharedpb.Faq, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkFaqAccess(session, "CreateFaq"); err != nil {
+			return nil, err
+		}
+
+		question := strings.TrimSpace(req.Question)
+		answer := strings.TrimSpace(req.Answer)
+		if question == "" || answer == "" {
+			return nil, status.Error(codes.InvalidArgument, "question and answer are required")
+		}
+
+		created, err := s.store.FaqStore().CreateFaq(ctx, question, answer, *session.UserID)
+		if err != nil {
+			return nil, err
+		}
+		return newFaq(created), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*sharedpb.Faq), nil
+}
+
+func (s *FaqService) UpdateFaq(ctx context.Context, req *faqpb.UpdateFaqRequest) (*sharedpb.Faq, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkFaqAccess(session, "UpdateFaq"); err != nil {
+			return nil, err
+		}
+		if req.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, "id is required")
+		}
+
+		var question, answer *string
+		if req.Question != nil {
+			q := strings.TrimSpace(*req.Question)
+			if q == "" {
+				return nil, status.Error(codes.InvalidArgument, "question can't be empty")
+			}
+			question = &q
+		}
+		if req.Answer != nil {
+			a := strings.TrimSpace(*req.Answer)
+			if a == "" {
+				return nil, status.Error(codes.InvalidArgument, "answer can't be empty")
+			}
+			answer = &a
+		}
+
+		updated, err := s.store.FaqStore().UpdateFaq(ctx, req.Id, question, answer, *session.UserID)
+		if err != nil {
+			return nil, err
+		}
+		return newFaq(updated), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*sharedpb.Faq), nil
+}
+
+func (s *FaqService) DeleteFaq(ctx context.Context, req *faqpb.DeleteFaqRequest) (*faqpb.DeleteFaqResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkFaqAccess(session, "DeleteFaq"); err != nil {
+			return nil, err
+		}
+		if req.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, "id is required")
+		}
+
+		if err := s.store.FaqStore().DeleteFaq(ctx, req.Id); err != nil {
+			return nil, err
+		}
+		return &faqpb.DeleteFaqResponse{
+			Success: true,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*faqpb.DeleteFaqResponse), nil
+}
+
+func newFaq(f *faq.Faq) *sharedpb.Faq {
+	return &sharedpb.Faq{
+		Id:        f.ID,
+		Question:  f.Question,
+		Answer:    f.Answer,
+		CreatedBy: f.CreatedBy,
+		UpdatedBy: f.UpdatedBy,
+		CreatedAt: timestamppb.New(f.CreatedAt),
+		UpdatedAt: timestamppb.New(f.UpdatedAt),
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	interactionpb "github.com/studyguides-com/study-guides-api/api/v1/interaction"
 	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	"github.com/studyguides-com/study-guides-api/internal/middleware"
 	"github.com/studyguides-com/study-guides-api/internal/store"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -23,6 +24,13 @@ func NewInteractionService(store store.Store) *InteractionService {
 }
 
 func (s *InteractionService) Interact(ctx context.Context, req *interactionpb.InteractRequest) (*interactionpb.InteractResponse, error) {
+	// Test answers are only recorded for callers whose plan includes test mode
+	if req.StudyMethod == sharedpb.StudyMethod_Test {
+		if err := middleware.GetSessionDetails(ctx).RequireEntitlement(middleware.FeatureTestMode); err != nil {
+			return nil, err
+		}
+	}
+
 	switch req.InteractionType {
 	case sharedpb.InteractionType_AnswerCorrectly:
 		return s.answerCorrectly(ctx, req)
@@ -0,0 +1,263 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	moderationpb "github.com/studyguides-com/study-guides-api/api/v1/moderation"
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	"github.com/studyguides-com/study-guides-api/internal/middleware"
+	"github.com/studyguides-com/study-guides-api/internal/store"
+	"github.com/studyguides-com/study-guides-api/internal/store/moderation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultOpenReportsLimit = 50
+
+var moderationTargetTypes = map[moderationpb.ModerationTargetType]string{
+	moderationpb.ModerationTargetType_MODERATION_TARGET_TYPE_QUESTION: moderation.TargetQuestion,
+	moderationpb.ModerationTargetType_MODERATION_TARGET_TYPE_TAG:      moderation.TargetTag,
+}
+
+var moderationActionTypes = map[moderationpb.ModerationActionType]string{
+	moderationpb.ModerationActionType_MODERATION_ACTION_TYPE_DISMISS:   moderation.ActionDismiss,
+	moderationpb.ModerationActionType_MODERATION_ACTION_TYPE_EDIT:      moderation.ActionEdit,
+	moderationpb.ModerationActionType_MODERATION_ACTION_TYPE_UNPUBLISH: moderation.ActionUnpublish,
+	moderationpb.ModerationActionType_MODERATION_ACTION_TYPE_HIDE:      moderation.ActionHide,
+}
+
+type ModerationService struct {
+	moderationpb.UnimplementedModerationServiceServer
+	store store.Store
+}
+
+func NewModerationService(store store.Store) *ModerationService {
+	return &ModerationService{
+		store: store,
+	}
+}
+
+// checkModeratorAccess allows admins to work the moderation queue
+func checkModeratorAccess(session *middleware.SessionDetails, method string) error {
+	if session.UserID == nil {
+		log.Printf("%s request from anonymous user", method)
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if !session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+		log.Printf("%s request from non-admin user %s", method, *session.UserID)
+		return status.Error(codes.PermissionDenied, "admin role required")
+	}
+	log.Printf("%s request from user %s", method, *session.UserID)
+	return nil
+}
+
+func (s *ModerationService) ListOpenReports(ctx context.Context, req *moderationpb.ListOpenReportsRequest) (*moderationpb.ListOpenReportsResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkModeratorAccess(session, "ListOpenReports"); err != nil {
+			return nil, err
+		}
+
+		limit := int(req.Limit)
+		if limit <= 0 {
+			limit = defaultOpenReportsLimit
+		}
+
+		groups, err := s.store.ModerationStore().ListOpenReports(ctx, moderationTargetTypes[req.TargetType], limit, int(req.Offset))
+		if err != nil {
+			return nil, err
+		}
+
+		response := &moderationpb.ListOpenReportsResponse{}
+		for _, g := range groups {
+			response.Groups = append(response.Groups, &moderationpb.ReportGroup{
+				TargetType:   targetTypeToProto(g.TargetType),
+				TargetId:     g.TargetID,
+				Label:        g.Label,
+				Public:       g.Public,
+				TotalReports: g.TotalReports,
+				Counts: []*moderationpb.ReportTypeCount{
+					{ReportType: sharedpb.ReportType_Spam, Count: g.SpamCount},
+					{ReportType: sharedpb.ReportType_Inappropriate, Count: g.InappropriateCount},
+					{ReportType: sharedpb.ReportType_Incorrect, Count: g.IncorrectCount},
+					{ReportType: sharedpb.ReportType_Other, Count: g.OtherCount},
+				},
+				Reasons:         g.Reasons,
+				FirstReportedAt: timestamppb.New(g.FirstReportedAt),
+				LastReportedAt:  timestamppb.New(g.LastReportedAt),
+			})
+		}
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*moderationpb.ListOpenReportsResponse), nil
+}
+
+func (s *ModerationService) ResolveReports(ctx context.Context, req *moderationpb.ResolveReportsRequest) (*moderationpb.ResolveReportsResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkModeratorAccess(session, "ResolveReports"); err != nil {
+			return nil, err
+		}
+
+		targetType, ok := moderationTargetTypes[req.TargetType]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "target_type is required")
+		}
+		action, ok := moderationActionTypes[req.Action]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "action is required")
+		}
+		if req.TargetId == "" {
+			return nil, status.Error(codes.InvalidArgument, "target_id is required")
+		}
+
+		resolution := &moderation.Resolution{
+			TargetType:  targetType,
+			TargetID:    req.TargetId,
+			Action:      action,
+			ModeratorID: *session.UserID,
+			Note:        req.Note,
+		}
+		if req.Edit != nil {
+			resolution.Edit = &moderation.ContentEdit{
+				QuestionText: req.Edit.QuestionText,
+				AnswerText:   req.Edit.AnswerText,
+				LearnMore:    req.Edit.LearnMore,
+				Distractors:  req.Edit.Distractors,
+				Name:         req.Edit.Name,
+				Description:  req.Edit.Description,
+			}
+		}
+
+		moderationAction, err := s.store.ModerationStore().Resolve(ctx, resolution)
+		if err != nil {
+			return nil, err
+		}
+		if moderationAction.Before != nil {
+			middleware.AuditBefore(ctx, json.RawMessage(moderationAction.Before))
+		}
+		if moderationAction.After != nil {
+			middleware.AuditAfter(ctx, json.RawMessage(moderationAction.After))
+		}
+
+		return &moderationpb.ResolveReportsResponse{
+			Action: newModerationAction(moderationAction),
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*moderationpb.ResolveReportsResponse), nil
+}
+
+func (s *ModerationService) ListModerationActions(ctx context.Context, req *moderationpb.ListModerationActionsRequest) (*moderationpb.ListModerationActionsResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkModeratorAccess(session, "ListModerationActions"); err != nil {
+			return nil, err
+		}
+
+		targetType, ok := moderationTargetTypes[req.TargetType]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "target_type is required")
+		}
+
+		actions, err := s.store.ModerationStore().ListActions(ctx, targetType, req.TargetId)
+		if err != nil {
+			return nil, err
+		}
+
+		response := &moderationpb.ListModerationActionsResponse{}
+		for _, a := range actions {
+			response.Actions = append(response.Actions, newModerationAction(a))
+		}
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*moderationpb.ListModerationActionsResponse), nil
+}
+
+// ListMyReports lets any signed in user follow up on the reports they filed
+func (s *ModerationService) ListMyReports(ctx context.Context, req *moderationpb.ListMyReportsRequest) (*moderationpb.ListMyReportsResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if session.UserID == nil {
+			return nil, status.Error(codes.Unauthenticated, "user must be authenticated to list reports")
+		}
+
+		reports, err := s.store.ModerationStore().ListReportsByUser(ctx, *session.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		response := &moderationpb.ListMyReportsResponse{}
+		for _, r := range reports {
+			report := &moderationpb.MyReport{
+				TargetType: targetTypeToProto(r.TargetType),
+				TargetId:   r.TargetID,
+				Label:      r.Label,
+				ReportType: sharedpb.ReportType(sharedpb.ReportType_value[r.ReportType]),
+				Status:     moderationpb.ReportStatus_REPORT_STATUS_OPEN,
+				CreatedAt:  timestamppb.New(r.CreatedAt),
+			}
+			if r.Reason != nil {
+				report.Reason = *r.Reason
+			}
+			if r.Status == moderation.StatusResolved {
+				report.Status = moderationpb.ReportStatus_REPORT_STATUS_RESOLVED
+			}
+			if r.Resolution != nil {
+				report.Resolution = actionTypeToProto(*r.Resolution)
+			}
+			if r.ResolvedAt != nil {
+				report.ResolvedAt = timestamppb.New(*r.ResolvedAt)
+			}
+			response.Reports = append(response.Reports, report)
+		}
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*moderationpb.ListMyReportsResponse), nil
+}
+
+func newModerationAction(a *moderation.Action) *moderationpb.ModerationAction {
+	action := &moderationpb.ModerationAction{
+		Id:          a.ID,
+		TargetType:  targetTypeToProto(a.TargetType),
+		TargetId:    a.TargetID,
+		Action:      actionTypeToProto(a.Action),
+		ModeratorId: a.ModeratorID,
+		ReportCount: a.ReportCount,
+		BeforeJson:  string(a.Before),
+		AfterJson:   string(a.After),
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+	}
+	if a.Note != nil {
+		action.Note = *a.Note
+	}
+	return action
+}
+
+func targetTypeToProto(targetType string) moderationpb.ModerationTargetType {
+	for k, v := range moderationTargetTypes {
+		if v == targetType {
+			return k
+		}
+	}
+	return moderationpb.ModerationTargetType_MODERATION_TARGET_TYPE_UNSPECIFIED
+}
+
+func actionTypeToProto(action string) moderationpb.ModerationActionType {
+	for k, v := range moderationActionTypes {
+		if v == action {
+			return k
+		}
+	}
+	return moderationpb.ModerationActionType_MODERATION_ACTION_TYPE_UNSPECIFIED
+}
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"log"
+
+	notificationpb "github.com/studyguides-com/study-guides-api/api/v1/notification"
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	"github.com/studyguides-com/study-guides-api/internal/middleware"
+	"github.com/studyguides-com/study-guides-api/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultNotificationsLimit = 50
+	maxNotificationsLimit     = 500
+)
+
+// NotificationService hands notification events to the Slack bot
+type NotificationService struct {
+	notificationpb.UnimplementedNotificationServiceServer
+	store store.Store
+}
+
+func NewNotificationService(store store.Store) *NotificationService {
+	return &NotificationService{
+		store: store,
+	}
+}
+
+// checkNotificationAccess limits the feed to admins, the bot uses an admin token
+func checkNotificationAccess(session *middleware.SessionDetails, method string) error {
+	if !session.IsAuth {
+		log.Printf("%s request from anonymous user", method)
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if !session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+		log.Printf("%s request from non-admin user %s", method, *session.UserID)
+		return status.Error(codes.PermissionDenied, "admin role required")
+	}
+	return nil
+}
+
+func (s *NotificationService) ListPendingNotifications(ctx context.Context, req *notificationpb.ListPendingNotificationsRequest) (*notificationpb.ListPendingNotificationsResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkNotificationAccess(session, "ListPendingNotifications"); err != nil {
+			return nil, err
+		}
+
+		limit := int(req.Limit)
+		if limit <= 0 {
+			limit = defaultNotificationsLimit
+		}
+		if limit > maxNotificationsLimit {
+			limit = maxNotificationsLimit
+		}
+
+		events, err := s.store.NotificationStore().ListPending(ctx, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		response := &notificationpb.ListPendingNotificationsResponse{}
+		for _, e := range events {
+			response.Notifications = append(response.Notifications, &notificationpb.Notification{
+				Id:          e.ID,
+				Type:        e.Type,
+				PayloadJson: e.Payload,
+				CreatedAt:   timestamppb.New(e.CreatedAt),
+			})
+		}
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*notificationpb.ListPendingNotificationsResponse), nil
+}
+
+func (s *NotificationService) AckNotifications(ctx context.Context, req *notificationpb.AckNotificationsRequest) (*notificationpb.AckNotificationsResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkNotificationAccess(session, "AckNotifications"); err != nil {
+			return nil, err
+		}
+		if len(req.Ids) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "ids are required")
+		}
+
+		acknowledged, err := s.store.NotificationStore().MarkDelivered(ctx, req.Ids)
+		if err != nil {
+			return nil, err
+		}
+		return &notificationpb.AckNotificationsResponse{
+			Acknowledged: int32(acknowledged),
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*notificationpb.AckNotificationsResponse), nil
+}
@@ -0,0 +1,266 @@
+package services
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	promptpb "github.com/studyguides-com/study-guides-api/api/v1/prompt"
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	"github.com/studyguides-com/study-guides-api/internal/lib/prompts"
+	"github.com/studyguides-com/study-guides-api/internal/middleware"
+	"github.com/studyguides-com/study-guides-api/internal/store"
+	"github.com/studyguides-com/study-guides-api/internal/store/prompt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var promptTypes = map[promptpb.PromptType]string{
+	promptpb.PromptType_PROMPT_TYPE_TAGGING:        prompt.TypeTagging,
+	promptpb.PromptType_PROMPT_TYPE_DISTRACTORS:    prompt.TypeDistractors,
+	promptpb.PromptType_PROMPT_TYPE_LEARN_MORE:     prompt.TypeLearnMore,
+	promptpb.PromptType_PROMPT_TYPE_CONTENT_RATING: prompt.TypeContentRating,
+	promptpb.PromptType_PROMPT_TYPE_CHAT:           prompt.TypeChat,
+}
+
+type PromptService struct {
+	promptpb.UnimplementedPromptServiceServer
+	store    store.Store
+	registry *prompts.Registry
+}
+
+func NewPromptService(store store.Store, registry *prompts.Registry) *PromptService {
+	return &PromptService{
+		store:    store,
+		registry: registry,
+	}
+}
+
+// checkPromptAccess limits the prompt registry to admins, prompts steer every AI caller
+func checkPromptAccess(session *middleware.SessionDetails, method string) error {
+	if session.UserID == nil {
+		log.Printf("%s request from anonymous user", method)
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if !session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+		log.Printf("%s request from non-admin user %s", method, *session.UserID)
+		return status.Error(codes.PermissionDenied, "admin role required")
+	}
+	log.Printf("%s request from user %s", method, *session.UserID)
+	return nil
+}
+
+func promptTypeName(promptType promptpb.PromptType) (string, error) {
+	name, ok := promptTypes[promptType]
+	if !ok {
+		return "", status.Error(codes.InvalidArgument, "prompt type is required")
+	}
+	return name, nil
+}
+
+func (s *PromptService) ListPrompts(ctx context.Context, req *promptpb.ListPromptsRequest) (*promptpb.ListPromptsResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkPromptAccess(session, "ListPrompts"); err != nil {
+			return nil, err
+		}
+
+		stored, err := s.store.PromptStore().ListPrompts(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		response := &promptpb.ListPromptsResponse{}
+		for _, p := range stored {
+			response.Prompts = append(response.Prompts, newPrompt(p))
+		}
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*promptpb.ListPromptsResponse), nil
+}
+
+func (s *PromptService) GetPrompt(ctx context.Context, req *promptpb.GetPromptRequest) (*promptpb.GetPromptResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkPromptAccess(session, "GetPrompt"); err != nil {
+			return nil, err
+		}
+
+		promptType, err := promptTypeName(req.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		promptStore := s.store.PromptStore()
+		stored, err := promptStore.Prompt(ctx, promptType)
+		if err != nil {
+			return nil, err
+		}
+		versions, err := promptStore.Versions(ctx, stored.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		response := &promptpb.GetPromptResponse{
+			Prompt: newPrompt(stored),
+		}
+		for _, v := range versions {
+			response.Versions = append(response.Versions, newPromptVersion(v))
+		}
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*promptpb.GetPromptResponse), nil
+}
+
+func (s *PromptService) CreatePrompt(ctx context.Context, req *promptpb.CreatePromptRequest) (*promptpb.CreatePromptResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkPromptAccess(session, "CreatePrompt"); err != nil {
+			return nil, err
+		}
+
+		promptType, err := promptTypeName(req.Type)
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(req.Text) == "" {
+			return nil, status.Error(codes.InvalidArgument, "text is required")
+		}
+
+		created, err := s.store.PromptStore().CreatePrompt(ctx, promptType, req.Text, *session.UserID)
+		if err != nil {
+			return nil, err
+		}
+		s.registry.Invalidate(created.Type)
+
+		return &promptpb.CreatePromptResponse{
+			Prompt: newPrompt(created),
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*promptpb.CreatePromptResponse), nil
+}
+
+func (s *PromptService) AddPromptVersion(ctx context.Context, req *promptpb.AddPromptVersionRequest) (*promptpb.AddPromptVersionResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkPromptAccess(session, "AddPromptVersion"); err != nil {
+			return nil, err
+		}
+
+		if req.PromptId == "" {
+			return nil, status.Error(codes.InvalidArgument, "prompt_id is required")
+		}
+		if strings.TrimSpace(req.Text) == "" {
+			return nil, status.Error(codes.InvalidArgument, "text is required")
+		}
+
+		promptStore := s.store.PromptStore()
+		version, err := promptStore.AddVersion(ctx, req.PromptId, req.Text, *session.UserID, req.Activate)
+		if err != nil {
+			return nil, err
+		}
+		updated, err := promptStore.PromptByID(ctx, req.PromptId)
+		if err != nil {
+			return nil, err
+		}
+		s.registry.Invalidate(updated.Type)
+
+		return &promptpb.AddPromptVersionResponse{
+			Version: newPromptVersion(version),
+			Prompt:  newPrompt(updated),
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*promptpb.AddPromptVersionResponse), nil
+}
+
+func (s *PromptService) ActivatePromptVersion(ctx context.Context, req *promptpb.ActivatePromptVersionRequest) (*promptpb.ActivatePromptVersionResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkPromptAccess(session, "ActivatePromptVersion"); err != nil {
+			return nil, err
+		}
+
+		if req.PromptId == "" || req.Version <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "prompt_id and version are required")
+		}
+
+		updated, err := s.store.PromptStore().ActivateVersion(ctx, req.PromptId, req.Version)
+		if err != nil {
+			return nil, err
+		}
+		s.registry.Invalidate(updated.Type)
+
+		return &promptpb.ActivatePromptVersionResponse{
+			Prompt: newPrompt(updated),
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*promptpb.ActivatePromptVersionResponse), nil
+}
+
+func (s *PromptService) RollbackPrompt(ctx context.Context, req *promptpb.RollbackPromptRequest) (*promptpb.RollbackPromptResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkPromptAccess(session, "RollbackPrompt"); err != nil {
+			return nil, err
+		}
+
+		if req.PromptId == "" {
+			return nil, status.Error(codes.InvalidArgument, "prompt_id is required")
+		}
+
+		updated, err := s.store.PromptStore().Rollback(ctx, req.PromptId)
+		if err != nil {
+			return nil, err
+		}
+		s.registry.Invalidate(updated.Type)
+
+		return &promptpb.RollbackPromptResponse{
+			Prompt: newPrompt(updated),
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*promptpb.RollbackPromptResponse), nil
+}
+
+func newPrompt(p *prompt.Prompt) *promptpb.Prompt {
+	result := &promptpb.Prompt{
+		Id:            p.ID,
+		Text:          p.Text,
+		ActiveVersion: p.Version,
+		VersionCount:  p.Versions,
+		CreatedAt:     timestamppb.New(p.CreatedAt),
+		UpdatedAt:     timestamppb.New(p.UpdatedAt),
+	}
+	for k, v := range promptTypes {
+		if v == p.Type {
+			result.Type = k
+		}
+	}
+	return result
+}
+
+func newPromptVersion(v *prompt.Version) *promptpb.PromptVersion {
+	result := &promptpb.PromptVersion{
+		Id:        v.ID,
+		PromptId:  v.PromptID,
+		Version:   v.Version,
+		Text:      v.Text,
+		CreatedAt: timestamppb.New(v.CreatedAt),
+	}
+	if v.CreatedBy != nil {
+		result.CreatedBy = *v.CreatedBy
+	}
+	return result
+}
@@ -0,0 +1,248 @@
+package services
+
+import (
+	"context"
+	"log"
+
+	qualitypb "github.com/studyguides-com/study-guides-api/api/v1/quality"
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	"github.com/studyguides-com/study-guides-api/internal/middleware"
+	"github.com/studyguides-com/study-guides-api/internal/store"
+	"github.com/studyguides-com/study-guides-api/internal/store/jobs"
+	"github.com/studyguides-com/study-guides-api/internal/store/quality"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultDuplicateClustersLimit = 25
+	defaultLintFindingsLimit      = 100
+)
+
+var lintSeverities = map[qualitypb.LintSeverity]string{
+	qualitypb.LintSeverity_LINT_SEVERITY_INFO:    quality.SeverityInfo,
+	qualitypb.LintSeverity_LINT_SEVERITY_WARNING: quality.SeverityWarning,
+	qualitypb.LintSeverity_LINT_SEVERITY_ERROR:   quality.SeverityError,
+}
+
+type QualityService struct {
+	qualitypb.UnimplementedQualityServiceServer
+	store store.Store
+}
+
+func NewQualityService(store store.Store) *QualityService {
+	return &QualityService{
+		store: store,
+	}
+}
+
+// checkQualityAccess limits quality tooling to admins
+func checkQualityAccess(session *middleware.SessionDetails, method string) error {
+	if session.UserID == nil {
+		log.Printf("%s request from anonymous user", method)
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if !session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+		log.Printf("%s request from non-admin user %s", method, *session.UserID)
+		return status.Error(codes.PermissionDenied, "admin role required")
+	}
+	log.Printf("%s request from user %s", method, *session.UserID)
+	return nil
+}
+
+func (s *QualityService) GetJob(ctx context.Context, req *qualitypb.GetJobRequest) (*qualitypb.GetJobResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkQualityAccess(session, "GetJob"); err != nil {
+			return nil, err
+		}
+
+		job, err := s.store.QualityStore().Job(ctx, req.JobId)
+		if err != nil {
+			return nil, err
+		}
+
+		return &qualitypb.GetJobResponse{
+			Job: newQualityJob(job),
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*qualitypb.GetJobResponse), nil
+}
+
+func (s *QualityService) StartDuplicateScan(ctx context.Context, req *qualitypb.StartDuplicateScanRequest) (*qualitypb.StartDuplicateScanResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkQualityAccess(session, "StartDuplicateScan"); err != nil {
+			return nil, err
+		}
+
+		threshold := req.Threshold
+		if threshold == 0 {
+			threshold = quality.DefaultDuplicateThreshold
+		}
+
+		jobID, err := s.store.QualityStore().StartDuplicateScan(ctx, req.ScopeTagId, threshold)
+		if err != nil {
+			return nil, err
+		}
+
+		return &qualitypb.StartDuplicateScanResponse{
+			JobId: jobID,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*qualitypb.StartDuplicateScanResponse), nil
+}
+
+func (s *QualityService) ListDuplicateClusters(ctx context.Context, req *qualitypb.ListDuplicateClustersRequest) (*qualitypb.ListDuplicateClustersResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkQualityAccess(session, "ListDuplicateClusters"); err != nil {
+			return nil, err
+		}
+
+		limit := int(req.Limit)
+		if limit <= 0 {
+			limit = defaultDuplicateClustersLimit
+		}
+
+		page, err := s.store.QualityStore().ListDuplicateClusters(ctx, req.JobId, req.ScopeTagId, limit, int(req.Offset))
+		if err != nil {
+			return nil, err
+		}
+
+		response := &qualitypb.ListDuplicateClustersResponse{
+			JobId: page.JobID,
+			Total: page.Total,
+		}
+		for _, c := range page.Clusters {
+			cluster := &qualitypb.DuplicateCluster{
+				Id:         c.ID,
+				Size:       c.Size,
+				Similarity: c.Similarity,
+			}
+			for _, m := range c.Members {
+				cluster.Members = append(cluster.Members, &qualitypb.DuplicateMember{
+					QuestionId:       m.QuestionID,
+					QuestionText:     m.QuestionText,
+					AnswerText:       m.AnswerText,
+					Similarity:       m.Similarity,
+					InteractionCount: m.InteractionCount,
+					TagPaths:         m.TagPaths,
+				})
+			}
+			response.Clusters = append(response.Clusters, cluster)
+		}
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*qualitypb.ListDuplicateClustersResponse), nil
+}
+
+func (s *QualityService) StartLint(ctx context.Context, req *qualitypb.StartLintRequest) (*qualitypb.StartLintResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkQualityAccess(session, "StartLint"); err != nil {
+			return nil, err
+		}
+
+		jobID, err := s.store.QualityStore().StartLint(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return &qualitypb.StartLintResponse{
+			JobId: jobID,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*qualitypb.StartLintResponse), nil
+}
+
+func (s *QualityService) ListLintFindings(ctx context.Context, req *qualitypb.ListLintFindingsRequest) (*qualitypb.ListLintFindingsResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkQualityAccess(session, "ListLintFindings"); err != nil {
+			return nil, err
+		}
+
+		filter := &quality.LintFilter{
+			SubtreeTagID: req.SubtreeTagId,
+			Rule:         req.Rule,
+			Limit:        int(req.Limit),
+			Offset:       int(req.Offset),
+		}
+		if filter.Limit <= 0 {
+			filter.Limit = defaultLintFindingsLimit
+		}
+		for _, severity := range req.Severities {
+			name, ok := lintSeverities[severity]
+			if !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid severity %s", severity)
+			}
+			filter.Severities = append(filter.Severities, name)
+		}
+
+		page, err := s.store.QualityStore().ListLintFindings(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		response := &qualitypb.ListLintFindingsResponse{
+			Total: page.Total,
+		}
+		for _, f := range page.Findings {
+			response.Findings = append(response.Findings, &qualitypb.LintFinding{
+				Id:           f.ID,
+				JobId:        f.JobID,
+				QuestionId:   f.QuestionID,
+				QuestionText: f.QuestionText,
+				Rule:         f.Rule,
+				Severity:     lintSeverityToProto(f.Severity),
+				Message:      f.Message,
+				CreatedAt:    timestamppb.New(f.CreatedAt),
+			})
+		}
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*qualitypb.ListLintFindingsResponse), nil
+}
+
+func lintSeverityToProto(severity string) qualitypb.LintSeverity {
+	for k, v := range lintSeverities {
+		if v == severity {
+			return k
+		}
+	}
+	return qualitypb.LintSeverity_LINT_SEVERITY_UNSPECIFIED
+}
+
+func newQualityJob(job *jobs.Job) *qualitypb.QualityJob {
+	result := &qualitypb.QualityJob{
+		Id:           job.ID,
+		Type:         job.Type,
+		Status:       job.Status,
+		MetadataJson: string(job.Metadata),
+	}
+	if job.Description != nil {
+		result.Description = *job.Description
+	}
+	if job.StartedAt != nil {
+		result.StartedAt = timestamppb.New(*job.StartedAt)
+	}
+	if job.CompletedAt != nil {
+		result.CompletedAt = timestamppb.New(*job.CompletedAt)
+	}
+	if job.Error != nil {
+		result.Error = *job.Error
+	}
+	return result
+}
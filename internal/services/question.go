@@ -1,13 +1,21 @@
 package services
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
 
 	questionpb "github.com/studyguides-com/study-guides-api/api/v1/question"
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
 	"github.com/studyguides-com/study-guides-api/internal/middleware"
 	"github.com/studyguides-com/study-guides-api/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// premiumPreviewCount is how many questions of a premium tag callers without a premium plan get
+const premiumPreviewCount = 10
+
 type QuestionService struct {
 	questionpb.UnimplementedQuestionServiceServer
 	store store.Store
@@ -21,13 +29,34 @@ func NewQuestionService(store store.Store) *QuestionService {
 
 func (s *QuestionService) ForTag(ctx context.Context, req *questionpb.ForTagRequest) (*questionpb.QuestionsResponse, error) {
 	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if req.GetStudyMethod() == sharedpb.StudyMethod_Test {
+			if err := session.RequireEntitlement(middleware.FeatureTestMode); err != nil {
+				return nil, err
+			}
+		}
+		if err := checkTagReadAccess(ctx, s.store, session, req.TagId); err != nil {
+			return nil, err
+		}
 		questions, err := s.store.QuestionStore().GetQuestionsByTagID(ctx, req.TagId)
 		if err != nil {
 			return nil, err
 		}
-		return &questionpb.QuestionsResponse{
-			Questions: questions,
-		}, nil
+
+		response := &questionpb.QuestionsResponse{
+			Questions:  questions,
+			TotalCount: int32(len(questions)),
+		}
+		if len(questions) > premiumPreviewCount && !session.Entitled(middleware.FeatureFullQuestionSets) {
+			premium, err := s.store.EntitlementStore().PremiumTag(ctx, req.TagId)
+			if err != nil {
+				return nil, err
+			}
+			if premium {
+				response.Questions = questions[:premiumPreviewCount]
+				response.Preview = true
+			}
+		}
+		return response, nil
 	})
 	if err != nil {
 		return nil, err
@@ -37,6 +66,13 @@ func (s *QuestionService) ForTag(ctx context.Context, req *questionpb.ForTagRequ
 
 func (s *QuestionService) Report(ctx context.Context, req *questionpb.ReportQuestionRequest) (*questionpb.ReportQuestionResponse, error) {
 	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if session.UserID == nil {
+			return nil, status.Error(codes.Unauthenticated, "user must be authenticated to report questions")
+		}
+		err := s.store.QuestionStore().Report(ctx, req.QuestionId, *session.UserID, req.ReportType, req.Reason)
+		if err != nil {
+			return nil, err
+		}
 		return &questionpb.ReportQuestionResponse{
 			Success: true,
 		}, nil
@@ -46,3 +82,45 @@ func (s *QuestionService) Report(ctx context.Context, req *questionpb.ReportQues
 	}
 	return resp.(*questionpb.ReportQuestionResponse), nil
 }
+
+func (s *QuestionService) ExportQuestions(ctx context.Context, req *questionpb.ExportQuestionsRequest) (*questionpb.ExportQuestionsResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if !session.IsAuth {
+			return nil, status.Error(codes.Unauthenticated, "user must be authenticated to export questions")
+		}
+		if err := session.RequireEntitlement(middleware.FeatureExports); err != nil {
+			return nil, err
+		}
+		if req.TagId == "" {
+			return nil, status.Error(codes.InvalidArgument, "tag_id is required")
+		}
+		if err := checkTagReadAccess(ctx, s.store, session, req.TagId); err != nil {
+			return nil, err
+		}
+		questions, err := s.store.QuestionStore().GetQuestionsByTagID(ctx, req.TagId)
+		if err != nil {
+			return nil, err
+		}
+
+		var buf bytes.Buffer
+		writer := csv.NewWriter(&buf)
+		writer.Write([]string{"question", "answer", "learn_more"})
+		for _, q := range questions {
+			writer.Write([]string{q.QuestionText, q.AnswerText, q.GetLearnMore()})
+		}
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to write export: %v", err)
+		}
+
+		return &questionpb.ExportQuestionsResponse{
+			Filename:    req.TagId + ".csv",
+			ContentType: "text/csv",
+			Content:     buf.Bytes(),
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*questionpb.ExportQuestionsResponse), nil
+}
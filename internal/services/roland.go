@@ -2,11 +2,20 @@ package services
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"time"
 
 	rolandpb "github.com/studyguides-com/study-guides-api/api/v1/roland"
 	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
 	"github.com/studyguides-com/study-guides-api/internal/middleware"
 	"github.com/studyguides-com/study-guides-api/internal/store"
+	"github.com/studyguides-com/study-guides-api/internal/store/admin"
+	"github.com/studyguides-com/study-guides-api/internal/store/roland"
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type RolandService struct {
@@ -20,8 +29,27 @@ func NewRolandService(store store.Store) *RolandService {
 	}
 }
 
+// checkRolandAccess limits bundle management to admins and freelancers
+func checkRolandAccess(session *middleware.SessionDetails, method string) error {
+	if session.UserID == nil || !session.IsAuth {
+		log.Printf("%s request from anonymous user", method)
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	if !session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) && !session.HasRole(sharedpb.UserRole_USER_ROLE_FREELANCER) {
+		log.Printf("%s request from user %s without admin or freelancer role", method, *session.UserID)
+		return status.Error(codes.PermissionDenied, "admin or freelancer role required")
+	}
+
+	return nil
+}
+
 func (s *RolandService) SaveBundle(ctx context.Context, req *rolandpb.SaveBundleRequest) (*rolandpb.SaveBundleResponse, error) {
 	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkRolandAccess(session, "SaveBundle"); err != nil {
+			return nil, err
+		}
+
 		created, err := s.store.RolandStore().SaveBundle(ctx, req.Bundle, req.Force)
 		if err != nil {
 			return nil, err
@@ -38,6 +66,10 @@ func (s *RolandService) SaveBundle(ctx context.Context, req *rolandpb.SaveBundle
 
 func (s *RolandService) Bundles(ctx context.Context, req *rolandpb.BundlesRequest) (*rolandpb.BundlesResponse, error) {
 	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkRolandAccess(session, "Bundles"); err != nil {
+			return nil, err
+		}
+
 		bundles, err := s.store.RolandStore().Bundles(ctx)
 		if err != nil {
 			return nil, err
@@ -54,6 +86,10 @@ func (s *RolandService) Bundles(ctx context.Context, req *rolandpb.BundlesReques
 
 func (s *RolandService) BundlesByParserType(ctx context.Context, req *rolandpb.BundlesByParserTypeRequest) (*rolandpb.BundlesByParserTypeResponse, error) {
 	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkRolandAccess(session, "BundlesByParserType"); err != nil {
+			return nil, err
+		}
+
 		// Convert the proto enum to the shared enum
 		parserType := sharedpb.ParserType(req.ParserType)
 		bundles, err := s.store.RolandStore().BundlesByParserType(ctx, parserType)
@@ -72,6 +108,10 @@ func (s *RolandService) BundlesByParserType(ctx context.Context, req *rolandpb.B
 
 func (s *RolandService) UpdateGob(ctx context.Context, req *rolandpb.UpdateGobRequest) (*rolandpb.UpdateGobResponse, error) {
 	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkRolandAccess(session, "UpdateGob"); err != nil {
+			return nil, err
+		}
+
 		updated, err := s.store.RolandStore().UpdateGob(ctx, req.Id, req.GobPayload, req.Force)
 		if err != nil {
 			return nil, err
@@ -88,6 +128,10 @@ func (s *RolandService) UpdateGob(ctx context.Context, req *rolandpb.UpdateGobRe
 
 func (s *RolandService) DeleteAllBundles(ctx context.Context, req *rolandpb.DeleteAllBundlesRequest) (*rolandpb.DeleteAllBundlesResponse, error) {
 	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkRolandAccess(session, "DeleteAllBundles"); err != nil {
+			return nil, err
+		}
+
 		err := s.store.RolandStore().DeleteAllBundles(ctx)
 		if err != nil {
 			return nil, err
@@ -104,6 +148,10 @@ func (s *RolandService) DeleteAllBundles(ctx context.Context, req *rolandpb.Dele
 
 func (s *RolandService) DeleteBundleByID(ctx context.Context, req *rolandpb.DeleteBundleByIDRequest) (*rolandpb.DeleteBundleByIDResponse, error) {
 	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkRolandAccess(session, "DeleteBundleByID"); err != nil {
+			return nil, err
+		}
+
 		err := s.store.RolandStore().DeleteBundleByID(ctx, req.Id)
 		if err != nil {
 			return nil, err
@@ -120,6 +168,10 @@ func (s *RolandService) DeleteBundleByID(ctx context.Context, req *rolandpb.Dele
 
 func (s *RolandService) DeleteBundlesByShortID(ctx context.Context, req *rolandpb.DeleteBundlesByShortIDRequest) (*rolandpb.DeleteBundlesByShortIDResponse, error) {
 	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkRolandAccess(session, "DeleteBundlesByShortID"); err != nil {
+			return nil, err
+		}
+
 		deletedCount, err := s.store.RolandStore().DeleteBundlesByShortID(ctx, req.ShortId)
 		if err != nil {
 			return nil, err
@@ -136,6 +188,10 @@ func (s *RolandService) DeleteBundlesByShortID(ctx context.Context, req *rolandp
 
 func (s *RolandService) MarkBundleExported(ctx context.Context, req *rolandpb.MarkBundleExportedRequest) (*rolandpb.MarkBundleExportedResponse, error) {
 	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkRolandAccess(session, "MarkBundleExported"); err != nil {
+			return nil, err
+		}
+
 		// Convert the proto enum to the shared enum
 		exportType := sharedpb.ExportType(req.ExportType)
 		updated, err := s.store.RolandStore().MarkBundleExported(ctx, req.Id, exportType)
@@ -151,3 +207,141 @@ func (s *RolandService) MarkBundleExported(ctx context.Context, req *rolandpb.Ma
 	}
 	return resp.(*rolandpb.MarkBundleExportedResponse), nil
 }
+
+func (s *RolandService) PromoteBundle(ctx context.Context, req *rolandpb.PromoteBundleRequest) (*rolandpb.PromoteBundleResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkRolandAccess(session, "PromoteBundle"); err != nil {
+			return nil, err
+		}
+
+		if req.ExportType == sharedpb.ExportType_EXPORT_TYPE_UNSPECIFIED {
+			return nil, status.Error(codes.InvalidArgument, "export_type is required")
+		}
+
+		log.Printf("PromoteBundle request from user %s for bundle %s to %s", *session.UserID, req.Id, req.ExportType)
+
+		bundle, err := s.store.RolandStore().BundleByID(ctx, req.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		targetStore, err := s.store.EnvironmentAdminStore(req.ExportType)
+		if err != nil {
+			return nil, err
+		}
+
+		promotion := &roland.Promotion{
+			ID:         utils.GetCUID(),
+			BundleID:   bundle.Id,
+			ShortID:    bundle.ShortId,
+			Title:      bundle.Title,
+			ExportType: req.ExportType.String(),
+			PromotedBy: *session.UserID,
+			CreatedAt:  time.Now(),
+		}
+
+		summary, importErr := importBundle(ctx, targetStore, bundle)
+		if importErr == nil {
+			promotion.BatchID = &summary.BatchID
+			// Only flag the bundle once the target database actually has its content
+			if _, err := s.store.RolandStore().MarkBundleExported(ctx, bundle.Id, req.ExportType); err != nil {
+				importErr = err
+			}
+		}
+		promotion.Succeeded = importErr == nil
+		if importErr != nil {
+			message := importErr.Error()
+			promotion.Error = &message
+		}
+
+		// The import lands in another database, so it cannot share a transaction with the
+		// promotion record. Say so plainly when only the record is missing.
+		if err := s.store.RolandStore().RecordPromotion(ctx, promotion); err != nil {
+			log.Printf("Error recording promotion of bundle %s: %v", bundle.Id, err)
+			if importErr == nil {
+				return nil, status.Errorf(codes.Internal, "bundle %s was imported to %s as batch %s but the promotion was not recorded: %v",
+					bundle.Id, req.ExportType, summary.BatchID, err)
+			}
+		}
+
+		if importErr != nil {
+			log.Printf("Error promoting bundle %s to %s: %v", bundle.Id, req.ExportType, importErr)
+			if _, ok := status.FromError(importErr); ok {
+				return nil, importErr
+			}
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to promote bundle %s: %v", bundle.Id, importErr))
+		}
+
+		return &rolandpb.PromoteBundleResponse{
+			Promotion:        newBundlePromotion(promotion),
+			TagsCreated:      summary.Tags.Created,
+			TagsUpdated:      summary.Tags.Updated,
+			QuestionsCreated: summary.Questions.Created,
+			QuestionsUpdated: summary.Questions.Updated,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*rolandpb.PromoteBundleResponse), nil
+}
+
+func (s *RolandService) ListPromotions(ctx context.Context, req *rolandpb.ListPromotionsRequest) (*rolandpb.ListPromotionsResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkRolandAccess(session, "ListPromotions"); err != nil {
+			return nil, err
+		}
+
+		promotions, err := s.store.RolandStore().Promotions(ctx, req.BundleId)
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]*rolandpb.BundlePromotion, 0, len(promotions))
+		for _, promotion := range promotions {
+			result = append(result, newBundlePromotion(promotion))
+		}
+
+		return &rolandpb.ListPromotionsResponse{
+			Promotions: result,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*rolandpb.ListPromotionsResponse), nil
+}
+
+// importBundle decodes a bundle's gob payload and imports it into the target database
+func importBundle(ctx context.Context, targetStore admin.AdminStore, bundle *sharedpb.Bundle) (*admin.ImportSummary, error) {
+	if len(bundle.GobPayload) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "bundle %s has no gob payload", bundle.Id)
+	}
+
+	guide, err := admin.DecodeGuide(bundle.GobPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	return targetStore.ImportGuide(ctx, guide, fmt.Sprintf("%s (%s)", bundle.Title, bundle.ShortId), false)
+}
+
+func newBundlePromotion(promotion *roland.Promotion) *rolandpb.BundlePromotion {
+	result := &rolandpb.BundlePromotion{
+		Id:         promotion.ID,
+		BundleId:   promotion.BundleID,
+		ShortId:    promotion.ShortID,
+		Title:      promotion.Title,
+		ExportType: sharedpb.ExportType(sharedpb.ExportType_value[promotion.ExportType]),
+		PromotedBy: promotion.PromotedBy,
+		Succeeded:  promotion.Succeeded,
+		CreatedAt:  timestamppb.New(promotion.CreatedAt),
+	}
+	if promotion.BatchID != nil {
+		result.BatchId = *promotion.BatchID
+	}
+	if promotion.Error != nil {
+		result.Error = *promotion.Error
+	}
+	return result
+}
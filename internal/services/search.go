@@ -70,6 +70,47 @@ func (s *SearchService) SearchUsers(ctx context.Context, req *searchpb.SearchUse
 	return resp.(*searchpb.SearchUsersResponse), nil
 }
 
+func (s *SearchService) SearchFaqs(ctx context.Context, req *searchpb.SearchFaqsRequest) (*searchpb.SearchFaqsResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		results, err := s.store.SearchStore().SearchFaqs(ctx, req.Query)
+		if err != nil {
+			return nil, err
+		}
+		return &searchpb.SearchFaqsResponse{
+			Results: results,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, status.Error(codes.Internal, "search service returned nil response")
+	}
+	return resp.(*searchpb.SearchFaqsResponse), nil
+}
+
+func (s *SearchService) SearchQuestions(ctx context.Context, req *searchpb.SearchQuestionsRequest) (*searchpb.SearchQuestionsResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		log.Printf("Question search request from user %s: query=%s", *session.UserID, req.Query)
+
+		opts := search.NewQuestionSearchOptionsFromRequest(ctx, req)
+		results, err := s.store.SearchStore().SearchQuestions(ctx, req.Query, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &searchpb.SearchQuestionsResponse{
+			Results: results,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, status.Error(codes.Internal, "search service returned nil response")
+	}
+	return resp.(*searchpb.SearchQuestionsResponse), nil
+}
+
 func (s *SearchService) ListIndexes(ctx context.Context, req *searchpb.ListIndexesRequest) (*searchpb.ListIndexesResponse, error) {
 	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
 		return &searchpb.ListIndexesResponse{
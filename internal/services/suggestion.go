@@ -0,0 +1,370 @@
+package services
+
+import (
+	"context"
+	"log"
+
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	suggestionpb "github.com/studyguides-com/study-guides-api/api/v1/suggestion"
+	"github.com/studyguides-com/study-guides-api/internal/lib/ai"
+	"github.com/studyguides-com/study-guides-api/internal/lib/aijobs"
+	"github.com/studyguides-com/study-guides-api/internal/lib/prompts"
+	"github.com/studyguides-com/study-guides-api/internal/middleware"
+	"github.com/studyguides-com/study-guides-api/internal/store"
+	"github.com/studyguides-com/study-guides-api/internal/store/jobs"
+	"github.com/studyguides-com/study-guides-api/internal/store/suggestion"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultSuggestionJobLimit = 100
+	defaultSuggestionsLimit   = 50
+)
+
+var suggestionKinds = map[suggestionpb.SuggestionKind]string{
+	suggestionpb.SuggestionKind_SUGGESTION_KIND_DISTRACTORS:    suggestion.KindDistractors,
+	suggestionpb.SuggestionKind_SUGGESTION_KIND_LEARN_MORE:     suggestion.KindLearnMore,
+	suggestionpb.SuggestionKind_SUGGESTION_KIND_CONTENT_RATING: suggestion.KindContentRating,
+}
+
+var suggestionStatuses = map[suggestionpb.SuggestionStatus]string{
+	suggestionpb.SuggestionStatus_SUGGESTION_STATUS_PENDING:  suggestion.StatusPending,
+	suggestionpb.SuggestionStatus_SUGGESTION_STATUS_APPROVED: suggestion.StatusApproved,
+	suggestionpb.SuggestionStatus_SUGGESTION_STATUS_REJECTED: suggestion.StatusRejected,
+}
+
+type SuggestionService struct {
+	suggestionpb.UnimplementedSuggestionServiceServer
+	store   store.Store
+	ai      ai.AiClient
+	prompts *prompts.Registry
+}
+
+func NewSuggestionService(store store.Store, ai ai.AiClient, prompts *prompts.Registry) *SuggestionService {
+	return &SuggestionService{
+		store:   store,
+		ai:      ai,
+		prompts: prompts,
+	}
+}
+
+// checkSuggestionAccess limits AI generation and review to admins
+func checkSuggestionAccess(session *middleware.SessionDetails, method string) error {
+	if session.UserID == nil {
+		log.Printf("%s request from anonymous user", method)
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if !session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+		log.Printf("%s request from non-admin user %s", method, *session.UserID)
+		return status.Error(codes.PermissionDenied, "admin role required")
+	}
+	log.Printf("%s request from user %s", method, *session.UserID)
+	return nil
+}
+
+func (s *SuggestionService) StartDistractorJob(ctx context.Context, req *suggestionpb.StartDistractorJobRequest) (*suggestionpb.StartDistractorJobResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkSuggestionAccess(session, "StartDistractorJob"); err != nil {
+			return nil, err
+		}
+
+		limit := int(req.Limit)
+		if limit <= 0 {
+			limit = defaultSuggestionJobLimit
+		}
+
+		suggestionStore := s.store.SuggestionStore()
+		jobID, err := suggestionStore.StartJob(ctx, suggestion.JobTypeDistractors, "Generate distractors for questions missing them", map[string]interface{}{
+			"limit": limit,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// Run in background, each question is a model call
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), jobs.Timeout)
+			defer cancel()
+			result, err := aijobs.NewDistractorGenerator(s.ai, suggestionStore, s.prompts).Run(ctx, jobID, limit)
+			if err != nil {
+				log.Printf("Distractor job %s failed: %v", jobID, err)
+				suggestionStore.FinishJob(jobID, err, nil)
+				return
+			}
+			suggestionStore.FinishJob(jobID, nil, result.Results())
+		}()
+
+		return &suggestionpb.StartDistractorJobResponse{
+			JobId: jobID,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*suggestionpb.StartDistractorJobResponse), nil
+}
+
+func (s *SuggestionService) StartLearnMoreJob(ctx context.Context, req *suggestionpb.StartLearnMoreJobRequest) (*suggestionpb.StartLearnMoreJobResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkSuggestionAccess(session, "StartLearnMoreJob"); err != nil {
+			return nil, err
+		}
+
+		limit := int(req.Limit)
+		if limit <= 0 {
+			limit = defaultSuggestionJobLimit
+		}
+
+		suggestionStore := s.store.SuggestionStore()
+		jobID, err := suggestionStore.StartJob(ctx, suggestion.JobTypeLearnMore, "Draft learnMore explanations for questions missing them", map[string]interface{}{
+			"limit": limit,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// Run in background, each question is a model call
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), jobs.Timeout)
+			defer cancel()
+			result, err := aijobs.NewLearnMoreGenerator(s.ai, suggestionStore, s.prompts).Run(ctx, jobID, limit)
+			if err != nil {
+				log.Printf("LearnMore job %s failed: %v", jobID, err)
+				suggestionStore.FinishJob(jobID, err, nil)
+				return
+			}
+			suggestionStore.FinishJob(jobID, nil, result.Results())
+		}()
+
+		return &suggestionpb.StartLearnMoreJobResponse{
+			JobId: jobID,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*suggestionpb.StartLearnMoreJobResponse), nil
+}
+
+func (s *SuggestionService) StartContentRatingJob(ctx context.Context, req *suggestionpb.StartContentRatingJobRequest) (*suggestionpb.StartContentRatingJobResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkSuggestionAccess(session, "StartContentRatingJob"); err != nil {
+			return nil, err
+		}
+
+		limit := int(req.Limit)
+		if limit <= 0 {
+			limit = defaultSuggestionJobLimit
+		}
+		threshold := req.AutoApplyThreshold
+		if threshold < 0 {
+			return nil, status.Error(codes.InvalidArgument, "auto_apply_threshold cannot be negative")
+		}
+		if threshold == 0 {
+			threshold = aijobs.DefaultAutoApplyThreshold
+		}
+
+		suggestionStore := s.store.SuggestionStore()
+		jobID, err := suggestionStore.StartJob(ctx, suggestion.JobTypeContentRating, "Classify content ratings for RatingPending topics", map[string]interface{}{
+			"limit":              limit,
+			"autoApplyThreshold": threshold,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// Run in background, each topic is a model call
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), jobs.Timeout)
+			defer cancel()
+			classifier := aijobs.NewContentRatingClassifier(s.ai, suggestionStore, s.store.AdminStore(), s.prompts, threshold)
+			result, err := classifier.Run(ctx, jobID, limit)
+			if err != nil {
+				log.Printf("Content rating job %s failed: %v", jobID, err)
+				suggestionStore.FinishJob(jobID, err, nil)
+				return
+			}
+			suggestionStore.FinishJob(jobID, nil, result.Results())
+		}()
+
+		return &suggestionpb.StartContentRatingJobResponse{
+			JobId: jobID,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*suggestionpb.StartContentRatingJobResponse), nil
+}
+
+func (s *SuggestionService) GetJob(ctx context.Context, req *suggestionpb.GetJobRequest) (*suggestionpb.GetJobResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkSuggestionAccess(session, "GetJob"); err != nil {
+			return nil, err
+		}
+
+		job, err := s.store.SuggestionStore().Job(ctx, req.JobId)
+		if err != nil {
+			return nil, err
+		}
+
+		return &suggestionpb.GetJobResponse{
+			Job: newSuggestionJob(job),
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*suggestionpb.GetJobResponse), nil
+}
+
+func (s *SuggestionService) ListSuggestions(ctx context.Context, req *suggestionpb.ListSuggestionsRequest) (*suggestionpb.ListSuggestionsResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkSuggestionAccess(session, "ListSuggestions"); err != nil {
+			return nil, err
+		}
+
+		limit := int(req.Limit)
+		if limit <= 0 {
+			limit = defaultSuggestionsLimit
+		}
+
+		suggestions, total, err := s.store.SuggestionStore().ListSuggestions(ctx, suggestionKinds[req.Kind], suggestionStatuses[req.Status], limit, int(req.Offset))
+		if err != nil {
+			return nil, err
+		}
+
+		response := &suggestionpb.ListSuggestionsResponse{
+			Total: total,
+		}
+		for _, sg := range suggestions {
+			response.Suggestions = append(response.Suggestions, newSuggestion(sg))
+		}
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*suggestionpb.ListSuggestionsResponse), nil
+}
+
+// ReviewSuggestion rejects a suggestion, or applies it to the catalog and marks it approved
+func (s *SuggestionService) ReviewSuggestion(ctx context.Context, req *suggestionpb.ReviewSuggestionRequest) (*suggestionpb.ReviewSuggestionResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkSuggestionAccess(session, "ReviewSuggestion"); err != nil {
+			return nil, err
+		}
+
+		var editedPayload []byte
+		if req.EditedPayloadJson != "" {
+			editedPayload = []byte(req.EditedPayloadJson)
+		}
+
+		reviewStatus := suggestion.StatusRejected
+		if req.Approve {
+			reviewStatus = suggestion.StatusApproved
+		}
+
+		reviewed, err := s.store.SuggestionStore().ReviewSuggestion(ctx, req.Id, reviewStatus, *session.UserID, editedPayload,
+			func(ctx context.Context, sg *suggestion.Suggestion, questions *suggestion.QuestionTx) error {
+				if err := applySuggestion(ctx, s.store, questions, sg); err != nil {
+					log.Printf("Error applying suggestion %s: %v", sg.ID, err)
+					if _, ok := status.FromError(err); ok {
+						return err
+					}
+					return status.Errorf(codes.InvalidArgument, "failed to apply suggestion: %v", err)
+				}
+				return nil
+			})
+		if err != nil {
+			return nil, err
+		}
+		return &suggestionpb.ReviewSuggestionResponse{
+			Suggestion: newSuggestion(reviewed),
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*suggestionpb.ReviewSuggestionResponse), nil
+}
+
+// applySuggestion writes an approved suggestion to the catalog, question edits go through the review transaction
+func applySuggestion(ctx context.Context, appStore store.Store, questions aijobs.QuestionWriter, sg *suggestion.Suggestion) error {
+	switch sg.Kind {
+	case suggestion.KindDistractors:
+		return aijobs.ApplyDistractors(ctx, questions, sg)
+	case suggestion.KindLearnMore:
+		return aijobs.ApplyLearnMore(ctx, questions, sg)
+	case suggestion.KindContentRating:
+		return aijobs.ApplyContentRating(ctx, appStore.AdminStore(), sg)
+	default:
+		return status.Errorf(codes.Unimplemented, "cannot apply %s suggestions", sg.Kind)
+	}
+}
+
+func newSuggestion(sg *suggestion.Suggestion) *suggestionpb.Suggestion {
+	result := &suggestionpb.Suggestion{
+		Id:          sg.ID,
+		TargetType:  sg.TargetType,
+		TargetId:    sg.TargetID,
+		PayloadJson: string(sg.Payload),
+		ContextJson: string(sg.Context),
+		Confidence:  sg.Confidence,
+		AutoApplied: sg.AutoApplied,
+		CreatedAt:   timestamppb.New(sg.CreatedAt),
+	}
+	for k, v := range suggestionKinds {
+		if v == sg.Kind {
+			result.Kind = k
+		}
+	}
+	for k, v := range suggestionStatuses {
+		if v == sg.Status {
+			result.Status = k
+		}
+	}
+	if sg.JobID != nil {
+		result.JobId = *sg.JobID
+	}
+	if sg.Model != nil {
+		result.Model = *sg.Model
+	}
+	if sg.PromptID != nil {
+		result.PromptId = *sg.PromptID
+	}
+	if sg.PromptVersion != nil {
+		result.PromptVersion = *sg.PromptVersion
+	}
+	if sg.ReviewedBy != nil {
+		result.ReviewedBy = *sg.ReviewedBy
+	}
+	if sg.ReviewedAt != nil {
+		result.ReviewedAt = timestamppb.New(*sg.ReviewedAt)
+	}
+	return result
+}
+
+func newSuggestionJob(job *jobs.Job) *suggestionpb.SuggestionJob {
+	result := &suggestionpb.SuggestionJob{
+		Id:           job.ID,
+		Type:         job.Type,
+		Status:       job.Status,
+		MetadataJson: string(job.Metadata),
+	}
+	if job.Description != nil {
+		result.Description = *job.Description
+	}
+	if job.StartedAt != nil {
+		result.StartedAt = timestamppb.New(*job.StartedAt)
+	}
+	if job.CompletedAt != nil {
+		result.CompletedAt = timestamppb.New(*job.CompletedAt)
+	}
+	if job.Error != nil {
+		result.Error = *job.Error
+	}
+	return result
+}
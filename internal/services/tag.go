@@ -24,6 +24,9 @@ func NewTagService(store store.Store) *TagService {
 
 func (s *TagService) GetTag(ctx context.Context, req *tagpb.GetTagRequest) (*sharedpb.Tag, error) {
 	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkTagReadAccess(ctx, s.store, session, req.Id); err != nil {
+			return nil, err
+		}
 		return s.store.TagStore().GetTagByID(ctx, req.Id)
 	})
 	if err != nil {
@@ -34,10 +37,17 @@ func (s *TagService) GetTag(ctx context.Context, req *tagpb.GetTagRequest) (*sha
 
 func (s *TagService) ListTagsByParent(ctx context.Context, req *tagpb.ListTagsByParentRequest) (*tagpb.ListTagsResponse, error) {
 	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkTagReadAccess(ctx, s.store, session, req.ParentId); err != nil {
+			return nil, err
+		}
 		tags, err := s.store.TagStore().ListTagsByParent(ctx, req.ParentId)
 		if err != nil {
 			return nil, err
 		}
+		tags, err = filterReadableTags(ctx, s.store, session, tags)
+		if err != nil {
+			return nil, err
+		}
 		return &tagpb.ListTagsResponse{
 			Tags: tags,
 		}, nil
@@ -54,6 +64,10 @@ func (s *TagService) ListTagsByType(ctx context.Context, req *tagpb.ListTagsByTy
 		if err != nil {
 			return nil, err
 		}
+		tags, err = filterReadableTags(ctx, s.store, session, tags)
+		if err != nil {
+			return nil, err
+		}
 		return &tagpb.ListTagsResponse{
 			Tags: tags,
 		}, nil
@@ -70,6 +84,10 @@ func (s *TagService) ListRootTags(ctx context.Context, req *tagpb.ListRootTagsRe
 		if err != nil {
 			return nil, err
 		}
+		tags, err = filterReadableTags(ctx, s.store, session, tags)
+		if err != nil {
+			return nil, err
+		}
 		return &tagpb.ListTagsResponse{
 			Tags: tags,
 		}, nil
@@ -0,0 +1,333 @@
+package services
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	tagpb "github.com/studyguides-com/study-guides-api/api/v1/tag"
+	"github.com/studyguides-com/study-guides-api/internal/middleware"
+	"github.com/studyguides-com/study-guides-api/internal/store"
+	"github.com/studyguides-com/study-guides-api/internal/store/sharing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var tagAccessLevels = map[tagpb.TagAccessLevel]string{
+	tagpb.TagAccessLevel_TAG_ACCESS_LEVEL_READ_ONLY:  sharing.AccessReadOnly,
+	tagpb.TagAccessLevel_TAG_ACCESS_LEVEL_READ_WRITE: sharing.AccessReadWrite,
+}
+
+var tagInviteStatuses = map[string]tagpb.TagInviteStatus{
+	sharing.InvitePending:  tagpb.TagInviteStatus_TAG_INVITE_STATUS_PENDING,
+	sharing.InviteAccepted: tagpb.TagInviteStatus_TAG_INVITE_STATUS_ACCEPTED,
+	sharing.InviteRejected: tagpb.TagInviteStatus_TAG_INVITE_STATUS_REJECTED,
+}
+
+// sessionUserID is the caller's user ID, empty when anonymous
+func sessionUserID(session *middleware.SessionDetails) string {
+	if !session.IsAuth || session.UserID == nil {
+		return ""
+	}
+	return *session.UserID
+}
+
+// checkTagReadAccess returns NotFound for tags the caller can't see so private tags aren't revealed
+func checkTagReadAccess(ctx context.Context, st store.Store, session *middleware.SessionDetails, tagID string) error {
+	if session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+		return nil
+	}
+	access, err := st.SharingStore().Access(ctx, tagID, sessionUserID(session))
+	if err != nil {
+		return err
+	}
+	if !access.CanRead() {
+		return status.Errorf(codes.NotFound, "tag %s not found", tagID)
+	}
+	return nil
+}
+
+// filterReadableTags drops private tags the caller neither owns nor was granted, directly or through an ancestor
+func filterReadableTags(ctx context.Context, st store.Store, session *middleware.SessionDetails, tags []*sharedpb.Tag) ([]*sharedpb.Tag, error) {
+	if session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+		return tags, nil
+	}
+
+	var private []string
+	for _, t := range tags {
+		if !t.Public {
+			private = append(private, t.Id)
+		}
+	}
+	if len(private) == 0 {
+		return tags, nil
+	}
+
+	accessible, err := st.SharingStore().AccessibleTagIDs(ctx, private, sessionUserID(session))
+	if err != nil {
+		return nil, err
+	}
+	allowed := make(map[string]bool, len(accessible))
+	for _, id := range accessible {
+		allowed[id] = true
+	}
+
+	readable := make([]*sharedpb.Tag, 0, len(tags))
+	for _, t := range tags {
+		if t.Public || allowed[t.Id] {
+			readable = append(readable, t)
+		}
+	}
+	return readable, nil
+}
+
+// checkTagManageAccess allows the owner of the tag or one of its ancestors, and admins
+func (s *TagService) checkTagManageAccess(ctx context.Context, session *middleware.SessionDetails, tagID, method string) error {
+	if !session.IsAuth {
+		log.Printf("%s request from anonymous user", method)
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if tagID == "" {
+		return status.Error(codes.InvalidArgument, "tag_id is required")
+	}
+	log.Printf("%s request from user %s for tag %s", method, *session.UserID, tagID)
+	if session.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+		return nil
+	}
+
+	access, err := s.store.SharingStore().Access(ctx, tagID, *session.UserID)
+	if err != nil {
+		return err
+	}
+	if !access.CanRead() {
+		return status.Errorf(codes.NotFound, "tag %s not found", tagID)
+	}
+	if !access.CanManage() {
+		return status.Error(codes.PermissionDenied, "only the tag owner can manage sharing")
+	}
+	return nil
+}
+
+func (s *TagService) InviteToTag(ctx context.Context, req *tagpb.InviteToTagRequest) (*tagpb.TagInvite, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := s.checkTagManageAccess(ctx, session, req.TagId, "InviteToTag"); err != nil {
+			return nil, err
+		}
+
+		accessType := sharing.AccessReadOnly
+		if req.AccessLevel != tagpb.TagAccessLevel_TAG_ACCESS_LEVEL_UNSPECIFIED {
+			var ok bool
+			if accessType, ok = tagAccessLevels[req.AccessLevel]; !ok {
+				return nil, status.Error(codes.InvalidArgument, "invalid access level")
+			}
+		}
+
+		invite := &sharing.NewInvite{
+			TagID:      req.TagId,
+			InviterID:  *session.UserID,
+			AccessType: accessType,
+		}
+		switch invitee := req.Invitee.(type) {
+		case *tagpb.InviteToTagRequest_UserId:
+			if invitee.UserId == *session.UserID {
+				return nil, status.Error(codes.InvalidArgument, "cannot invite yourself")
+			}
+			invite.InviteeID = invitee.UserId
+		case *tagpb.InviteToTagRequest_Email:
+			if !strings.Contains(invitee.Email, "@") {
+				return nil, status.Error(codes.InvalidArgument, "invalid email")
+			}
+			invite.Email = invitee.Email
+		}
+
+		created, err := s.store.SharingStore().CreateInvite(ctx, invite)
+		if err != nil {
+			return nil, err
+		}
+		return newTagInvite(created), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*tagpb.TagInvite), nil
+}
+
+func (s *TagService) AcceptTagInvite(ctx context.Context, req *tagpb.AcceptTagInviteRequest) (*tagpb.TagInvite, error) {
+	return s.respondToInvite(ctx, req.InviteId, true, "AcceptTagInvite")
+}
+
+func (s *TagService) DeclineTagInvite(ctx context.Context, req *tagpb.DeclineTagInviteRequest) (*tagpb.TagInvite, error) {
+	return s.respondToInvite(ctx, req.InviteId, false, "DeclineTagInvite")
+}
+
+func (s *TagService) respondToInvite(ctx context.Context, inviteID string, accept bool, method string) (*tagpb.TagInvite, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if !session.IsAuth {
+			log.Printf("%s request from anonymous user", method)
+			return nil, status.Error(codes.Unauthenticated, "authentication required")
+		}
+		if inviteID == "" {
+			return nil, status.Error(codes.InvalidArgument, "invite_id is required")
+		}
+		log.Printf("%s request from user %s for invite %s", method, *session.UserID, inviteID)
+
+		invite, err := s.store.SharingStore().RespondToInvite(ctx, inviteID, *session.UserID, accept)
+		if err != nil {
+			return nil, err
+		}
+		return newTagInvite(invite), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*tagpb.TagInvite), nil
+}
+
+func (s *TagService) RevokeTagAccess(ctx context.Context, req *tagpb.RevokeTagAccessRequest) (*tagpb.RevokeTagAccessResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := s.checkTagManageAccess(ctx, session, req.TagId, "RevokeTagAccess"); err != nil {
+			return nil, err
+		}
+
+		sharingStore := s.store.SharingStore()
+		switch target := req.Target.(type) {
+		case *tagpb.RevokeTagAccessRequest_UserId:
+			if err := sharingStore.RevokeAccess(ctx, req.TagId, target.UserId); err != nil {
+				return nil, err
+			}
+		case *tagpb.RevokeTagAccessRequest_InviteId:
+			invite, err := sharingStore.Invite(ctx, target.InviteId)
+			if err != nil {
+				return nil, err
+			}
+			if invite.TagID != req.TagId {
+				return nil, status.Errorf(codes.NotFound, "invite %s not found", target.InviteId)
+			}
+			if err := sharingStore.RevokeInvite(ctx, target.InviteId); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, status.Error(codes.InvalidArgument, "a user_id or invite_id is required")
+		}
+
+		return &tagpb.RevokeTagAccessResponse{
+			Success: true,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*tagpb.RevokeTagAccessResponse), nil
+}
+
+func (s *TagService) ListTagCollaborators(ctx context.Context, req *tagpb.ListTagCollaboratorsRequest) (*tagpb.ListTagCollaboratorsResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := s.checkTagManageAccess(ctx, session, req.TagId, "ListTagCollaborators"); err != nil {
+			return nil, err
+		}
+
+		sharingStore := s.store.SharingStore()
+		collaborators, err := sharingStore.ListCollaborators(ctx, req.TagId)
+		if err != nil {
+			return nil, err
+		}
+		invites, err := sharingStore.ListInvitesForTag(ctx, req.TagId)
+		if err != nil {
+			return nil, err
+		}
+
+		response := &tagpb.ListTagCollaboratorsResponse{}
+		for _, c := range collaborators {
+			response.Collaborators = append(response.Collaborators, &tagpb.TagCollaborator{
+				UserId:      c.UserID,
+				Name:        c.Name,
+				Email:       c.Email,
+				AccessLevel: tagAccessLevel(c.AccessType),
+				CreatedAt:   timestamppb.New(c.CreatedAt),
+			})
+		}
+		for _, invite := range invites {
+			response.PendingInvites = append(response.PendingInvites, newTagInvite(invite))
+		}
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*tagpb.ListTagCollaboratorsResponse), nil
+}
+
+func (s *TagService) UpdateTagAccess(ctx context.Context, req *tagpb.UpdateTagAccessRequest) (*tagpb.UpdateTagAccessResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := s.checkTagManageAccess(ctx, session, req.TagId, "UpdateTagAccess"); err != nil {
+			return nil, err
+		}
+		if req.UserId == "" {
+			return nil, status.Error(codes.InvalidArgument, "user_id is required")
+		}
+		accessType, ok := tagAccessLevels[req.AccessLevel]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "access_level is required")
+		}
+
+		if err := s.store.SharingStore().SetAccessType(ctx, req.TagId, req.UserId, accessType); err != nil {
+			return nil, err
+		}
+		return &tagpb.UpdateTagAccessResponse{
+			Success: true,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*tagpb.UpdateTagAccessResponse), nil
+}
+
+func (s *TagService) ListMyTagInvites(ctx context.Context, req *tagpb.ListMyTagInvitesRequest) (*tagpb.ListTagInvitesResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if !session.IsAuth {
+			return nil, status.Error(codes.Unauthenticated, "authentication required")
+		}
+
+		invites, err := s.store.SharingStore().ListInvitesForUser(ctx, *session.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		response := &tagpb.ListTagInvitesResponse{}
+		for _, invite := range invites {
+			response.Invites = append(response.Invites, newTagInvite(invite))
+		}
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*tagpb.ListTagInvitesResponse), nil
+}
+
+func tagAccessLevel(accessType string) tagpb.TagAccessLevel {
+	for level, name := range tagAccessLevels {
+		if name == accessType {
+			return level
+		}
+	}
+	return tagpb.TagAccessLevel_TAG_ACCESS_LEVEL_UNSPECIFIED
+}
+
+func newTagInvite(invite *sharing.Invite) *tagpb.TagInvite {
+	return &tagpb.TagInvite{
+		Id:          invite.ID,
+		TagId:       invite.TagID,
+		TagName:     invite.TagName,
+		InviterId:   invite.InviterID,
+		InviteeId:   invite.InviteeID,
+		Email:       invite.Email,
+		AccessLevel: tagAccessLevel(invite.AccessType),
+		Status:      tagInviteStatuses[invite.Status],
+		CreatedAt:   timestamppb.New(invite.CreatedAt),
+		ExpiresAt:   timestamppb.New(invite.ExpiresAt),
+	}
+}
@@ -59,3 +59,31 @@ func (s *UserService) Profile(ctx context.Context, req *userpb.ProfileRequest) (
 	}
 	return resp.(*userpb.ProfileResponse), nil
 }
+
+// Entitlements tells the client which plan the caller is on and what it unlocks, anonymous callers get the free plan
+func (s *UserService) Entitlements(ctx context.Context, req *userpb.EntitlementsRequest) (*userpb.EntitlementsResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		response := &userpb.EntitlementsResponse{
+			Plan: userpb.Plan_PLAN_FREE,
+		}
+		if session.Entitlements.Plan == middleware.PlanPremium {
+			response.Plan = userpb.Plan_PLAN_PREMIUM
+		}
+		if session.Entitlements.SubscriptionType != "" {
+			response.SubscriptionType = &session.Entitlements.SubscriptionType
+		}
+		if session.Entitlements.ExpiresAt != nil {
+			response.ExpiresAt = timestamppb.New(*session.Entitlements.ExpiresAt)
+		}
+		for _, feature := range middleware.PremiumFeatures {
+			if session.Entitled(feature) {
+				response.Features = append(response.Features, string(feature))
+			}
+		}
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*userpb.EntitlementsResponse), nil
+}
@@ -0,0 +1,295 @@
+package services
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	usercontentpb "github.com/studyguides-com/study-guides-api/api/v1/usercontent"
+	"github.com/studyguides-com/study-guides-api/internal/middleware"
+	"github.com/studyguides-com/study-guides-api/internal/store"
+	"github.com/studyguides-com/study-guides-api/internal/store/usercontent"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxUserTagNameLength  = 200
+	maxUserQuestionLength = 4000
+)
+
+var userTagTypes = map[sharedpb.TagType]string{
+	sharedpb.TagType_UserFolder:     usercontent.TypeFolder,
+	sharedpb.TagType_UserStudyGuide: usercontent.TypeStudyGuide,
+	sharedpb.TagType_UserTopic:      usercontent.TypeTopic,
+}
+
+type UserContentService struct {
+	usercontentpb.UnimplementedUserContentServiceServer
+	store  store.Store
+	quotas usercontent.Quotas
+}
+
+func NewUserContentService(store store.Store) *UserContentService {
+	return &UserContentService{
+		store:  store,
+		quotas: usercontent.DefaultQuotas,
+	}
+}
+
+// checkAuthorAccess requires a signed-in user, every authoring call is scoped to the caller
+func checkAuthorAccess(session *middleware.SessionDetails, method string) error {
+	if !session.IsAuth {
+		log.Printf("%s request from anonymous user", method)
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	log.Printf("%s request from user %s", method, *session.UserID)
+	return nil
+}
+
+func (s *UserContentService) CreateTag(ctx context.Context, req *usercontentpb.CreateTagRequest) (*sharedpb.Tag, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkAuthorAccess(session, "CreateTag"); err != nil {
+			return nil, err
+		}
+
+		tagType, ok := userTagTypes[req.Type]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "type must be UserFolder, UserStudyGuide or UserTopic")
+		}
+		name, err := userTagName(req.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		tagID, err := s.store.UserContentStore().CreateTag(ctx, &usercontent.NewTag{
+			OwnerID:     *session.UserID,
+			ParentID:    req.ParentId,
+			Type:        tagType,
+			Name:        name,
+			Description: req.Description,
+		}, s.quotas.Limit(tagType))
+		if err != nil {
+			return nil, err
+		}
+		return s.store.TagStore().GetTagByID(ctx, tagID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*sharedpb.Tag), nil
+}
+
+func (s *UserContentService) UpdateTag(ctx context.Context, req *usercontentpb.UpdateTagRequest) (*sharedpb.Tag, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkAuthorAccess(session, "UpdateTag"); err != nil {
+			return nil, err
+		}
+		if req.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, "id is required")
+		}
+
+		update := &usercontent.TagUpdate{Description: req.Description}
+		if req.Name != nil {
+			name, err := userTagName(*req.Name)
+			if err != nil {
+				return nil, err
+			}
+			update.Name = &name
+		}
+
+		if err := s.store.UserContentStore().UpdateTag(ctx, *session.UserID, req.Id, update); err != nil {
+			return nil, err
+		}
+		return s.store.TagStore().GetTagByID(ctx, req.Id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*sharedpb.Tag), nil
+}
+
+func (s *UserContentService) DeleteTag(ctx context.Context, req *usercontentpb.DeleteTagRequest) (*usercontentpb.DeleteTagResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkAuthorAccess(session, "DeleteTag"); err != nil {
+			return nil, err
+		}
+		if req.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, "id is required")
+		}
+
+		if _, err := s.store.UserContentStore().OwnedTag(ctx, *session.UserID, req.Id); err != nil {
+			return nil, err
+		}
+		// Same path as an admin kill so the subtree lands in trash and leaves the index, taking
+		// the author's questions in it along
+		if _, err := s.store.AdminStore().KillOwnedTree(ctx, req.Id, *session.UserID, 0); err != nil {
+			return nil, err
+		}
+		return &usercontentpb.DeleteTagResponse{
+			Success: true,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*usercontentpb.DeleteTagResponse), nil
+}
+
+func (s *UserContentService) CreateQuestion(ctx context.Context, req *usercontentpb.CreateQuestionRequest) (*sharedpb.Question, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkAuthorAccess(session, "CreateQuestion"); err != nil {
+			return nil, err
+		}
+		if req.TagId == "" {
+			return nil, status.Error(codes.InvalidArgument, "tag_id is required")
+		}
+		questionText, err := userQuestionText("question_text", req.QuestionText)
+		if err != nil {
+			return nil, err
+		}
+		answerText, err := userQuestionText("answer_text", req.AnswerText)
+		if err != nil {
+			return nil, err
+		}
+
+		questionID, err := s.store.UserContentStore().CreateQuestion(ctx, &usercontent.NewQuestion{
+			OwnerID:      *session.UserID,
+			TagID:        req.TagId,
+			QuestionText: questionText,
+			AnswerText:   answerText,
+			LearnMore:    req.LearnMore,
+			Distractors:  cleanDistractors(req.Distractors),
+		}, s.quotas.Questions)
+		if err != nil {
+			return nil, err
+		}
+		return s.store.QuestionStore().GetQuestionByID(ctx, questionID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*sharedpb.Question), nil
+}
+
+func (s *UserContentService) UpdateQuestion(ctx context.Context, req *usercontentpb.UpdateQuestionRequest) (*sharedpb.Question, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkAuthorAccess(session, "UpdateQuestion"); err != nil {
+			return nil, err
+		}
+		if req.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, "id is required")
+		}
+
+		update := &usercontent.QuestionUpdate{LearnMore: req.LearnMore}
+		if req.QuestionText != nil {
+			questionText, err := userQuestionText("question_text", *req.QuestionText)
+			if err != nil {
+				return nil, err
+			}
+			update.QuestionText = &questionText
+		}
+		if req.AnswerText != nil {
+			answerText, err := userQuestionText("answer_text", *req.AnswerText)
+			if err != nil {
+				return nil, err
+			}
+			update.AnswerText = &answerText
+		}
+		if req.Distractors != nil {
+			distractors := cleanDistractors(req.Distractors.Values)
+			if distractors == nil {
+				distractors = []string{}
+			}
+			update.Distractors = &distractors
+		}
+
+		if err := s.store.UserContentStore().UpdateQuestion(ctx, *session.UserID, req.Id, update); err != nil {
+			return nil, err
+		}
+		return s.store.QuestionStore().GetQuestionByID(ctx, req.Id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*sharedpb.Question), nil
+}
+
+func (s *UserContentService) DeleteQuestion(ctx context.Context, req *usercontentpb.DeleteQuestionRequest) (*usercontentpb.DeleteQuestionResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkAuthorAccess(session, "DeleteQuestion"); err != nil {
+			return nil, err
+		}
+		if req.Id == "" {
+			return nil, status.Error(codes.InvalidArgument, "id is required")
+		}
+
+		if err := s.store.UserContentStore().DeleteQuestion(ctx, *session.UserID, req.Id); err != nil {
+			return nil, err
+		}
+		return &usercontentpb.DeleteQuestionResponse{
+			Success: true,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*usercontentpb.DeleteQuestionResponse), nil
+}
+
+func (s *UserContentService) GetUsage(ctx context.Context, req *usercontentpb.GetUsageRequest) (*usercontentpb.GetUsageResponse, error) {
+	resp, err := AuthBaseHandler(ctx, func(ctx context.Context, session *middleware.SessionDetails) (interface{}, error) {
+		if err := checkAuthorAccess(session, "GetUsage"); err != nil {
+			return nil, err
+		}
+
+		usage, err := s.store.UserContentStore().Usage(ctx, *session.UserID)
+		if err != nil {
+			return nil, err
+		}
+		return &usercontentpb.GetUsageResponse{
+			Folders:     &usercontentpb.Quota{Used: int32(usage.Folders), Limit: int32(s.quotas.Folders)},
+			StudyGuides: &usercontentpb.Quota{Used: int32(usage.StudyGuides), Limit: int32(s.quotas.StudyGuides)},
+			Topics:      &usercontentpb.Quota{Used: int32(usage.Topics), Limit: int32(s.quotas.Topics)},
+			Questions:   &usercontentpb.Quota{Used: int32(usage.Questions), Limit: int32(s.quotas.Questions)},
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*usercontentpb.GetUsageResponse), nil
+}
+
+func userTagName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", status.Error(codes.InvalidArgument, "name is required")
+	}
+	if len(name) > maxUserTagNameLength {
+		return "", status.Errorf(codes.InvalidArgument, "name must be at most %d characters", maxUserTagNameLength)
+	}
+	return name, nil
+}
+
+func userQuestionText(field, text string) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "", status.Errorf(codes.InvalidArgument, "%s is required", field)
+	}
+	if len(text) > maxUserQuestionLength {
+		return "", status.Errorf(codes.InvalidArgument, "%s must be at most %d characters", field, maxUserQuestionLength)
+	}
+	return text, nil
+}
+
+// cleanDistractors trims distractors and drops blanks
+func cleanDistractors(distractors []string) []string {
+	var cleaned []string
+	for _, d := range distractors {
+		if d = strings.TrimSpace(d); d != "" {
+			cleaned = append(cleaned, d)
+		}
+	}
+	return cleaned
+}
@@ -2,6 +2,7 @@ package admin
 
 import (
 	"context"
+	"time"
 
 	"github.com/algolia/algoliasearch-client-go/v3/algolia/search"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -30,6 +31,9 @@ type AdminStore interface {
 	// UpsertPassage saves or updates a passage in the database
 	UpsertPassage(ctx context.Context, passage *sharedpb.Passage) (*sharedpb.Passage, error)
 
+	// Question retrieves a question by its ID
+	Question(ctx context.Context, id string) (*sharedpb.Question, error)
+
 	// UpsertQuestion saves or updates a question in the database
 	UpsertQuestion(ctx context.Context, question *sharedpb.Question) (*sharedpb.Question, error)
 
@@ -51,12 +55,40 @@ type AdminStore interface {
 	// TreeId retrieves the entire study guide hierarchy for a given id
 	Tree(ctx context.Context, id string) (*sharedpb.TagNode, error)
 
-	// KillTree kills the tree for a given id
-	KillTree(ctx context.Context, id string) ([]string, error)
+	// KillTree moves the tree for a given id into trash and deletes it
+	KillTree(ctx context.Context, id string, deletedBy string, retention time.Duration) (*TrashEntry, error)
+
+	// KillOwnedTree is KillTree for an author deleting their own tree, which also trashes the
+	// questions they own that only the tree held
+	KillOwnedTree(ctx context.Context, id string, ownerID string, retention time.Duration) (*TrashEntry, error)
+
+	// ListTrash lists trashed trees that have not been restored
+	ListTrash(ctx context.Context, includeExpired bool) ([]*TrashEntry, error)
+
+	// RestoreTree restores a trashed tree and queues its tags for indexing
+	RestoreTree(ctx context.Context, trashID string, restoredBy string) ([]string, error)
+
+	// PurgeTrash permanently removes a trash entry, or all expired entries when trashID is empty
+	PurgeTrash(ctx context.Context, trashID string) (int64, error)
 
 	// KillUser kills the user for a given email, returns true if deleted, false if not found
 	KillUser(ctx context.Context, email string) (bool, error)
 
+	// KillTreeImpact reports what KillTree would delete for a given id
+	KillTreeImpact(ctx context.Context, id string) (*KillImpact, error)
+
+	// KillUserImpact reports what KillUser would delete for a given email
+	KillUserImpact(ctx context.Context, email string) (*KillImpact, error)
+
+	// CreateKillConfirmation issues a single-use token the real kill call must present
+	CreateKillConfirmation(ctx context.Context, action string, targetID string, requestedBy string) (*KillConfirmation, error)
+
+	// ConsumeKillConfirmation validates and uses up a confirmation token
+	ConsumeKillConfirmation(ctx context.Context, token string, action string, targetID string, requestedBy string) error
+
+	// ReleaseKillConfirmation makes a used token available again after the kill failed
+	ReleaseKillConfirmation(ctx context.Context, token string) error
+
 	// TagExistsFor checks if a tag exists for a given objectID
 	TagExistsFor(ctx context.Context, id string) (bool, error)
 
@@ -66,9 +98,21 @@ type AdminStore interface {
 	// UpdateMetadataFor updates the metadata for a given id
 	UpdateMetadataFor(ctx context.Context, id string, metadata *sharedpb.Metadata) error
 
+	// BulkUpdateMetadata applies a merge patch to the metadata of every tag or question matching a filter
+	BulkUpdateMetadata(ctx context.Context, filter *MetadataFilter, patch *MetadataPatch, dryRun bool) (*BulkMetadataResult, error)
+
+	// ListContentAudit pages through topics missing content rating, descriptors or meta tags
+	ListContentAudit(ctx context.Context, filter *ContentAuditFilter) ([]*ContentAuditRow, int64, error)
+
+	// BulkUpdateContent sets rating data across a selection of tags and queues them for indexing
+	BulkUpdateContent(ctx context.Context, update *ContentUpdate) ([]string, error)
+
 	// ImportGob imports a gob payload into the database
 	ImportGob(ctx context.Context, gobPayload []byte) (bool, error)
 
+	// ImportGuide imports a guide in one transaction and records it as an import batch, a dry run reports changes and rolls back
+	ImportGuide(ctx context.Context, guide *sharedpb.GuideData, fileName string, dryRun bool) (*ImportSummary, error)
+
 	// BuildIndexCache builds the index cache for a given id
 	UpdateIndexCache(ctx context.Context, id string) error
 
@@ -0,0 +1,237 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+)
+
+// Object types whose metadata can be bulk edited
+const (
+	MetadataObjectTag      = "Tag"
+	MetadataObjectQuestion = "Question"
+)
+
+// MetadataFilter selects the tags or questions a bulk metadata edit applies to.
+// At least one criterion is required so an empty filter never rewrites a whole table.
+type MetadataFilter struct {
+	ObjectType string
+	SubtreeID  string  // tags under this tag, or questions linked to them
+	BatchID    string  // rows created by an import batch
+	ParserType string  // metadata parserType stamped on import
+	Key        string  // rows whose metadata has this key
+	Value      *string // and, when set, this value for Key
+}
+
+// MetadataPatch is an RFC 7386 merge patch plus set/unset shorthands, which are applied after it
+type MetadataPatch struct {
+	MergePatch []byte
+	Set        map[string]string
+	Unset      []string
+}
+
+// BulkMetadataResult reports how many rows matched and which of them actually changed
+type BulkMetadataResult struct {
+	Matched     int
+	AffectedIDs []string
+}
+
+type metadataRow struct {
+	ID       string `db:"id"`
+	Metadata []byte `db:"metadata"`
+}
+
+// BulkUpdateMetadata applies a patch to the metadata of every matching row. A dry run reports the
+// rows that would change without writing them.
+func (s *SqlAdminStore) BulkUpdateMetadata(ctx context.Context, filter *MetadataFilter, patch *MetadataPatch, dryRun bool) (*BulkMetadataResult, error) {
+	if filter.ObjectType != MetadataObjectTag && filter.ObjectType != MetadataObjectQuestion {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown object type %q", filter.ObjectType)
+	}
+	changes, err := patch.changes()
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := metadataFilterQuery(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var rows []*metadataRow
+	if err := pgxscan.Select(ctx, tx, &rows, query, args...); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to select %s metadata: %v", filter.ObjectType, err)
+	}
+
+	result := &BulkMetadataResult{Matched: len(rows)}
+	var patched []string
+	for _, row := range rows {
+		updated, changed, err := applyMergePatch(row.Metadata, changes)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to patch metadata for %s: %v", row.ID, err)
+		}
+		if !changed {
+			continue
+		}
+		result.AffectedIDs = append(result.AffectedIDs, row.ID)
+		patched = append(patched, string(updated))
+	}
+
+	if dryRun || len(result.AffectedIDs) == 0 {
+		return result, nil
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE public."`+filter.ObjectType+`" x
+		SET metadata = v.metadata::jsonb, "updatedAt" = NOW()
+		FROM unnest($1::text[], $2::text[]) AS v(id, metadata)
+		WHERE x.id = v.id
+	`, result.AffectedIDs, patched)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update %s metadata: %v", filter.ObjectType, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit metadata update: %v", err)
+	}
+	return result, nil
+}
+
+// metadataFilterQuery builds the locking select for the rows a filter matches
+func metadataFilterQuery(filter *MetadataFilter) (string, []interface{}, error) {
+	var args []interface{}
+	var where []string
+	with := ""
+
+	if filter.SubtreeID != "" {
+		args = append(args, filter.SubtreeID, utils.MaxTreeDepth)
+		with = fmt.Sprintf(`
+			WITH RECURSIVE subtree AS (
+				SELECT id, 0 AS depth FROM public."Tag" WHERE id = $%d
+				UNION ALL
+				SELECT c.id, p.depth + 1 FROM public."Tag" c JOIN subtree p ON c."parentTagId" = p.id
+				WHERE p.depth < $%d
+			)`, len(args)-1, len(args))
+		if filter.ObjectType == MetadataObjectTag {
+			where = append(where, `x.id IN (SELECT id FROM subtree)`)
+		} else {
+			where = append(where, `EXISTS (SELECT 1 FROM public."QuestionTag" qt JOIN subtree s ON s.id = qt."tagId" WHERE qt."questionId" = x.id)`)
+		}
+	}
+	if filter.BatchID != "" {
+		args = append(args, filter.BatchID)
+		where = append(where, fmt.Sprintf(`x."batchId" = $%d`, len(args)))
+	}
+	if filter.ParserType != "" {
+		args = append(args, filter.ParserType)
+		where = append(where, fmt.Sprintf(`x.metadata->>'parserType' = $%d`, len(args)))
+	}
+	if filter.Key != "" {
+		args = append(args, filter.Key)
+		if filter.Value != nil {
+			args = append(args, *filter.Value)
+			where = append(where, fmt.Sprintf(`x.metadata->>$%d = $%d`, len(args)-1, len(args)))
+		} else {
+			where = append(where, fmt.Sprintf(`x.metadata ? $%d`, len(args)))
+		}
+	} else if filter.Value != nil {
+		return "", nil, status.Error(codes.InvalidArgument, "a metadata value filter needs a key")
+	}
+
+	if len(where) == 0 {
+		return "", nil, status.Error(codes.InvalidArgument, "at least one filter is required")
+	}
+
+	query := with + `
+		SELECT x.id, x.metadata
+		FROM public."` + filter.ObjectType + `" x
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY x.id
+		FOR UPDATE OF x`
+	return query, args, nil
+}
+
+// changes folds the set/unset shorthands into the merge patch. Metadata is a flat string map,
+// so every patched value must be a string or null.
+func (p *MetadataPatch) changes() (map[string]interface{}, error) {
+	changes := make(map[string]interface{})
+	if len(bytes.TrimSpace(p.MergePatch)) > 0 {
+		if err := json.Unmarshal(p.MergePatch, &changes); err != nil || changes == nil {
+			return nil, status.Error(codes.InvalidArgument, "merge patch must be a JSON object")
+		}
+	}
+	for k, v := range changes {
+		switch v.(type) {
+		case string, nil:
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "merge patch value for %q must be a string or null", k)
+		}
+	}
+	for k, v := range p.Set {
+		changes[k] = v
+	}
+	for _, k := range p.Unset {
+		changes[k] = nil
+	}
+	if len(changes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "patch has no changes")
+	}
+	return changes, nil
+}
+
+// applyMergePatch merges changes into stored metadata, reporting whether anything changed
+func applyMergePatch(current []byte, changes map[string]interface{}) ([]byte, bool, error) {
+	var target interface{}
+	if len(current) > 0 {
+		if err := json.Unmarshal(current, &target); err != nil {
+			return nil, false, err
+		}
+	}
+	before, err := json.Marshal(target)
+	if err != nil {
+		return nil, false, err
+	}
+
+	merged := mergePatch(target, changes)
+	if obj, ok := merged.(map[string]interface{}); ok && len(obj) == 0 && target == nil {
+		return nil, false, nil
+	}
+	after, err := json.Marshal(merged)
+	if err != nil {
+		return nil, false, err
+	}
+	return after, !bytes.Equal(before, after), nil
+}
+
+// mergePatch implements RFC 7386: objects merge recursively, nulls remove keys, anything else replaces
+func mergePatch(target interface{}, patch interface{}) interface{} {
+	patchObj, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	targetObj, ok := target.(map[string]interface{})
+	if !ok {
+		targetObj = make(map[string]interface{})
+	}
+	for k, v := range patchObj {
+		if v == nil {
+			delete(targetObj, k)
+			continue
+		}
+		targetObj[k] = mergePatch(targetObj[k], v)
+	}
+	return targetObj
+}
@@ -0,0 +1,164 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+)
+
+// ContentAuditFilter selects topics that are missing rating data. When no missing flag is set,
+// topics missing any of the three are returned.
+type ContentAuditFilter struct {
+	MissingContentRating      bool
+	MissingContentDescriptors bool
+	MissingMetaTags           bool
+	Context                   *string // ContextType name
+	AncestorID                string
+	Limit                     int
+	Offset                    int
+}
+
+// ContentAuditRow is a topic as shown to raters
+type ContentAuditRow struct {
+	ID                 string   `db:"id"`
+	Name               string   `db:"name"`
+	Context            *string  `db:"context"`
+	ParentName         *string  `db:"parentName"`
+	ContentRating      string   `db:"contentRating"`
+	ContentDescriptors []string `db:"contentDescriptors"`
+	MetaTags           []string `db:"metaTags"`
+}
+
+// ContentUpdate is applied to every selected tag, nil and unset fields are left alone
+type ContentUpdate struct {
+	TagIDs                []string
+	ContentRating         *string
+	ContentDescriptors    []string
+	SetContentDescriptors bool
+	MetaTags              []string
+	SetMetaTags           bool
+}
+
+const (
+	missingContentRatingClause      = `t."contentRating" = 'RatingPending'`
+	missingContentDescriptorsClause = `cardinality(t."contentDescriptors") = 0`
+	missingMetaTagsClause           = `cardinality(t."metaTags") = 0`
+)
+
+// ListContentAudit pages through topics missing rating, descriptor or meta tag data
+func (s *SqlAdminStore) ListContentAudit(ctx context.Context, filter *ContentAuditFilter) ([]*ContentAuditRow, int64, error) {
+	var missing []string
+	if filter.MissingContentRating {
+		missing = append(missing, missingContentRatingClause)
+	}
+	if filter.MissingContentDescriptors {
+		missing = append(missing, missingContentDescriptorsClause)
+	}
+	if filter.MissingMetaTags {
+		missing = append(missing, missingMetaTagsClause)
+	}
+	if len(missing) == 0 {
+		missing = []string{missingContentRatingClause, missingContentDescriptorsClause, missingMetaTagsClause}
+	}
+
+	var args []interface{}
+	with := ""
+	from := `public."Tag" t`
+	where := []string{`t.type = 'Topic'`, "(" + strings.Join(missing, " OR ") + ")"}
+	if filter.AncestorID != "" {
+		args = append(args, filter.AncestorID, utils.MaxTreeDepth)
+		with = fmt.Sprintf(`
+			WITH RECURSIVE subtree AS (
+				SELECT id, 0 AS depth FROM public."Tag" WHERE id = $%d
+				UNION ALL
+				SELECT c.id, p.depth + 1 FROM public."Tag" c JOIN subtree p ON c."parentTagId" = p.id
+				WHERE p.depth < $%d
+			)`, len(args)-1, len(args))
+		from = `subtree s JOIN public."Tag" t ON t.id = s.id`
+	}
+	if filter.Context != nil {
+		args = append(args, *filter.Context)
+		where = append(where, fmt.Sprintf(`t.context::text = $%d`, len(args)))
+	}
+
+	// %s is the select list, shared by the count and the page
+	query := with + ` SELECT %s FROM ` + from + ` WHERE ` + strings.Join(where, " AND ")
+
+	var total int64
+	if err := pgxscan.Get(ctx, s.db, &total, fmt.Sprintf(query, "COUNT(*)"), args...); err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "failed to count content audit rows: %v", err)
+	}
+
+	pageArgs := append(args, filter.Limit, filter.Offset)
+	var rows []*ContentAuditRow
+	err := pgxscan.Select(ctx, s.db, &rows, fmt.Sprintf(query, `t.id, t.name, t.context::text AS context,
+			(SELECT p.name FROM public."Tag" p WHERE p.id = t."parentTagId") AS "parentName",
+			t."contentRating"::text AS "contentRating", t."contentDescriptors", t."metaTags"`)+
+		fmt.Sprintf(` ORDER BY t.name, t.id LIMIT $%d OFFSET $%d`, len(args)+1, len(args)+2), pageArgs...)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "failed to list content audit rows: %v", err)
+	}
+	return rows, total, nil
+}
+
+// BulkUpdateContent sets rating data on the selected tags and queues them for indexing
+func (s *SqlAdminStore) BulkUpdateContent(ctx context.Context, update *ContentUpdate) ([]string, error) {
+	if len(update.TagIDs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no tags selected")
+	}
+	if update.ContentRating == nil && !update.SetContentDescriptors && !update.SetMetaTags {
+		return nil, status.Error(codes.InvalidArgument, "no content changes given")
+	}
+
+	descriptors := update.ContentDescriptors
+	if descriptors == nil {
+		descriptors = []string{}
+	}
+	metaTags := update.MetaTags
+	if metaTags == nil {
+		metaTags = []string{}
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var updatedIDs []string
+	err = pgxscan.Select(ctx, tx, &updatedIDs, `
+		UPDATE public."Tag"
+		SET "contentRating" = COALESCE($2::text::"ContentRatingType", "contentRating"),
+			"contentDescriptors" = CASE WHEN $3 THEN $4::text[] ELSE "contentDescriptors" END,
+			"metaTags" = CASE WHEN $5 THEN $6::text[] ELSE "metaTags" END,
+			"updatedAt" = NOW()
+		WHERE id = ANY($1)
+		RETURNING id
+	`, update.TagIDs, update.ContentRating, update.SetContentDescriptors, descriptors, update.SetMetaTags, metaTags)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update content ratings: %v", err)
+	}
+
+	// Queue the tags so Algolia picks up the new missing* flags
+	_, err = tx.Exec(ctx, `
+		INSERT INTO public."IndexOutbox" ("objectType", "objectId", action, "queuedAt")
+		SELECT 'Tag', id, 'upsert', NOW()
+		FROM unnest($1::text[]) AS id
+		ON CONFLICT ("objectType", "objectId") DO UPDATE
+		SET action = 'upsert', "queuedAt" = NOW()
+	`, updatedIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to queue tags for indexing: %v", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit content update: %v", err)
+	}
+	return updatedIDs, nil
+}
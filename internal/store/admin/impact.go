@@ -0,0 +1,305 @@
+package admin
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+)
+
+const (
+	KillActionTree = "KillTree"
+	KillActionUser = "KillUser"
+
+	killConfirmationTTL = 10 * time.Minute // How long a preview token can be used for the real call
+)
+
+// ImpactCount is the number of rows a kill would remove from, or detach in, a table
+type ImpactCount struct {
+	Table  string
+	Detail string
+	Count  int64
+}
+
+// KillImpact describes everything a KillTree or KillUser call would delete
+type KillImpact struct {
+	Counts        []ImpactCount
+	TopLevelNames []string
+}
+
+// KillConfirmation is a single-use token returned by a preview that the real kill must present
+type KillConfirmation struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+// impactQuery counts rows in a table for the target of a kill
+type impactQuery struct {
+	table  string
+	detail string
+	query  string
+}
+
+var treeImpactQueries = []impactQuery{
+	{table: "Passage", query: `SELECT COUNT(*) FROM public."Passage" WHERE "tagId" = ANY($1)`},
+	{table: "QuestionTag", query: `SELECT COUNT(*) FROM public."QuestionTag" WHERE "tagId" = ANY($1)`},
+	{
+		table:  "Question",
+		detail: "orphaned",
+		query: `
+			SELECT COUNT(DISTINCT qt."questionId")
+			FROM public."QuestionTag" qt
+			WHERE qt."tagId" = ANY($1)
+			AND NOT EXISTS (
+				SELECT 1 FROM public."QuestionTag" o
+				WHERE o."questionId" = qt."questionId" AND NOT (o."tagId" = ANY($1))
+			)`,
+	},
+	{table: "TestSession", query: `SELECT COUNT(*) FROM public."TestSession" WHERE "tagId" = ANY($1)`},
+	{table: "SurvivalSession", query: `SELECT COUNT(*) FROM public."SurvivalSession" WHERE "tagId" = ANY($1)`},
+	{table: "UserTagFavorite", query: `SELECT COUNT(*) FROM public."UserTagFavorite" WHERE "tagId" = ANY($1)`},
+	{table: "UserTagRecent", query: `SELECT COUNT(*) FROM public."UserTagRecent" WHERE "tagId" = ANY($1)`},
+	{table: "UserTopicProgress", query: `SELECT COUNT(*) FROM public."UserTopicProgress" WHERE "topicId" = ANY($1)`},
+	{table: "UserTagRating", query: `SELECT COUNT(*) FROM public."UserTagRating" WHERE "tagId" = ANY($1)`},
+	{table: "UserTagReport", query: `SELECT COUNT(*) FROM public."UserTagReport" WHERE "tagId" = ANY($1)`},
+	{table: "TagAccess", query: `SELECT COUNT(*) FROM public."TagAccess" WHERE "tagId" = ANY($1)`},
+	{table: "TagInvite", query: `SELECT COUNT(*) FROM public."TagInvite" WHERE "tagId" = ANY($1)`},
+	{
+		table:  "Algolia",
+		detail: "tags",
+		query: `
+			SELECT COUNT(*) FROM public."SearchIndexState"
+			WHERE "objectType" = 'Tag' AND "objectId" = ANY($1) AND "lastIndexedAt" IS NOT NULL`,
+	},
+}
+
+var userImpactQueries = []impactQuery{
+	{table: "Account", query: `SELECT COUNT(*) FROM public."Account" WHERE "userId" = $1`},
+	{table: "Session", query: `SELECT COUNT(*) FROM public."Session" WHERE "userId" = $1`},
+	{table: "UserRole", query: `SELECT COUNT(*) FROM public."UserRole" WHERE "userId" = $1`},
+	{table: "Subscription", query: `SELECT COUNT(*) FROM public."Subscription" WHERE "userId" = $1`},
+	{table: "TestSession", query: `SELECT COUNT(*) FROM public."TestSession" WHERE "userId" = $1`},
+	{table: "SurvivalSession", query: `SELECT COUNT(*) FROM public."SurvivalSession" WHERE "userId" = $1`},
+	{table: "UserTagFavorite", query: `SELECT COUNT(*) FROM public."UserTagFavorite" WHERE "userId" = $1`},
+	{table: "UserTagRecent", query: `SELECT COUNT(*) FROM public."UserTagRecent" WHERE "userId" = $1`},
+	{table: "UserTopicProgress", query: `SELECT COUNT(*) FROM public."UserTopicProgress" WHERE "userId" = $1`},
+	{table: "UserQuestionInteraction", query: `SELECT COUNT(*) FROM public."UserQuestionInteraction" WHERE "userId" = $1`},
+	{table: "UserTagRating", query: `SELECT COUNT(*) FROM public."UserTagRating" WHERE "userId" = $1`},
+	{table: "UserQuestionRating", query: `SELECT COUNT(*) FROM public."UserQuestionRating" WHERE "userId" = $1`},
+	{table: "UserTagReport", query: `SELECT COUNT(*) FROM public."UserTagReport" WHERE "userId" = $1`},
+	{table: "UserQuestionReport", query: `SELECT COUNT(*) FROM public."UserQuestionReport" WHERE "userId" = $1`},
+	{table: "TagAccess", query: `SELECT COUNT(*) FROM public."TagAccess" WHERE "userId" = $1`},
+	{table: "QuestionAccess", query: `SELECT COUNT(*) FROM public."QuestionAccess" WHERE "userId" = $1`},
+	{table: "ExperiencePoint", query: `SELECT COUNT(*) FROM public."ExperiencePoint" WHERE "userId" = $1`},
+	// Owner relations are optional, so the user's questions survive with no owner
+	{table: "Question", detail: "ownership cleared", query: `SELECT COUNT(*) FROM public."Question" WHERE "ownerId" = $1`},
+}
+
+// KillTreeImpact reports what KillTree would delete for a given id without deleting anything
+func (s *SqlAdminStore) KillTreeImpact(ctx context.Context, id string) (*KillImpact, error) {
+	tree, err := s.Tree(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := collectNodeIDs(tree)
+
+	impact := &KillImpact{
+		Counts:        tagTypeCounts(tree),
+		TopLevelNames: []string{tree.TagRow.Name},
+	}
+	for _, child := range tree.Children {
+		impact.TopLevelNames = append(impact.TopLevelNames, child.TagRow.Name)
+	}
+
+	counts, err := s.runImpactQueries(ctx, treeImpactQueries, ids)
+	if err != nil {
+		return nil, err
+	}
+	impact.Counts = append(impact.Counts, counts...)
+
+	return impact, nil
+}
+
+// KillUserImpact reports what KillUser would delete for a given email without deleting anything
+func (s *SqlAdminStore) KillUserImpact(ctx context.Context, email string) (*KillImpact, error) {
+	user, err := s.UserByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	impact := &KillImpact{
+		Counts: []ImpactCount{
+			{Table: "User", Count: 1},
+		},
+	}
+
+	// Owned tags by type, with the roots of the user's trees as the top-level names. The
+	// tags themselves survive the kill with their owner cleared
+	var owned []struct {
+		ID          string  `db:"id"`
+		Name        string  `db:"name"`
+		Type        string  `db:"type"`
+		ParentTagID *string `db:"parentTagId"`
+	}
+	query := `
+		SELECT t.id, t.name, t.type, t."parentTagId"
+		FROM public."Tag" t
+		WHERE t."ownerId" = $1
+		ORDER BY t.name
+	`
+	if err := pgxscan.Select(ctx, s.db, &owned, query, user.Id); err != nil {
+		log.Printf("Failed to get owned tags for user %s: %v", user.Id, err)
+		return nil, status.Error(codes.Internal, "failed to get owned tags")
+	}
+
+	ownedIDs := make(map[string]bool, len(owned))
+	for _, tag := range owned {
+		ownedIDs[tag.ID] = true
+	}
+
+	byType := make(map[string]int64)
+	var typeOrder []string
+	for _, tag := range owned {
+		if _, seen := byType[tag.Type]; !seen {
+			typeOrder = append(typeOrder, tag.Type)
+		}
+		byType[tag.Type]++
+		if tag.ParentTagID == nil || !ownedIDs[*tag.ParentTagID] {
+			impact.TopLevelNames = append(impact.TopLevelNames, tag.Name)
+		}
+	}
+	for _, tagType := range typeOrder {
+		impact.Counts = append(impact.Counts, ImpactCount{Table: "Tag", Detail: tagType + ", ownership cleared", Count: byType[tagType]})
+	}
+
+	if len(impact.TopLevelNames) == 0 {
+		if user.Name != nil && *user.Name != "" {
+			impact.TopLevelNames = append(impact.TopLevelNames, *user.Name)
+		} else {
+			impact.TopLevelNames = append(impact.TopLevelNames, email)
+		}
+	}
+
+	counts, err := s.runImpactQueries(ctx, userImpactQueries, user.Id)
+	if err != nil {
+		return nil, err
+	}
+	impact.Counts = append(impact.Counts, counts...)
+
+	return impact, nil
+}
+
+// runImpactQueries runs each count query with the same argument, skipping tables with nothing to delete
+func (s *SqlAdminStore) runImpactQueries(ctx context.Context, queries []impactQuery, arg interface{}) ([]ImpactCount, error) {
+	counts := make([]ImpactCount, 0, len(queries))
+	for _, q := range queries {
+		var count int64
+		if err := s.db.QueryRow(ctx, q.query, arg).Scan(&count); err != nil {
+			log.Printf("Failed to count %s rows: %v", q.table, err)
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to count %s rows: %v", q.table, err))
+		}
+		if count == 0 {
+			continue
+		}
+		counts = append(counts, ImpactCount{Table: q.table, Detail: q.detail, Count: count})
+	}
+	return counts, nil
+}
+
+// tagTypeCounts counts the tags in a tree by type
+func tagTypeCounts(tree *sharedpb.TagNode) []ImpactCount {
+	byType := make(map[sharedpb.TagType]int64)
+	var walk func(node *sharedpb.TagNode)
+	walk = func(node *sharedpb.TagNode) {
+		byType[node.TagRow.Type]++
+		for _, child := range node.Children {
+			walk(child)
+		}
+	}
+	walk(tree)
+
+	types := make([]sharedpb.TagType, 0, len(byType))
+	for tagType := range byType {
+		types = append(types, tagType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+
+	counts := make([]ImpactCount, 0, len(types))
+	for _, tagType := range types {
+		counts = append(counts, ImpactCount{Table: "Tag", Detail: tagType.String(), Count: byType[tagType]})
+	}
+	return counts
+}
+
+// CreateKillConfirmation issues a single-use token for a kill action on a target
+func (s *SqlAdminStore) CreateKillConfirmation(ctx context.Context, action string, targetID string, requestedBy string) (*KillConfirmation, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate confirmation token")
+	}
+
+	confirmation := &KillConfirmation{
+		Token:     hex.EncodeToString(buf),
+		ExpiresAt: time.Now().Add(killConfirmationTTL),
+	}
+
+	query := `
+		INSERT INTO public."KillConfirmation" (id, action, "targetId", "requestedBy", "expiresAt", "createdAt")
+		VALUES ($1, $2, $3, $4, $5, NOW())
+	`
+	if _, err := s.db.Exec(ctx, query, confirmation.Token, action, targetID, requestedBy, confirmation.ExpiresAt); err != nil {
+		log.Printf("Failed to create kill confirmation for %s %s: %v", action, targetID, err)
+		return nil, status.Error(codes.Internal, "failed to create confirmation token")
+	}
+
+	return confirmation, nil
+}
+
+// ConsumeKillConfirmation marks a token as used, failing if it does not match the action, target and requester
+func (s *SqlAdminStore) ConsumeKillConfirmation(ctx context.Context, token string, action string, targetID string, requestedBy string) error {
+	var expiresAt time.Time
+	query := `
+		UPDATE public."KillConfirmation"
+		SET "usedAt" = NOW()
+		WHERE id = $1 AND action = $2 AND "targetId" = $3 AND "requestedBy" = $4 AND "usedAt" IS NULL
+		RETURNING "expiresAt"
+	`
+	err := s.db.QueryRow(ctx, query, token, action, targetID, requestedBy).Scan(&expiresAt)
+	return killConfirmationError(err, expiresAt)
+}
+
+// ReleaseKillConfirmation clears the used mark of a token so the same preview can be retried
+func (s *SqlAdminStore) ReleaseKillConfirmation(ctx context.Context, token string) error {
+	_, err := s.db.Exec(ctx, `UPDATE public."KillConfirmation" SET "usedAt" = NULL WHERE id = $1`, token)
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Sprintf("failed to release confirmation token: %v", err))
+	}
+	return nil
+}
+
+// killConfirmationError maps the lookup of a confirmation token to the error the caller sees
+func killConfirmationError(err error, expiresAt time.Time) error {
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Error(codes.FailedPrecondition, "confirmation token is invalid or already used, run the preview again")
+		}
+		return status.Error(codes.Internal, fmt.Sprintf("failed to check confirmation token: %v", err))
+	}
+	if time.Now().After(expiresAt) {
+		return status.Error(codes.FailedPrecondition, "confirmation token has expired, run the preview again")
+	}
+
+	return nil
+}
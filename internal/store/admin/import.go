@@ -0,0 +1,729 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+)
+
+// ImportCounts tallies what an import did to one kind of row
+type ImportCounts struct {
+	Created   int32
+	Updated   int32
+	Unchanged int32
+}
+
+// ImportSummary is the result of importing a guide
+type ImportSummary struct {
+	BatchID      string
+	FileID       string
+	Tags         ImportCounts
+	Passages     ImportCounts
+	Questions    ImportCounts
+	QuestionTags ImportCounts
+	Changes      []ImportChange // only filled for dry runs
+}
+
+const (
+	ImportActionCreate    = "create"
+	ImportActionUpdate    = "update"
+	ImportActionUnchanged = "unchanged"
+)
+
+// ImportChange is what an import would do to a single tag, passage or question
+type ImportChange struct {
+	ObjectType  string
+	Hash        string
+	Label       string
+	Action      string
+	Diffs       []FieldDiff
+	OtherTagIDs []string // tags a prompt is already linked to besides its section's topic
+}
+
+// FieldDiff is a single field that differs between an existing row and incoming guide data
+type FieldDiff struct {
+	Field string
+	Old   string
+	New   string
+}
+
+// importTagRow holds the tag columns an import can set
+type importTagRow struct {
+	ID                 string   `db:"id"`
+	Name               string   `db:"name"`
+	Type               string   `db:"type"`
+	Context            *string  `db:"context"`
+	ParentTagID        *string  `db:"parentTagId"`
+	ContentRating      string   `db:"contentRating"`
+	ContentDescriptors []string `db:"contentDescriptors"`
+	MetaTags           []string `db:"metaTags"`
+}
+
+// importPassageRow holds the passage columns an import can set
+type importPassageRow struct {
+	ID    string `db:"id"`
+	Title string `db:"title"`
+	Body  string `db:"body"`
+	TagID string `db:"tagId"`
+}
+
+// importQuestionRow holds the question columns an import can set
+type importQuestionRow struct {
+	ID           string   `db:"id"`
+	QuestionText string   `db:"questionText"`
+	AnswerText   string   `db:"answerText"`
+	LearnMore    *string  `db:"learnMore"`
+	Distractors  []string `db:"distractors"`
+	PassageID    *string  `db:"passageId"`
+}
+
+// DecodeGuide decodes a gob payload into a GuideData
+func DecodeGuide(gobPayload []byte) (*sharedpb.GuideData, error) {
+	var guideData sharedpb.GuideData
+	if err := gob.NewDecoder(bytes.NewReader(gobPayload)).Decode(&guideData); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("failed to decode gob payload: %v", err))
+	}
+	return &guideData, nil
+}
+
+// ImportGuide imports a guide in a single transaction, recording it as an import batch.
+// A dry run reports every change from a read-only transaction, the rows it would write are
+// staged in memory so later sections of the guide see them.
+func (s *SqlAdminStore) ImportGuide(ctx context.Context, guide *sharedpb.GuideData, fileName string, dryRun bool) (*ImportSummary, error) {
+	if guide == nil {
+		return nil, status.Error(codes.InvalidArgument, "guide is required")
+	}
+	if fileName == "" {
+		fileName = guide.Title
+	}
+
+	txOptions := pgx.TxOptions{}
+	if dryRun {
+		txOptions.AccessMode = pgx.ReadOnly
+	}
+	tx, err := s.db.BeginTx(ctx, txOptions)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to begin transaction: %v", err))
+	}
+	defer tx.Rollback(ctx)
+
+	imp := &guideImporter{
+		tx:     tx,
+		guide:  guide,
+		now:    time.Now(),
+		dryRun: dryRun,
+		summary: &ImportSummary{
+			BatchID: utils.GetCUID(),
+			FileID:  utils.GetCUID(),
+		},
+		stagedTags:      make(map[string]*importTagRow),
+		stagedPassages:  make(map[string]*importPassageRow),
+		stagedQuestions: make(map[string]*importQuestionRow),
+		stagedLinks:     make(map[string]map[string]bool),
+	}
+
+	err = imp.exec(ctx, `
+		INSERT INTO public."ImportBatch" (id, file, "startedAt") VALUES ($1, $2, $3)
+	`, imp.summary.BatchID, fileName, imp.now)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to create import batch: %v", err))
+	}
+
+	for _, section := range guide.Sections {
+		if err := imp.importSection(ctx, section); err != nil {
+			log.Printf("Import of %s failed at section %s: %v", fileName, section.Title, err)
+			if _, ok := status.FromError(err); ok {
+				return nil, err
+			}
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to import section %s: %v", section.Title, err))
+		}
+	}
+
+	err = imp.exec(ctx, `
+		INSERT INTO public."ImportFile" (id, name, imported, "importedAt", "importBatchId") VALUES ($1, $2, true, NOW(), $3)
+	`, imp.summary.FileID, fileName, imp.summary.BatchID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to create import file: %v", err))
+	}
+
+	err = imp.exec(ctx, `UPDATE public."ImportBatch" SET "completedAt" = NOW() WHERE id = $1`, imp.summary.BatchID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to complete import batch: %v", err))
+	}
+
+	if dryRun {
+		// Nothing is kept, so there is no batch or file to point at
+		imp.summary.BatchID = ""
+		imp.summary.FileID = ""
+		return imp.summary, nil
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to commit import: %v", err))
+	}
+
+	return imp.summary, nil
+}
+
+// guideImporter carries the state of one ImportGuide transaction
+type guideImporter struct {
+	tx      pgx.Tx
+	guide   *sharedpb.GuideData
+	now     time.Time
+	dryRun  bool
+	summary *ImportSummary
+
+	// Rows a dry run would have written, keyed by hash, and question links by question then tag
+	stagedTags      map[string]*importTagRow
+	stagedPassages  map[string]*importPassageRow
+	stagedQuestions map[string]*importQuestionRow
+	stagedLinks     map[string]map[string]bool
+}
+
+// exec runs a write, a dry run skips it
+func (imp *guideImporter) exec(ctx context.Context, sql string, args ...interface{}) error {
+	if imp.dryRun {
+		return nil
+	}
+	_, err := imp.tx.Exec(ctx, sql, args...)
+	return err
+}
+
+func (imp *guideImporter) tagByHash(ctx context.Context, hash string) (*importTagRow, error) {
+	if row, ok := imp.stagedTags[hash]; ok {
+		return row, nil
+	}
+	return tagByHash(ctx, imp.tx, hash)
+}
+
+func (imp *guideImporter) passageByHash(ctx context.Context, hash string) (*importPassageRow, error) {
+	if row, ok := imp.stagedPassages[hash]; ok {
+		return row, nil
+	}
+	return passageByHash(ctx, imp.tx, hash)
+}
+
+func (imp *guideImporter) questionByHash(ctx context.Context, hash string) (*importQuestionRow, error) {
+	if row, ok := imp.stagedQuestions[hash]; ok {
+		return row, nil
+	}
+	return questionByHash(ctx, imp.tx, hash)
+}
+
+// stageTag keeps a tag a dry run would have written, stagePassage and stageQuestion do the same
+func (imp *guideImporter) stageTag(hash, id string, row importTagRow) {
+	if imp.dryRun {
+		row.ID = id
+		imp.stagedTags[hash] = &row
+	}
+}
+
+func (imp *guideImporter) stagePassage(hash, id string, row importPassageRow) {
+	if imp.dryRun {
+		row.ID = id
+		imp.stagedPassages[hash] = &row
+	}
+}
+
+func (imp *guideImporter) stageQuestion(hash, id string, row importQuestionRow) {
+	if imp.dryRun {
+		row.ID = id
+		imp.stagedQuestions[hash] = &row
+	}
+}
+
+// record keeps a change for the dry run report
+func (imp *guideImporter) record(change ImportChange) {
+	if imp.dryRun {
+		imp.summary.Changes = append(imp.summary.Changes, change)
+	}
+}
+
+// metadata builds the metadata stamped on every row created for a section
+func (imp *guideImporter) metadata(sectionTitle string) ([]byte, error) {
+	return json.Marshal(map[string]string{
+		"parserType":   imp.guide.ParserType.String(),
+		"ts":           imp.now.UTC().Format(time.RFC3339),
+		"guideTitle":   imp.guide.Title,
+		"sectionTitle": sectionTitle,
+	})
+}
+
+func (imp *guideImporter) importSection(ctx context.Context, section *sharedpb.SectionData) error {
+	metadata, err := imp.metadata(section.Title)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to marshal metadata")
+	}
+
+	topicID, err := imp.importAncestry(ctx, section, metadata)
+	if err != nil {
+		return err
+	}
+
+	for _, passage := range section.Passages {
+		passageID, err := imp.importPassage(ctx, passage, topicID, metadata)
+		if err != nil {
+			return err
+		}
+		for _, prompt := range passage.Prompts {
+			if err := imp.importPrompt(ctx, prompt, &passageID, topicID, metadata); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, prompt := range section.Prompts {
+		if err := imp.importPrompt(ctx, prompt, nil, topicID, metadata); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importAncestry upserts the section's ancestors from oldest to youngest and returns the topic id
+func (imp *guideImporter) importAncestry(ctx context.Context, section *sharedpb.SectionData, metadata []byte) (string, error) {
+	ancestors := ancestorChain(section.Ancestor)
+	if len(ancestors) == 0 {
+		return "", status.Error(codes.InvalidArgument, fmt.Sprintf("section %s has no ancestor", section.Title))
+	}
+
+	var parentID *string
+	for i, anc := range ancestors {
+		desired := desiredAncestorTag(imp.guide.ParserType, anc, parentID, i == len(ancestors)-1, section)
+
+		id, err := imp.upsertTag(ctx, anc.Hash, desired, metadata)
+		if err != nil {
+			return "", err
+		}
+		parentID = &id
+	}
+
+	return *parentID, nil
+}
+
+func (imp *guideImporter) upsertTag(ctx context.Context, hash string, desired importTagRow, metadata []byte) (string, error) {
+	if hash == "" {
+		return "", status.Error(codes.InvalidArgument, fmt.Sprintf("ancestor %s has no hash", desired.Name))
+	}
+
+	existing, err := imp.tagByHash(ctx, hash)
+	if err != nil {
+		return "", err
+	}
+
+	if existing == nil {
+		id := utils.GetCUID()
+		err := imp.exec(ctx, `
+			INSERT INTO public."Tag" (
+				id, "batchId", hash, name, description, type, context, "parentTagId",
+				"contentRating", "contentDescriptors", "metaTags", public, metadata, "createdAt", "updatedAt"
+			) VALUES ($1, $2, $3, $4, $4, $5, $6, $7, $8, $9, $10, true, $11, $12, $12)
+		`, id, imp.summary.BatchID, hash, desired.Name, desired.Type, desired.Context, desired.ParentTagID,
+			desired.ContentRating, desired.ContentDescriptors, desired.MetaTags, metadata, imp.now)
+		if err != nil {
+			return "", status.Error(codes.Internal, fmt.Sprintf("failed to create tag %s: %v", desired.Name, err))
+		}
+		imp.stageTag(hash, id, desired)
+		imp.summary.Tags.Created++
+		imp.record(ImportChange{ObjectType: "Tag", Hash: hash, Label: desired.Name, Action: ImportActionCreate})
+		return id, imp.markHasChildren(ctx, desired.ParentTagID)
+	}
+
+	desired = keepCuratedTagFields(existing, desired)
+	diffs := diffTag(existing, &desired)
+	if len(diffs) == 0 {
+		imp.summary.Tags.Unchanged++
+		imp.record(ImportChange{ObjectType: "Tag", Hash: hash, Label: desired.Name, Action: ImportActionUnchanged})
+		return existing.ID, nil
+	}
+
+	err = imp.exec(ctx, `
+		UPDATE public."Tag" SET
+			name = $2, description = $2, type = $3, context = $4, "parentTagId" = $5,
+			"contentRating" = $6, "contentDescriptors" = $7, "metaTags" = $8, "updatedAt" = $9
+		WHERE id = $1
+	`, existing.ID, desired.Name, desired.Type, desired.Context, desired.ParentTagID,
+		desired.ContentRating, desired.ContentDescriptors, desired.MetaTags, imp.now)
+	if err != nil {
+		return "", status.Error(codes.Internal, fmt.Sprintf("failed to update tag %s: %v", desired.Name, err))
+	}
+	imp.stageTag(hash, existing.ID, desired)
+	imp.summary.Tags.Updated++
+	imp.record(ImportChange{ObjectType: "Tag", Hash: hash, Label: desired.Name, Action: ImportActionUpdate, Diffs: diffs})
+	return existing.ID, imp.markHasChildren(ctx, desired.ParentTagID)
+}
+
+func (imp *guideImporter) markHasChildren(ctx context.Context, parentID *string) error {
+	if parentID == nil {
+		return nil
+	}
+	err := imp.exec(ctx, `UPDATE public."Tag" SET "hasChildren" = true WHERE id = $1 AND NOT "hasChildren"`, *parentID)
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Sprintf("failed to update parent tag: %v", err))
+	}
+	return nil
+}
+
+func (imp *guideImporter) importPassage(ctx context.Context, passage *sharedpb.PassageData, tagID string, metadata []byte) (string, error) {
+	if passage.Hash == "" {
+		return "", status.Error(codes.InvalidArgument, fmt.Sprintf("passage %s has no hash", passage.Title))
+	}
+
+	desired := importPassageRow{Title: passage.Title, Body: passage.Content, TagID: tagID}
+
+	existing, err := imp.passageByHash(ctx, passage.Hash)
+	if err != nil {
+		return "", err
+	}
+
+	if existing == nil {
+		id := utils.GetCUID()
+		err := imp.exec(ctx, `
+			INSERT INTO public."Passage" (id, title, body, hash, "tagId", metadata, "createdAt", "updatedAt")
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $7)
+		`, id, desired.Title, desired.Body, passage.Hash, desired.TagID, metadata, imp.now)
+		if err != nil {
+			return "", status.Error(codes.Internal, fmt.Sprintf("failed to create passage %s: %v", passage.Title, err))
+		}
+		imp.stagePassage(passage.Hash, id, desired)
+		imp.summary.Passages.Created++
+		imp.record(ImportChange{ObjectType: "Passage", Hash: passage.Hash, Label: passage.Title, Action: ImportActionCreate})
+		return id, nil
+	}
+
+	diffs := diffPassage(existing, &desired)
+	if len(diffs) == 0 {
+		imp.summary.Passages.Unchanged++
+		imp.record(ImportChange{ObjectType: "Passage", Hash: passage.Hash, Label: passage.Title, Action: ImportActionUnchanged})
+		return existing.ID, nil
+	}
+
+	err = imp.exec(ctx, `
+		UPDATE public."Passage" SET title = $2, body = $3, "tagId" = $4, "updatedAt" = $5 WHERE id = $1
+	`, existing.ID, desired.Title, desired.Body, desired.TagID, imp.now)
+	if err != nil {
+		return "", status.Error(codes.Internal, fmt.Sprintf("failed to update passage %s: %v", passage.Title, err))
+	}
+	imp.stagePassage(passage.Hash, existing.ID, desired)
+	imp.summary.Passages.Updated++
+	imp.record(ImportChange{ObjectType: "Passage", Hash: passage.Hash, Label: passage.Title, Action: ImportActionUpdate, Diffs: diffs})
+	return existing.ID, nil
+}
+
+func (imp *guideImporter) importPrompt(ctx context.Context, prompt *sharedpb.PromptData, passageID *string, topicID string, metadata []byte) error {
+	if prompt.Hash == "" {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("prompt %q has no hash", prompt.Question))
+	}
+
+	desired := desiredQuestion(prompt, passageID)
+
+	existing, err := imp.questionByHash(ctx, prompt.Hash)
+	if err != nil {
+		return err
+	}
+
+	change := ImportChange{ObjectType: "Question", Hash: prompt.Hash, Label: prompt.Question}
+
+	var questionID string
+	var diffs []FieldDiff
+	if existing != nil {
+		diffs = diffQuestion(existing, &desired)
+	}
+
+	switch {
+	case existing == nil:
+		questionID = utils.GetCUID()
+		err := imp.exec(ctx, `
+			INSERT INTO public."Question" (
+				id, "batchId", "questionText", "answerText", hash, "learnMore", distractors,
+				version, public, metadata, "createdAt", "updatedAt", "passageId"
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, 1, true, $8, $9, $9, $10)
+		`, questionID, imp.summary.BatchID, desired.QuestionText, desired.AnswerText, prompt.Hash,
+			desired.LearnMore, desired.Distractors, metadata, imp.now, desired.PassageID)
+		if err != nil {
+			return status.Error(codes.Internal, fmt.Sprintf("failed to create question %q: %v", prompt.Question, err))
+		}
+		imp.stageQuestion(prompt.Hash, questionID, desired)
+		imp.summary.Questions.Created++
+		change.Action = ImportActionCreate
+	case len(diffs) == 0:
+		questionID = existing.ID
+		imp.summary.Questions.Unchanged++
+		change.Action = ImportActionUnchanged
+	default:
+		questionID = existing.ID
+		err := imp.exec(ctx, `
+			UPDATE public."Question" SET
+				"questionText" = $2, "answerText" = $3, "learnMore" = $4, distractors = $5,
+				"passageId" = $6, version = version + 1, "updatedAt" = $7
+			WHERE id = $1
+		`, questionID, desired.QuestionText, desired.AnswerText, desired.LearnMore, desired.Distractors, desired.PassageID, imp.now)
+		if err != nil {
+			return status.Error(codes.Internal, fmt.Sprintf("failed to update question %q: %v", prompt.Question, err))
+		}
+		imp.stageQuestion(prompt.Hash, questionID, desired)
+		imp.summary.Questions.Updated++
+		change.Action = ImportActionUpdate
+		change.Diffs = diffs
+	}
+
+	// Flag prompts that already live under a different topic, the import would link them here too
+	if imp.dryRun && existing != nil {
+		var otherTagIDs []string
+		err := pgxscan.Select(ctx, imp.tx, &otherTagIDs, `
+			SELECT "tagId" FROM public."QuestionTag" WHERE "questionId" = $1 AND "tagId" <> $2 ORDER BY "tagId"
+		`, questionID, topicID)
+		if err != nil {
+			return status.Error(codes.Internal, fmt.Sprintf("failed to look up tags for question %q: %v", prompt.Question, err))
+		}
+		// Links staged by earlier sections aren't in the table yet
+		for tagID := range imp.stagedLinks[questionID] {
+			if tagID != topicID {
+				otherTagIDs = append(otherTagIDs, tagID)
+			}
+		}
+		sort.Strings(otherTagIDs)
+		change.OtherTagIDs = otherTagIDs
+	}
+	imp.record(change)
+
+	linked, err := imp.linkQuestion(ctx, questionID, topicID)
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Sprintf("failed to link question %q: %v", prompt.Question, err))
+	}
+	if !linked {
+		imp.summary.QuestionTags.Unchanged++
+		return nil
+	}
+	imp.summary.QuestionTags.Created++
+
+	err = imp.exec(ctx, `UPDATE public."Tag" SET "hasQuestions" = true WHERE id = $1 AND NOT "hasQuestions"`, topicID)
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Sprintf("failed to update topic: %v", err))
+	}
+	return nil
+}
+
+// linkQuestion links a question to a topic and reports whether the link is new. A dry run
+// only checks for an existing link and stages the new one.
+func (imp *guideImporter) linkQuestion(ctx context.Context, questionID, topicID string) (bool, error) {
+	if !imp.dryRun {
+		tag, err := imp.tx.Exec(ctx, `
+			INSERT INTO public."QuestionTag" ("questionId", "tagId", "batchId", "createdAt")
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT ("questionId", "tagId") DO NOTHING
+		`, questionID, topicID, imp.summary.BatchID, imp.now)
+		if err != nil {
+			return false, err
+		}
+		return tag.RowsAffected() > 0, nil
+	}
+
+	if imp.stagedLinks[questionID][topicID] {
+		return false, nil
+	}
+	var exists bool
+	err := imp.tx.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM public."QuestionTag" WHERE "questionId" = $1 AND "tagId" = $2)
+	`, questionID, topicID).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		return false, nil
+	}
+	if imp.stagedLinks[questionID] == nil {
+		imp.stagedLinks[questionID] = make(map[string]bool)
+	}
+	imp.stagedLinks[questionID][topicID] = true
+	return true, nil
+}
+
+// ancestorChain returns a section's ancestors ordered oldest first
+func ancestorChain(ancestor *sharedpb.Ancestor) []*sharedpb.Ancestor {
+	var ancestors []*sharedpb.Ancestor
+	for current := ancestor; current != nil; current = current.NextAncestor {
+		ancestors = append(ancestors, current)
+	}
+	for i, j := 0, len(ancestors)-1; i < j; i, j = i+1, j-1 {
+		ancestors[i], ancestors[j] = ancestors[j], ancestors[i]
+	}
+	return ancestors
+}
+
+// desiredAncestorTag builds the tag columns the guide wants for an ancestor, the topic takes the section's rating
+func desiredAncestorTag(parserType sharedpb.ParserType, anc *sharedpb.Ancestor, parentID *string, isTopic bool, section *sharedpb.SectionData) importTagRow {
+	rating := anc.ContentRating
+	descriptors := []string{}
+	metaTags := []string{}
+	if isTopic {
+		rating = section.ContentRating
+		for _, d := range section.ContentDescriptors {
+			descriptors = append(descriptors, d.String())
+		}
+		if section.MetaTags != nil {
+			metaTags = section.MetaTags
+		}
+	}
+	if rating == sharedpb.ContentRating_Unspecified {
+		rating = sharedpb.ContentRating_RatingPending
+	}
+
+	var contextValue *string
+	if contextType, ok := utils.GetContextTypeForParser(parserType); ok {
+		value := contextType.String()
+		contextValue = &value
+	}
+
+	return importTagRow{
+		Name:               anc.Name,
+		Type:               anc.TagType.String(),
+		Context:            contextValue,
+		ParentTagID:        parentID,
+		ContentRating:      rating.String(),
+		ContentDescriptors: descriptors,
+		MetaTags:           metaTags,
+	}
+}
+
+// keepCuratedTagFields stops an import from wiping ratings and descriptors that were set after the tag was created
+func keepCuratedTagFields(existing *importTagRow, desired importTagRow) importTagRow {
+	if desired.ContentRating == sharedpb.ContentRating_RatingPending.String() {
+		desired.ContentRating = existing.ContentRating
+	}
+	if len(desired.ContentDescriptors) == 0 {
+		desired.ContentDescriptors = existing.ContentDescriptors
+	}
+	if len(desired.MetaTags) == 0 {
+		desired.MetaTags = existing.MetaTags
+	}
+	return desired
+}
+
+func desiredQuestion(prompt *sharedpb.PromptData, passageID *string) importQuestionRow {
+	var learnMore *string
+	if prompt.LearnMore != "" {
+		learnMore = &prompt.LearnMore
+	}
+	distractors := prompt.Distractors
+	if distractors == nil {
+		distractors = []string{}
+	}
+	return importQuestionRow{
+		QuestionText: prompt.Question,
+		AnswerText:   prompt.Answer,
+		LearnMore:    learnMore,
+		Distractors:  distractors,
+		PassageID:    passageID,
+	}
+}
+
+func tagByHash(ctx context.Context, db pgxscan.Querier, hash string) (*importTagRow, error) {
+	var row importTagRow
+	err := pgxscan.Get(ctx, db, &row, `
+		SELECT id, name, type, context, "parentTagId", "contentRating", "contentDescriptors", "metaTags"
+		FROM public."Tag" WHERE hash = $1
+	`, hash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to look up tag by hash: %v", err))
+	}
+	return &row, nil
+}
+
+func passageByHash(ctx context.Context, db pgxscan.Querier, hash string) (*importPassageRow, error) {
+	var row importPassageRow
+	err := pgxscan.Get(ctx, db, &row, `
+		SELECT id, title, body, "tagId" FROM public."Passage" WHERE hash = $1
+	`, hash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to look up passage by hash: %v", err))
+	}
+	return &row, nil
+}
+
+func questionByHash(ctx context.Context, db pgxscan.Querier, hash string) (*importQuestionRow, error) {
+	var row importQuestionRow
+	err := pgxscan.Get(ctx, db, &row, `
+		SELECT id, "questionText", "answerText", "learnMore", distractors, "passageId"
+		FROM public."Question" WHERE hash = $1
+	`, hash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to look up question by hash: %v", err))
+	}
+	return &row, nil
+}
+
+func diffTag(existing *importTagRow, desired *importTagRow) []FieldDiff {
+	var diffs []FieldDiff
+	diffs = appendDiff(diffs, "name", existing.Name, desired.Name)
+	diffs = appendDiff(diffs, "type", existing.Type, desired.Type)
+	diffs = appendDiff(diffs, "context", derefString(existing.Context), derefString(desired.Context))
+	diffs = appendDiff(diffs, "parentTagId", derefString(existing.ParentTagID), derefString(desired.ParentTagID))
+	diffs = appendDiff(diffs, "contentRating", existing.ContentRating, desired.ContentRating)
+	diffs = appendDiff(diffs, "contentDescriptors", joinSorted(existing.ContentDescriptors), joinSorted(desired.ContentDescriptors))
+	diffs = appendDiff(diffs, "metaTags", joinSorted(existing.MetaTags), joinSorted(desired.MetaTags))
+	return diffs
+}
+
+func diffPassage(existing *importPassageRow, desired *importPassageRow) []FieldDiff {
+	var diffs []FieldDiff
+	diffs = appendDiff(diffs, "title", existing.Title, desired.Title)
+	diffs = appendDiff(diffs, "body", existing.Body, desired.Body)
+	diffs = appendDiff(diffs, "tagId", existing.TagID, desired.TagID)
+	return diffs
+}
+
+func diffQuestion(existing *importQuestionRow, desired *importQuestionRow) []FieldDiff {
+	var diffs []FieldDiff
+	diffs = appendDiff(diffs, "questionText", existing.QuestionText, desired.QuestionText)
+	diffs = appendDiff(diffs, "answerText", existing.AnswerText, desired.AnswerText)
+	diffs = appendDiff(diffs, "learnMore", derefString(existing.LearnMore), derefString(desired.LearnMore))
+	diffs = appendDiff(diffs, "distractors", strings.Join(existing.Distractors, "|"), strings.Join(desired.Distractors, "|"))
+	diffs = appendDiff(diffs, "passageId", derefString(existing.PassageID), derefString(desired.PassageID))
+	return diffs
+}
+
+func appendDiff(diffs []FieldDiff, field string, old string, new string) []FieldDiff {
+	if old == new {
+		return diffs
+	}
+	return append(diffs, FieldDiff{Field: field, Old: old, New: new})
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func joinSorted(values []string) string {
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
@@ -1,9 +1,7 @@
 package admin
 
 import (
-	"bytes"
 	"context"
-	"encoding/gob"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -196,6 +194,9 @@ func (s *SqlAdminStore) UpsertQuestion(ctx context.Context, question *sharedpb.Q
 		question.CreatedAt = timestamppb.New(now)
 	}
 	question.UpdatedAt = timestamppb.New(now)
+	if question.Id == "" {
+		question.Id = utils.GetCUID()
+	}
 
 	query := `
 		INSERT INTO public."Question" (
@@ -218,12 +219,14 @@ func (s *SqlAdminStore) UpsertQuestion(ctx context.Context, question *sharedpb.Q
 			"metadata" = EXCLUDED."metadata",
 			"updatedAt" = EXCLUDED."updatedAt",
 			"passageId" = EXCLUDED."passageId"
-		RETURNING id, "batchId", "questionText", "answerText", "hash", "learnMore",
-			"distractors", "videoUrl", "imageUrl", "version", "public",
-			"metadata", "createdAt", "updatedAt", "passageId"
-	`
+		RETURNING ` + adminQuestionColumns
 
-	var updated sharedpb.Question
+	distractors := question.Distractors
+	if distractors == nil {
+		distractors = []string{}
+	}
+
+	var updated adminQuestionRow
 	err := pgxscan.Get(ctx, s.db, &updated, query,
 		question.Id,
 		question.BatchId,
@@ -231,21 +234,77 @@ func (s *SqlAdminStore) UpsertQuestion(ctx context.Context, question *sharedpb.Q
 		question.AnswerText,
 		question.Hash,
 		question.LearnMore,
-		question.Distractors,
+		distractors,
 		question.VideoUrl,
 		question.ImageUrl,
 		question.Version,
 		question.Public,
-		question.Metadata,
-		question.CreatedAt,
-		question.UpdatedAt,
+		question.GetMetadata().GetMetadata(),
+		question.CreatedAt.AsTime(),
+		question.UpdatedAt.AsTime(),
 		question.PassageId,
 	)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to upsert question")
 	}
 
-	return &updated, nil
+	return updated.toQuestion(), nil
+}
+
+// Question retrieves a question by its ID
+func (s *SqlAdminStore) Question(ctx context.Context, id string) (*sharedpb.Question, error) {
+	var row adminQuestionRow
+	err := pgxscan.Get(ctx, s.db, &row, `SELECT `+adminQuestionColumns+` FROM public."Question" WHERE id = $1`, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "question %s not found", id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get question: %v", err)
+	}
+	return row.toQuestion(), nil
+}
+
+const adminQuestionColumns = `id, "batchId", "questionText", "answerText", "hash", "learnMore",
+	"distractors", "videoUrl", "imageUrl", "version", "public",
+	"metadata", "createdAt", "updatedAt", "passageId"`
+
+// adminQuestionRow is a Question row as written by UpsertQuestion
+type adminQuestionRow struct {
+	ID           string            `db:"id"`
+	BatchID      *string           `db:"batchId"`
+	QuestionText string            `db:"questionText"`
+	AnswerText   string            `db:"answerText"`
+	Hash         string            `db:"hash"`
+	LearnMore    *string           `db:"learnMore"`
+	Distractors  []string          `db:"distractors"`
+	VideoURL     *string           `db:"videoUrl"`
+	ImageURL     *string           `db:"imageUrl"`
+	Version      int32             `db:"version"`
+	Public       bool              `db:"public"`
+	Metadata     map[string]string `db:"metadata"`
+	CreatedAt    time.Time         `db:"createdAt"`
+	UpdatedAt    time.Time         `db:"updatedAt"`
+	PassageID    *string           `db:"passageId"`
+}
+
+func (row *adminQuestionRow) toQuestion() *sharedpb.Question {
+	return &sharedpb.Question{
+		Id:           row.ID,
+		BatchId:      row.BatchID,
+		QuestionText: row.QuestionText,
+		AnswerText:   row.AnswerText,
+		Hash:         row.Hash,
+		LearnMore:    row.LearnMore,
+		Distractors:  row.Distractors,
+		VideoUrl:     row.VideoURL,
+		ImageUrl:     row.ImageURL,
+		Version:      row.Version,
+		Public:       row.Public,
+		Metadata:     &sharedpb.Metadata{Metadata: row.Metadata},
+		CreatedAt:    timestamppb.New(row.CreatedAt),
+		UpdatedAt:    timestamppb.New(row.UpdatedAt),
+		PassageId:    row.PassageID,
+	}
 }
 
 // UpsertQuestionTag saves a question tag if it doesn't exist
@@ -698,7 +757,17 @@ func (s *SqlAdminStore) Tree(ctx context.Context, id string) (*sharedpb.TagNode,
 	return rootNode, nil
 }
 
-func (s *SqlAdminStore) KillTree(ctx context.Context, id string) ([]string, error) {
+func (s *SqlAdminStore) KillTree(ctx context.Context, id string, deletedBy string, retention time.Duration) (*TrashEntry, error) {
+	return s.killTree(ctx, id, deletedBy, "", retention)
+}
+
+func (s *SqlAdminStore) KillOwnedTree(ctx context.Context, id string, ownerID string, retention time.Duration) (*TrashEntry, error) {
+	return s.killTree(ctx, id, ownerID, ownerID, retention)
+}
+
+// killTree trashes and deletes a tree. With an owner it also trashes the questions the owner
+// has only in the tree, which would otherwise be left behind unreachable.
+func (s *SqlAdminStore) killTree(ctx context.Context, id string, deletedBy string, ownerID string, retention time.Duration) (*TrashEntry, error) {
 	tree, err := s.Tree(ctx, id)
 	if err != nil {
 		return nil, err
@@ -707,11 +776,54 @@ func (s *SqlAdminStore) KillTree(ctx context.Context, id string) ([]string, erro
 	// Collect all IDs from the tree
 	ids := collectNodeIDs(tree)
 
+	if retention <= 0 {
+		retention = defaultTrashRetention
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to begin transaction: %v", err))
+	}
+	defer tx.Rollback(ctx)
+
+	questionIDs := []string{}
+	if ownerID != "" {
+		err := pgxscan.Select(ctx, tx, &questionIDs, `
+			SELECT DISTINCT qt."questionId"
+			FROM public."QuestionTag" qt
+			JOIN public."Question" q ON q.id = qt."questionId"
+			WHERE qt."tagId" = ANY($1) AND q."ownerId" = $2
+			AND NOT EXISTS (
+				SELECT 1 FROM public."QuestionTag" o
+				WHERE o."questionId" = qt."questionId" AND NOT (o."tagId" = ANY($1))
+			)
+		`, ids, ownerID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get owned questions: %v", err))
+		}
+	}
+
+	// Snapshot the tree and its dependents into trash before deleting anything
+	entry, err := s.moveTreeToTrash(ctx, tx, tree.TagRow, ids, questionIDs, deletedBy, retention)
+	if err != nil {
+		return nil, err
+	}
+
 	// Recursively delete the tree from database starting from leaf nodes
-	if err := s.deleteTreeRecursively(ctx, tree); err != nil {
+	if err := s.deleteTreeRecursively(ctx, tx, tree); err != nil {
 		return nil, err
 	}
 
+	if len(questionIDs) > 0 {
+		if err := deleteTrashedQuestions(ctx, tx, questionIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to commit kill tree: %v", err))
+	}
+
 	// Batch delete from Algolia after successful database deletion
 	if s.tagIndex != nil && len(ids) > 0 {
 		if err := s.batchDeleteFromAlgolia(ctx, ids); err != nil {
@@ -720,7 +832,38 @@ func (s *SqlAdminStore) KillTree(ctx context.Context, id string) ([]string, erro
 		}
 	}
 
-	return ids, nil
+	return entry, nil
+}
+
+// deleteTrashedQuestions deletes questions trashed with a tree and queues them to leave the index
+func deleteTrashedQuestions(ctx context.Context, tx pgx.Tx, questionIDs []string) error {
+	// Interactions, ratings, reports and progress cascade, these don't
+	_, err := tx.Exec(ctx, `
+		WITH deleted_access AS (
+			DELETE FROM public."QuestionAccess" WHERE "questionId" = ANY($1)
+		),
+		deleted_survival_questions AS (
+			DELETE FROM public."SurvivalQuestion" WHERE "questionId" = ANY($1)
+		),
+		deleted_test_questions AS (
+			DELETE FROM public."TestQuestion" WHERE "questionId" = ANY($1)
+		)
+		DELETE FROM public."Question" WHERE id = ANY($1)
+	`, questionIDs)
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Sprintf("failed to delete owned questions: %v", err))
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO public."IndexOutbox" ("objectType", "objectId", action, "queuedAt")
+		SELECT 'Question', id, 'delete', NOW() FROM unnest($1::text[]) AS id
+		ON CONFLICT ("objectType", "objectId") DO UPDATE
+		SET action = 'delete', "queuedAt" = NOW()
+	`, questionIDs)
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Sprintf("failed to queue owned questions for removal: %v", err))
+	}
+	return nil
 }
 
 // batchDeleteFromAlgolia deletes tags from Algolia in batches
@@ -795,16 +938,16 @@ func collectNodeIDs(node *sharedpb.TagNode) []string {
 }
 
 // deleteTreeRecursively recursively deletes a tree of tags, starting from leaf nodes
-func (s *SqlAdminStore) deleteTreeRecursively(ctx context.Context, node *sharedpb.TagNode) error {
+func (s *SqlAdminStore) deleteTreeRecursively(ctx context.Context, tx pgx.Tx, node *sharedpb.TagNode) error {
 	// First delete all children recursively
 	for _, child := range node.Children {
-		if err := s.deleteTreeRecursively(ctx, child); err != nil {
+		if err := s.deleteTreeRecursively(ctx, tx, child); err != nil {
 			return err
 		}
 	}
 
 	// Then delete this node and its references
-	if err := s.deleteTagAndReferences(ctx, node.TagRow.Id); err != nil {
+	if err := s.deleteTagAndReferences(ctx, tx, node.TagRow.Id); err != nil {
 		return fmt.Errorf("failed to delete tag %s (%s): %w", node.TagRow.Id, node.TagRow.Name, err)
 	}
 
@@ -812,7 +955,7 @@ func (s *SqlAdminStore) deleteTreeRecursively(ctx context.Context, node *sharedp
 }
 
 // deleteTagAndReferences deletes a tag and all its references from related tables
-func (s *SqlAdminStore) deleteTagAndReferences(ctx context.Context, tagID string) error {
+func (s *SqlAdminStore) deleteTagAndReferences(ctx context.Context, tx pgx.Tx, tagID string) error {
 	query := `
 		WITH deleted_question_tags AS (
 			DELETE FROM public."QuestionTag" WHERE "tagId" = $1
@@ -861,20 +1004,13 @@ func (s *SqlAdminStore) deleteTagAndReferences(ctx context.Context, tagID string
 		DELETE FROM public."Tag" WHERE id = $1
 	`
 
-	_, err := s.db.Exec(ctx, query, tagID)
+	_, err := tx.Exec(ctx, query, tagID)
 	if err != nil {
 		log.Printf("Database error deleting tag %s: %v", tagID, err)
 		return status.Error(codes.Internal, fmt.Sprintf("database error deleting tag %s: %v", tagID, err))
 	}
 
-	// Delete from Algolia after successful database deletion
-	if s.tagIndex != nil {
-		if _, err := s.tagIndex.DeleteObject(tagID); err != nil {
-			// Log but don't fail - database is source of truth
-			log.Printf("Warning: Failed to delete tag %s from Algolia: %v", tagID, err)
-		}
-	}
-
+	// Algolia deletion happens in KillTree once the transaction has committed
 	return nil
 }
 
@@ -963,169 +1099,18 @@ func (s *SqlAdminStore) UpdateMetadataFor(ctx context.Context, id string, metada
 
 func (s *SqlAdminStore) ImportGob(ctx context.Context, gobPayload []byte) (bool, error) {
 	// convert the gobPayload into a guideData
-	var guideData sharedpb.GuideData
-	err := gob.NewDecoder(bytes.NewReader(gobPayload)).Decode(&guideData)
+	guideData, err := DecodeGuide(gobPayload)
 	if err != nil {
-		return false, status.Error(codes.Internal, "failed to decode gob payload")
+		return false, err
 	}
 
-	for _, section := range guideData.Sections {
-		topicId, err := s.ImportAncestry(ctx, section.Ancestor, guideData.ParserType, guideData.Title, *section)
-		if err != nil {
-			return false, status.Error(codes.Internal, fmt.Sprintf("failed to import ancestry for section %s", section.Title))
-		}
-
-		for _, passage := range section.Passages {
-			metadata := &sharedpb.Metadata{
-				Metadata: map[string]string{
-					"parserType":   guideData.ParserType.String(),
-					"ts":           time.Now().UTC().Format(time.RFC3339),
-					"guideTitle":   guideData.Title,
-					"sectionTitle": section.Title,
-				},
-			}
-			p := NewPassage(utils.GetCUID(), passage.Title, passage.Content, topicId, metadata)
-			_, err := s.UpsertPassage(ctx, p)
-			if err != nil {
-				return false, status.Error(codes.Internal, fmt.Sprintf("failed to upsert passage for section %s", section.Title))
-			}
-
-			for _, prompt := range passage.Prompts {
-				metadata := &sharedpb.Metadata{
-					Metadata: map[string]string{
-						"parserType":   guideData.ParserType.String(),
-						"ts":           time.Now().UTC().Format(time.RFC3339),
-						"guideTitle":   guideData.Title,
-						"sectionTitle": section.Title,
-					},
-				}
-				question := NewQuestion(utils.GetCUID(), &p.Id, prompt.Hash, prompt.Question, prompt.Answer, &prompt.LearnMore, &prompt.Distractors, metadata)
-				updatedQuestion, err := s.UpsertQuestion(ctx, question)
-				if err != nil {
-					return false, status.Error(codes.Internal, fmt.Sprintf("failed to upsert question for section %s", section.Title))
-				}
-
-				questionTag := NewQuestionTag(updatedQuestion.Id, topicId)
-				err = s.UpsertQuestionTag(ctx, questionTag)
-				if err != nil {
-					return false, status.Error(codes.Internal, fmt.Sprintf("failed to upsert question tag for section %s", section.Title))
-				}
-			}
-		}
-
-		for _, prompt := range section.Prompts {
-			metadata := &sharedpb.Metadata{
-				Metadata: map[string]string{
-					"parserType":   guideData.ParserType.String(),
-					"ts":           time.Now().UTC().Format(time.RFC3339),
-					"guideTitle":   guideData.Title,
-					"sectionTitle": section.Title,
-				},
-			}
-			question := NewQuestion(utils.GetCUID(), nil, prompt.Hash, prompt.Question, prompt.Answer, &prompt.LearnMore, &prompt.Distractors, metadata)
-			updatedQuestion, err := s.UpsertQuestion(ctx, question)
-			if err != nil {
-				return false, status.Error(codes.Internal, fmt.Sprintf("failed to upsert question for section %s", section.Title))
-			}
-
-			questionTag := NewQuestionTag(updatedQuestion.Id, topicId)
-			err = s.UpsertQuestionTag(ctx, questionTag)
-			if err != nil {
-				return false, status.Error(codes.Internal, fmt.Sprintf("failed to upsert question tag for section %s", section.Title))
-			}
-		}
+	if _, err := s.ImportGuide(ctx, guideData, "", false); err != nil {
+		return false, err
 	}
 
 	return true, nil
 }
 
-func (s *SqlAdminStore) ancestorList(ancestor *sharedpb.Ancestor) ([]*sharedpb.Ancestor, error) {
-	if ancestor == nil {
-		return nil, nil
-	}
-
-	// Step 1: Collect all ancestors into a slice, starting from the youngest
-	var ancestors []*sharedpb.Ancestor
-	current := ancestor
-	for current != nil {
-		ancestors = append(ancestors, current)
-		current = current.NextAncestor
-	}
-
-	return ancestors, nil
-}
-
-func (s *SqlAdminStore) ImportAncestry(ctx context.Context, ancestor *sharedpb.Ancestor, parserType sharedpb.ParserType, guideTitle string, section sharedpb.SectionData) (string, error) {
-	if ancestor == nil {
-		return "", status.Error(codes.Internal, "ancestor is nil")
-	}
-
-	// Step 1: Collect all ancestors into a slice, starting from the youngest
-	ancestors, err := s.ancestorList(ancestor)
-	if err != nil {
-		return "", status.Error(codes.Internal, "failed to get ancestors")
-	}
-
-	// Step 2: Reverse the slice to get oldest first
-	for i, j := 0, len(ancestors)-1; i < j; i, j = i+1, j-1 {
-		ancestors[i], ancestors[j] = ancestors[j], ancestors[i]
-	}
-
-	metadata := &sharedpb.Metadata{
-		Metadata: map[string]string{
-			"parserType":   parserType.String(),
-			"ts":           time.Now().UTC().Format(time.RFC3339),
-			"guideTitle":   guideTitle,
-			"sectionTitle": section.Title,
-		},
-	}
-
-	// Step 3: Process ancestors from oldest to newest
-	var parentID *string
-	for i, anc := range ancestors {
-		// Create a new tag for this ancestor
-		var tag *sharedpb.Tag
-		if i == len(ancestors)-1 {
-			tag = NewTag(
-				utils.GetCUID(),
-				anc.Name,
-				anc.Hash,
-				anc.TagType,
-				parentID,
-				section.ContentRating,
-				section.ContentDescriptors,
-				section.MetaTags,
-				parserType,
-				metadata,
-			)
-		} else {
-			tag = NewTag(
-				utils.GetCUID(),
-				anc.Name,
-				anc.Hash,
-				anc.TagType,
-				parentID,
-				anc.ContentRating,
-				nil,
-				nil,
-				parserType,
-				metadata,
-			)
-		}
-
-		// Upsert the tag and get the result
-		updatedTag, err := s.UpsertTag(ctx, tag)
-		if err != nil {
-			return "", status.Error(codes.Internal, fmt.Sprintf("failed to upsert tag for ancestor %s", anc.Name))
-		}
-
-		// Set this tag's ID as the parent ID for the next iteration
-		parentID = &updatedTag.Id
-	}
-
-	return *parentID, nil
-}
-
 // Tag retrieves a tag by its ID
 func (s *SqlAdminStore) Tag(ctx context.Context, id string) (*sharedpb.Tag, error) {
 	query := `
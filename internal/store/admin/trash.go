@@ -0,0 +1,322 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+)
+
+const defaultTrashRetention = 30 * 24 * time.Hour // How long a killed tree can be restored
+
+// TrashEntry is a tree that was removed by KillTree and can still be restored
+type TrashEntry struct {
+	ID          string    `db:"id"`
+	RootTagID   string    `db:"rootTagId"`
+	RootTagName string    `db:"rootTagName"`
+	TagIDs      []string  `db:"tagIds"`
+	DeletedBy   *string   `db:"deletedBy"`
+	DeletedAt   time.Time `db:"deletedAt"`
+	ExpiresAt   time.Time `db:"expiresAt"`
+}
+
+// trashTable describes how rows of a table are captured for a tree and put back on restore.
+// match selects rows (aliased x) for the tag ids in $1, or the trashed question ids when
+// byQuestion is set. restoreFilter skips rows (aliased r) whose other parents have
+// disappeared since the tree was trashed.
+type trashTable struct {
+	name          string
+	match         string
+	restoreFilter string
+	byQuestion    bool
+}
+
+const (
+	userStillExists     = `(r."userId" IS NULL OR EXISTS (SELECT 1 FROM public."User" u WHERE u.id = r."userId"))`
+	browserStillExists  = `(r."browserId" IS NULL OR EXISTS (SELECT 1 FROM public."Browser" b WHERE b."browserId" = r."browserId"))`
+	questionStillExists = `EXISTS (SELECT 1 FROM public."Question" q WHERE q.id = r."questionId")`
+	ownerStillExists    = `(r."ownerId" IS NULL OR EXISTS (SELECT 1 FROM public."User" u WHERE u.id = r."ownerId"))`
+)
+
+// trashTables mirrors deleteTagAndReferences, ordered so parents are restored before children
+var trashTables = []trashTable{
+	{name: "Tag", match: `x.id = ANY($1)`},
+	{name: "Passage", match: `x."tagId" = ANY($1)`},
+	{name: "Question", match: `x.id = ANY($1)`, byQuestion: true, restoreFilter: ownerStillExists},
+	{name: "QuestionAccess", match: `x."questionId" = ANY($1)`, byQuestion: true, restoreFilter: userStillExists},
+	{name: "QuestionTag", match: `x."tagId" = ANY($1)`, restoreFilter: questionStillExists},
+	{name: "UserTagRating", match: `x."tagId" = ANY($1)`, restoreFilter: userStillExists},
+	{name: "TestSession", match: `x."tagId" = ANY($1)`, restoreFilter: userStillExists + " AND " + browserStillExists},
+	{
+		name:          "TestQuestion",
+		match:         `x."sessionId" IN (SELECT id FROM public."TestSession" WHERE "tagId" = ANY($1))`,
+		restoreFilter: questionStillExists + ` AND EXISTS (SELECT 1 FROM public."TestSession" ts WHERE ts.id = r."sessionId")`,
+	},
+	{name: "UserTagReport", match: `x."tagId" = ANY($1)`, restoreFilter: userStillExists},
+	{name: "UserTagRecent", match: `x."tagId" = ANY($1)`, restoreFilter: userStillExists + " AND " + browserStillExists},
+	{name: "UserTagFavorite", match: `x."tagId" = ANY($1)`, restoreFilter: userStillExists + " AND " + browserStillExists},
+	{name: "UserTopicProgress", match: `x."topicId" = ANY($1)`, restoreFilter: userStillExists + " AND " + browserStillExists + " AND " + questionStillExists},
+	{name: "SurvivalSession", match: `x."tagId" = ANY($1)`, restoreFilter: userStillExists + " AND " + browserStillExists},
+	{
+		name:          "SurvivalQuestion",
+		match:         `x."sessionId" IN (SELECT id FROM public."SurvivalSession" WHERE "tagId" = ANY($1))`,
+		restoreFilter: questionStillExists + ` AND EXISTS (SELECT 1 FROM public."SurvivalSession" ss WHERE ss.id = r."sessionId")`,
+	},
+	{name: "TagAccess", match: `x."tagId" = ANY($1)`, restoreFilter: userStillExists},
+	{
+		name:  "TagInvite",
+		match: `x."tagId" = ANY($1)`,
+		restoreFilter: `EXISTS (SELECT 1 FROM public."User" u WHERE u.id = r."inviterId")
+			AND (r."inviteeId" IS NULL OR EXISTS (SELECT 1 FROM public."User" u WHERE u.id = r."inviteeId"))`,
+	},
+}
+
+// moveTreeToTrash snapshots every row KillTree is about to delete, including the questions
+// in questionIDs, and stores it as a trash entry
+func (s *SqlAdminStore) moveTreeToTrash(ctx context.Context, tx pgx.Tx, root *sharedpb.TagRow, ids []string, questionIDs []string, deletedBy string, retention time.Duration) (*TrashEntry, error) {
+	snapshot := make(map[string]json.RawMessage, len(trashTables))
+	for _, table := range trashTables {
+		query := fmt.Sprintf(`SELECT COALESCE(jsonb_agg(to_jsonb(x)), '[]'::jsonb) FROM public."%s" x WHERE %s`, table.name, table.match)
+		matchIDs := ids
+		if table.byQuestion {
+			matchIDs = questionIDs
+		}
+
+		var rows []byte
+		if err := tx.QueryRow(ctx, query, matchIDs).Scan(&rows); err != nil {
+			log.Printf("Failed to snapshot %s rows for tree %s: %v", table.name, root.Id, err)
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to snapshot %s rows: %v", table.name, err))
+		}
+		snapshot[table.name] = rows
+	}
+
+	payload, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to encode trash snapshot: %v", err))
+	}
+
+	entry := &TrashEntry{
+		ID:          utils.GetCUID(),
+		RootTagID:   root.Id,
+		RootTagName: root.Name,
+		TagIDs:      ids,
+		DeletedAt:   time.Now(),
+	}
+	entry.ExpiresAt = entry.DeletedAt.Add(retention)
+	if deletedBy != "" {
+		entry.DeletedBy = &deletedBy
+	}
+
+	query := `
+		INSERT INTO public."TrashedTree" (id, "rootTagId", "rootTagName", "tagIds", snapshot, "deletedBy", "deletedAt", "expiresAt")
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	_, err = tx.Exec(ctx, query, entry.ID, entry.RootTagID, entry.RootTagName, entry.TagIDs, payload, entry.DeletedBy, entry.DeletedAt, entry.ExpiresAt)
+	if err != nil {
+		log.Printf("Failed to insert trash entry for tree %s: %v", root.Id, err)
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to move tree to trash: %v", err))
+	}
+
+	return entry, nil
+}
+
+func (s *SqlAdminStore) ListTrash(ctx context.Context, includeExpired bool) ([]*TrashEntry, error) {
+	query := `
+		SELECT id, "rootTagId", "rootTagName", "tagIds", "deletedBy", "deletedAt", "expiresAt"
+		FROM public."TrashedTree"
+		WHERE "restoredAt" IS NULL
+		AND ($1 OR "expiresAt" > NOW())
+		ORDER BY "deletedAt" DESC
+	`
+
+	var entries []*TrashEntry
+	if err := pgxscan.Select(ctx, s.db, &entries, query, includeExpired); err != nil {
+		log.Printf("Failed to list trash: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list trash")
+	}
+
+	return entries, nil
+}
+
+func (s *SqlAdminStore) RestoreTree(ctx context.Context, trashID string, restoredBy string) ([]string, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to begin transaction: %v", err))
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		rootTagID string
+		tagIDs    []string
+		payload   []byte
+		expiresAt time.Time
+	)
+	err = tx.QueryRow(ctx, `
+		SELECT "rootTagId", "tagIds", snapshot, "expiresAt"
+		FROM public."TrashedTree"
+		WHERE id = $1 AND "restoredAt" IS NULL
+		FOR UPDATE
+	`, trashID).Scan(&rootTagID, &tagIDs, &payload, &expiresAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("trash entry '%s' not found", trashID))
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to load trash entry: %v", err))
+	}
+	if time.Now().After(expiresAt) {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("trash entry '%s' expired at %s", trashID, expiresAt.Format(time.RFC3339)))
+	}
+
+	var snapshot map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &snapshot); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to decode trash snapshot: %v", err))
+	}
+
+	// The tags must not have been re-created (same id or hash) since they were trashed
+	var conflicts int
+	err = tx.QueryRow(ctx, `
+		SELECT COUNT(*)
+		FROM jsonb_populate_recordset(NULL::public."Tag", $1::jsonb) r
+		JOIN public."Tag" t ON t.id = r.id OR t.hash = r.hash
+	`, snapshot["Tag"]).Scan(&conflicts)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to check for conflicting tags: %v", err))
+	}
+	if conflicts > 0 {
+		return nil, status.Error(codes.AlreadyExists, fmt.Sprintf("%d tags from trash entry '%s' already exist", conflicts, trashID))
+	}
+
+	// The root's parent must still be there to hang the tree back on
+	var parentTagID *string
+	err = tx.QueryRow(ctx, `
+		SELECT r."parentTagId"
+		FROM jsonb_populate_recordset(NULL::public."Tag", $1::jsonb) r
+		WHERE r.id = $2
+	`, snapshot["Tag"], rootTagID).Scan(&parentTagID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to read root tag from snapshot: %v", err))
+	}
+	if parentTagID != nil {
+		exists, err := s.tagExistsTx(ctx, tx, *parentTagID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("parent tag '%s' no longer exists", *parentTagID))
+		}
+	}
+
+	for _, table := range trashTables {
+		rows, ok := snapshot[table.name]
+		if !ok {
+			continue
+		}
+
+		where := "TRUE"
+		if table.restoreFilter != "" {
+			where = table.restoreFilter
+		}
+		query := fmt.Sprintf(`
+			INSERT INTO public."%[1]s"
+			SELECT r.* FROM jsonb_populate_recordset(NULL::public."%[1]s", $1::jsonb) r
+			WHERE %[2]s
+			ON CONFLICT DO NOTHING
+		`, table.name, where)
+
+		tag, err := tx.Exec(ctx, query, rows)
+		if err != nil {
+			log.Printf("Failed to restore %s rows from trash %s: %v", table.name, trashID, err)
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to restore %s rows: %v", table.name, err))
+		}
+		log.Printf("Restored %d %s rows from trash %s", tag.RowsAffected(), table.name, trashID)
+	}
+
+	if parentTagID != nil {
+		if _, err := tx.Exec(ctx, `UPDATE public."Tag" SET "hasChildren" = true WHERE id = $1`, *parentTagID); err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update parent tag: %v", err))
+		}
+	}
+
+	// Queue the restored tags so the next indexing run pushes them back to Algolia
+	_, err = tx.Exec(ctx, `
+		INSERT INTO public."IndexOutbox" ("objectType", "objectId", action, "queuedAt")
+		SELECT 'Tag', id, 'upsert', NOW()
+		FROM public."Tag"
+		WHERE id = ANY($1) AND context IS NOT NULL
+		ON CONFLICT ("objectType", "objectId") DO UPDATE
+		SET action = 'upsert', "queuedAt" = NOW()
+	`, tagIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to queue restored tags for indexing: %v", err))
+	}
+
+	// Questions trashed with an author's tree go back to the index too
+	_, err = tx.Exec(ctx, `
+		INSERT INTO public."IndexOutbox" ("objectType", "objectId", action, "queuedAt")
+		SELECT 'Question', q.id, 'upsert', NOW()
+		FROM jsonb_populate_recordset(NULL::public."Question", $1::jsonb) r
+		JOIN public."Question" q ON q.id = r.id
+		ON CONFLICT ("objectType", "objectId") DO UPDATE
+		SET action = 'upsert', "queuedAt" = NOW()
+	`, snapshot["Question"])
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to queue restored questions for indexing: %v", err))
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE public."TrashedTree" SET "restoredAt" = NOW(), "restoredBy" = $2 WHERE id = $1
+	`, trashID, restoredBy)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to mark trash entry restored: %v", err))
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to commit restore: %v", err))
+	}
+
+	return tagIDs, nil
+}
+
+func (s *SqlAdminStore) PurgeTrash(ctx context.Context, trashID string) (int64, error) {
+	var (
+		query string
+		args  []interface{}
+	)
+	if trashID != "" {
+		query = `DELETE FROM public."TrashedTree" WHERE id = $1`
+		args = append(args, trashID)
+	} else {
+		query = `DELETE FROM public."TrashedTree" WHERE "expiresAt" <= NOW() OR "restoredAt" IS NOT NULL`
+	}
+
+	tag, err := s.db.Exec(ctx, query, args...)
+	if err != nil {
+		log.Printf("Failed to purge trash: %v", err)
+		return 0, status.Error(codes.Internal, "failed to purge trash")
+	}
+	if trashID != "" && tag.RowsAffected() == 0 {
+		return 0, status.Error(codes.NotFound, fmt.Sprintf("trash entry '%s' not found", trashID))
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// tagExistsTx checks whether a tag exists inside a transaction
+func (s *SqlAdminStore) tagExistsTx(ctx context.Context, tx pgx.Tx, id string) (bool, error) {
+	var exists bool
+	err := tx.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM public."Tag" WHERE id = $1)`, id).Scan(&exists)
+	if err != nil {
+		return false, status.Error(codes.Internal, fmt.Sprintf("failed to check tag existence: %v", err))
+	}
+	return exists, nil
+}
@@ -0,0 +1,59 @@
+package announcement
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Announcement types, stored as the AnnouncementType enum
+const (
+	TypeInfo   = "Info"
+	TypeWarn   = "Warn"
+	TypeDanger = "Danger"
+)
+
+type AnnouncementStore interface {
+	// ListAnnouncements returns announcements newest first, optionally only the active ones
+	ListAnnouncements(ctx context.Context, activeOnly bool) ([]*Announcement, error)
+	// ListActive returns active announcements the user hasn't dismissed. An empty userID lists them all.
+	ListActive(ctx context.Context, userID string) ([]*Announcement, error)
+	// CreateAnnouncement adds an active announcement
+	CreateAnnouncement(ctx context.Context, announcement *NewAnnouncement) (*Announcement, error)
+	// SetActive activates or deactivates an announcement
+	SetActive(ctx context.Context, id string, active bool) (*Announcement, error)
+	// Dismiss hides a dismissible announcement from a user
+	Dismiss(ctx context.Context, id, userID string) error
+}
+
+// Announcement targeting lives in metadata. Empty Roles or Contexts means everyone.
+type Announcement struct {
+	ID          string    `db:"id"`
+	Message     string    `db:"message"`
+	Type        string    `db:"type"`
+	Dismissible bool      `db:"dismissible"`
+	IsActive    bool      `db:"isActive"`
+	Roles       []string  `db:"roles"`    // UserRole names
+	Contexts    []string  `db:"contexts"` // ContextType names
+	CreatedAt   time.Time `db:"createdAt"`
+	UpdatedAt   time.Time `db:"updatedAt"`
+}
+
+type NewAnnouncement struct {
+	Message     string
+	Type        string
+	Dismissible bool
+	Roles       []string
+	Contexts    []string
+}
+
+func NewSqlAnnouncementStore(ctx context.Context, dbURL string) (*SqlAnnouncementStore, error) {
+	db, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to connect to postgres: "+err.Error())
+	}
+	return &SqlAnnouncementStore{db: db}, nil
+}
@@ -0,0 +1,120 @@
+package announcement
+
+import (
+	"context"
+	"errors"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+)
+
+type SqlAnnouncementStore struct {
+	db *pgxpool.Pool
+}
+
+// announcementColumns unpacks the targeting lists from metadata
+const announcementColumns = `a.id, a.message, a.type::text AS type, a.dismissible, a."isActive",
+	ARRAY(SELECT jsonb_array_elements_text(COALESCE(a.metadata->'roles', '[]'::jsonb))) AS roles,
+	ARRAY(SELECT jsonb_array_elements_text(COALESCE(a.metadata->'contexts', '[]'::jsonb))) AS contexts,
+	a."createdAt", a."updatedAt"`
+
+func (s *SqlAnnouncementStore) ListAnnouncements(ctx context.Context, activeOnly bool) ([]*Announcement, error) {
+	var announcements []*Announcement
+	err := pgxscan.Select(ctx, s.db, &announcements, `
+		SELECT `+announcementColumns+`
+		FROM public."Announcement" a
+		WHERE NOT $1 OR a."isActive"
+		ORDER BY a."createdAt" DESC
+	`, activeOnly)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list announcements: %v", err)
+	}
+	return announcements, nil
+}
+
+func (s *SqlAnnouncementStore) ListActive(ctx context.Context, userID string) ([]*Announcement, error) {
+	var announcements []*Announcement
+	err := pgxscan.Select(ctx, s.db, &announcements, `
+		SELECT `+announcementColumns+`
+		FROM public."Announcement" a
+		WHERE a."isActive"
+		AND NOT EXISTS (
+			SELECT 1 FROM public."UserAnnouncementDismiss" d
+			WHERE d."announcementId" = a.id AND d."userId" = $1
+		)
+		ORDER BY a."createdAt" DESC
+	`, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list active announcements: %v", err)
+	}
+	return announcements, nil
+}
+
+func (s *SqlAnnouncementStore) CreateAnnouncement(ctx context.Context, announcement *NewAnnouncement) (*Announcement, error) {
+	roles := announcement.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+	contexts := announcement.Contexts
+	if contexts == nil {
+		contexts = []string{}
+	}
+
+	var created Announcement
+	err := pgxscan.Get(ctx, s.db, &created, `
+		INSERT INTO public."Announcement" AS a (id, message, type, dismissible, "isActive", metadata, "createdAt", "updatedAt")
+		VALUES ($1, $2, $3::"AnnouncementType", $4, true,
+			jsonb_build_object('roles', to_jsonb($5::text[]), 'contexts', to_jsonb($6::text[])), NOW(), NOW())
+		RETURNING `+announcementColumns,
+		utils.GetCUID(), announcement.Message, announcement.Type, announcement.Dismissible, roles, contexts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create announcement: %v", err)
+	}
+	return &created, nil
+}
+
+func (s *SqlAnnouncementStore) SetActive(ctx context.Context, id string, active bool) (*Announcement, error) {
+	var updated Announcement
+	err := pgxscan.Get(ctx, s.db, &updated, `
+		UPDATE public."Announcement" a SET "isActive" = $2, "updatedAt" = NOW()
+		WHERE a.id = $1
+		RETURNING `+announcementColumns, id, active)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "announcement %s not found", id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update announcement: %v", err)
+	}
+	return &updated, nil
+}
+
+func (s *SqlAnnouncementStore) Dismiss(ctx context.Context, id, userID string) error {
+	var dismissible bool
+	err := s.db.QueryRow(ctx, `
+		SELECT dismissible FROM public."Announcement" WHERE id = $1 AND "isActive"
+	`, id).Scan(&dismissible)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return status.Errorf(codes.NotFound, "announcement %s not found", id)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get announcement: %v", err)
+	}
+	if !dismissible {
+		return status.Error(codes.FailedPrecondition, "announcement can't be dismissed")
+	}
+
+	_, err = s.db.Exec(ctx, `
+		INSERT INTO public."UserAnnouncementDismiss" ("userId", "announcementId", "createdAt")
+		VALUES ($1, $2, NOW())
+		ON CONFLICT ("userId", "announcementId") DO NOTHING
+	`, userID, id)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to dismiss announcement: %v", err)
+	}
+	return nil
+}
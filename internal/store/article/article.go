@@ -0,0 +1,117 @@
+package article
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Article statuses, stored as the ArticleStatus enum
+const (
+	StatusDraft     = "Draft"
+	StatusPublished = "Published"
+)
+
+const maxSlugLength = 80
+
+var (
+	slugSeparators = regexp.MustCompile(`[^a-z0-9]+`)
+	slugPattern    = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+)
+
+type ArticleStore interface {
+	// ListArticles returns articles newest first, see ArticleFilter. Content is left out of lists.
+	ListArticles(ctx context.Context, filter *ArticleFilter) ([]*Article, error)
+	// ListCategories returns the categories with published articles and how many each has
+	ListCategories(ctx context.Context) ([]*Category, error)
+	GetArticle(ctx context.Context, id string) (*Article, error)
+	GetArticleBySlug(ctx context.Context, slug string) (*Article, error)
+	// CreateArticle saves a draft, or publishes it straight away. Without a slug one is made from the title and suffixed until it's free,
+	// an explicit slug that's taken is AlreadyExists.
+	CreateArticle(ctx context.Context, article *NewArticle) (*Article, error)
+	// UpdateArticle changes the given fields, nil fields are left alone. The slug only changes when set.
+	UpdateArticle(ctx context.Context, id string, update *ArticleUpdate) (*Article, error)
+	// SetStatus publishes or unpublishes an article, publishedAt is kept from the first publish
+	SetStatus(ctx context.Context, id, toStatus, updatedBy string) (*Article, error)
+	DeleteArticle(ctx context.Context, id string) error
+}
+
+type Article struct {
+	ID          string     `db:"id"`
+	Title       string     `db:"title"`
+	Content     string     `db:"content"`
+	Slug        string     `db:"slug"`
+	Description string     `db:"description"`
+	Category    string     `db:"category"`
+	ImageURL    string     `db:"imageUrl"`
+	Status      string     `db:"status"`
+	PublishedAt *time.Time `db:"publishedAt"`
+	CreatedBy   *string    `db:"createdBy"`
+	UpdatedBy   *string    `db:"updatedBy"`
+	CreatedAt   time.Time  `db:"createdAt"`
+	UpdatedAt   time.Time  `db:"updatedAt"`
+}
+
+type NewArticle struct {
+	Title       string
+	Content     string
+	Slug        string // optional
+	Description string
+	Category    string
+	ImageURL    string
+	Publish     bool
+	CreatedBy   string
+}
+
+type ArticleUpdate struct {
+	Title       *string
+	Content     *string
+	Slug        *string
+	Description *string
+	Category    *string
+	ImageURL    *string
+	UpdatedBy   string
+}
+
+// ArticleFilter narrows ListArticles, nil fields match everything
+type ArticleFilter struct {
+	Status   *string
+	Category *string
+	Limit    int
+	Offset   int
+}
+
+type Category struct {
+	Name         string `db:"name"`
+	ArticleCount int    `db:"articleCount"`
+}
+
+// Slugify turns a title into a URL slug, e.g. "What's New in 2025?" becomes "what-s-new-in-2025"
+func Slugify(title string) string {
+	slug := strings.Trim(slugSeparators.ReplaceAllString(strings.ToLower(title), "-"), "-")
+	if len(slug) > maxSlugLength {
+		slug = strings.TrimRight(slug[:maxSlugLength], "-")
+	}
+	if slug == "" {
+		return "article"
+	}
+	return slug
+}
+
+// ValidSlug reports whether a slug is lowercase words joined by single dashes
+func ValidSlug(slug string) bool {
+	return len(slug) <= maxSlugLength && slugPattern.MatchString(slug)
+}
+
+func NewSqlArticleStore(ctx context.Context, dbURL string) (*SqlArticleStore, error) {
+	db, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to connect to postgres: "+err.Error())
+	}
+	return &SqlArticleStore{db: db}, nil
+}
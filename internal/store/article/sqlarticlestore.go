@@ -0,0 +1,204 @@
+package article
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+)
+
+// slugAttempts bounds retries when a generated slug is taken between choosing and inserting it
+const slugAttempts = 3
+
+type SqlArticleStore struct {
+	db *pgxpool.Pool
+}
+
+const articleColumns = `id, title, content, slug, description, category, "imageUrl", status::text AS status,
+	"publishedAt", "createdBy", "updatedBy", "createdAt", "updatedAt"`
+
+// summaryColumns leaves out the article body for lists
+const summaryColumns = `id, title, '' AS content, slug, description, category, "imageUrl", status::text AS status,
+	"publishedAt", "createdBy", "updatedBy", "createdAt", "updatedAt"`
+
+func (s *SqlArticleStore) ListArticles(ctx context.Context, filter *ArticleFilter) ([]*Article, error) {
+	var articles []*Article
+	err := pgxscan.Select(ctx, s.db, &articles, `
+		SELECT `+summaryColumns+`
+		FROM public."Article"
+		WHERE ($1::text IS NULL OR status::text = $1)
+		AND ($2::text IS NULL OR category = $2)
+		ORDER BY COALESCE("publishedAt", "createdAt") DESC, id
+		LIMIT $3 OFFSET $4
+	`, filter.Status, filter.Category, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list articles: %v", err)
+	}
+	return articles, nil
+}
+
+func (s *SqlArticleStore) ListCategories(ctx context.Context) ([]*Category, error) {
+	var categories []*Category
+	err := pgxscan.Select(ctx, s.db, &categories, `
+		SELECT category AS name, COUNT(*) AS "articleCount"
+		FROM public."Article"
+		WHERE status = 'Published'
+		GROUP BY category
+		ORDER BY category
+	`)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list article categories: %v", err)
+	}
+	return categories, nil
+}
+
+func (s *SqlArticleStore) GetArticle(ctx context.Context, id string) (*Article, error) {
+	return s.getArticle(ctx, "id", id)
+}
+
+func (s *SqlArticleStore) GetArticleBySlug(ctx context.Context, slug string) (*Article, error) {
+	return s.getArticle(ctx, "slug", slug)
+}
+
+func (s *SqlArticleStore) getArticle(ctx context.Context, column, value string) (*Article, error) {
+	var article Article
+	err := pgxscan.Get(ctx, s.db, &article, `SELECT `+articleColumns+` FROM public."Article" WHERE `+column+` = $1`, value)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "article %s not found", value)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get article: %v", err)
+	}
+	return &article, nil
+}
+
+func (s *SqlArticleStore) CreateArticle(ctx context.Context, article *NewArticle) (*Article, error) {
+	for attempt := 1; ; attempt++ {
+		slug := article.Slug
+		if slug == "" {
+			var err error
+			if slug, err = s.availableSlug(ctx, Slugify(article.Title)); err != nil {
+				return nil, err
+			}
+		}
+
+		articleStatus := StatusDraft
+		if article.Publish {
+			articleStatus = StatusPublished
+		}
+
+		var created Article
+		err := pgxscan.Get(ctx, s.db, &created, `
+			INSERT INTO public."Article" (id, title, content, slug, description, category, "imageUrl", status,
+				"publishedAt", "createdBy", "updatedBy", "createdAt", "updatedAt")
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8::"ArticleStatus",
+				CASE WHEN $8 = 'Published' THEN NOW() END, $9, $9, NOW(), NOW())
+			RETURNING `+articleColumns,
+			utils.GetCUID(), article.Title, article.Content, slug, article.Description, article.Category,
+			article.ImageURL, articleStatus, article.CreatedBy)
+		if isUniqueViolation(err) && article.Slug == "" && attempt < slugAttempts {
+			continue
+		}
+		if err != nil {
+			return nil, articleWriteError("create", slug, err)
+		}
+		return &created, nil
+	}
+}
+
+func (s *SqlArticleStore) UpdateArticle(ctx context.Context, id string, update *ArticleUpdate) (*Article, error) {
+	var updated Article
+	err := pgxscan.Get(ctx, s.db, &updated, `
+		UPDATE public."Article"
+		SET title = COALESCE($2, title), content = COALESCE($3, content), slug = COALESCE($4, slug),
+			description = COALESCE($5, description), category = COALESCE($6, category),
+			"imageUrl" = COALESCE($7, "imageUrl"), "updatedBy" = $8, "updatedAt" = NOW()
+		WHERE id = $1
+		RETURNING `+articleColumns,
+		id, update.Title, update.Content, update.Slug, update.Description, update.Category, update.ImageURL, update.UpdatedBy)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "article %s not found", id)
+	}
+	if err != nil {
+		slug := ""
+		if update.Slug != nil {
+			slug = *update.Slug
+		}
+		return nil, articleWriteError("update", slug, err)
+	}
+	return &updated, nil
+}
+
+func (s *SqlArticleStore) SetStatus(ctx context.Context, id, toStatus, updatedBy string) (*Article, error) {
+	var updated Article
+	err := pgxscan.Get(ctx, s.db, &updated, `
+		UPDATE public."Article"
+		SET status = $2::"ArticleStatus",
+			"publishedAt" = CASE WHEN $2 = 'Published' THEN COALESCE("publishedAt", NOW()) ELSE "publishedAt" END,
+			"updatedBy" = $3, "updatedAt" = NOW()
+		WHERE id = $1
+		RETURNING `+articleColumns, id, toStatus, updatedBy)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "article %s not found", id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update article status: %v", err)
+	}
+	return &updated, nil
+}
+
+func (s *SqlArticleStore) DeleteArticle(ctx context.Context, id string) error {
+	tag, err := s.db.Exec(ctx, `DELETE FROM public."Article" WHERE id = $1`, id)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to delete article: %v", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "article %s not found", id)
+	}
+	return nil
+}
+
+// availableSlug returns base, or base with the lowest numbered suffix that isn't taken
+func (s *SqlArticleStore) availableSlug(ctx context.Context, base string) (string, error) {
+	var slugs []string
+	err := pgxscan.Select(ctx, s.db, &slugs, `
+		SELECT slug FROM public."Article" WHERE slug = $1 OR slug LIKE $1 || '-%'
+	`, base)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to check article slugs: %v", err)
+	}
+
+	taken := make(map[string]bool, len(slugs))
+	for _, slug := range slugs {
+		taken[slug] = true
+	}
+	if !taken[base] {
+		return base, nil
+	}
+	for n := 2; ; n++ {
+		candidate := fmt.Sprintf("%s-%d", base, n)
+		if !taken[candidate] {
+			return candidate, nil
+		}
+	}
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+func articleWriteError(action, slug string, err error) error {
+	if isUniqueViolation(err) {
+		return status.Errorf(codes.AlreadyExists, "an article with slug %s already exists", slug)
+	}
+	return status.Errorf(codes.Internal, "failed to %s article: %v", action, err)
+}
@@ -0,0 +1,53 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AuditStore interface {
+	// Record saves an audit event
+	Record(ctx context.Context, event *Event) error
+	// ListEvents pages through audit events, newest first
+	ListEvents(ctx context.Context, filter *Filter) ([]*Event, int64, error)
+}
+
+// Event is one audited RPC call
+type Event struct {
+	ID           string    `db:"id"`
+	ActorID      *string   `db:"actorId"`
+	Roles        []string  `db:"roles"`
+	Method       string    `db:"method"`
+	TargetIDs    []string  `db:"targetIds"`
+	Request      []byte    `db:"request"`
+	Outcome      string    `db:"outcome"`
+	ErrorMessage *string   `db:"errorMessage"`
+	Before       []byte    `db:"before"`
+	After        []byte    `db:"after"`
+	DurationMs   int32     `db:"durationMs"`
+	CreatedAt    time.Time `db:"createdAt"`
+}
+
+// Filter narrows ListEvents, empty fields match everything
+type Filter struct {
+	ActorID  string
+	Method   string // matched as a substring, so "KillTree" finds the full method name
+	TargetID string
+	Outcome  string
+	Since    *time.Time
+	Until    *time.Time
+	Limit    int
+	Offset   int
+}
+
+func NewSqlAuditStore(ctx context.Context, dbURL string) (*SqlAuditStore, error) {
+	db, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to connect to postgres: "+err.Error())
+	}
+	return &SqlAuditStore{db: db}, nil
+}
@@ -0,0 +1,92 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+)
+
+type SqlAuditStore struct {
+	db *pgxpool.Pool
+}
+
+func (s *SqlAuditStore) Record(ctx context.Context, event *Event) error {
+	if event.ID == "" {
+		event.ID = utils.GetCUID()
+	}
+	roles := event.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+	targetIDs := event.TargetIDs
+	if targetIDs == nil {
+		targetIDs = []string{}
+	}
+	_, err := s.db.Exec(ctx, `
+		INSERT INTO public."AuditEvent" (id, "actorId", roles, method, "targetIds", request, outcome,
+			"errorMessage", before, after, "durationMs", "createdAt")
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NOW())
+	`, event.ID, event.ActorID, roles, event.Method, targetIDs, event.Request, event.Outcome,
+		event.ErrorMessage, event.Before, event.After, event.DurationMs)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to record audit event: %v", err)
+	}
+	return nil
+}
+
+func (s *SqlAuditStore) ListEvents(ctx context.Context, filter *Filter) ([]*Event, int64, error) {
+	var args []interface{}
+	var where []string
+	if filter.ActorID != "" {
+		args = append(args, filter.ActorID)
+		where = append(where, fmt.Sprintf(`"actorId" = $%d`, len(args)))
+	}
+	if filter.Method != "" {
+		args = append(args, "%"+filter.Method+"%")
+		where = append(where, fmt.Sprintf(`method ILIKE $%d`, len(args)))
+	}
+	if filter.TargetID != "" {
+		args = append(args, filter.TargetID)
+		where = append(where, fmt.Sprintf(`$%d = ANY("targetIds")`, len(args)))
+	}
+	if filter.Outcome != "" {
+		args = append(args, filter.Outcome)
+		where = append(where, fmt.Sprintf(`outcome = $%d`, len(args)))
+	}
+	if filter.Since != nil {
+		args = append(args, *filter.Since)
+		where = append(where, fmt.Sprintf(`"createdAt" >= $%d`, len(args)))
+	}
+	if filter.Until != nil {
+		args = append(args, *filter.Until)
+		where = append(where, fmt.Sprintf(`"createdAt" < $%d`, len(args)))
+	}
+
+	from := `FROM public."AuditEvent"`
+	if len(where) > 0 {
+		from += ` WHERE ` + strings.Join(where, " AND ")
+	}
+
+	var total int64
+	if err := pgxscan.Get(ctx, s.db, &total, `SELECT COUNT(*) `+from, args...); err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "failed to count audit events: %v", err)
+	}
+
+	pageArgs := append(args, filter.Limit, filter.Offset)
+	var events []*Event
+	err := pgxscan.Select(ctx, s.db, &events, `
+		SELECT id, "actorId", roles, method, "targetIds", request, outcome, "errorMessage",
+			before, after, "durationMs", "createdAt" `+from+
+		fmt.Sprintf(` ORDER BY "createdAt" DESC, id LIMIT $%d OFFSET $%d`, len(args)+1, len(args)+2), pageArgs...)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "failed to list audit events: %v", err)
+	}
+	return events, total, nil
+}
@@ -0,0 +1,45 @@
+package billing
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type BillingStore interface {
+	// ApplySubscriptionEvent records a Stripe event, links the customer to the user and upserts the subscription
+	// in one transaction. It returns false and changes nothing when the event was already processed, and
+	// NotFound when the customer can't be tied to a user yet.
+	ApplySubscriptionEvent(ctx context.Context, event *SubscriptionEvent) (bool, error)
+}
+
+// SubscriptionEvent is what a Stripe webhook event changes
+type SubscriptionEvent struct {
+	EventID      string
+	EventType    string
+	EventCreated time.Time
+	CustomerID   string
+	UserID       string              // from our metadata or the checkout's client reference, may be empty
+	Subscription *SubscriptionUpdate // nil when the event only links the customer
+}
+
+type SubscriptionUpdate struct {
+	StripeSubscriptionID string
+	Status               string // entitlement.Status*
+	Type                 string // entitlement.Type*
+	StartDate            time.Time
+	EndDate              *time.Time
+	// Provisional rows are only inserted, never written over a subscription Stripe has described
+	Provisional bool
+}
+
+func NewSqlBillingStore(ctx context.Context, dbURL string) (*SqlBillingStore, error) {
+	db, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to connect to postgres: "+err.Error())
+	}
+	return &SqlBillingStore{db: db}, nil
+}
@@ -0,0 +1,96 @@
+package billing
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+)
+
+type SqlBillingStore struct {
+	db *pgxpool.Pool
+}
+
+func (s *SqlBillingStore) ApplySubscriptionEvent(ctx context.Context, event *SubscriptionEvent) (bool, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO public."StripeEvent" (id, type, "processedAt")
+		VALUES ($1, $2, NOW())
+		ON CONFLICT (id) DO NOTHING
+	`, event.EventID, event.EventType)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "failed to record stripe event: %v", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	userID, err := linkCustomer(ctx, tx, event.UserID, event.CustomerID)
+	if err != nil {
+		return false, err
+	}
+
+	if sub := event.Subscription; sub != nil {
+		// Stripe doesn't promise delivery order, an older event never overwrites a newer one
+		_, err := tx.Exec(ctx, `
+			INSERT INTO public."Subscription" AS s (id, "userId", status, type, "startDate", "endDate",
+				"stripeSubscriptionId", metadata, "createdAt", "updatedAt")
+			VALUES ($1, $2, $3::"SubscriptionStatus", $4::"SubscriptionType", $5, $6, $7,
+				jsonb_build_object('stripeCustomerId', $8::text, 'stripeEventCreated', $9::bigint), NOW(), NOW())
+			ON CONFLICT ("stripeSubscriptionId") DO UPDATE
+			SET "userId" = EXCLUDED."userId", status = EXCLUDED.status, type = EXCLUDED.type,
+				"startDate" = EXCLUDED."startDate", "endDate" = EXCLUDED."endDate",
+				metadata = COALESCE(s.metadata, '{}'::jsonb) || EXCLUDED.metadata, "updatedAt" = NOW()
+			WHERE NOT $10
+			AND COALESCE((s.metadata->>'stripeEventCreated')::bigint, 0) <= $9::bigint
+		`, utils.GetCUID(), userID, sub.Status, sub.Type, sub.StartDate, sub.EndDate, sub.StripeSubscriptionID,
+			event.CustomerID, event.EventCreated.Unix(), sub.Provisional)
+		if err != nil {
+			return false, status.Errorf(codes.Internal, "failed to upsert subscription %s: %v", sub.StripeSubscriptionID, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, status.Errorf(codes.Internal, "failed to commit stripe event: %v", err)
+	}
+	return true, nil
+}
+
+// linkCustomer sets the user's Stripe customer ID when the event names the user, otherwise finds the user
+// the customer was linked to earlier
+func linkCustomer(ctx context.Context, tx pgx.Tx, userID, customerID string) (string, error) {
+	if userID == "" {
+		err := tx.QueryRow(ctx, `SELECT id FROM public."User" WHERE "stripeCustomerId" = $1`, customerID).Scan(&userID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", status.Errorf(codes.NotFound, "no user is linked to stripe customer %s", customerID)
+		}
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "failed to find user for stripe customer: %v", err)
+		}
+		return userID, nil
+	}
+
+	tag, err := tx.Exec(ctx, `UPDATE public."User" SET "stripeCustomerId" = $2 WHERE id = $1`, userID, customerID)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return "", status.Errorf(codes.AlreadyExists, "stripe customer %s is linked to another user", customerID)
+	}
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to link stripe customer: %v", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return "", status.Errorf(codes.NotFound, "user %s not found", userID)
+	}
+	return userID, nil
+}
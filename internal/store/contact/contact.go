@@ -0,0 +1,106 @@
+package contact
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Contact statuses, stored as the ContactRequestStatus enum
+const (
+	StatusPending  = "Pending"
+	StatusResolved = "Resolved"
+	StatusClosed   = "Closed"
+)
+
+// Contact event types, stored as the ContactEventType enum
+const (
+	EventSubmitted     = "Submitted"
+	EventAssigned      = "Assigned"
+	EventReplied       = "Replied"
+	EventStatusChanged = "StatusChanged"
+)
+
+// transitions lists the statuses a contact can move to from each status. Closed is final.
+var transitions = map[string][]string{
+	StatusPending:  {StatusResolved, StatusClosed},
+	StatusResolved: {StatusClosed},
+}
+
+type ContactStore interface {
+	// CreateContact saves a submission. Contacts that aren't spam emit a contact.submitted notification.
+	CreateContact(ctx context.Context, contact *NewContact) (*Contact, error)
+	// CountRecent counts submissions from the same email since emailSince, and with the same message from anyone since messageSince
+	CountRecent(ctx context.Context, email, message string, emailSince, messageSince time.Time) (*RecentCounts, error)
+	ListContacts(ctx context.Context, filter *ContactFilter) ([]*Contact, error)
+	GetContact(ctx context.Context, id string) (*Contact, error)
+	// ListEvents returns a contact's history oldest first
+	ListEvents(ctx context.Context, id string) ([]*Event, error)
+	// Assign hands a contact to an admin, an empty assigneeID unassigns it
+	Assign(ctx context.Context, id, actorID, assigneeID string) (*Contact, error)
+	// Reply records a reply to the submitter and emits a contact.replied notification for delivery
+	Reply(ctx context.Context, id, actorID, body string) (*Event, error)
+	// SetStatus moves a contact along its lifecycle, see transitions
+	SetStatus(ctx context.Context, id, actorID, toStatus, note string) (*Contact, error)
+}
+
+type Contact struct {
+	ID          string    `db:"id"`
+	Name        string    `db:"name"`
+	Email       string    `db:"email"`
+	Phone       *string   `db:"phone"`
+	Message     string    `db:"message"`
+	Status      string    `db:"status"`
+	UserID      *string   `db:"userId"`
+	AssignedTo  *string   `db:"assignedTo"`
+	Spam        bool      `db:"spam"`
+	SpamReasons []string  `db:"spamReasons"`
+	CreatedAt   time.Time `db:"createdAt"`
+	UpdatedAt   time.Time `db:"updatedAt"`
+}
+
+type NewContact struct {
+	Name        string
+	Email       string
+	Phone       *string
+	Message     string
+	UserID      *string
+	Spam        bool
+	SpamReasons []string
+}
+
+type Event struct {
+	ID         string    `db:"id"`
+	ContactID  string    `db:"contactId"`
+	Type       string    `db:"type"`
+	ActorID    *string   `db:"actorId"`
+	FromStatus *string   `db:"fromStatus"`
+	ToStatus   *string   `db:"toStatus"`
+	Body       *string   `db:"body"`
+	CreatedAt  time.Time `db:"createdAt"`
+}
+
+type RecentCounts struct {
+	SameEmail   int `db:"sameEmail"`
+	SameMessage int `db:"sameMessage"`
+}
+
+// ContactFilter narrows ListContacts. Nil fields match everything, spam is left out unless asked for.
+type ContactFilter struct {
+	Status      *string
+	AssignedTo  *string
+	IncludeSpam bool
+	Limit       int
+	Offset      int
+}
+
+func NewSqlContactStore(ctx context.Context, dbURL string) (*SqlContactStore, error) {
+	db, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to connect to postgres: "+err.Error())
+	}
+	return &SqlContactStore{db: db}, nil
+}
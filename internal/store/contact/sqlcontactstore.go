@@ -0,0 +1,279 @@
+package contact
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/studyguides-com/study-guides-api/internal/store/notification"
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+)
+
+type SqlContactStore struct {
+	db *pgxpool.Pool
+}
+
+const contactColumns = `id, name, email, phone, message, status::text AS status, "userId", "assignedTo",
+	spam, "spamReasons", "createdAt", "updatedAt"`
+
+const eventColumns = `id, "contactId", type::text AS type, "actorId", "fromStatus"::text AS "fromStatus",
+	"toStatus"::text AS "toStatus", body, "createdAt"`
+
+func (s *SqlContactStore) CreateContact(ctx context.Context, contact *NewContact) (*Contact, error) {
+	reasons := contact.SpamReasons
+	if reasons == nil {
+		reasons = []string{}
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var created Contact
+	err = pgxscan.Get(ctx, tx, &created, `
+		INSERT INTO public."Contact" (id, name, email, phone, message, status, "userId", spam, "spamReasons", "createdAt", "updatedAt")
+		VALUES ($1, $2, $3, $4, $5, 'Pending', $6, $7, $8, NOW(), NOW())
+		RETURNING `+contactColumns,
+		utils.GetCUID(), contact.Name, contact.Email, contact.Phone, contact.Message, contact.UserID, contact.Spam, reasons)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create contact: %v", err)
+	}
+
+	if _, err := insertEvent(ctx, tx, created.ID, EventSubmitted, contact.UserID, nil, StatusPending, ""); err != nil {
+		return nil, err
+	}
+	if !created.Spam {
+		err := notification.Emit(ctx, tx, notification.TypeContactSubmitted, map[string]interface{}{
+			"contactId": created.ID,
+			"name":      created.Name,
+			"email":     created.Email,
+			"phone":     created.Phone,
+			"message":   created.Message,
+			"reasons":   created.SpamReasons,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit contact: %v", err)
+	}
+	return &created, nil
+}
+
+func (s *SqlContactStore) CountRecent(ctx context.Context, email, message string, emailSince, messageSince time.Time) (*RecentCounts, error) {
+	var counts RecentCounts
+	err := pgxscan.Get(ctx, s.db, &counts, `
+		SELECT
+			COUNT(*) FILTER (WHERE lower(email) = lower($1) AND "createdAt" >= $3) AS "sameEmail",
+			COUNT(*) FILTER (WHERE message = $2 AND "createdAt" >= $4) AS "sameMessage"
+		FROM public."Contact"
+		WHERE "createdAt" >= $3 OR "createdAt" >= $4
+	`, email, message, emailSince, messageSince)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count recent contacts: %v", err)
+	}
+	return &counts, nil
+}
+
+func (s *SqlContactStore) ListContacts(ctx context.Context, filter *ContactFilter) ([]*Contact, error) {
+	var contacts []*Contact
+	err := pgxscan.Select(ctx, s.db, &contacts, `
+		SELECT `+contactColumns+`
+		FROM public."Contact"
+		WHERE ($1::text IS NULL OR status::text = $1)
+		AND ($2::text IS NULL OR "assignedTo" = $2)
+		AND ($3 OR NOT spam)
+		ORDER BY "createdAt" DESC, id
+		LIMIT $4 OFFSET $5
+	`, filter.Status, filter.AssignedTo, filter.IncludeSpam, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list contacts: %v", err)
+	}
+	return contacts, nil
+}
+
+func (s *SqlContactStore) GetContact(ctx context.Context, id string) (*Contact, error) {
+	var contact Contact
+	err := pgxscan.Get(ctx, s.db, &contact, `SELECT `+contactColumns+` FROM public."Contact" WHERE id = $1`, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "contact %s not found", id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get contact: %v", err)
+	}
+	return &contact, nil
+}
+
+func (s *SqlContactStore) ListEvents(ctx context.Context, id string) ([]*Event, error) {
+	var events []*Event
+	err := pgxscan.Select(ctx, s.db, &events, `
+		SELECT `+eventColumns+`
+		FROM public."ContactEvent"
+		WHERE "contactId" = $1
+		ORDER BY "createdAt", id
+	`, id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list contact events: %v", err)
+	}
+	return events, nil
+}
+
+func (s *SqlContactStore) Assign(ctx context.Context, id, actorID, assigneeID string) (*Contact, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := lockContact(ctx, tx, id); err != nil {
+		return nil, err
+	}
+	if assigneeID != "" {
+		var exists bool
+		err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM public."User" WHERE id = $1)`, assigneeID).Scan(&exists)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check assignee: %v", err)
+		}
+		if !exists {
+			return nil, status.Errorf(codes.NotFound, "user %s not found", assigneeID)
+		}
+	}
+
+	var updated Contact
+	err = pgxscan.Get(ctx, tx, &updated, `
+		UPDATE public."Contact" SET "assignedTo" = NULLIF($2, ''), "updatedAt" = NOW()
+		WHERE id = $1
+		RETURNING `+contactColumns, id, assigneeID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to assign contact: %v", err)
+	}
+
+	// Assigned events carry the assignee in body, none when the contact was unassigned
+	if _, err := insertEvent(ctx, tx, id, EventAssigned, &actorID, nil, "", assigneeID); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit contact assignment: %v", err)
+	}
+	return &updated, nil
+}
+
+func (s *SqlContactStore) Reply(ctx context.Context, id, actorID, body string) (*Event, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	current, err := lockContact(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if current.Status == StatusClosed {
+		return nil, status.Errorf(codes.FailedPrecondition, "contact %s is closed", id)
+	}
+
+	event, err := insertEvent(ctx, tx, id, EventReplied, &actorID, nil, "", body)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.Exec(ctx, `UPDATE public."Contact" SET "updatedAt" = NOW() WHERE id = $1`, id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update contact: %v", err)
+	}
+	err = notification.Emit(ctx, tx, notification.TypeContactReplied, map[string]interface{}{
+		"contactId": id,
+		"eventId":   event.ID,
+		"name":      current.Name,
+		"email":     current.Email,
+		"actorId":   actorID,
+		"body":      body,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit contact reply: %v", err)
+	}
+	return event, nil
+}
+
+func (s *SqlContactStore) SetStatus(ctx context.Context, id, actorID, toStatus, note string) (*Contact, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	current, err := lockContact(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !canTransition(current.Status, toStatus) {
+		return nil, status.Errorf(codes.FailedPrecondition, "contact %s can't move from %s to %s", id, current.Status, toStatus)
+	}
+
+	var updated Contact
+	err = pgxscan.Get(ctx, tx, &updated, `
+		UPDATE public."Contact" SET status = $2::"ContactRequestStatus", "updatedAt" = NOW()
+		WHERE id = $1
+		RETURNING `+contactColumns, id, toStatus)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update contact status: %v", err)
+	}
+
+	if _, err := insertEvent(ctx, tx, id, EventStatusChanged, &actorID, &current.Status, toStatus, note); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit contact status: %v", err)
+	}
+	return &updated, nil
+}
+
+func canTransition(from, to string) bool {
+	for _, allowed := range transitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// lockContact reads a contact and holds its row until the transaction ends
+func lockContact(ctx context.Context, tx pgx.Tx, id string) (*Contact, error) {
+	var contact Contact
+	err := pgxscan.Get(ctx, tx, &contact, `SELECT `+contactColumns+` FROM public."Contact" WHERE id = $1 FOR UPDATE`, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "contact %s not found", id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get contact: %v", err)
+	}
+	return &contact, nil
+}
+
+// insertEvent appends to a contact's history, empty toStatus and body are stored as NULL
+func insertEvent(ctx context.Context, tx pgx.Tx, contactID, eventType string, actorID, fromStatus *string, toStatus, body string) (*Event, error) {
+	var event Event
+	err := pgxscan.Get(ctx, tx, &event, `
+		INSERT INTO public."ContactEvent" (id, "contactId", type, "actorId", "fromStatus", "toStatus", body, "createdAt")
+		VALUES ($1, $2, $3::"ContactEventType", $4, $5::"ContactRequestStatus",
+			NULLIF($6, '')::"ContactRequestStatus", NULLIF($7, ''), NOW())
+		RETURNING `+eventColumns, utils.GetCUID(), contactID, eventType, actorID, fromStatus, toStatus, body)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record contact event: %v", err)
+	}
+	return &event, nil
+}
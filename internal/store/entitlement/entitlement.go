@@ -0,0 +1,51 @@
+package entitlement
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Subscription types, stored as the SubscriptionType enum
+const (
+	TypeFree    = "Free"
+	TypeMonthly = "Monthly"
+	TypeYearly  = "Yearly"
+)
+
+// Subscription statuses, stored as the SubscriptionStatus enum
+const (
+	StatusActive    = "Active"
+	StatusCancelled = "Cancelled"
+)
+
+type EntitlementStore interface {
+	// ActiveSubscription returns the user's current subscription, paid plans first, or nil when there is none.
+	// A cancelled subscription stays current until its end date.
+	ActiveSubscription(ctx context.Context, userID string) (*Subscription, error)
+	// PremiumTag reports whether a tag is premium content. The nearest tag up the tree with metadata.premium
+	// set to true or false decides, so a context or subtree is marked on its root and can free single children.
+	// The string forms BulkUpdateMetadata's set writes count too.
+	PremiumTag(ctx context.Context, tagID string) (bool, error)
+}
+
+type Subscription struct {
+	ID                   string     `db:"id"`
+	UserID               string     `db:"userId"`
+	Status               string     `db:"status"`
+	Type                 string     `db:"type"`
+	StartDate            time.Time  `db:"startDate"`
+	EndDate              *time.Time `db:"endDate"`
+	StripeSubscriptionID *string    `db:"stripeSubscriptionId"`
+}
+
+func NewSqlEntitlementStore(ctx context.Context, dbURL string) (*SqlEntitlementStore, error) {
+	db, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to connect to postgres: "+err.Error())
+	}
+	return &SqlEntitlementStore{db: db}, nil
+}
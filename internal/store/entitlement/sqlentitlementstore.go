@@ -0,0 +1,68 @@
+package entitlement
+
+import (
+	"context"
+	"errors"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+)
+
+type SqlEntitlementStore struct {
+	db *pgxpool.Pool
+}
+
+func (s *SqlEntitlementStore) ActiveSubscription(ctx context.Context, userID string) (*Subscription, error) {
+	var subscription Subscription
+	err := pgxscan.Get(ctx, s.db, &subscription, `
+		SELECT id, "userId", status::text AS status, type::text AS type, "startDate", "endDate", "stripeSubscriptionId"
+		FROM public."Subscription"
+		WHERE "userId" = $1
+		AND "startDate" <= NOW()
+		AND ("endDate" IS NULL OR "endDate" > NOW())
+		-- A cancelled subscription keeps granting access until the end of the period it was paid
+		-- through, so any status counts while "endDate" is in the future. Open-ended rows need Active.
+		AND (status = 'Active' OR "endDate" IS NOT NULL)
+		ORDER BY (type <> 'Free') DESC, "endDate" DESC NULLS FIRST, "startDate" DESC
+		LIMIT 1
+	`, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get subscription: %v", err)
+	}
+	return &subscription, nil
+}
+
+func (s *SqlEntitlementStore) PremiumTag(ctx context.Context, tagID string) (bool, error) {
+	var premium *bool
+	err := s.db.QueryRow(ctx, `
+		WITH RECURSIVE ancestors AS (
+			SELECT id, "parentTagId", metadata, 0 AS depth
+			FROM public."Tag" WHERE id = $1
+			UNION ALL
+			SELECT t.id, t."parentTagId", t.metadata, a.depth + 1
+			FROM public."Tag" t
+			JOIN ancestors a ON t.id = a."parentTagId"
+			WHERE a.depth < $2
+		)
+		SELECT metadata->>'premium' = 'true'
+		FROM ancestors
+		WHERE metadata->>'premium' IN ('true', 'false')
+		ORDER BY depth
+		LIMIT 1
+	`, tagID, utils.MaxTreeDepth).Scan(&premium)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "failed to check premium tag: %v", err)
+	}
+	return premium != nil && *premium, nil
+}
@@ -0,0 +1,39 @@
+package faq
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Every change queues the FAQ in IndexOutbox so the faqs index follows the table.
+type FaqStore interface {
+	ListFaqs(ctx context.Context) ([]*Faq, error)
+	GetFaq(ctx context.Context, id string) (*Faq, error)
+	// CreateFaq adds a FAQ, AlreadyExists when the same question is already asked
+	CreateFaq(ctx context.Context, question, answer, createdBy string) (*Faq, error)
+	// UpdateFaq changes the question or answer, nil fields are left alone
+	UpdateFaq(ctx context.Context, id string, question, answer *string, updatedBy string) (*Faq, error)
+	DeleteFaq(ctx context.Context, id string) error
+}
+
+type Faq struct {
+	ID        string    `db:"id"`
+	Question  string    `db:"question"`
+	Answer    string    `db:"answer"`
+	CreatedBy string    `db:"createdBy"`
+	UpdatedBy string    `db:"updatedBy"`
+	CreatedAt time.Time `db:"createdAt"`
+	UpdatedAt time.Time `db:"updatedAt"`
+}
+
+func NewSqlFaqStore(ctx context.Context, dbURL string) (*SqlFaqStore, error) {
+	db, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to connect to postgres: "+err.Error())
+	}
+	return &SqlFaqStore{db: db}, nil
+}
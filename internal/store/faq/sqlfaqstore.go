@@ -0,0 +1,159 @@
+package faq
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+)
+
+type SqlFaqStore struct {
+	db *pgxpool.Pool
+}
+
+const faqColumns = `id, question, answer, "createdBy", "updatedBy", "createdAt", "updatedAt"`
+
+func (s *SqlFaqStore) ListFaqs(ctx context.Context) ([]*Faq, error) {
+	var faqs []*Faq
+	err := pgxscan.Select(ctx, s.db, &faqs, `SELECT `+faqColumns+` FROM public."Faq" ORDER BY "createdAt"`)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list faqs: %v", err)
+	}
+	return faqs, nil
+}
+
+func (s *SqlFaqStore) GetFaq(ctx context.Context, id string) (*Faq, error) {
+	var faq Faq
+	err := pgxscan.Get(ctx, s.db, &faq, `SELECT `+faqColumns+` FROM public."Faq" WHERE id = $1`, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "faq %s not found", id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get faq: %v", err)
+	}
+	return &faq, nil
+}
+
+func (s *SqlFaqStore) CreateFaq(ctx context.Context, question, answer, createdBy string) (*Faq, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var faq Faq
+	err = pgxscan.Get(ctx, tx, &faq, `
+		INSERT INTO public."Faq" (id, question, answer, hash, "createdBy", "updatedBy", "createdAt", "updatedAt")
+		VALUES ($1, $2, $3, $4, $5, $5, NOW(), NOW())
+		RETURNING `+faqColumns, utils.GetCUID(), question, answer, questionHash(question), createdBy)
+	if err != nil {
+		return nil, faqWriteError("create", err)
+	}
+
+	if err := queueFaq(ctx, tx, faq.ID, "upsert"); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit faq: %v", err)
+	}
+	return &faq, nil
+}
+
+func (s *SqlFaqStore) UpdateFaq(ctx context.Context, id string, question, answer *string, updatedBy string) (*Faq, error) {
+	var hash *string
+	if question != nil {
+		h := questionHash(*question)
+		hash = &h
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var faq Faq
+	err = pgxscan.Get(ctx, tx, &faq, `
+		UPDATE public."Faq"
+		SET question = COALESCE($2, question), answer = COALESCE($3, answer), hash = COALESCE($4, hash),
+			"updatedBy" = $5, "updatedAt" = NOW()
+		WHERE id = $1
+		RETURNING `+faqColumns, id, question, answer, hash, updatedBy)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "faq %s not found", id)
+	}
+	if err != nil {
+		return nil, faqWriteError("update", err)
+	}
+
+	if err := queueFaq(ctx, tx, id, "upsert"); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit faq: %v", err)
+	}
+	return &faq, nil
+}
+
+func (s *SqlFaqStore) DeleteFaq(ctx context.Context, id string) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `DELETE FROM public."Faq" WHERE id = $1`, id)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to delete faq: %v", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "faq %s not found", id)
+	}
+
+	if err := queueFaq(ctx, tx, id, "delete"); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return status.Errorf(codes.Internal, "failed to commit faq delete: %v", err)
+	}
+	return nil
+}
+
+// queueFaq queues the FAQ for the next indexing run
+func queueFaq(ctx context.Context, tx pgx.Tx, id, action string) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO public."IndexOutbox" ("objectType", "objectId", action, "queuedAt")
+		VALUES ('Faq', $1, $2, NOW())
+		ON CONFLICT ("objectType", "objectId") DO UPDATE
+		SET action = $2, "queuedAt" = NOW()
+	`, id, action)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to queue faq for indexing: %v", err)
+	}
+	return nil
+}
+
+func faqWriteError(action string, err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return status.Error(codes.AlreadyExists, "a faq with this question already exists")
+	}
+	return status.Errorf(codes.Internal, "failed to %s faq: %v", action, err)
+}
+
+// questionHash keys FAQs on their normalised question so the same question can't be added twice
+func questionHash(question string) string {
+	normalized := strings.Join(strings.Fields(strings.ToLower(question)), " ")
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
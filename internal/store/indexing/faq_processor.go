@@ -0,0 +1,92 @@
+package indexing
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// AlgoliaFaqRecord represents the exact structure for Algolia indexing
+type AlgoliaFaqRecord struct {
+	ObjectID  string `json:"objectID"`
+	ID        string `json:"id"`
+	Question  string `json:"question"`
+	Answer    string `json:"answer"`
+	UpdatedAt int64  `json:"updatedAt"` // unix seconds, sortable in Algolia
+}
+
+// faqRow represents a row from the faq query
+type faqRow struct {
+	ID        string    `db:"id"`
+	Question  string    `db:"question"`
+	Answer    string    `db:"answer"`
+	UpdatedAt time.Time `db:"updatedAt"`
+}
+
+// processFaqOperation handles indexing operations for FAQs
+func (s *SqlIndexingStore) processFaqOperation(ctx context.Context, op IndexOperation, force bool) error {
+	if op.Action == "delete" {
+		// Delete from Algolia
+		_, err := s.faqIndex.DeleteObject(op.ObjectID)
+		if err != nil {
+			return fmt.Errorf("failed to delete from Algolia: %w", err)
+		}
+		return nil
+	}
+
+	// Get FAQ data
+	var row faqRow
+	err := pgxscan.Get(ctx, s.pool, &row, `
+		SELECT id, question, answer, "updatedAt" FROM "Faq" WHERE id = $1
+	`, op.ObjectID)
+	if err != nil {
+		return fmt.Errorf("failed to get faq: %w", err)
+	}
+
+	// Transform to Algolia record
+	record := transformFaqToAlgoliaRecord(row)
+
+	// Compute hash of the record
+	recordJSON, _ := json.Marshal(record)
+	hash := sha256.Sum256(recordJSON)
+
+	if !force {
+		// Check if content has changed
+		state, _ := s.GetIndexState(ctx, "Faq", op.ObjectID)
+		if state != nil && state.LastIndexedHash != nil {
+			if bytes.Equal(state.LastIndexedHash, hash[:]) {
+				// Content hasn't changed, skip indexing
+				return nil
+			}
+		}
+	}
+
+	// Push to Algolia
+	_, err = s.faqIndex.SaveObject(record)
+	if err != nil {
+		return fmt.Errorf("failed to save to Algolia: %w", err)
+	}
+
+	// Update state
+	if err := s.UpdateIndexState(ctx, "Faq", op.ObjectID, hash[:]); err != nil {
+		return fmt.Errorf("failed to update index state: %w", err)
+	}
+
+	return nil
+}
+
+// transformFaqToAlgoliaRecord transforms a FAQ into the Algolia record format
+func transformFaqToAlgoliaRecord(row faqRow) AlgoliaFaqRecord {
+	return AlgoliaFaqRecord{
+		ObjectID:  row.ID,
+		ID:        row.ID,
+		Question:  row.Question,
+		Answer:    row.Answer,
+		UpdatedAt: row.UpdatedAt.Unix(),
+	}
+}
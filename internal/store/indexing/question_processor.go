@@ -0,0 +1,235 @@
+package indexing
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+)
+
+// AlgoliaQuestionRecord represents the exact structure for Algolia indexing. It carries
+// the context and visibility fields of its topic so the tag filters apply unchanged.
+type AlgoliaQuestionRecord struct {
+	ObjectID   string    `json:"objectID"`
+	ID         string    `json:"id"`
+	Text       string    `json:"text"`
+	Answer     string    `json:"answer"`
+	Difficulty float64   `json:"difficulty"`
+	Context    string    `json:"context"`
+	Public     bool      `json:"public"`
+	Premium    bool      `json:"premium"`
+	OwnerID    *string   `json:"ownerId,omitempty"`
+	AccessList []string  `json:"accessList"`
+	Tags       []TagInfo `json:"tags"`
+}
+
+// questionRow represents a row from the question query
+type questionRow struct {
+	ID              string   `db:"id"`
+	QuestionText    string   `db:"questionText"`
+	AnswerText      string   `db:"answerText"`
+	DifficultyRatio *float64 `db:"difficultyRatio"`
+	Public          bool     `db:"public"`
+	OwnerID         *string  `db:"ownerId"`
+	Hidden          bool     `db:"hidden"`
+	Context         *string  `db:"context"`
+	Premium         bool     `db:"premium"`
+}
+
+// processQuestionOperation handles indexing operations for questions
+func (s *SqlIndexingStore) processQuestionOperation(ctx context.Context, op IndexOperation, force bool) error {
+	if op.Action == "delete" {
+		return s.removeQuestion(ctx, op.ObjectID)
+	}
+
+	// Get question data, the context comes from the first topic that has one. The question
+	// is premium when one of its topics is, each topic taking the setting of the nearest
+	// tag up its tree that has one, so search can withhold premium answers.
+	var row questionRow
+	err := pgxscan.Get(ctx, s.pool, &row, `
+		SELECT q.id, q."questionText", q."answerText", q."difficultyRatio", q.public, q."ownerId",
+		       COALESCE(q.metadata->>'moderationHidden', '') = 'true' AS hidden,
+		       (
+		           SELECT t.context::text FROM "QuestionTag" qt JOIN "Tag" t ON t.id = qt."tagId"
+		           WHERE qt."questionId" = q.id AND t.context IS NOT NULL
+		           ORDER BY qt."createdAt", t.id
+		           LIMIT 1
+		       ) AS context,
+		       (
+		           WITH RECURSIVE ancestors AS (
+		               SELECT qt."tagId" AS "originId", t."parentTagId", t.metadata, 0 AS depth
+		               FROM "QuestionTag" qt JOIN "Tag" t ON t.id = qt."tagId"
+		               WHERE qt."questionId" = q.id
+		               UNION ALL
+		               SELECT a."originId", t."parentTagId", t.metadata, a.depth + 1
+		               FROM "Tag" t JOIN ancestors a ON t.id = a."parentTagId"
+		               WHERE a.depth < $2
+		           )
+		           SELECT COALESCE(bool_or(nearest.premium), false) FROM (
+		               SELECT DISTINCT ON ("originId") metadata->>'premium' = 'true' AS premium
+		               FROM ancestors
+		               WHERE metadata->>'premium' IN ('true', 'false')
+		               ORDER BY "originId", depth
+		           ) nearest
+		       ) AS premium
+		FROM "Question" q
+		WHERE q.id = $1
+	`, op.ObjectID, utils.MaxTreeDepth)
+	if err != nil {
+		return fmt.Errorf("failed to get question: %w", err)
+	}
+
+	// Questions hidden by moderation or detached from every topic leave the index
+	if row.Hidden || row.Context == nil {
+		return s.removeQuestion(ctx, op.ObjectID)
+	}
+
+	var tagIDs []string
+	err = pgxscan.Select(ctx, s.pool, &tagIDs, `
+		SELECT "tagId" FROM "QuestionTag" WHERE "questionId" = $1 ORDER BY "tagId"
+	`, op.ObjectID)
+	if err != nil {
+		return fmt.Errorf("failed to get question tags: %w", err)
+	}
+
+	// Get access list
+	accessList, err := s.getQuestionAccessList(ctx, op.ObjectID, tagIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get access list: %w", err)
+	}
+
+	// Get ancestry of every topic the question belongs to
+	ancestry, err := s.getQuestionAncestry(ctx, tagIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get ancestry: %w", err)
+	}
+
+	// Transform to Algolia record
+	record := transformQuestionToAlgoliaRecord(row, accessList, ancestry)
+
+	// Compute hash of the record
+	recordJSON, _ := json.Marshal(record)
+	hash := sha256.Sum256(recordJSON)
+
+	if !force {
+		// Check if content has changed
+		state, _ := s.GetIndexState(ctx, "Question", op.ObjectID)
+		if state != nil && state.LastIndexedHash != nil {
+			if bytes.Equal(state.LastIndexedHash, hash[:]) {
+				// Content hasn't changed, skip indexing
+				return nil
+			}
+		}
+	}
+
+	// Push to Algolia
+	_, err = s.questionIndex.SaveObject(record)
+	if err != nil {
+		return fmt.Errorf("failed to save to Algolia: %w", err)
+	}
+
+	// Update state
+	if err := s.UpdateIndexState(ctx, "Question", op.ObjectID, hash[:]); err != nil {
+		return fmt.Errorf("failed to update index state: %w", err)
+	}
+
+	return nil
+}
+
+// removeQuestion deletes the question from Algolia and forgets its last indexed hash,
+// so it is pushed again if it reappears with unchanged content
+func (s *SqlIndexingStore) removeQuestion(ctx context.Context, questionID string) error {
+	if _, err := s.questionIndex.DeleteObject(questionID); err != nil {
+		return fmt.Errorf("failed to delete from Algolia: %w", err)
+	}
+	if err := s.UpdateIndexState(ctx, "Question", questionID, nil); err != nil {
+		return fmt.Errorf("failed to update index state: %w", err)
+	}
+	return nil
+}
+
+// getQuestionAccessList retrieves the users granted the question directly and those
+// with access to one of its topics, sorted so the record hash stays stable
+func (s *SqlIndexingStore) getQuestionAccessList(ctx context.Context, questionID string, tagIDs []string) ([]string, error) {
+	var direct []string
+	err := pgxscan.Select(ctx, s.pool, &direct, `
+		SELECT DISTINCT "userId" FROM "QuestionAccess" WHERE "questionId" = $1
+	`, questionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query question access: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	accessList := []string{}
+	add := func(userIDs []string) {
+		for _, userID := range userIDs {
+			if !seen[userID] {
+				seen[userID] = true
+				accessList = append(accessList, userID)
+			}
+		}
+	}
+	add(direct)
+
+	for _, tagID := range tagIDs {
+		inherited, err := s.getTagAccessList(ctx, tagID)
+		if err != nil {
+			return nil, err
+		}
+		add(inherited)
+	}
+
+	sort.Strings(accessList)
+	return accessList, nil
+}
+
+// getQuestionAncestry merges the ancestry chains of the question's topics
+func (s *SqlIndexingStore) getQuestionAncestry(ctx context.Context, tagIDs []string) ([]TagInfo, error) {
+	seen := make(map[string]bool)
+	ancestry := []TagInfo{}
+	for _, tagID := range tagIDs {
+		chain, err := s.getTagAncestry(ctx, tagID)
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range chain {
+			if !seen[info.ID] {
+				seen[info.ID] = true
+				ancestry = append(ancestry, info)
+			}
+		}
+	}
+	return ancestry, nil
+}
+
+// transformQuestionToAlgoliaRecord transforms a question into the Algolia record format
+func transformQuestionToAlgoliaRecord(row questionRow, accessList []string, ancestry []TagInfo) AlgoliaQuestionRecord {
+	var difficulty float64
+	if row.DifficultyRatio != nil {
+		difficulty = *row.DifficultyRatio
+	}
+	var context string
+	if row.Context != nil {
+		context = *row.Context
+	}
+
+	return AlgoliaQuestionRecord{
+		ObjectID:   row.ID,
+		ID:         row.ID,
+		Text:       row.QuestionText,
+		Answer:     row.AnswerText,
+		Difficulty: difficulty,
+		Context:    context,
+		Public:     row.Public,
+		Premium:    row.Premium,
+		OwnerID:    row.OwnerID,
+		AccessList: accessList,
+		Tags:       ancestry,
+	}
+}
@@ -20,13 +20,22 @@ import (
 
 // SqlIndexingStore implements IndexingStore using PostgreSQL
 type SqlIndexingStore struct {
-	db         *sql.DB
-	pool       *pgxpool.Pool
-	tagStore   tag.TagStore
-	algolia    *search.Client
-	tagIndex   *search.Index
+	db            *sql.DB
+	pool          *pgxpool.Pool
+	tagStore      tag.TagStore
+	algolia       *search.Client
+	tagIndex      *search.Index
+	faqIndex      *search.Index
+	questionIndex *search.Index
 }
 
+// indexableQuestion matches questions linked to at least one topic with a context,
+// the same topics that make it into the tags index
+const indexableQuestion = `EXISTS (
+				SELECT 1 FROM "QuestionTag" qt JOIN "Tag" t ON t.id = qt."tagId"
+				WHERE qt."questionId" = q.id AND t.context IS NOT NULL
+			)`
+
 // NewSqlIndexingStore creates a new SQL-based indexing store
 func NewSqlIndexingStore(ctx context.Context, dbURL string, pool *pgxpool.Pool, tagStore tag.TagStore, algoliaAppID, algoliaAPIKey string) (IndexingStore, error) {
 	db, err := sql.Open("pgx", dbURL)
@@ -42,13 +51,17 @@ func NewSqlIndexingStore(ctx context.Context, dbURL string, pool *pgxpool.Pool,
 	// Initialize Algolia client
 	algoliaClient := search.NewClient(algoliaAppID, algoliaAPIKey)
 	tagIndex := algoliaClient.InitIndex("tags")
+	faqIndex := algoliaClient.InitIndex("faqs")
+	questionIndex := algoliaClient.InitIndex("questions")
 	
 	return &SqlIndexingStore{
-		db:       db,
-		pool:     pool,
-		tagStore: tagStore,
-		algolia:  algoliaClient,
-		tagIndex: tagIndex,
+		db:            db,
+		pool:          pool,
+		tagStore:      tagStore,
+		algolia:       algoliaClient,
+		tagIndex:      tagIndex,
+		faqIndex:      faqIndex,
+		questionIndex: questionIndex,
 	}, nil
 }
 
@@ -434,6 +447,23 @@ func (s *SqlIndexingStore) QueueBatchForReindex(ctx context.Context, objectType
 			ON CONFLICT ("objectType", "objectId") DO UPDATE
 			SET action = 'upsert', "queuedAt" = NOW()
 		`
+	case "Faq":
+		query = `
+			INSERT INTO "IndexOutbox" ("objectType", "objectId", action, "queuedAt")
+			SELECT 'Faq', id, 'upsert', NOW()
+			FROM "Faq"
+			ON CONFLICT ("objectType", "objectId") DO UPDATE
+			SET action = 'upsert', "queuedAt" = NOW()
+		`
+	case "Question":
+		query = `
+			INSERT INTO "IndexOutbox" ("objectType", "objectId", action, "queuedAt")
+			SELECT 'Question', q.id, 'upsert', NOW()
+			FROM "Question" q
+			WHERE ` + indexableQuestion + `
+			ON CONFLICT ("objectType", "objectId") DO UPDATE
+			SET action = 'upsert', "queuedAt" = NOW()
+		`
 	default:
 		return fmt.Errorf("unsupported object type for batch reindex: %s", objectType)
 	}
@@ -490,6 +520,48 @@ func (s *SqlIndexingStore) QueueChangedForIndex(ctx context.Context, objectType
 			ON CONFLICT ("objectType", "objectId") DO UPDATE
 			SET action = 'upsert', "queuedAt" = NOW()
 		`
+	case "Faq":
+		// FAQs have no ancestry or access list, so only new and edited rows need indexing
+		query = `
+			INSERT INTO "IndexOutbox" ("objectType", "objectId", action, "queuedAt")
+			SELECT 'Faq', f.id, 'upsert', NOW()
+			FROM "Faq" f
+			LEFT JOIN "SearchIndexState" s ON s."objectType" = 'Faq' AND s."objectId" = f.id
+			WHERE s."objectId" IS NULL
+			OR f."updatedAt" > COALESCE(s."lastIndexedAt", '1970-01-01'::timestamp)
+			ON CONFLICT ("objectType", "objectId") DO UPDATE
+			SET action = 'upsert', "queuedAt" = NOW()
+		`
+	case "Question":
+		// Questions carry their topics' ancestry and access, so a changed topic or a
+		// new grant on the question queues it as well
+		query = `
+			INSERT INTO "IndexOutbox" ("objectType", "objectId", action, "queuedAt")
+			SELECT 'Question', q.id, 'upsert', NOW()
+			FROM "Question" q
+			LEFT JOIN "SearchIndexState" s ON s."objectType" = 'Question' AND s."objectId" = q.id
+			WHERE ` + indexableQuestion + `
+			AND (
+				-- Never indexed
+				s."objectId" IS NULL
+				-- Or question itself was updated
+				OR q."updatedAt" > COALESCE(s."lastIndexedAt", '1970-01-01'::timestamp)
+				-- Or one of its topics was updated
+				OR EXISTS (
+					SELECT 1 FROM "QuestionTag" qt JOIN "Tag" t ON t.id = qt."tagId"
+					WHERE qt."questionId" = q.id
+					AND t."updatedAt" > COALESCE(s."lastIndexedAt", '1970-01-01'::timestamp)
+				)
+				-- Or it was shared since
+				OR EXISTS (
+					SELECT 1 FROM "QuestionAccess" qa
+					WHERE qa."questionId" = q.id
+					AND qa."createdAt" > COALESCE(s."lastIndexedAt", '1970-01-01'::timestamp)
+				)
+			)
+			ON CONFLICT ("objectType", "objectId") DO UPDATE
+			SET action = 'upsert', "queuedAt" = NOW()
+		`
 	default:
 		return fmt.Errorf("unsupported object type for changed index: %s", objectType)
 	}
@@ -551,6 +623,10 @@ func (s *SqlIndexingStore) QueueBatchForReindexWithFilters(ctx context.Context,
 			ON CONFLICT ("objectType", "objectId") DO UPDATE
 			SET action = 'upsert', "queuedAt" = NOW()`
 
+	case "Faq", "Question":
+		// Tag and context filters don't apply to FAQs or questions
+		return s.QueueBatchForReindex(ctx, objectType)
+
 	default:
 		return fmt.Errorf("unsupported object type for batch reindex: %s", objectType)
 	}
@@ -638,6 +714,10 @@ func (s *SqlIndexingStore) QueueChangedForIndexWithFilters(ctx context.Context,
 			ON CONFLICT ("objectType", "objectId") DO UPDATE
 			SET action = 'upsert', "queuedAt" = NOW()`
 
+	case "Faq", "Question":
+		// Tag and context filters don't apply to FAQs or questions
+		return s.QueueChangedForIndex(ctx, objectType)
+
 	default:
 		return fmt.Errorf("unsupported object type for changed index: %s", objectType)
 	}
@@ -885,7 +965,11 @@ func (s *SqlIndexingStore) processOperation(ctx context.Context, op IndexOperati
 	switch op.ObjectType {
 	case "Tag":
 		return s.processTagOperation(ctx, op, force)
-	// Future: Add User, Contact, FAQ cases
+	case "Faq":
+		return s.processFaqOperation(ctx, op, force)
+	case "Question":
+		return s.processQuestionOperation(ctx, op, force)
+	// Future: Add User, Contact cases
 	default:
 		return fmt.Errorf("unsupported object type: %s", op.ObjectType)
 	}
@@ -941,27 +1025,39 @@ func (s *SqlIndexingStore) runPruningAsync(jobID, objectType string, tagTypes []
 		}
 	}()
 
-	// Currently only support Tag pruning
-	if objectType != "Tag" {
-		s.updateJobStatus(jobID, "Failed", fmt.Sprintf("unsupported object type: %s", objectType), 0)
-		return
-	}
-
 	// Prepare existence check query with optional filters
-	checkQuery := `SELECT 1 FROM "Tag" WHERE id = $1`
+	var index *search.Index
+	var checkQuery string
 	filterArgs := []interface{}{}
 	argCount := 1
 
-	if len(tagTypes) > 0 {
-		argCount++
-		checkQuery += fmt.Sprintf(" AND type = ANY($%d)", argCount)
-		filterArgs = append(filterArgs, pq.Array(tagTypes))
-	}
+	switch objectType {
+	case "Tag":
+		index = s.tagIndex
+		checkQuery = `SELECT 1 FROM "Tag" WHERE id = $1`
 
-	if len(contextTypes) > 0 {
-		argCount++
-		checkQuery += fmt.Sprintf(" AND context = ANY($%d)", argCount)
-		filterArgs = append(filterArgs, pq.Array(contextTypes))
+		if len(tagTypes) > 0 {
+			argCount++
+			checkQuery += fmt.Sprintf(" AND type = ANY($%d)", argCount)
+			filterArgs = append(filterArgs, pq.Array(tagTypes))
+		}
+
+		if len(contextTypes) > 0 {
+			argCount++
+			checkQuery += fmt.Sprintf(" AND context = ANY($%d)", argCount)
+			filterArgs = append(filterArgs, pq.Array(contextTypes))
+		}
+	case "Question":
+		// Hidden and detached questions are removed when they are processed, only
+		// records whose question is gone need pruning
+		index = s.questionIndex
+		checkQuery = `SELECT 1 FROM "Question" WHERE id = $1`
+	case "Faq":
+		index = s.faqIndex
+		checkQuery = `SELECT 1 FROM "Faq" WHERE id = $1`
+	default:
+		s.updateJobStatus(jobID, "Failed", fmt.Sprintf("unsupported object type: %s", objectType), 0)
+		return
 	}
 
 	// Prepare statement for efficient repeated queries
@@ -973,7 +1069,7 @@ func (s *SqlIndexingStore) runPruningAsync(jobID, objectType string, tagTypes []
 	defer stmt.Close() // Moved immediately after successful preparation
 
 	// Stream through Algolia objects
-	it, err := s.tagIndex.BrowseObjects()
+	it, err := index.BrowseObjects()
 	if err != nil {
 		s.updateJobStatus(jobID, "Failed", fmt.Sprintf("failed to browse Algolia index: %v", err), 0)
 		return
@@ -1020,7 +1116,7 @@ func (s *SqlIndexingStore) runPruningAsync(jobID, objectType string, tagTypes []
 
 			// Delete when buffer is full
 			if len(deleteBuffer) >= 1000 {
-				_, err := s.tagIndex.DeleteObjects(deleteBuffer)
+				_, err := index.DeleteObjects(deleteBuffer)
 				if err == nil {
 					deletedCount += len(deleteBuffer)
 				} else {
@@ -1029,7 +1125,7 @@ func (s *SqlIndexingStore) runPruningAsync(jobID, objectType string, tagTypes []
 				deleteBuffer = deleteBuffer[:0] // Clear buffer
 
 				// Periodic progress update
-				s.updatePruneProgress(jobID, deletedCount)
+				s.updatePruneProgress(jobID, objectType, deletedCount)
 			}
 		}
 	}
@@ -1037,7 +1133,7 @@ func (s *SqlIndexingStore) runPruningAsync(jobID, objectType string, tagTypes []
 cleanup:
 	// Delete remaining items in buffer
 	if len(deleteBuffer) > 0 {
-		_, err := s.tagIndex.DeleteObjects(deleteBuffer)
+		_, err := index.DeleteObjects(deleteBuffer)
 		if err == nil {
 			deletedCount += len(deleteBuffer)
 		} else {
@@ -1048,16 +1144,16 @@ cleanup:
 	// Update job as completed
 	duration := int(time.Since(startTime).Seconds())
 	s.updateJobStatus(jobID, "Completed", "", duration)
-	s.updatePruneProgress(jobID, deletedCount)
+	s.updatePruneProgress(jobID, objectType, deletedCount)
 
 	fmt.Printf("Pruning job %s completed: processed %d items, deleted %d orphaned objects in %d seconds\n",
 		jobID, processedCount, deletedCount, duration)
 }
 
 // updatePruneProgress updates the pruning job metadata with deletion count
-func (s *SqlIndexingStore) updatePruneProgress(jobID string, deletedCount int) {
+func (s *SqlIndexingStore) updatePruneProgress(jobID, objectType string, deletedCount int) {
 	metadata := map[string]interface{}{
-		"objectType": "Tag",
+		"objectType": objectType,
 		"pruneCount": deletedCount,
 	}
 	metadataJSON, _ := json.Marshal(metadata)
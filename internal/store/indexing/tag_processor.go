@@ -9,6 +9,7 @@ import (
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	"github.com/studyguides-com/study-guides-api/internal/utils"
 )
 
 // AlgoliaTagRecord represents the exact structure for Algolia indexing
@@ -104,11 +105,20 @@ func (s *SqlIndexingStore) processTagOperation(ctx context.Context, op IndexOper
 	return nil
 }
 
-// getTagAccessList retrieves the list of users with access to a tag
+// getTagAccessList retrieves the list of users with access to a tag, including
+// users granted access to one of its ancestors
 func (s *SqlIndexingStore) getTagAccessList(ctx context.Context, tagID string) ([]string, error) {
 	rows, err := s.pool.Query(ctx, `
-		SELECT "userId" FROM "TagAccess" WHERE "tagId" = $1
-	`, tagID)
+		WITH RECURSIVE chain AS (
+			SELECT id, "parentTagId", 0 AS depth FROM "Tag" WHERE id = $1
+			UNION ALL
+			SELECT t.id, t."parentTagId", c.depth + 1
+			FROM "Tag" t
+			JOIN chain c ON t.id = c."parentTagId"
+			WHERE c.depth < $2
+		)
+		SELECT DISTINCT "userId" FROM "TagAccess" WHERE "tagId" IN (SELECT id FROM chain)
+	`, tagID, utils.MaxTreeDepth)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query tag access: %w", err)
 	}
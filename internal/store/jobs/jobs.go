@@ -0,0 +1,119 @@
+// Package jobs tracks background runs in the shared Job table, the same table KPI runs are tracked in.
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+)
+
+// Timeout bounds a single background run
+const Timeout = 30 * time.Minute
+
+// Job is a background run tracked in the Job table
+type Job struct {
+	ID          string     `db:"id"`
+	Type        string     `db:"type"`
+	Status      string     `db:"status"`
+	Description *string    `db:"description"`
+	StartedAt   *time.Time `db:"startedAt"`
+	CompletedAt *time.Time `db:"completedAt"`
+	Error       *string    `db:"errorMessge"`
+	Metadata    []byte     `db:"metadata"`
+}
+
+// Start records a running job and returns its id
+func Start(ctx context.Context, db *pgxpool.Pool, jobType, description string, metadata map[string]interface{}) (string, error) {
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return "", status.Error(codes.Internal, "failed to marshal job metadata")
+	}
+	jobID := utils.GetCUID()
+	_, err = db.Exec(ctx, `
+		INSERT INTO "Job" (id, type, status, description, "startedAt", metadata, "createdAt", "updatedAt")
+		VALUES ($1, $2, 'Running', $3, NOW(), $4, NOW(), NOW())
+	`, jobID, jobType, description, metadataJSON)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to create job record: %v", err)
+	}
+	return jobID, nil
+}
+
+// Finish marks a job completed or failed, merging results into its metadata
+func Finish(db *pgxpool.Pool, jobID string, runErr error, results map[string]interface{}) {
+	ctx := context.Background()
+	if runErr != nil {
+		_, err := db.Exec(ctx, `
+			UPDATE "Job"
+			SET status = 'Failed', "completedAt" = NOW(),
+				"durationSeconds" = EXTRACT(EPOCH FROM NOW() - "startedAt")::int,
+				"errorMessge" = $2, "updatedAt" = NOW()
+			WHERE id = $1
+		`, jobID, runErr.Error())
+		if err != nil {
+			log.Printf("Failed to update job %s as failed: %v", jobID, err)
+		}
+		return
+	}
+
+	resultsJSON, err := json.Marshal(results)
+	if err != nil {
+		resultsJSON = []byte(`{}`)
+	}
+	_, err = db.Exec(ctx, `
+		UPDATE "Job"
+		SET status = 'Completed', "completedAt" = NOW(),
+			"durationSeconds" = EXTRACT(EPOCH FROM NOW() - "startedAt")::int,
+			progress = 100, metadata = COALESCE(metadata::jsonb, '{}'::jsonb) || $2::jsonb, "updatedAt" = NOW()
+		WHERE id = $1
+	`, jobID, resultsJSON)
+	if err != nil {
+		log.Printf("Failed to update job %s as completed: %v", jobID, err)
+	}
+}
+
+// Get returns a job by id
+func Get(ctx context.Context, db *pgxpool.Pool, jobID string) (*Job, error) {
+	var job Job
+	err := pgxscan.Get(ctx, db, &job, `
+		SELECT id, type, status, description, "startedAt", "completedAt", "errorMessge", metadata::jsonb AS metadata
+		FROM "Job"
+		WHERE id = $1
+	`, jobID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "job %s not found", jobID)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get job: %v", err)
+	}
+	return &job, nil
+}
+
+// Latest finds the most recent completed job of a type whose metadata scopeTagId matches
+func Latest(ctx context.Context, db *pgxpool.Pool, jobType, scopeTagID string) (string, error) {
+	var jobID string
+	err := db.QueryRow(ctx, `
+		SELECT id FROM "Job"
+		WHERE type = $1 AND status = 'Completed'
+			AND COALESCE(metadata::jsonb->>'scopeTagId', '') = $2
+		ORDER BY "completedAt" DESC
+		LIMIT 1
+	`, jobType, scopeTagID).Scan(&jobID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", status.Error(codes.NotFound, "no completed job for this scope")
+	}
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to find latest job: %v", err)
+	}
+	return jobID, nil
+}
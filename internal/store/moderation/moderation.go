@@ -0,0 +1,113 @@
+package moderation
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Report targets
+const (
+	TargetQuestion = "Question"
+	TargetTag      = "Tag"
+)
+
+// Moderation actions, stored as the ModerationActionType enum
+const (
+	ActionDismiss   = "Dismiss"
+	ActionEdit      = "Edit"
+	ActionUnpublish = "Unpublish"
+	ActionHide      = "Hide"
+)
+
+// Report statuses, stored as the ReportStatus enum
+const (
+	StatusOpen     = "Open"
+	StatusResolved = "Resolved"
+)
+
+type ModerationStore interface {
+	// ListOpenReports returns open reports grouped by target, most reported first.
+	// An empty targetType lists both questions and tags.
+	ListOpenReports(ctx context.Context, targetType string, limit, offset int) ([]*ReportGroup, error)
+	// Resolve applies a moderation action to a target and closes all of its open reports
+	Resolve(ctx context.Context, resolution *Resolution) (*Action, error)
+	// ListActions returns the audit trail for a target, newest first
+	ListActions(ctx context.Context, targetType, targetID string) ([]*Action, error)
+	// ListReportsByUser returns the reports a user filed along with their resolution status
+	ListReportsByUser(ctx context.Context, userID string) ([]*UserReport, error)
+}
+
+// ReportGroup is every open report filed against a single question or tag
+type ReportGroup struct {
+	TargetType         string    `db:"target_type"`
+	TargetID           string    `db:"target_id"`
+	Label              string    `db:"label"`
+	Public             bool      `db:"public"`
+	TotalReports       int32     `db:"total_reports"`
+	SpamCount          int32     `db:"spam_count"`
+	InappropriateCount int32     `db:"inappropriate_count"`
+	IncorrectCount     int32     `db:"incorrect_count"`
+	OtherCount         int32     `db:"other_count"`
+	Reasons            []string  `db:"reasons"`
+	FirstReportedAt    time.Time `db:"first_reported_at"`
+	LastReportedAt     time.Time `db:"last_reported_at"`
+}
+
+// ContentEdit holds the fields a moderator rewrites with ActionEdit, nil fields are left alone
+type ContentEdit struct {
+	QuestionText *string
+	AnswerText   *string
+	LearnMore    *string
+	Distractors  []string
+	Name         *string
+	Description  *string
+}
+
+// Resolution is a moderator's decision on a reported target
+type Resolution struct {
+	TargetType  string
+	TargetID    string
+	Action      string
+	ModeratorID string
+	Note        string
+	Edit        *ContentEdit
+}
+
+// Action is an entry in the moderation audit trail
+type Action struct {
+	ID          string    `db:"id"`
+	TargetType  string    `db:"targetType"`
+	TargetID    string    `db:"targetId"`
+	Action      string    `db:"action"`
+	ModeratorID string    `db:"moderatorId"`
+	Note        *string   `db:"note"`
+	ReportCount int32     `db:"reportCount"`
+	Before      []byte    `db:"before"`
+	After       []byte    `db:"after"`
+	CreatedAt   time.Time `db:"createdAt"`
+}
+
+// UserReport is a report as seen by the user who filed it
+type UserReport struct {
+	TargetType string     `db:"target_type"`
+	TargetID   string     `db:"target_id"`
+	Label      string     `db:"label"`
+	ReportType string     `db:"report"`
+	Reason     *string    `db:"reason"`
+	Status     string     `db:"status"`
+	Resolution *string    `db:"resolution"`
+	CreatedAt  time.Time  `db:"createdAt"`
+	ResolvedAt *time.Time `db:"resolvedAt"`
+}
+
+func NewSqlModerationStore(ctx context.Context, dbURL string) (*SqlModerationStore, error) {
+	db, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to connect to postgres: "+err.Error())
+	}
+	return &SqlModerationStore{db: db}, nil
+}
@@ -0,0 +1,278 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+)
+
+type SqlModerationStore struct {
+	db *pgxpool.Pool
+}
+
+// moderationTarget ties a reportable table to the table its reports live in
+type moderationTarget struct {
+	table        string
+	reportTable  string
+	reportColumn string
+}
+
+var moderationTargets = map[string]moderationTarget{
+	TargetQuestion: {table: "Question", reportTable: "UserQuestionReport", reportColumn: "questionId"},
+	TargetTag:      {table: "Tag", reportTable: "UserTagReport", reportColumn: "tagId"},
+}
+
+var moderationActions = map[string]bool{
+	ActionDismiss:   true,
+	ActionEdit:      true,
+	ActionUnpublish: true,
+	ActionHide:      true,
+}
+
+// reportsWithTargets flattens both report tables together with the label and visibility of what was reported
+const reportsWithTargets = `
+	SELECT 'Question' AS target_type, r."questionId" AS target_id, q."questionText" AS label, q.public,
+		r.report, r.reason, r.status, r.resolution, r."createdAt", r."resolvedAt", r."userId"
+	FROM public."UserQuestionReport" r
+	JOIN public."Question" q ON q.id = r."questionId"
+	UNION ALL
+	SELECT 'Tag', r."tagId", t.name, t.public,
+		r.report, r.reason, r.status, r.resolution, r."createdAt", r."resolvedAt", r."userId"
+	FROM public."UserTagReport" r
+	JOIN public."Tag" t ON t.id = r."tagId"`
+
+func (s *SqlModerationStore) ListOpenReports(ctx context.Context, targetType string, limit, offset int) ([]*ReportGroup, error) {
+	if targetType != "" {
+		if _, ok := moderationTargets[targetType]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown target type %q", targetType)
+		}
+	}
+
+	var groups []*ReportGroup
+	err := pgxscan.Select(ctx, s.db, &groups, `
+		WITH reports AS (`+reportsWithTargets+`)
+		SELECT target_type, target_id, label, public,
+			COUNT(*)::int AS total_reports,
+			COUNT(*) FILTER (WHERE report = 'Spam')::int AS spam_count,
+			COUNT(*) FILTER (WHERE report = 'Inappropriate')::int AS inappropriate_count,
+			COUNT(*) FILTER (WHERE report = 'Incorrect')::int AS incorrect_count,
+			COUNT(*) FILTER (WHERE report = 'Other')::int AS other_count,
+			COALESCE(array_agg(reason ORDER BY "createdAt" DESC) FILTER (WHERE reason IS NOT NULL), '{}') AS reasons,
+			MIN("createdAt") AS first_reported_at,
+			MAX("createdAt") AS last_reported_at
+		FROM reports
+		WHERE status = 'Open' AND ($1::text = '' OR target_type = $1::text)
+		GROUP BY target_type, target_id, label, public
+		ORDER BY total_reports DESC, last_reported_at DESC
+		LIMIT $2 OFFSET $3
+	`, targetType, limit, offset)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list open reports: %v", err)
+	}
+	return groups, nil
+}
+
+func (s *SqlModerationStore) Resolve(ctx context.Context, resolution *Resolution) (*Action, error) {
+	target, ok := moderationTargets[resolution.TargetType]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown target type %q", resolution.TargetType)
+	}
+	if !moderationActions[resolution.Action] {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown moderation action %q", resolution.Action)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := snapshotTarget(ctx, tx, target, resolution.TargetID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var openReports int32
+	err = tx.QueryRow(ctx, `
+		SELECT COUNT(*)::int FROM public."`+target.reportTable+`"
+		WHERE "`+target.reportColumn+`" = $1 AND status = 'Open'
+	`, resolution.TargetID).Scan(&openReports)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count open reports: %v", err)
+	}
+	if openReports == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s %s has no open reports", resolution.TargetType, resolution.TargetID)
+	}
+
+	if err := applyAction(ctx, tx, resolution); err != nil {
+		return nil, err
+	}
+
+	after, err := snapshotTarget(ctx, tx, target, resolution.TargetID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE public."`+target.reportTable+`"
+		SET status = 'Resolved', resolution = $2, "resolvedAt" = NOW(), "resolvedBy" = $3
+		WHERE "`+target.reportColumn+`" = $1 AND status = 'Open'
+	`, resolution.TargetID, resolution.Action, resolution.ModeratorID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resolve reports: %v", err)
+	}
+
+	var action Action
+	err = pgxscan.Get(ctx, tx, &action, `
+		INSERT INTO public."ModerationAction"
+			(id, "targetType", "targetId", action, "moderatorId", note, "reportCount", before, after, "createdAt")
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), $7, $8, $9, NOW())
+		RETURNING id, "targetType", "targetId", action::text, "moderatorId", note, "reportCount", before, after, "createdAt"
+	`, utils.GetCUID(), resolution.TargetType, resolution.TargetID, resolution.Action, resolution.ModeratorID,
+		resolution.Note, openReports, before, after)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record moderation action: %v", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit moderation action: %v", err)
+	}
+	return &action, nil
+}
+
+// snapshotTarget captures the target row as json for the audit trail, locking it when asked
+func snapshotTarget(ctx context.Context, tx pgx.Tx, target moderationTarget, id string, lock bool) ([]byte, error) {
+	query := `SELECT to_jsonb(x) FROM public."` + target.table + `" x WHERE x.id = $1`
+	if lock {
+		query += ` FOR UPDATE`
+	}
+	var snapshot []byte
+	err := tx.QueryRow(ctx, query, id).Scan(&snapshot)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "%s %s not found", target.table, id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load %s: %v", target.table, err)
+	}
+	return snapshot, nil
+}
+
+// applyAction changes the reported content. Unpublish keeps the content visible to its owner,
+// Hide additionally flags it in metadata and pulls it out of the search index entirely.
+func applyAction(ctx context.Context, tx pgx.Tx, resolution *Resolution) error {
+	target := moderationTargets[resolution.TargetType]
+	var err error
+	switch resolution.Action {
+	case ActionDismiss:
+		return nil
+	case ActionEdit:
+		err = applyEdit(ctx, tx, resolution)
+	case ActionUnpublish:
+		_, err = tx.Exec(ctx, `
+			UPDATE public."`+target.table+`" SET public = false, "updatedAt" = NOW() WHERE id = $1
+		`, resolution.TargetID)
+	case ActionHide:
+		_, err = tx.Exec(ctx, `
+			UPDATE public."`+target.table+`"
+			SET public = false,
+				metadata = COALESCE(metadata, '{}'::jsonb) || jsonb_build_object('moderationHidden', 'true'),
+				"updatedAt" = NOW()
+			WHERE id = $1
+		`, resolution.TargetID)
+	}
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Errorf(codes.Internal, "failed to apply %s: %v", resolution.Action, err)
+	}
+
+	// Both target tables are indexed under their own name
+	indexAction := "upsert"
+	if resolution.Action == ActionHide {
+		indexAction = "delete"
+	}
+	_, err = tx.Exec(ctx, `
+		INSERT INTO public."IndexOutbox" ("objectType", "objectId", action, "queuedAt")
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT ("objectType", "objectId") DO UPDATE
+		SET action = $3, "queuedAt" = NOW()
+	`, target.table, resolution.TargetID, indexAction)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to queue %s for indexing: %v", target.table, err)
+	}
+	return nil
+}
+
+func applyEdit(ctx context.Context, tx pgx.Tx, resolution *Resolution) error {
+	edit := resolution.Edit
+	if edit == nil {
+		return status.Error(codes.InvalidArgument, "edit action requires content changes")
+	}
+
+	if resolution.TargetType == TargetQuestion {
+		if edit.QuestionText == nil && edit.AnswerText == nil && edit.LearnMore == nil && len(edit.Distractors) == 0 {
+			return status.Error(codes.InvalidArgument, "edit has no question changes")
+		}
+		_, err := tx.Exec(ctx, `
+			UPDATE public."Question"
+			SET "questionText" = COALESCE($2, "questionText"),
+				"answerText" = COALESCE($3, "answerText"),
+				"learnMore" = COALESCE($4, "learnMore"),
+				distractors = CASE WHEN cardinality($5::text[]) > 0 THEN $5::text[] ELSE distractors END,
+				version = version + 1,
+				"updatedAt" = NOW()
+			WHERE id = $1
+		`, resolution.TargetID, edit.QuestionText, edit.AnswerText, edit.LearnMore, edit.Distractors)
+		return err
+	}
+
+	if edit.Name == nil && edit.Description == nil {
+		return status.Error(codes.InvalidArgument, "edit has no tag changes")
+	}
+	_, err := tx.Exec(ctx, `
+		UPDATE public."Tag"
+		SET name = COALESCE($2, name),
+			description = COALESCE($3, description),
+			"updatedAt" = NOW()
+		WHERE id = $1
+	`, resolution.TargetID, edit.Name, edit.Description)
+	return err
+}
+
+func (s *SqlModerationStore) ListActions(ctx context.Context, targetType, targetID string) ([]*Action, error) {
+	var actions []*Action
+	err := pgxscan.Select(ctx, s.db, &actions, `
+		SELECT id, "targetType", "targetId", action::text, "moderatorId", note, "reportCount", before, after, "createdAt"
+		FROM public."ModerationAction"
+		WHERE "targetType" = $1 AND "targetId" = $2
+		ORDER BY "createdAt" DESC
+	`, targetType, targetID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list moderation actions: %v", err)
+	}
+	return actions, nil
+}
+
+func (s *SqlModerationStore) ListReportsByUser(ctx context.Context, userID string) ([]*UserReport, error) {
+	var reports []*UserReport
+	err := pgxscan.Select(ctx, s.db, &reports, `
+		WITH reports AS (`+reportsWithTargets+`)
+		SELECT target_type, target_id, label, report::text, reason, status::text, resolution::text,
+			"createdAt", "resolvedAt"
+		FROM reports
+		WHERE "userId" = $1
+		ORDER BY "createdAt" DESC
+	`, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list reports: %v", err)
+	}
+	return reports, nil
+}
@@ -0,0 +1,38 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Notification event types
+const (
+	TypeContactSubmitted = "contact.submitted"
+	TypeContactReplied   = "contact.replied"
+)
+
+type NotificationStore interface {
+	// ListPending returns undelivered events oldest first
+	ListPending(ctx context.Context, limit int) ([]*Event, error)
+	// MarkDelivered acknowledges events and returns how many were still pending
+	MarkDelivered(ctx context.Context, ids []string) (int64, error)
+}
+
+type Event struct {
+	ID        string    `db:"id"`
+	Type      string    `db:"type"`
+	Payload   string    `db:"payload"` // JSON
+	CreatedAt time.Time `db:"createdAt"`
+}
+
+func NewSqlNotificationStore(ctx context.Context, dbURL string) (*SqlNotificationStore, error) {
+	db, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to connect to postgres: "+err.Error())
+	}
+	return &SqlNotificationStore{db: db}, nil
+}
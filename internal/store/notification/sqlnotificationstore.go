@@ -0,0 +1,60 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+)
+
+type SqlNotificationStore struct {
+	db *pgxpool.Pool
+}
+
+func (s *SqlNotificationStore) ListPending(ctx context.Context, limit int) ([]*Event, error) {
+	var events []*Event
+	err := pgxscan.Select(ctx, s.db, &events, `
+		SELECT id, type, payload::text AS payload, "createdAt"
+		FROM public."NotificationEvent"
+		WHERE "deliveredAt" IS NULL
+		ORDER BY "createdAt", id
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list notifications: %v", err)
+	}
+	return events, nil
+}
+
+func (s *SqlNotificationStore) MarkDelivered(ctx context.Context, ids []string) (int64, error) {
+	tag, err := s.db.Exec(ctx, `
+		UPDATE public."NotificationEvent" SET "deliveredAt" = NOW()
+		WHERE id = ANY($1) AND "deliveredAt" IS NULL
+	`, ids)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to mark notifications delivered: %v", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// Emit queues an event inside the caller's transaction so it is only sent if the change commits
+func Emit(ctx context.Context, tx pgx.Tx, eventType string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to encode %s notification: %v", eventType, err)
+	}
+	_, err = tx.Exec(ctx, `
+		INSERT INTO public."NotificationEvent" (id, type, payload, "createdAt")
+		VALUES ($1, $2, $3::jsonb, NOW())
+	`, utils.GetCUID(), eventType, string(body))
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to emit %s notification: %v", eventType, err)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package prompt
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Prompt types, stored as the PromptType enum
+const (
+	TypeTagging       = "Tagging"
+	TypeDistractors   = "Distractors"
+	TypeLearnMore     = "LearnMore"
+	TypeContentRating = "ContentRating"
+	TypeChat          = "Chat"
+)
+
+type PromptStore interface {
+	// ListPrompts returns every stored prompt with its active version
+	ListPrompts(ctx context.Context) ([]*Prompt, error)
+	// Prompt returns the prompt for a type, NotFound when none was created
+	Prompt(ctx context.Context, promptType string) (*Prompt, error)
+	// PromptByID returns a prompt by its ID
+	PromptByID(ctx context.Context, promptID string) (*Prompt, error)
+	// Versions returns every version of a prompt, newest first
+	Versions(ctx context.Context, promptID string) ([]*Version, error)
+	// CreatePrompt creates the prompt for a type with its text as version 1
+	CreatePrompt(ctx context.Context, promptType, text, createdBy string) (*Prompt, error)
+	// AddVersion stores a new version of a prompt and optionally makes it active
+	AddVersion(ctx context.Context, promptID, text, createdBy string, activate bool) (*Version, error)
+	// ActivateVersion makes an existing version the active one
+	ActivateVersion(ctx context.Context, promptID string, version int32) (*Prompt, error)
+	// Rollback activates the newest version older than the active one
+	Rollback(ctx context.Context, promptID string) (*Prompt, error)
+	// RecordUsage logs the prompt version an AI call ran with
+	RecordUsage(ctx context.Context, usage *Usage) error
+}
+
+// Prompt is a prompt type with its active text
+type Prompt struct {
+	ID        string    `db:"id"`
+	Type      string    `db:"type"`
+	Text      string    `db:"text"`
+	Version   int32     `db:"version"` // the active version
+	Versions  int32     `db:"versions"`
+	CreatedAt time.Time `db:"createdAt"`
+	UpdatedAt time.Time `db:"updatedAt"`
+}
+
+// Version is one revision of a prompt's text
+type Version struct {
+	ID        string    `db:"id"`
+	PromptID  string    `db:"promptId"`
+	Text      string    `db:"text"`
+	Version   int32     `db:"version"`
+	CreatedBy *string   `db:"createdBy"`
+	CreatedAt time.Time `db:"createdAt"`
+}
+
+// Usage is a single AI call against a prompt. PromptID is nil and Version 0 when the built-in default was used.
+type Usage struct {
+	Type     string
+	PromptID *string
+	Version  int32
+	Model    string
+	Caller   string
+	JobID    *string
+}
+
+func NewSqlPromptStore(ctx context.Context, dbURL string) (*SqlPromptStore, error) {
+	db, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to connect to postgres: "+err.Error())
+	}
+	return &SqlPromptStore{db: db}, nil
+}
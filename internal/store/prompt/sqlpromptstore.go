@@ -0,0 +1,203 @@
+package prompt
+
+import (
+	"context"
+	"errors"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+)
+
+type SqlPromptStore struct {
+	db *pgxpool.Pool
+}
+
+const promptColumns = `p.id, p.type::text AS type, p.text, p.version,
+	(SELECT COUNT(*) FROM public."PromptVersion" v WHERE v."promptId" = p.id)::int AS versions,
+	p."createdAt", p."updatedAt"`
+
+// versionColumns reads createdBy out of the version metadata
+const versionColumns = `id, "promptId", text, version, metadata->>'createdBy' AS "createdBy", "createdAt"`
+
+func (s *SqlPromptStore) ListPrompts(ctx context.Context) ([]*Prompt, error) {
+	var prompts []*Prompt
+	err := pgxscan.Select(ctx, s.db, &prompts, `SELECT `+promptColumns+` FROM public."Prompt" p ORDER BY p.type`)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list prompts: %v", err)
+	}
+	return prompts, nil
+}
+
+func (s *SqlPromptStore) Prompt(ctx context.Context, promptType string) (*Prompt, error) {
+	var prompt Prompt
+	err := pgxscan.Get(ctx, s.db, &prompt, `SELECT `+promptColumns+` FROM public."Prompt" p WHERE p.type::text = $1`, promptType)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "no %s prompt", promptType)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get prompt: %v", err)
+	}
+	return &prompt, nil
+}
+
+func (s *SqlPromptStore) PromptByID(ctx context.Context, promptID string) (*Prompt, error) {
+	var prompt Prompt
+	err := pgxscan.Get(ctx, s.db, &prompt, `SELECT `+promptColumns+` FROM public."Prompt" p WHERE p.id = $1`, promptID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "prompt %s not found", promptID)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get prompt: %v", err)
+	}
+	return &prompt, nil
+}
+
+func (s *SqlPromptStore) Versions(ctx context.Context, promptID string) ([]*Version, error) {
+	var versions []*Version
+	err := pgxscan.Select(ctx, s.db, &versions, `
+		SELECT `+versionColumns+` FROM public."PromptVersion" WHERE "promptId" = $1 ORDER BY version DESC
+	`, promptID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list prompt versions: %v", err)
+	}
+	return versions, nil
+}
+
+func (s *SqlPromptStore) CreatePrompt(ctx context.Context, promptType, text, createdBy string) (*Prompt, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	promptID := utils.GetCUID()
+	_, err = tx.Exec(ctx, `
+		INSERT INTO public."Prompt" (id, text, type, version, "createdAt", "updatedAt")
+		VALUES ($1, $2, $3, 1, NOW(), NOW())
+	`, promptID, text, promptType)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, status.Errorf(codes.AlreadyExists, "a %s prompt already exists, add a version instead", promptType)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create prompt: %v", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO public."PromptVersion" (id, "promptId", text, version, metadata, "createdAt")
+		VALUES ($1, $2, $3, 1, jsonb_build_object('createdBy', $4::text), NOW())
+	`, utils.GetCUID(), promptID, text, createdBy)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create prompt version: %v", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit prompt: %v", err)
+	}
+	return s.Prompt(ctx, promptType)
+}
+
+func (s *SqlPromptStore) AddVersion(ctx context.Context, promptID, text, createdBy string, activate bool) (*Version, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock the prompt so concurrent adds don't pick the same version number
+	var next int32
+	err = tx.QueryRow(ctx, `
+		SELECT COALESCE((SELECT MAX(version) FROM public."PromptVersion" WHERE "promptId" = p.id), 0) + 1
+		FROM public."Prompt" p WHERE p.id = $1
+		FOR UPDATE
+	`, promptID).Scan(&next)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "prompt %s not found", promptID)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to lock prompt: %v", err)
+	}
+
+	var version Version
+	err = pgxscan.Get(ctx, tx, &version, `
+		INSERT INTO public."PromptVersion" (id, "promptId", text, version, metadata, "createdAt")
+		VALUES ($1, $2, $3, $4, jsonb_build_object('createdBy', $5::text), NOW())
+		RETURNING `+versionColumns, utils.GetCUID(), promptID, text, next, createdBy)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add prompt version: %v", err)
+	}
+
+	if activate {
+		if err := activateVersion(ctx, tx, promptID, next); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit prompt version: %v", err)
+	}
+	return &version, nil
+}
+
+func (s *SqlPromptStore) ActivateVersion(ctx context.Context, promptID string, version int32) (*Prompt, error) {
+	if err := activateVersion(ctx, s.db, promptID, version); err != nil {
+		return nil, err
+	}
+	return s.PromptByID(ctx, promptID)
+}
+
+func (s *SqlPromptStore) Rollback(ctx context.Context, promptID string) (*Prompt, error) {
+	var previous int32
+	err := s.db.QueryRow(ctx, `
+		SELECT COALESCE(MAX(v.version), 0)
+		FROM public."Prompt" p
+		JOIN public."PromptVersion" v ON v."promptId" = p.id AND v.version < p.version
+		WHERE p.id = $1
+	`, promptID).Scan(&previous)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find previous prompt version: %v", err)
+	}
+	if previous == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "prompt %s has no earlier version to roll back to", promptID)
+	}
+	return s.ActivateVersion(ctx, promptID, previous)
+}
+
+func (s *SqlPromptStore) RecordUsage(ctx context.Context, usage *Usage) error {
+	_, err := s.db.Exec(ctx, `
+		INSERT INTO public."PromptUsage" (id, type, "promptId", "promptVersion", model, caller, "jobId", "createdAt")
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, $7, NOW())
+	`, utils.GetCUID(), usage.Type, usage.PromptID, usage.Version, usage.Model, usage.Caller, usage.JobID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to record prompt usage: %v", err)
+	}
+	return nil
+}
+
+// execer is satisfied by both the pool and a transaction
+type execer interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+}
+
+// activate_ copies a version's text onto its prompt so readers only need the Prompt row
+func activateVersion(ctx context.Context, db execer, promptID string, version int32) error {
+	tag, err := db.Exec(ctx, `
+		UPDATE public."Prompt" p
+		SET text = v.text, version = v.version, "updatedAt" = NOW()
+		FROM public."PromptVersion" v
+		WHERE p.id = $1 AND v."promptId" = p.id AND v.version = $2
+	`, promptID, version)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to activate prompt version: %v", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "prompt %s has no version %d", promptID, version)
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package quality
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/studyguides-com/study-guides-api/internal/store/jobs"
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+)
+
+func (s *SqlQualityStore) StartDuplicateScan(ctx context.Context, scopeTagID string, threshold float64) (string, error) {
+	if threshold <= 0 || threshold > 1 {
+		return "", status.Error(codes.InvalidArgument, "threshold must be between 0 and 1")
+	}
+
+	description := "Find near-duplicate questions across the catalog"
+	if scopeTagID != "" {
+		description = fmt.Sprintf("Find near-duplicate questions under %s", scopeTagID)
+	}
+	jobID, err := jobs.Start(ctx, s.db, JobTypeDuplicates, description, map[string]interface{}{
+		"scopeTagId": scopeTagID,
+		"threshold":  threshold,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// Run in background since a catalog scan reads every question
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), jobs.Timeout)
+		defer cancel()
+		results, err := s.scanDuplicates(ctx, jobID, scopeTagID, threshold)
+		if err != nil {
+			log.Printf("Duplicate scan %s failed: %v", jobID, err)
+		}
+		jobs.Finish(s.db, jobID, err, results)
+	}()
+
+	return jobID, nil
+}
+
+// scanDuplicates signs every question in scope, clusters the signatures and stores the clusters
+func (s *SqlQualityStore) scanDuplicates(ctx context.Context, jobID, scopeTagID string, threshold float64) (map[string]interface{}, error) {
+	rows, err := s.db.Query(ctx, scopedQuestions, scopeTagID, utils.MaxTreeDepth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load questions: %w", err)
+	}
+	var questionIDs []string
+	var signatures [][]uint32
+	for rows.Next() {
+		var id, questionText, answerText string
+		if err := rows.Scan(&id, &questionText, &answerText); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan question: %w", err)
+		}
+		if signature := questionSignature(questionText, answerText); signature != nil {
+			questionIDs = append(questionIDs, id)
+			signatures = append(signatures, signature)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to load questions: %w", err)
+	}
+
+	clusters := clusterSignatures(signatures, threshold)
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Replace the clusters of earlier scans of the same scope, only the latest scan is listed
+	_, err = tx.Exec(ctx, `
+		DELETE FROM public."DuplicateCluster"
+		WHERE "jobId" <> $1 AND "scopeTagId" IS NOT DISTINCT FROM NULLIF($2, '')
+	`, jobID, scopeTagID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prune earlier clusters: %w", err)
+	}
+
+	duplicates := 0
+	for _, cluster := range clusters {
+		clusterID := utils.GetCUID()
+		memberIDs := make([]string, len(cluster.Members))
+		similarity := 1.0
+		for i, m := range cluster.Members {
+			memberIDs[i] = questionIDs[m]
+			if cluster.Similarity[i] < similarity {
+				similarity = cluster.Similarity[i]
+			}
+		}
+		duplicates += len(cluster.Members)
+
+		_, err := tx.Exec(ctx, `
+			INSERT INTO public."DuplicateCluster" (id, "jobId", "scopeTagId", size, similarity, "createdAt")
+			VALUES ($1, $2, NULLIF($3, ''), $4, $5, NOW())
+		`, clusterID, jobID, scopeTagID, len(cluster.Members), similarity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to store cluster: %w", err)
+		}
+		_, err = tx.Exec(ctx, `
+			INSERT INTO public."DuplicateClusterMember" ("clusterId", "questionId", similarity)
+			SELECT $1, m.id, m.similarity
+			FROM unnest($2::text[], $3::float8[]) AS m(id, similarity)
+		`, clusterID, memberIDs, cluster.Similarity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to store cluster members: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit clusters: %w", err)
+	}
+
+	return map[string]interface{}{
+		"questionsScanned":   len(signatures),
+		"clusters":           len(clusters),
+		"duplicateQuestions": duplicates,
+	}, nil
+}
+
+func (s *SqlQualityStore) ListDuplicateClusters(ctx context.Context, jobID string, scopeTagID string, limit, offset int) (*DuplicateClusterPage, error) {
+	if jobID == "" {
+		var err error
+		jobID, err = jobs.Latest(ctx, s.db, JobTypeDuplicates, scopeTagID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	page := &DuplicateClusterPage{JobID: jobID}
+	err := s.db.QueryRow(ctx, `SELECT COUNT(*) FROM public."DuplicateCluster" WHERE "jobId" = $1`, jobID).Scan(&page.Total)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count duplicate clusters: %v", err)
+	}
+
+	err = pgxscan.Select(ctx, s.db, &page.Clusters, `
+		SELECT id, size, similarity
+		FROM public."DuplicateCluster"
+		WHERE "jobId" = $1
+		ORDER BY size DESC, similarity DESC, id
+		LIMIT $2 OFFSET $3
+	`, jobID, limit, offset)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list duplicate clusters: %v", err)
+	}
+	if len(page.Clusters) == 0 {
+		return page, nil
+	}
+
+	clusterIDs := make([]string, len(page.Clusters))
+	byID := make(map[string]*DuplicateCluster, len(page.Clusters))
+	for i, c := range page.Clusters {
+		clusterIDs[i] = c.ID
+		byID[c.ID] = c
+	}
+
+	// Tag paths are built leaf to root, one row per tag the question is linked to. A path
+	// cut short by the depth limit never reaches a root and is left out.
+	var members []*DuplicateMember
+	err = pgxscan.Select(ctx, s.db, &members, `
+		WITH RECURSIVE members AS (
+			SELECT m."clusterId", m."questionId", m.similarity
+			FROM public."DuplicateClusterMember" m
+			WHERE m."clusterId" = ANY($1)
+		),
+		paths AS (
+			SELECT qt."questionId", t."parentTagId" AS next, t.name::text AS path, 0 AS depth
+			FROM public."QuestionTag" qt
+			JOIN public."Tag" t ON t.id = qt."tagId"
+			WHERE qt."questionId" IN (SELECT "questionId" FROM members)
+			UNION ALL
+			SELECT p."questionId", t."parentTagId", t.name || ' > ' || p.path, p.depth + 1
+			FROM paths p
+			JOIN public."Tag" t ON t.id = p.next
+			WHERE p.depth < $2
+		)
+		SELECT m."clusterId", m."questionId", q."questionText", q."answerText", m.similarity,
+			(SELECT COUNT(*) FROM public."UserQuestionInteraction" i WHERE i."questionId" = m."questionId") AS "interactionCount",
+			COALESCE((SELECT array_agg(p.path ORDER BY p.path) FROM paths p WHERE p."questionId" = m."questionId" AND p.next IS NULL), '{}') AS "tagPaths"
+		FROM members m
+		JOIN public."Question" q ON q.id = m."questionId"
+		ORDER BY m."clusterId", m.similarity DESC, m."questionId"
+	`, clusterIDs, utils.MaxTreeDepth)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list duplicate cluster members: %v", err)
+	}
+	for _, m := range members {
+		if c, ok := byID[m.ClusterID]; ok {
+			c.Members = append(c.Members, m)
+		}
+	}
+	return page, nil
+}
@@ -0,0 +1,200 @@
+package quality
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+// Finding severities
+const (
+	SeverityInfo    = "info"
+	SeverityWarning = "warning"
+	SeverityError   = "error"
+)
+
+// Lint rule names, stored on each finding
+const (
+	RuleDistractorMatchesAnswer  = "distractor-matches-answer"
+	RuleDuplicateDistractors     = "duplicate-distractors"
+	RuleTooFewDistractors        = "too-few-distractors"
+	RuleMissingLearnMore         = "missing-learn-more"
+	RuleAnswerLongerThanQuestion = "answer-longer-than-question"
+	RuleUnbalancedMarkup         = "unbalanced-markup"
+	RuleInvalidMediaURL          = "invalid-media-url"
+	RuleNeverAnswered            = "never-answered"
+	RuleExtremeDifficulty        = "extreme-difficulty"
+)
+
+const (
+	minDistractors        = 3
+	minDifficultyAttempts = 20   // attempts before difficultyRatio is trusted
+	lowDifficultyRatio    = 0.05 // almost nobody answers correctly, the answer may be wrong
+	highDifficultyRatio   = 0.98 // almost everybody answers correctly, the question may give it away
+)
+
+// lintQuestion is the question data the rules look at
+type lintQuestion struct {
+	ID              string   `db:"id"`
+	QuestionText    string   `db:"questionText"`
+	AnswerText      string   `db:"answerText"`
+	LearnMore       *string  `db:"learnMore"`
+	Distractors     []string `db:"distractors"`
+	ImageURL        *string  `db:"imageUrl"`
+	VideoURL        *string  `db:"videoUrl"`
+	CorrectCount    *int64   `db:"correctCount"`
+	IncorrectCount  *int64   `db:"incorrectCount"`
+	DifficultyRatio *float64 `db:"difficultyRatio"`
+}
+
+// lintIssue is a single rule violation before it is stored as a finding
+type lintIssue struct {
+	Rule     string
+	Severity string
+	Message  string
+}
+
+type lintRule func(q *lintQuestion) []lintIssue
+
+var lintRules = []lintRule{
+	lintDistractors,
+	lintLearnMore,
+	lintAnswerLength,
+	lintMarkup,
+	lintMediaURLs,
+	lintAnswerStats,
+}
+
+// lintQuestionIssues runs every rule against a question
+func lintQuestionIssues(q *lintQuestion) []lintIssue {
+	var issues []lintIssue
+	for _, rule := range lintRules {
+		issues = append(issues, rule(q)...)
+	}
+	return issues
+}
+
+func lintDistractors(q *lintQuestion) []lintIssue {
+	var issues []lintIssue
+	answer := normalizeText(q.AnswerText)
+	seen := make(map[string]bool)
+	count := 0
+	for _, d := range q.Distractors {
+		normalized := normalizeText(d)
+		if normalized == "" {
+			continue
+		}
+		switch {
+		case normalized == answer:
+			issues = append(issues, lintIssue{RuleDistractorMatchesAnswer, SeverityError, fmt.Sprintf("distractor %q is the answer", d)})
+		case seen[normalized]:
+			issues = append(issues, lintIssue{RuleDuplicateDistractors, SeverityError, fmt.Sprintf("distractor %q is repeated", d)})
+		default:
+			// Only distinct wrong answers count towards the minimum
+			count++
+		}
+		seen[normalized] = true
+	}
+	if count < minDistractors {
+		issues = append(issues, lintIssue{RuleTooFewDistractors, SeverityWarning, fmt.Sprintf("%d distractors, at least %d needed", count, minDistractors)})
+	}
+	return issues
+}
+
+func lintLearnMore(q *lintQuestion) []lintIssue {
+	if q.LearnMore == nil || strings.TrimSpace(*q.LearnMore) == "" {
+		return []lintIssue{{RuleMissingLearnMore, SeverityInfo, "learnMore is empty"}}
+	}
+	return nil
+}
+
+func lintAnswerLength(q *lintQuestion) []lintIssue {
+	questionLength := utf8.RuneCountInString(strings.TrimSpace(q.QuestionText))
+	answerLength := utf8.RuneCountInString(strings.TrimSpace(q.AnswerText))
+	if answerLength > questionLength {
+		return []lintIssue{{RuleAnswerLongerThanQuestion, SeverityWarning, fmt.Sprintf("answer has %d characters, question has %d", answerLength, questionLength)}}
+	}
+	return nil
+}
+
+// markupPairs are the delimiters checked for balance in question and answer text
+var markupPairs = map[rune]rune{')': '(', ']': '[', '}': '{'}
+
+// markupToggles must appear an even number of times
+var markupToggles = []string{"**", "`", "$$"}
+
+func lintMarkup(q *lintQuestion) []lintIssue {
+	var issues []lintIssue
+	fields := []struct{ name, text string }{{"questionText", q.QuestionText}, {"answerText", q.AnswerText}}
+	for _, field := range fields {
+		if problem := markupProblem(field.text); problem != "" {
+			issues = append(issues, lintIssue{RuleUnbalancedMarkup, SeverityWarning, fmt.Sprintf("%s has %s", field.name, problem)})
+		}
+	}
+	return issues
+}
+
+// markupProblem describes the first unbalanced delimiter in text, or returns an empty string
+func markupProblem(text string) string {
+	var stack []rune
+	for _, r := range text {
+		switch r {
+		case '(', '[', '{':
+			stack = append(stack, r)
+		case ')', ']', '}':
+			if len(stack) == 0 || stack[len(stack)-1] != markupPairs[r] {
+				return fmt.Sprintf("an unmatched %q", r)
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+	if len(stack) > 0 {
+		return fmt.Sprintf("an unclosed %q", stack[len(stack)-1])
+	}
+	for _, toggle := range markupToggles {
+		if strings.Count(text, toggle)%2 != 0 {
+			return fmt.Sprintf("an unclosed %q", toggle)
+		}
+	}
+	return ""
+}
+
+func lintMediaURLs(q *lintQuestion) []lintIssue {
+	var issues []lintIssue
+	fields := []struct {
+		name  string
+		value *string
+	}{{"imageUrl", q.ImageURL}, {"videoUrl", q.VideoURL}}
+	for _, field := range fields {
+		if field.value == nil || *field.value == "" {
+			continue
+		}
+		u, err := url.Parse(*field.value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			issues = append(issues, lintIssue{RuleInvalidMediaURL, SeverityError, fmt.Sprintf("%s %q is not an http(s) URL", field.name, *field.value)})
+		}
+	}
+	return issues
+}
+
+func lintAnswerStats(q *lintQuestion) []lintIssue {
+	var attempts int64
+	if q.CorrectCount != nil {
+		attempts += *q.CorrectCount
+	}
+	if q.IncorrectCount != nil {
+		attempts += *q.IncorrectCount
+	}
+	if attempts == 0 {
+		return []lintIssue{{RuleNeverAnswered, SeverityInfo, "question has never been answered"}}
+	}
+	if attempts < minDifficultyAttempts || q.DifficultyRatio == nil {
+		return nil
+	}
+	ratio := *q.DifficultyRatio
+	if ratio < lowDifficultyRatio || ratio > highDifficultyRatio {
+		return []lintIssue{{RuleExtremeDifficulty, SeverityWarning, fmt.Sprintf("%.0f%% of %d attempts were correct", ratio*100, attempts)}}
+	}
+	return nil
+}
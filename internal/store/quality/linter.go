@@ -0,0 +1,148 @@
+package quality
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/studyguides-com/study-guides-api/internal/store/jobs"
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+)
+
+var lintSeverities = map[string]bool{
+	SeverityInfo:    true,
+	SeverityWarning: true,
+	SeverityError:   true,
+}
+
+func (s *SqlQualityStore) StartLint(ctx context.Context) (string, error) {
+	jobID, err := jobs.Start(ctx, s.db, JobTypeLint, "Lint every question", map[string]interface{}{})
+	if err != nil {
+		return "", err
+	}
+
+	// Run in background, the lint reads every question
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), jobs.Timeout)
+		defer cancel()
+		results, err := s.runLint(ctx, jobID)
+		if err != nil {
+			log.Printf("Lint %s failed: %v", jobID, err)
+		}
+		jobs.Finish(s.db, jobID, err, results)
+	}()
+
+	return jobID, nil
+}
+
+// runLint checks every question and replaces the stored findings with this run's
+func (s *SqlQualityStore) runLint(ctx context.Context, jobID string) (map[string]interface{}, error) {
+	rows, err := s.db.Query(ctx, `
+		SELECT id, "questionText", "answerText", "learnMore", distractors, "imageUrl", "videoUrl",
+			"correctCount"::bigint AS "correctCount", "incorrectCount"::bigint AS "incorrectCount", "difficultyRatio"
+		FROM public."Question"
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load questions: %w", err)
+	}
+
+	scanner := pgxscan.NewRowScanner(rows)
+	var findings [][]interface{}
+	bySeverity := make(map[string]int)
+	scanned := 0
+	for rows.Next() {
+		var q lintQuestion
+		if err := scanner.Scan(&q); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan question: %w", err)
+		}
+		scanned++
+		for _, issue := range lintQuestionIssues(&q) {
+			findings = append(findings, []interface{}{utils.GetCUID(), jobID, q.ID, issue.Rule, issue.Severity, issue.Message})
+			bySeverity[issue.Severity]++
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to load questions: %w", err)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.CopyFrom(ctx,
+		pgx.Identifier{"public", "QuestionLintFinding"},
+		[]string{"id", "jobId", "questionId", "rule", "severity", "message"},
+		pgx.CopyFromRows(findings),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store findings: %w", err)
+	}
+
+	// Only the latest run's findings are kept
+	if _, err := tx.Exec(ctx, `DELETE FROM public."QuestionLintFinding" WHERE "jobId" <> $1`, jobID); err != nil {
+		return nil, fmt.Errorf("failed to clear old findings: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit findings: %w", err)
+	}
+
+	return map[string]interface{}{
+		"questionsScanned": scanned,
+		"findings":         len(findings),
+		"bySeverity":       bySeverity,
+	}, nil
+}
+
+func (s *SqlQualityStore) ListLintFindings(ctx context.Context, filter *LintFilter) (*LintFindingPage, error) {
+	for _, severity := range filter.Severities {
+		if !lintSeverities[severity] {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown severity %q", severity)
+		}
+	}
+
+	where := `
+		WITH RECURSIVE subtree AS (
+			SELECT id, 0 AS depth FROM public."Tag" WHERE id = $1
+			UNION ALL
+			SELECT c.id, p.depth + 1 FROM public."Tag" c JOIN subtree p ON c."parentTagId" = p.id
+			WHERE p.depth < $4
+		)
+		%s
+		FROM public."QuestionLintFinding" f
+		JOIN public."Question" q ON q.id = f."questionId"
+		WHERE ($1 = '' OR EXISTS (
+				SELECT 1 FROM public."QuestionTag" qt JOIN subtree s ON s.id = qt."tagId" WHERE qt."questionId" = f."questionId"
+			))
+			AND (cardinality($2::text[]) = 0 OR f.severity = ANY($2))
+			AND ($3 = '' OR f.rule = $3)`
+	severities := filter.Severities
+	if severities == nil {
+		severities = []string{}
+	}
+
+	page := &LintFindingPage{}
+	err := s.db.QueryRow(ctx, fmt.Sprintf(where, `SELECT COUNT(*)`), filter.SubtreeTagID, severities, filter.Rule, utils.MaxTreeDepth).Scan(&page.Total)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count lint findings: %v", err)
+	}
+
+	err = pgxscan.Select(ctx, s.db, &page.Findings, fmt.Sprintf(where, `
+		SELECT f.id, f."jobId", f."questionId", q."questionText", f.rule, f.severity, f.message, f."createdAt"`)+`
+		ORDER BY CASE f.severity WHEN 'error' THEN 0 WHEN 'warning' THEN 1 ELSE 2 END, f."questionId", f.rule
+		LIMIT $5 OFFSET $6
+	`, filter.SubtreeTagID, severities, filter.Rule, utils.MaxTreeDepth, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list lint findings: %v", err)
+	}
+	return page, nil
+}
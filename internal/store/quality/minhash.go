@@ -0,0 +1,174 @@
+package quality
+
+import (
+	"hash/fnv"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	DefaultDuplicateThreshold = 0.8 // estimated Jaccard similarity for two questions to cluster
+
+	shingleSize          = 5  // characters per shingle
+	minHashSize          = 32 // hash functions per signature
+	minHashBands         = 8  // LSH bands
+	minHashRows          = minHashSize / minHashBands
+	maxBucketComparisons = 50 // cap on pairs checked per bucket entry, boilerplate can fill a bucket
+)
+
+// minHashSeeds are fixed so signatures are comparable across runs
+var minHashSeeds = func() [minHashSize]uint64 {
+	var seeds [minHashSize]uint64
+	x := uint64(0x5eed)
+	for i := range seeds {
+		x = splitmix64(x)
+		seeds[i] = x
+	}
+	return seeds
+}()
+
+func splitmix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
+
+// normalizeText lowercases and drops punctuation so questions that differ only in formatting match
+func normalizeText(s string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}), " ")
+}
+
+// questionSignature returns the MinHash signature of a question and its answer, nil when there is no text
+func questionSignature(questionText, answerText string) []uint32 {
+	question, answer := normalizeText(questionText), normalizeText(answerText)
+	if question == "" && answer == "" {
+		return nil
+	}
+	text := question + " | " + answer
+
+	runes := []rune(text)
+	signature := make([]uint32, minHashSize)
+	for i := range signature {
+		signature[i] = math.MaxUint32
+	}
+
+	addShingle := func(shingle []rune) {
+		h := fnv.New64a()
+		h.Write([]byte(string(shingle)))
+		sum := h.Sum64()
+		for i, seed := range minHashSeeds {
+			if v := uint32(splitmix64(sum^seed) >> 32); v < signature[i] {
+				signature[i] = v
+			}
+		}
+	}
+
+	if len(runes) <= shingleSize {
+		addShingle(runes)
+		return signature
+	}
+	for i := 0; i+shingleSize <= len(runes); i++ {
+		addShingle(runes[i : i+shingleSize])
+	}
+	return signature
+}
+
+// signatureSimilarity estimates the Jaccard similarity of two signatures
+func signatureSimilarity(a, b []uint32) float64 {
+	same := 0
+	for i := range a {
+		if a[i] == b[i] {
+			same++
+		}
+	}
+	return float64(same) / float64(len(a))
+}
+
+// signatureCluster is a set of signature indexes, Similarity[i] is member i's similarity to Members[0]
+type signatureCluster struct {
+	Members    []int
+	Similarity []float64
+}
+
+// clusterSignatures groups signatures with LSH banding, only candidates sharing a band are compared
+func clusterSignatures(signatures [][]uint32, threshold float64) []*signatureCluster {
+	parent := make([]int, len(signatures))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	union := func(a, b int) {
+		ra, rb := find(a), find(b)
+		if ra == rb {
+			return
+		}
+		if ra < rb {
+			parent[rb] = ra
+		} else {
+			parent[ra] = rb
+		}
+	}
+
+	for band := 0; band < minHashBands; band++ {
+		buckets := make(map[[minHashRows]uint32][]int)
+		for i, signature := range signatures {
+			var key [minHashRows]uint32
+			copy(key[:], signature[band*minHashRows:(band+1)*minHashRows])
+			buckets[key] = append(buckets[key], i)
+		}
+		for _, bucket := range buckets {
+			for j := 1; j < len(bucket); j++ {
+				start := 0
+				if j > maxBucketComparisons {
+					start = j - maxBucketComparisons
+				}
+				for k := start; k < j; k++ {
+					// Keep comparing after a merge so the clusters don't depend on bucket order
+					if find(bucket[j]) == find(bucket[k]) {
+						continue
+					}
+					if signatureSimilarity(signatures[bucket[j]], signatures[bucket[k]]) >= threshold {
+						union(bucket[j], bucket[k])
+					}
+				}
+			}
+		}
+	}
+
+	groups := make(map[int][]int)
+	for i := range signatures {
+		root := find(i)
+		groups[root] = append(groups[root], i)
+	}
+
+	var clusters []*signatureCluster
+	for _, members := range groups {
+		if len(members) < 2 {
+			continue
+		}
+		sort.Ints(members)
+		cluster := &signatureCluster{Members: members, Similarity: make([]float64, len(members))}
+		for i, m := range members {
+			cluster.Similarity[i] = signatureSimilarity(signatures[members[0]], signatures[m])
+		}
+		clusters = append(clusters, cluster)
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		if len(clusters[i].Members) != len(clusters[j].Members) {
+			return len(clusters[i].Members) > len(clusters[j].Members)
+		}
+		return clusters[i].Members[0] < clusters[j].Members[0]
+	})
+	return clusters
+}
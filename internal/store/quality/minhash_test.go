@@ -0,0 +1,99 @@
+package quality
+
+import (
+	"reflect"
+	"testing"
+)
+
+// signatureFrom returns a signature of base with the positions in changed set to a distinct value
+func signatureFrom(base uint32, changed ...int) []uint32 {
+	signature := make([]uint32, minHashSize)
+	for i := range signature {
+		signature[i] = base + uint32(i)
+	}
+	for _, i := range changed {
+		signature[i] = base + 1000 + uint32(i)
+	}
+	return signature
+}
+
+func TestClusterSignatures(t *testing.T) {
+	tests := []struct {
+		name       string
+		signatures [][]uint32
+		want       [][]int
+	}{
+		{
+			name: "identical signatures cluster",
+			signatures: [][]uint32{
+				signatureFrom(1),
+				signatureFrom(1),
+			},
+			want: [][]int{{0, 1}},
+		},
+		{
+			name: "distinct signatures stay apart",
+			signatures: [][]uint32{
+				signatureFrom(1),
+				signatureFrom(100000),
+				signatureFrom(200000),
+			},
+			want: nil,
+		},
+		{
+			name: "similar signatures cluster apart from a distinct one",
+			signatures: [][]uint32{
+				signatureFrom(1, 0, 5),
+				signatureFrom(100000),
+				signatureFrom(1, 9, 30),
+			},
+			want: [][]int{{0, 2}},
+		},
+		{
+			name: "below the threshold stays apart",
+			signatures: [][]uint32{
+				signatureFrom(1),
+				signatureFrom(1, 0, 4, 8, 12, 16, 20, 24),
+			},
+			want: nil,
+		},
+		{
+			name: "a shared neighbour joins two groups",
+			signatures: [][]uint32{
+				signatureFrom(1, 0, 4, 8),
+				signatureFrom(1, 20, 24, 28),
+				signatureFrom(1),
+				signatureFrom(100000),
+				signatureFrom(100000, 31),
+			},
+			want: [][]int{{0, 1, 2}, {3, 4}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusters := clusterSignatures(tt.signatures, DefaultDuplicateThreshold)
+			var got [][]int
+			for _, cluster := range clusters {
+				got = append(got, cluster.Members)
+				if cluster.Similarity[0] != 1 {
+					t.Errorf("first member similarity = %v, want 1", cluster.Similarity[0])
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("clusters = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuestionSignatureIgnoresFormatting(t *testing.T) {
+	a := questionSignature("What is the capital of France?", "Paris")
+	b := questionSignature("what is the capital of france", "PARIS.")
+	if got := signatureSimilarity(a, b); got != 1 {
+		t.Errorf("similarity = %v, want 1", got)
+	}
+	if questionSignature("  ", "") != nil {
+		t.Error("expected nil signature for empty text")
+	}
+}
@@ -0,0 +1,94 @@
+package quality
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/studyguides-com/study-guides-api/internal/store/jobs"
+)
+
+// Job types written to the shared Job table
+const (
+	JobTypeDuplicates = "QuestionDuplicates"
+	JobTypeLint       = "QuestionLint"
+)
+
+type QualityStore interface {
+	// StartDuplicateScan clusters near-duplicate questions in the background and returns the job id.
+	// An empty scopeTagID scans the whole catalog.
+	StartDuplicateScan(ctx context.Context, scopeTagID string, threshold float64) (string, error)
+	// Job returns the status of a quality job
+	Job(ctx context.Context, jobID string) (*jobs.Job, error)
+	// ListDuplicateClusters pages through the clusters found by a scan, largest first.
+	// An empty jobID uses the latest completed scan for scopeTagID.
+	ListDuplicateClusters(ctx context.Context, jobID string, scopeTagID string, limit, offset int) (*DuplicateClusterPage, error)
+	// StartLint runs the question linter in the background and returns the job id
+	StartLint(ctx context.Context) (string, error)
+	// ListLintFindings pages through the findings of the latest lint run, most severe first
+	ListLintFindings(ctx context.Context, filter *LintFilter) (*LintFindingPage, error)
+}
+
+// DuplicateClusterPage is a page of clusters from one scan
+type DuplicateClusterPage struct {
+	JobID    string
+	Total    int64
+	Clusters []*DuplicateCluster
+}
+
+// DuplicateCluster is a group of questions whose normalized text is nearly identical
+type DuplicateCluster struct {
+	ID         string  `db:"id"`
+	Size       int32   `db:"size"`
+	Similarity float64 `db:"similarity"`
+	Members    []*DuplicateMember
+}
+
+// DuplicateMember is a question in a cluster, with what editors need to pick the one to keep
+type DuplicateMember struct {
+	ClusterID        string   `db:"clusterId"`
+	QuestionID       string   `db:"questionId"`
+	QuestionText     string   `db:"questionText"`
+	AnswerText       string   `db:"answerText"`
+	Similarity       float64  `db:"similarity"`
+	InteractionCount int64    `db:"interactionCount"`
+	TagPaths         []string `db:"tagPaths"`
+}
+
+// LintFilter narrows lint findings, empty fields match everything
+type LintFilter struct {
+	SubtreeTagID string
+	Severities   []string
+	Rule         string
+	Limit        int
+	Offset       int
+}
+
+// LintFindingPage is a page of lint findings
+type LintFindingPage struct {
+	Total    int64
+	Findings []*LintFinding
+}
+
+// LintFinding is a rule violation found on a question
+type LintFinding struct {
+	ID           string    `db:"id"`
+	JobID        string    `db:"jobId"`
+	QuestionID   string    `db:"questionId"`
+	QuestionText string    `db:"questionText"`
+	Rule         string    `db:"rule"`
+	Severity     string    `db:"severity"`
+	Message      string    `db:"message"`
+	CreatedAt    time.Time `db:"createdAt"`
+}
+
+func NewSqlQualityStore(ctx context.Context, dbURL string) (*SqlQualityStore, error) {
+	db, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to connect to postgres: "+err.Error())
+	}
+	return &SqlQualityStore{db: db}, nil
+}
@@ -0,0 +1,32 @@
+package quality
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/studyguides-com/study-guides-api/internal/store/jobs"
+)
+
+type SqlQualityStore struct {
+	db *pgxpool.Pool
+}
+
+func (s *SqlQualityStore) Job(ctx context.Context, jobID string) (*jobs.Job, error) {
+	return jobs.Get(ctx, s.db, jobID)
+}
+
+// scopedQuestions selects the questions linked to a subtree, or every question when $1 is
+// empty, walking no deeper than $2
+const scopedQuestions = `
+	WITH RECURSIVE subtree AS (
+		SELECT id, 0 AS depth FROM public."Tag" WHERE id = $1
+		UNION ALL
+		SELECT c.id, p.depth + 1 FROM public."Tag" c JOIN subtree p ON c."parentTagId" = p.id
+		WHERE p.depth < $2
+	)
+	SELECT q.id, q."questionText", q."answerText"
+	FROM public."Question" q
+	WHERE $1 = '' OR EXISTS (
+		SELECT 1 FROM public."QuestionTag" qt JOIN subtree s ON s.id = qt."tagId" WHERE qt."questionId" = q.id
+	)`
@@ -11,6 +11,7 @@ import (
 )
 
 type QuestionStore interface {
+	GetQuestionByID(ctx context.Context, id string) (*sharedpb.Question, error)
 	GetQuestionsByTagID(ctx context.Context, tagID string) ([]*sharedpb.Question, error)
 	Report(ctx context.Context, questionID string, userId string, reportType sharedpb.ReportType, reason string) error
 }
@@ -68,6 +68,28 @@ func mapRowsToQuestions(rows []questionRow) []*sharedpb.Question {
 	return questions
 }
 
+func (s *SqlQuestionStore) GetQuestionByID(ctx context.Context, id string) (*sharedpb.Question, error) {
+	var rows []questionRow
+
+	err := pgxscan.Select(ctx, s.db, &rows, `
+		SELECT 
+			q.id, q."batchId", q."questionText", q."answerText", q.hash, q."learnMore",
+			q.distractors, q."videoUrl", q."imageUrl", q.version, q.public, q.metadata,
+			q."createdAt", q."updatedAt", q."correctCount", q."difficultyRatio",
+			q."incorrectCount", q."ownerId", q."passageId"
+		FROM "Question" q
+		WHERE q.id = $1
+	`, id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query question: %v", err)
+	}
+	if len(rows) == 0 {
+		return nil, status.Errorf(codes.NotFound, "question %s not found", id)
+	}
+
+	return mapRowsToQuestions(rows)[0], nil
+}
+
 func (s *SqlQuestionStore) GetQuestionsByTagID(ctx context.Context, tagID string) ([]*sharedpb.Question, error) {
 	var rows []questionRow
 
@@ -90,11 +112,12 @@ func (s *SqlQuestionStore) GetQuestionsByTagID(ctx context.Context, tagID string
 
 func (s *SqlQuestionStore) Report(ctx context.Context, questionID string, userId string, reportType sharedpb.ReportType, reason string) error {
 	_, err := s.db.Exec(ctx, `
-		INSERT INTO "UserQuestionReport" ("userId", "questionId", report)
-		VALUES ($1, $2, $3)
+		INSERT INTO "UserQuestionReport" ("userId", "questionId", report, reason)
+		VALUES ($1, $2, $3, NULLIF($4, ''))
 		ON CONFLICT ("userId", "questionId") 
-		DO UPDATE SET report = $3, "createdAt" = CURRENT_TIMESTAMP
-	`, userId, questionID, reportType)
+		DO UPDATE SET report = $3, reason = NULLIF($4, ''), status = 'Open', resolution = NULL,
+			"resolvedAt" = NULL, "resolvedBy" = NULL, "createdAt" = CURRENT_TIMESTAMP
+	`, userId, questionID, reportType.String(), reason)
 	if err != nil {
 		return status.Errorf(codes.Internal, "failed to report question: %v", err)
 	}
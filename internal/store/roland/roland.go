@@ -2,6 +2,7 @@ package roland
 
 import (
 	"context"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc/codes"
@@ -20,6 +21,24 @@ type RolandStore interface {
 	DeleteBundleByID(ctx context.Context, id string) error
 	DeleteBundlesByShortID(ctx context.Context, prefix string) (int, error)
 	MarkBundleExported(ctx context.Context, id string, exportType sharedpb.ExportType) (bool, error)
+	BundleByID(ctx context.Context, id string) (*sharedpb.Bundle, error)
+	RecordPromotion(ctx context.Context, promotion *Promotion) error
+	Promotions(ctx context.Context, bundleID string) ([]*Promotion, error)
+}
+
+// Promotion records a bundle being imported into an environment's database, stored in
+// bundle_promotions (migrations/roland/001_bundle_promotions.sql)
+type Promotion struct {
+	ID         string    `db:"id"`
+	BundleID   string    `db:"bundle_id"`
+	ShortID    string    `db:"short_id"`
+	Title      string    `db:"title"`
+	ExportType string    `db:"export_type"`
+	PromotedBy string    `db:"promoted_by"`
+	BatchID    *string   `db:"batch_id"`
+	Succeeded  bool      `db:"succeeded"`
+	Error      *string   `db:"error"`
+	CreatedAt  time.Time `db:"created_at"`
 }
 
 func NewSqlRolandStore(ctx context.Context, dbURL string) (*SqlRolandStore, error) {
@@ -4,11 +4,13 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/binary"
+	"errors"
 	"math/rand"
 	"time"
 
 	petname "github.com/dustinkirkland/golang-petname"
 	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -226,3 +228,75 @@ func (s *SqlRolandStore) MarkBundleExported(ctx context.Context, id string, expo
 	rowsAffected := result.RowsAffected()
 	return rowsAffected > 0, nil
 }
+
+// BundleByID retrieves a single bundle including its gob payload
+func (s *SqlRolandStore) BundleByID(ctx context.Context, id string) (*sharedpb.Bundle, error) {
+	query := `
+		SELECT id, short_id, parser_type, title, payload,
+			exported_to_dev, exported_to_test, exported_to_prod,
+			created_at, updated_at, assisted_at, gob_payload
+		FROM bundles
+		WHERE id = $1
+	`
+
+	var bundle sharedpb.Bundle
+	err := pgxscan.Get(ctx, s.db, &bundle, query, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "no bundle found with ID: %s", id)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to query bundle: %v", err)
+	}
+
+	return &bundle, nil
+}
+
+// RecordPromotion stores a promotion attempt in bundle_promotions
+func (s *SqlRolandStore) RecordPromotion(ctx context.Context, promotion *Promotion) error {
+	query := `
+		INSERT INTO bundle_promotions (
+			id, bundle_id, short_id, title, export_type,
+			promoted_by, batch_id, succeeded, error, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5,
+			$6, $7, $8, $9, $10
+		)
+	`
+
+	_, err := s.db.Exec(ctx, query,
+		promotion.ID,
+		promotion.BundleID,
+		promotion.ShortID,
+		promotion.Title,
+		promotion.ExportType,
+		promotion.PromotedBy,
+		promotion.BatchID,
+		promotion.Succeeded,
+		promotion.Error,
+		promotion.CreatedAt,
+	)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to record promotion: %v", err)
+	}
+
+	return nil
+}
+
+// Promotions lists promotions newest first, optionally for a single bundle
+func (s *SqlRolandStore) Promotions(ctx context.Context, bundleID string) ([]*Promotion, error) {
+	query := `
+		SELECT id, bundle_id, short_id, title, export_type,
+			promoted_by, batch_id, succeeded, error, created_at
+		FROM bundle_promotions
+		WHERE ($1 = '' OR bundle_id = $1)
+		ORDER BY created_at DESC
+	`
+
+	var promotions []*Promotion
+	err := pgxscan.Select(ctx, s.db, &promotions, query, bundleID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query promotions: %v", err)
+	}
+
+	return promotions, nil
+}
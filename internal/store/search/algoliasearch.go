@@ -54,13 +54,15 @@ func (c *AlgoliaStore) buildTagFilters(opts *SearchOptions) []interface{} {
 		filters = append(filters, fmt.Sprintf("type:%s", opts.Type))
 	}
 
-	// Handle public/private content based on context and user ID
+	// Handle public/private content based on context and user ID. Private tags are
+	// visible to their owner and to users on the accessList, which includes grants
+	// inherited from ancestor tags.
 	if opts.ContextType == sharedpb.ContextType_UserGeneratedContent {
 		if opts.UserID != nil && *opts.UserID != "" {
-			filters = append(filters, fmt.Sprintf("public:false AND ownerId:%s", *opts.UserID))
+			filters = append(filters, fmt.Sprintf("public:false AND (ownerId:%s OR accessList:%s)", *opts.UserID, *opts.UserID))
 		}
 	} else if opts.UserID != nil && *opts.UserID != "" {
-		filters = append(filters, fmt.Sprintf("(public:true OR ownerId:%s)", *opts.UserID))
+		filters = append(filters, fmt.Sprintf("(public:true OR ownerId:%s OR accessList:%s)", *opts.UserID, *opts.UserID))
 	} else {
 		filters = append(filters, "public:true")
 	}
@@ -250,6 +252,84 @@ func NewUserSearchResults(hits []map[string]interface{}) []*sharedpb.UserSearchR
 	return results
 }
 
+// SearchFaqs searches the public faqs index
+func (s *AlgoliaStore) SearchFaqs(ctx context.Context, query string) ([]*sharedpb.Faq, error) {
+	log.Printf("Searching for faqs with query: %s", query)
+	index := s.GetIndex("faqs")
+
+	res, err := index.Search(query)
+	if err != nil {
+		log.Printf("Error searching for faqs: %v", err)
+		return nil, err
+	}
+
+	results := make([]*sharedpb.Faq, 0, len(res.Hits))
+	for _, hit := range res.Hits {
+		id, _ := hit["id"].(string)
+		question, _ := hit["question"].(string)
+		answer, _ := hit["answer"].(string)
+		results = append(results, &sharedpb.Faq{
+			Id:       id,
+			Question: question,
+			Answer:   answer,
+		})
+	}
+	return results, nil
+}
+
+// SearchQuestions searches for questions using Algolia. Question records carry the
+// context and visibility fields of their topic, so the tag filters apply to them too.
+func (s *AlgoliaStore) SearchQuestions(ctx context.Context, query string, opts *SearchOptions) ([]*sharedpb.QuestionSearchResult, error) {
+	log.Printf("Searching for questions with query: %s, context: %v, userID: %v", query, opts.ContextType, opts.UserID)
+	index := s.GetIndex("questions")
+
+	res, err := index.Search(query, s.buildTagFilters(opts)...)
+	if err != nil {
+		log.Printf("Error searching for questions: %v", err)
+		return nil, err
+	}
+
+	results := make([]*sharedpb.QuestionSearchResult, 0, len(res.Hits))
+	log.Printf("Found %d questions", len(res.Hits))
+	for _, hit := range res.Hits {
+		results = append(results, withholdPremiumAnswer(NewQuestionSearchResult(hit), opts))
+	}
+	return results, nil
+}
+
+// NewQuestionSearchResult creates a QuestionSearchResult from a hit
+func NewQuestionSearchResult(hit map[string]interface{}) *sharedpb.QuestionSearchResult {
+	id, _ := hit["id"].(string)
+	text, _ := hit["text"].(string)
+	answer, _ := hit["answer"].(string)
+	context, _ := hit["context"].(string)
+	difficulty, _ := hit["difficulty"].(float64)
+	public, _ := hit["public"].(bool)
+	premium, _ := hit["premium"].(bool)
+	objectID, _ := hit["objectID"].(string)
+
+	return &sharedpb.QuestionSearchResult{
+		Id:           id,
+		QuestionText: text,
+		AnswerText:   answer,
+		Tags:         NewTagSearchPaths(hit),
+		Context:      context,
+		Difficulty:   difficulty,
+		Public:       public,
+		ObjectId:     objectID,
+		Premium:      premium,
+	}
+}
+
+// withholdPremiumAnswer drops the answer of a premium question unless the caller's plan
+// includes full question sets, matching the preview ForTag gives them
+func withholdPremiumAnswer(result *sharedpb.QuestionSearchResult, opts *SearchOptions) *sharedpb.QuestionSearchResult {
+	if result.Premium && !opts.FullQuestionSets {
+		result.AnswerText = ""
+	}
+	return result
+}
+
 func (s *AlgoliaStore) ListIndexes(ctx context.Context) *searchpb.ListIndexesResponse {
 	// Get all indices from Algolia
 	indices, err := s.client.ListIndices()
@@ -0,0 +1,79 @@
+package search
+
+import (
+	"context"
+	"log"
+
+	searchpb "github.com/studyguides-com/study-guides-api/api/v1/search"
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FallbackStore serves searches from a primary store and retries them against a
+// secondary one when the primary fails, so an Algolia outage degrades search
+// rather than breaking it
+type FallbackStore struct {
+	primary   SearchStore
+	secondary SearchStore
+}
+
+// NewFallbackStore creates a search store that falls back to secondary
+func NewFallbackStore(primary, secondary SearchStore) *FallbackStore {
+	return &FallbackStore{
+		primary:   primary,
+		secondary: secondary,
+	}
+}
+
+// shouldFallback reports whether err is an outage rather than a refusal the
+// secondary store would repeat
+func shouldFallback(err error) bool {
+	switch status.Code(err) {
+	case codes.PermissionDenied, codes.Unauthenticated, codes.InvalidArgument:
+		return false
+	}
+	return true
+}
+
+func (s *FallbackStore) SearchTags(ctx context.Context, query string, opts *SearchOptions) ([]*sharedpb.TagSearchResult, error) {
+	results, err := s.primary.SearchTags(ctx, query, opts)
+	if err != nil && shouldFallback(err) {
+		log.Printf("Primary tag search failed, falling back: %v", err)
+		return s.secondary.SearchTags(ctx, query, opts)
+	}
+	return results, err
+}
+
+func (s *FallbackStore) SearchUsers(ctx context.Context, query string, opts *SearchOptions) ([]*sharedpb.UserSearchResult, error) {
+	results, err := s.primary.SearchUsers(ctx, query, opts)
+	if err != nil && shouldFallback(err) {
+		log.Printf("Primary user search failed, falling back: %v", err)
+		return s.secondary.SearchUsers(ctx, query, opts)
+	}
+	return results, err
+}
+
+func (s *FallbackStore) SearchFaqs(ctx context.Context, query string) ([]*sharedpb.Faq, error) {
+	results, err := s.primary.SearchFaqs(ctx, query)
+	if err != nil && shouldFallback(err) {
+		log.Printf("Primary faq search failed, falling back: %v", err)
+		return s.secondary.SearchFaqs(ctx, query)
+	}
+	return results, err
+}
+
+func (s *FallbackStore) SearchQuestions(ctx context.Context, query string, opts *SearchOptions) ([]*sharedpb.QuestionSearchResult, error) {
+	results, err := s.primary.SearchQuestions(ctx, query, opts)
+	if err != nil && shouldFallback(err) {
+		log.Printf("Primary question search failed, falling back: %v", err)
+		return s.secondary.SearchQuestions(ctx, query, opts)
+	}
+	return results, err
+}
+
+// ListIndexes reports the primary store's indexes, since those are the ones the
+// indexing pipeline maintains
+func (s *FallbackStore) ListIndexes(ctx context.Context) *searchpb.ListIndexesResponse {
+	return s.primary.ListIndexes(ctx)
+}
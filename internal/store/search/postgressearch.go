@@ -0,0 +1,521 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5/pgxpool"
+	searchpb "github.com/studyguides-com/study-guides-api/api/v1/search"
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// postgresHitLimit matches the number of hits Algolia returns per page by default
+const postgresHitLimit = 20
+
+// PostgresStore searches tags, questions, users and faqs directly in Postgres using full-text
+// search with pg_trgm similarity for typos and partial words. It needs no external
+// service, so it backs local development and CI and can stand in for Algolia.
+type PostgresStore struct {
+	db *pgxpool.Pool
+}
+
+// Columns each search matches, the trigram indexes on them live in the Prisma schema
+var (
+	tagSearchColumns      = []string{"name", "description"}
+	questionSearchColumns = []string{`"questionText"`, `"answerText"`}
+	userSearchColumns     = []string{"name", "email", `"gamerTag"`}
+	faqSearchColumns      = []string{"question", "answer"}
+)
+
+// NewPostgresStore creates a new Postgres search client
+func NewPostgresStore(ctx context.Context, dbURL string) (*PostgresStore, error) {
+	db, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to connect to postgres: "+err.Error())
+	}
+	return &PostgresStore{db: db}, nil
+}
+
+// searchDocument is the tsvector expression searched for the columns. The indexes in
+// prisma/migrations/search_document_indexes.sql must build it identically for the planner to use them.
+func searchDocument(columns []string) string {
+	return "to_tsvector('simple', " + strings.Join(coalesced(columns), " || ' ' || ") + ")"
+}
+
+// textMatch returns a condition matching query ($1, with its LIKE pattern in $2)
+// against the columns of the table aliased as alias, and the rank expression used
+// to order the matches. An empty query matches everything, as it does in Algolia.
+func textMatch(alias string, columns []string) (string, string) {
+	qualified := make([]string, len(columns))
+	for i, column := range columns {
+		qualified[i] = alias + "." + column
+	}
+	document := searchDocument(qualified)
+
+	// ILIKE and <% (word similarity above pg_trgm.word_similarity_threshold) can
+	// both use the trigram indexes on the searched columns
+	var matches, similarity []string
+	for _, column := range qualified {
+		matches = append(matches, column+" ILIKE $2", "$1 <% "+column)
+		similarity = append(similarity, "word_similarity($1, coalesce("+column+", ''))")
+	}
+
+	condition := "($1 = '' OR " + document + " @@ plainto_tsquery('simple', $1) OR " + strings.Join(matches, " OR ") + ")"
+	rank := "ts_rank(" + document + ", plainto_tsquery('simple', $1)) + greatest(" + strings.Join(similarity, ", ") + ")"
+	return condition, rank
+}
+
+func coalesced(columns []string) []string {
+	result := make([]string, len(columns))
+	for i, column := range columns {
+		result[i] = "coalesce(" + column + ", '')"
+	}
+	return result
+}
+
+// likePattern turns a query into an ILIKE pattern that matches it anywhere
+func likePattern(query string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(query)
+	return "%" + escaped + "%"
+}
+
+// postgresTag represents a tag row returned by a search
+type postgresTag struct {
+	ID                 string   `db:"id"`
+	Name               string   `db:"name"`
+	Type               string   `db:"type"`
+	Context            *string  `db:"context"`
+	Public             bool     `db:"public"`
+	BatchID            *string  `db:"batchId"`
+	MetaTags           []string `db:"metaTags"`
+	ContentRating      string   `db:"contentRating"`
+	ContentDescriptors []string `db:"contentDescriptors"`
+	HasQuestions       bool     `db:"hasQuestions"`
+	HasChildren        bool     `db:"hasChildren"`
+}
+
+// buildTagFilters builds the SQL equivalent of the Algolia tag filters. Conditions
+// reference the query parameters, so args must already hold $1 and $2.
+func (s *PostgresStore) buildTagFilters(opts *SearchOptions, args []interface{}) ([]string, []interface{}) {
+	// Tags without a context are never indexed in Algolia
+	filters := []string{"t.context IS NOT NULL"}
+
+	// Add context filter if not "all"
+	if opts.ContextType != sharedpb.ContextType_All {
+		args = append(args, opts.ContextType.String())
+		filters = append(filters, fmt.Sprintf("t.context::text = $%d", len(args)))
+	}
+
+	// Add type filter
+	if opts.Type != 0 {
+		args = append(args, opts.Type.String())
+		filters = append(filters, fmt.Sprintf("t.type::text = $%d", len(args)))
+	}
+
+	// Private tags are visible to their owner and to users granted access to the tag
+	// or one of its ancestors, the same grants the Algolia accessList carries
+	hasUser := opts.UserID != nil && *opts.UserID != ""
+	if hasUser {
+		args = append(args, *opts.UserID, utils.MaxTreeDepth)
+	}
+	visible := fmt.Sprintf(`(t."ownerId" = $%[1]d OR t.id IN (`+grantedTags+`))`, len(args)-1, len(args))
+
+	return append(filters, visibilityFilter("t", opts, hasUser, visible)), args
+}
+
+// grantedTags selects the tags shared with a user, directly or through an ancestor.
+// It is a format fragment, the user's and the depth limit's parameter numbers are its arguments.
+const grantedTags = `
+			WITH RECURSIVE granted AS (
+				SELECT "tagId" AS id, 0 AS depth FROM public."TagAccess" WHERE "userId" = $%[1]d
+				UNION ALL
+				SELECT c.id, g.depth + 1 FROM public."Tag" c JOIN granted g ON c."parentTagId" = g.id
+				WHERE g.depth < $%[2]d
+			)
+			SELECT id FROM granted
+		`
+
+// visibilityFilter applies the public/owner rules of the Algolia filters to the
+// table aliased as alias, visible being the condition for the user's private content
+func visibilityFilter(alias string, opts *SearchOptions, hasUser bool, visible string) string {
+	if opts.ContextType == sharedpb.ContextType_UserGeneratedContent {
+		if hasUser {
+			return alias + ".public = false AND " + visible
+		}
+		return "true"
+	} else if hasUser {
+		return "(" + alias + ".public = true OR " + visible + ")"
+	}
+	return alias + ".public = true"
+}
+
+// SearchTags searches for tags by name and description
+func (s *PostgresStore) SearchTags(ctx context.Context, query string, opts *SearchOptions) ([]*sharedpb.TagSearchResult, error) {
+	log.Printf("Searching postgres for tags with query: %s, context: %v, userID: %v", query, opts.ContextType, opts.UserID)
+	query = strings.TrimSpace(query)
+
+	match, rank := textMatch("t", tagSearchColumns)
+	filters, args := s.buildTagFilters(opts, []interface{}{query, likePattern(query)})
+	filters = append([]string{match}, filters...)
+
+	var tags []*postgresTag
+	err := pgxscan.Select(ctx, s.db, &tags, `
+		SELECT t.id, t.name, t.type::text AS type, t.context::text AS context, t.public, t."batchId", t."metaTags",
+		       t."contentRating"::text AS "contentRating", t."contentDescriptors", t."hasQuestions", t."hasChildren"
+		FROM public."Tag" t
+		WHERE `+strings.Join(filters, " AND ")+`
+		ORDER BY `+rank+` DESC, t.name
+		LIMIT `+strconv.Itoa(postgresHitLimit), args...)
+	if err != nil {
+		log.Printf("Error searching for tags: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to search tags: %v", err)
+	}
+
+	ids := make([]string, len(tags))
+	for i, tag := range tags {
+		ids[i] = tag.ID
+	}
+	paths, err := s.tagPaths(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*sharedpb.TagSearchResult, 0, len(tags))
+	log.Printf("Found %d tags", len(tags))
+	for _, tag := range tags {
+		var tagContext string
+		if tag.Context != nil {
+			tagContext = *tag.Context
+		}
+		results = append(results, &sharedpb.TagSearchResult{
+			Id:                        tag.ID,
+			Name:                      tag.Name,
+			Type:                      sharedpb.TagType(sharedpb.TagType_value[tag.Type]),
+			ContentRating:             sharedpb.ContentRating(sharedpb.ContentRating_value[tag.ContentRating]),
+			MetaTags:                  tag.MetaTags,
+			ContentDescriptors:        tag.ContentDescriptors,
+			Tags:                      paths[tag.ID],
+			Context:                   tagContext,
+			Public:                    tag.Public,
+			HasQuestions:              tag.HasQuestions,
+			HasChildren:               tag.HasChildren,
+			BatchId:                   stringValue(tag.BatchID),
+			MissingMetaTags:           len(tag.MetaTags) == 0,
+			MissingContentRating:      tag.ContentRating == sharedpb.ContentRating_RatingPending.String(),
+			MissingContentDescriptors: len(tag.ContentDescriptors) == 0,
+			ObjectId:                  tag.ID,
+		})
+	}
+	return results, nil
+}
+
+// tagPathRow represents one step of a tag's ancestry
+type tagPathRow struct {
+	OriginID string `db:"originId"`
+	ID       string `db:"id"`
+	Name     string `db:"name"`
+	Type     string `db:"type"`
+}
+
+// tagPaths loads the ancestry of each tag, root first and ending with the tag itself
+func (s *PostgresStore) tagPaths(ctx context.Context, ids []string) (map[string][]*sharedpb.TagSearchPath, error) {
+	paths := make(map[string][]*sharedpb.TagSearchPath, len(ids))
+	if len(ids) == 0 {
+		return paths, nil
+	}
+
+	var rows []*tagPathRow
+	err := pgxscan.Select(ctx, s.db, &rows, `
+		WITH RECURSIVE ancestry AS (
+			SELECT id AS "originId", id, name, type, "parentTagId", 0 AS depth
+			FROM public."Tag"
+			WHERE id = ANY($1)
+			UNION ALL
+			SELECT a."originId", t.id, t.name, t.type, t."parentTagId", a.depth + 1
+			FROM public."Tag" t
+			JOIN ancestry a ON t.id = a."parentTagId"
+			WHERE a.depth < $2
+		)
+		SELECT "originId", id, name, type::text AS type
+		FROM ancestry
+		ORDER BY "originId", depth DESC
+	`, ids, utils.MaxTreeDepth)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load tag ancestry: %v", err)
+	}
+
+	for _, row := range rows {
+		paths[row.OriginID] = append(paths[row.OriginID], &sharedpb.TagSearchPath{
+			Id:   row.ID,
+			Name: row.Name,
+			Type: sharedpb.TagType(sharedpb.TagType_value[row.Type]),
+		})
+	}
+	return paths, nil
+}
+
+// postgresQuestion represents a question row returned by a search
+type postgresQuestion struct {
+	ID              string   `db:"id"`
+	QuestionText    string   `db:"questionText"`
+	AnswerText      string   `db:"answerText"`
+	DifficultyRatio *float64 `db:"difficultyRatio"`
+	Public          bool     `db:"public"`
+	Context         *string  `db:"context"`
+	TagIDs          []string `db:"tagIds"`
+	Premium         bool     `db:"premium"`
+}
+
+// buildQuestionFilters builds the SQL equivalent of the Algolia filters on question
+// records, which take their context from their topics and their visibility from the
+// question, its grants and the grants on its topics
+func (s *PostgresStore) buildQuestionFilters(opts *SearchOptions, args []interface{}) ([]string, []interface{}) {
+	// Only questions in a topic with a context are indexed, and never hidden ones
+	topic := `EXISTS (
+			SELECT 1 FROM public."QuestionTag" qt JOIN public."Tag" t ON t.id = qt."tagId"
+			WHERE qt."questionId" = q.id AND t.context IS NOT NULL`
+	if opts.ContextType != sharedpb.ContextType_All {
+		args = append(args, opts.ContextType.String())
+		topic += fmt.Sprintf(" AND t.context::text = $%d", len(args))
+	}
+	filters := []string{
+		topic + ")",
+		`COALESCE(q.metadata->>'moderationHidden', '') <> 'true'`,
+	}
+
+	hasUser := opts.UserID != nil && *opts.UserID != ""
+	if hasUser {
+		args = append(args, *opts.UserID, utils.MaxTreeDepth)
+	}
+	visible := fmt.Sprintf(`(q."ownerId" = $%[1]d
+		OR EXISTS (SELECT 1 FROM public."QuestionAccess" qa WHERE qa."questionId" = q.id AND qa."userId" = $%[1]d)
+		OR EXISTS (SELECT 1 FROM public."QuestionTag" qt WHERE qt."questionId" = q.id AND qt."tagId" IN (`+grantedTags+`)))`, len(args)-1, len(args))
+
+	return append(filters, visibilityFilter("q", opts, hasUser, visible)), args
+}
+
+// premiumQuestion reports whether any of the question's topics is premium, each topic taking
+// the setting of the nearest tag up its tree that has one. It is a format fragment, the
+// depth limit's parameter number is its argument.
+const premiumQuestion = `(
+			WITH RECURSIVE ancestors AS (
+				SELECT qt."tagId" AS "originId", t."parentTagId", t.metadata, 0 AS depth
+				FROM public."QuestionTag" qt JOIN public."Tag" t ON t.id = qt."tagId"
+				WHERE qt."questionId" = q.id
+				UNION ALL
+				SELECT a."originId", t."parentTagId", t.metadata, a.depth + 1
+				FROM public."Tag" t JOIN ancestors a ON t.id = a."parentTagId"
+				WHERE a.depth < $%d
+			)
+			SELECT COALESCE(bool_or(nearest.premium), false) FROM (
+				SELECT DISTINCT ON ("originId") metadata->>'premium' = 'true' AS premium
+				FROM ancestors
+				WHERE metadata->>'premium' IN ('true', 'false')
+				ORDER BY "originId", depth
+			) nearest
+		)`
+
+// SearchQuestions searches question and answer text
+func (s *PostgresStore) SearchQuestions(ctx context.Context, query string, opts *SearchOptions) ([]*sharedpb.QuestionSearchResult, error) {
+	log.Printf("Searching postgres for questions with query: %s, context: %v, userID: %v", query, opts.ContextType, opts.UserID)
+	query = strings.TrimSpace(query)
+
+	match, rank := textMatch("q", questionSearchColumns)
+	filters, args := s.buildQuestionFilters(opts, []interface{}{query, likePattern(query)})
+	filters = append([]string{match}, filters...)
+	args = append(args, utils.MaxTreeDepth)
+
+	var questions []*postgresQuestion
+	err := pgxscan.Select(ctx, s.db, &questions, `
+		SELECT q.id, q."questionText", q."answerText", q."difficultyRatio", q.public,
+		       (
+		           SELECT t.context::text FROM public."QuestionTag" qt JOIN public."Tag" t ON t.id = qt."tagId"
+		           WHERE qt."questionId" = q.id AND t.context IS NOT NULL
+		           ORDER BY qt."createdAt", t.id
+		           LIMIT 1
+		       ) AS context,
+		       ARRAY(SELECT qt."tagId" FROM public."QuestionTag" qt WHERE qt."questionId" = q.id ORDER BY qt."tagId") AS "tagIds",
+		       `+fmt.Sprintf(premiumQuestion, len(args))+` AS premium
+		FROM public."Question" q
+		WHERE `+strings.Join(filters, " AND ")+`
+		ORDER BY `+rank+` DESC, q.id
+		LIMIT `+strconv.Itoa(postgresHitLimit), args...)
+	if err != nil {
+		log.Printf("Error searching for questions: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to search questions: %v", err)
+	}
+
+	var tagIDs []string
+	for _, question := range questions {
+		tagIDs = append(tagIDs, question.TagIDs...)
+	}
+	paths, err := s.tagPaths(ctx, tagIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*sharedpb.QuestionSearchResult, 0, len(questions))
+	log.Printf("Found %d questions", len(questions))
+	for _, question := range questions {
+		// Merge the ancestry of every topic the question belongs to
+		seen := make(map[string]bool)
+		var tags []*sharedpb.TagSearchPath
+		for _, tagID := range question.TagIDs {
+			for _, path := range paths[tagID] {
+				if !seen[path.Id] {
+					seen[path.Id] = true
+					tags = append(tags, path)
+				}
+			}
+		}
+
+		var difficulty float64
+		if question.DifficultyRatio != nil {
+			difficulty = *question.DifficultyRatio
+		}
+		results = append(results, withholdPremiumAnswer(&sharedpb.QuestionSearchResult{
+			Id:           question.ID,
+			QuestionText: question.QuestionText,
+			AnswerText:   question.AnswerText,
+			Tags:         tags,
+			Context:      stringValue(question.Context),
+			Difficulty:   difficulty,
+			Public:       question.Public,
+			ObjectId:     question.ID,
+			Premium:      question.Premium,
+		}, opts))
+	}
+	return results, nil
+}
+
+// postgresUser represents a user row returned by a search
+type postgresUser struct {
+	ID               string    `db:"id"`
+	Name             *string   `db:"name"`
+	Email            *string   `db:"email"`
+	GamerTag         *string   `db:"gamerTag"`
+	CreatedAt        time.Time `db:"createdAt"`
+	StripeCustomerID *string   `db:"stripeCustomerId"`
+}
+
+func (s *PostgresStore) SearchUsers(ctx context.Context, query string, opts *SearchOptions) ([]*sharedpb.UserSearchResult, error) {
+	query = strings.TrimSpace(query)
+	match, rank := textMatch("u", userSearchColumns)
+	args := []interface{}{query, likePattern(query)}
+
+	// If user is authenticated and has admin role, return full results
+	if opts.HasRole(sharedpb.UserRole_USER_ROLE_ADMIN) {
+		log.Printf("Admin user search with query: %s", query)
+	} else if opts.UserID != nil && *opts.UserID != "" {
+		// Non-admin users can only search for their own user record
+		log.Printf("Non-admin user search with query: %s, userID: %s", query, *opts.UserID)
+		args = append(args, *opts.UserID)
+		match += " AND u.id = $3"
+	} else {
+		return nil, status.Error(codes.PermissionDenied, "you must be an administrator to search users")
+	}
+
+	var users []*postgresUser
+	err := pgxscan.Select(ctx, s.db, &users, `
+		SELECT u.id, u.name, u.email, u."gamerTag", u."createdAt", u."stripeCustomerId"
+		FROM public."User" u
+		WHERE `+match+`
+		ORDER BY `+rank+` DESC, u."createdAt" DESC
+		LIMIT `+strconv.Itoa(postgresHitLimit), args...)
+	if err != nil {
+		log.Printf("Error searching for users: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to search users: %v", err)
+	}
+
+	results := make([]*sharedpb.UserSearchResult, 0, len(users))
+	log.Printf("Found %d users", len(users))
+	for _, user := range users {
+		results = append(results, &sharedpb.UserSearchResult{
+			Id:               user.ID,
+			Name:             stringValue(user.Name),
+			Email:            stringValue(user.Email),
+			GamerTag:         stringValue(user.GamerTag),
+			CreatedAt:        user.CreatedAt.Format(time.RFC3339),
+			StripeCustomerId: stringValue(user.StripeCustomerID),
+			ObjectId:         user.ID,
+		})
+	}
+	return results, nil
+}
+
+// SearchFaqs searches faq questions and answers
+func (s *PostgresStore) SearchFaqs(ctx context.Context, query string) ([]*sharedpb.Faq, error) {
+	log.Printf("Searching postgres for faqs with query: %s", query)
+	query = strings.TrimSpace(query)
+	match, rank := textMatch("f", faqSearchColumns)
+
+	var results []*sharedpb.Faq
+	rows, err := s.db.Query(ctx, `
+		SELECT f.id, f.question, f.answer
+		FROM public."Faq" f
+		WHERE `+match+`
+		ORDER BY `+rank+` DESC, f."createdAt"
+		LIMIT `+strconv.Itoa(postgresHitLimit), query, likePattern(query))
+	if err != nil {
+		log.Printf("Error searching for faqs: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to search faqs: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		faq := &sharedpb.Faq{}
+		if err := rows.Scan(&faq.Id, &faq.Question, &faq.Answer); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan faq: %v", err)
+		}
+		results = append(results, faq)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search faqs: %v", err)
+	}
+	return results, nil
+}
+
+// postgresIndexes maps each Algolia index name to the table that stands in for it
+var postgresIndexes = []struct {
+	name  string
+	table string
+}{
+	{name: "tags", table: `public."Tag"`},
+	{name: "users", table: `public."User"`},
+	{name: "faqs", table: `public."Faq"`},
+	{name: "questions", table: `public."Question"`},
+}
+
+func (s *PostgresStore) ListIndexes(ctx context.Context) *searchpb.ListIndexesResponse {
+	results := make([]*searchpb.IndexInfo, 0, len(postgresIndexes))
+	for _, index := range postgresIndexes {
+		var entries int64
+		if err := s.db.QueryRow(ctx, `SELECT count(*) FROM `+index.table).Scan(&entries); err != nil {
+			log.Printf("Error getting entries count for index %s: %v", index.name, err)
+			continue
+		}
+		results = append(results, &searchpb.IndexInfo{
+			Name:    index.name,
+			Entries: entries,
+		})
+	}
+
+	return &searchpb.ListIndexesResponse{
+		Indexes: results,
+	}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -5,14 +5,58 @@ import (
 
 	searchpb "github.com/studyguides-com/study-guides-api/api/v1/search"
 	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Search backends selectable through SEARCH_BACKEND and SEARCH_FALLBACK
+const (
+	BackendAlgolia  = "algolia"
+	BackendPostgres = "postgres"
 )
 
 type SearchStore interface {
 	SearchTags(ctx context.Context, query string, opts *SearchOptions) ([]*sharedpb.TagSearchResult, error)
 	SearchUsers(ctx context.Context, query string, opts *SearchOptions) ([]*sharedpb.UserSearchResult, error)
+	SearchFaqs(ctx context.Context, query string) ([]*sharedpb.Faq, error)
+	SearchQuestions(ctx context.Context, query string, opts *SearchOptions) ([]*sharedpb.QuestionSearchResult, error)
 	ListIndexes(ctx context.Context) *searchpb.ListIndexesResponse
 }
 
 func NewAlgoliaSearchStore(ctx context.Context, appID, apiKey string) (SearchStore, error) {
 	return NewAlgoliaStore(appID, apiKey)
 }
+
+func NewPostgresSearchStore(ctx context.Context, dbURL string) (SearchStore, error) {
+	return NewPostgresStore(ctx, dbURL)
+}
+
+// NewSearchStore creates the search store for backend, which defaults to Algolia.
+// When fallback names the Postgres backend, failed Algolia searches are retried there.
+func NewSearchStore(ctx context.Context, backend, fallback, dbURL, appID, apiKey string) (SearchStore, error) {
+	switch backend {
+	case BackendPostgres:
+		return NewPostgresSearchStore(ctx, dbURL)
+	case "", BackendAlgolia:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown search backend %q", backend)
+	}
+
+	algoliaStore, err := NewAlgoliaSearchStore(ctx, appID, apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	switch fallback {
+	case "":
+		return algoliaStore, nil
+	case BackendPostgres:
+		postgresStore, err := NewPostgresSearchStore(ctx, dbURL)
+		if err != nil {
+			return nil, err
+		}
+		return NewFallbackStore(algoliaStore, postgresStore), nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported search fallback %q", fallback)
+	}
+}
@@ -18,6 +18,8 @@ type SearchOptions struct {
 	UserRoles *[]sharedpb.UserRole
 	// Type is the type of tag to filter by
 	Type sharedpb.TagType
+	// FullQuestionSets is whether the caller's plan includes the answers of premium questions
+	FullQuestionSets bool
 }
 
 // NewSearchOptions creates a new SearchOptions with optional fields
@@ -57,6 +59,13 @@ func WithType(tagType sharedpb.TagType) func(*SearchOptions) {
 	}
 }
 
+// WithFullQuestionSets sets the FullQuestionSets field
+func WithFullQuestionSets(fullQuestionSets bool) func(*SearchOptions) {
+	return func(o *SearchOptions) {
+		o.FullQuestionSets = fullQuestionSets
+	}
+}
+
 // FromProtoContextType converts a proto ContextType to our internal types.ContextType
 func FromProtoContextType(ct sharedpb.ContextType) sharedpb.ContextType {
 	switch ct {
@@ -85,6 +94,17 @@ func NewSearchOptionsFromRequest(ctx context.Context, req *searchpb.SearchTagsRe
 	)
 }
 
+// NewQuestionSearchOptionsFromRequest creates a new SearchOptions from the context and question search request
+func NewQuestionSearchOptionsFromRequest(ctx context.Context, req *searchpb.SearchQuestionsRequest) *SearchOptions {
+	session := middleware.GetSessionDetails(ctx)
+	return NewSearchOptions(
+		WithUserID(session.UserID),
+		WithUserRoles(session.UserRoles),
+		WithContextType(FromProtoContextType(req.Context)),
+		WithFullQuestionSets(session.Entitled(middleware.FeatureFullQuestionSets)),
+	)
+}
+
 // NewSearchOptionsFrom creates a new SearchOptions from the context for user search
 func NewSearchOptionsFrom(ctx context.Context) *SearchOptions {
 	session := middleware.GetSessionDetails(ctx)
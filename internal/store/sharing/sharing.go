@@ -0,0 +1,113 @@
+package sharing
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Access levels a collaborator can hold, stored as the AccessType enum
+const (
+	AccessReadOnly  = "ReadOnly"
+	AccessReadWrite = "ReadWrite"
+)
+
+// Invite statuses, stored as the TagInviteStatus enum
+const (
+	InvitePending  = "Pending"
+	InviteAccepted = "Accepted"
+	InviteRejected = "Rejected"
+)
+
+// Access on a tag is inherited by its whole subtree, so sharing a folder shares everything in it.
+type SharingStore interface {
+	// Access reports what a user can do with a tag, NotFound when the tag doesn't exist.
+	// An empty userID checks anonymous access.
+	Access(ctx context.Context, tagID, userID string) (*Access, error)
+	// AccessibleTagIDs returns the subset of tagIDs a user owns or was granted, directly or through an ancestor
+	AccessibleTagIDs(ctx context.Context, tagIDs []string, userID string) ([]string, error)
+
+	// CreateInvite invites a user by ID or email. An email that belongs to a user is linked to them.
+	// Invites expire after the schema default of one day.
+	CreateInvite(ctx context.Context, invite *NewInvite) (*Invite, error)
+	// Invite retrieves an invite by its ID
+	Invite(ctx context.Context, inviteID string) (*Invite, error)
+	// ListInvitesForUser returns pending, unexpired invites addressed to a user by ID or email
+	ListInvitesForUser(ctx context.Context, userID string) ([]*Invite, error)
+	// ListInvitesForTag returns pending invites on a tag
+	ListInvitesForTag(ctx context.Context, tagID string) ([]*Invite, error)
+	// RespondToInvite accepts or declines a pending invite on behalf of its invitee
+	RespondToInvite(ctx context.Context, inviteID, userID string, accept bool) (*Invite, error)
+	// RevokeInvite withdraws a pending invite
+	RevokeInvite(ctx context.Context, inviteID string) error
+
+	// ListCollaborators returns the users granted access to a tag
+	ListCollaborators(ctx context.Context, tagID string) ([]*Collaborator, error)
+	// SetAccessType changes a collaborator's access level
+	SetAccessType(ctx context.Context, tagID, userID, accessType string) error
+	// RevokeAccess removes a collaborator from a tag
+	RevokeAccess(ctx context.Context, tagID, userID string) error
+}
+
+// Access is a user's effective access to a tag
+type Access struct {
+	Public     bool    `db:"public"`
+	Owner      bool    `db:"owner"`      // owns the tag or one of its ancestors
+	AccessType *string `db:"accessType"` // strongest grant on the tag or one of its ancestors
+}
+
+// CanRead reports whether the tag is visible to the user
+func (a *Access) CanRead() bool {
+	return a.Public || a.Owner || a.AccessType != nil
+}
+
+// CanWrite reports whether the user may change the tag's content
+func (a *Access) CanWrite() bool {
+	return a.Owner || (a.AccessType != nil && *a.AccessType == AccessReadWrite)
+}
+
+// CanManage reports whether the user may invite, revoke and change access levels
+func (a *Access) CanManage() bool {
+	return a.Owner
+}
+
+// NewInvite is an invite to create. Exactly one of InviteeID and Email is set.
+type NewInvite struct {
+	TagID      string
+	InviterID  string
+	InviteeID  string
+	Email      string
+	AccessType string
+}
+
+type Invite struct {
+	ID         string    `db:"id"`
+	TagID      string    `db:"tagId"`
+	TagName    string    `db:"tagName"`
+	InviterID  string    `db:"inviterId"`
+	InviteeID  *string   `db:"inviteeId"`
+	Email      *string   `db:"email"`
+	AccessType string    `db:"accessType"`
+	Status     string    `db:"status"`
+	CreatedAt  time.Time `db:"createdAt"`
+	ExpiresAt  time.Time `db:"expiresAt"`
+}
+
+type Collaborator struct {
+	UserID     string    `db:"userId"`
+	Name       *string   `db:"name"`
+	Email      *string   `db:"email"`
+	AccessType string    `db:"accessType"`
+	CreatedAt  time.Time `db:"createdAt"`
+}
+
+func NewSqlSharingStore(ctx context.Context, dbURL string) (*SqlSharingStore, error) {
+	db, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to connect to postgres: "+err.Error())
+	}
+	return &SqlSharingStore{db: db}, nil
+}
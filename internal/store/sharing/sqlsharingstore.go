@@ -0,0 +1,393 @@
+package sharing
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+)
+
+type SqlSharingStore struct {
+	db *pgxpool.Pool
+}
+
+const inviteColumns = `i.id, i."tagId", t.name AS "tagName", i."inviterId", i."inviteeId", i.email,
+	i."accessType"::text AS "accessType", i.status::text AS status, i."createdAt", i."expiresAt"`
+
+func (s *SqlSharingStore) Access(ctx context.Context, tagID, userID string) (*Access, error) {
+	var access Access
+	err := pgxscan.Get(ctx, s.db, &access, `
+		WITH RECURSIVE chain AS (
+			SELECT id, "parentTagId", public, "ownerId", 0 AS depth
+			FROM public."Tag" WHERE id = $1
+			UNION ALL
+			SELECT t.id, t."parentTagId", t.public, t."ownerId", c.depth + 1
+			FROM public."Tag" t
+			JOIN chain c ON t.id = c."parentTagId"
+			WHERE c.depth < $3
+		)
+		SELECT
+			COALESCE(bool_or(c.depth = 0 AND c.public), false) AS public,
+			COALESCE(bool_or($2 <> '' AND c."ownerId" = $2), false) AS owner,
+			(
+				SELECT CASE
+					WHEN bool_or(a."accessType" = 'ReadWrite') THEN 'ReadWrite'
+					WHEN COUNT(*) > 0 THEN 'ReadOnly'
+				END
+				FROM public."TagAccess" a
+				WHERE a."tagId" IN (SELECT id FROM chain)
+				AND a."userId" = $2
+				AND a."accessType" IN ('ReadOnly', 'ReadWrite')
+			) AS "accessType"
+		FROM chain c
+		HAVING COUNT(*) > 0
+	`, tagID, userID, utils.MaxTreeDepth)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "tag %s not found", tagID)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check tag access: %v", err)
+	}
+	return &access, nil
+}
+
+func (s *SqlSharingStore) AccessibleTagIDs(ctx context.Context, tagIDs []string, userID string) ([]string, error) {
+	if len(tagIDs) == 0 || userID == "" {
+		return nil, nil
+	}
+
+	var ids []string
+	err := pgxscan.Select(ctx, s.db, &ids, `
+		WITH RECURSIVE chain AS (
+			SELECT id AS "rootId", id, "parentTagId", "ownerId", 0 AS depth
+			FROM public."Tag" WHERE id = ANY($1)
+			UNION ALL
+			SELECT c."rootId", t.id, t."parentTagId", t."ownerId", c.depth + 1
+			FROM public."Tag" t
+			JOIN chain c ON t.id = c."parentTagId"
+			WHERE c.depth < $3
+		)
+		SELECT DISTINCT c."rootId"
+		FROM chain c
+		WHERE c."ownerId" = $2
+		OR EXISTS (
+			SELECT 1 FROM public."TagAccess" a
+			WHERE a."tagId" = c.id AND a."userId" = $2
+			AND a."accessType" IN ('ReadOnly', 'ReadWrite')
+		)
+	`, tagIDs, userID, utils.MaxTreeDepth)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check tag access: %v", err)
+	}
+	return ids, nil
+}
+
+func (s *SqlSharingStore) CreateInvite(ctx context.Context, invite *NewInvite) (*Invite, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var inviteeID, email *string
+	switch {
+	case invite.InviteeID != "":
+		var exists bool
+		err = tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM public."User" WHERE id = $1)`, invite.InviteeID).Scan(&exists)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to look up invitee: %v", err)
+		}
+		if !exists {
+			return nil, status.Errorf(codes.NotFound, "user %s not found", invite.InviteeID)
+		}
+		inviteeID = &invite.InviteeID
+	case invite.Email != "":
+		normalized := strings.ToLower(strings.TrimSpace(invite.Email))
+		email = &normalized
+		var userID string
+		err = tx.QueryRow(ctx, `SELECT id FROM public."User" WHERE lower(email) = $1`, normalized).Scan(&userID)
+		if err == nil {
+			inviteeID = &userID
+		} else if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.Internal, "failed to look up invitee: %v", err)
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "an invitee user or email is required")
+	}
+
+	if inviteeID != nil {
+		var collaborator bool
+		err = tx.QueryRow(ctx, `
+			SELECT EXISTS (SELECT 1 FROM public."TagAccess" WHERE "tagId" = $1 AND "userId" = $2)
+		`, invite.TagID, *inviteeID).Scan(&collaborator)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check existing access: %v", err)
+		}
+		if collaborator {
+			return nil, status.Error(codes.AlreadyExists, "user already has access to this tag")
+		}
+	}
+
+	var pending bool
+	err = tx.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM public."TagInvite"
+			WHERE "tagId" = $1 AND status = 'Pending' AND "expiresAt" > NOW()
+			AND ("inviteeId" = $2 OR lower(email) = $3)
+		)
+	`, invite.TagID, inviteeID, email).Scan(&pending)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check pending invites: %v", err)
+	}
+	if pending {
+		return nil, status.Error(codes.AlreadyExists, "an invite is already pending for this user")
+	}
+
+	inviteID := utils.GetCUID()
+	_, err = tx.Exec(ctx, `
+		INSERT INTO public."TagInvite" (id, "tagId", "inviterId", "inviteeId", email, "accessType", status, "createdAt", "updatedAt")
+		VALUES ($1, $2, $3, $4, $5, $6::"AccessType", 'Pending', NOW(), NOW())
+	`, inviteID, invite.TagID, invite.InviterID, inviteeID, email, invite.AccessType)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return nil, status.Errorf(codes.NotFound, "tag %s not found", invite.TagID)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create invite: %v", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit invite: %v", err)
+	}
+	return s.Invite(ctx, inviteID)
+}
+
+func (s *SqlSharingStore) Invite(ctx context.Context, inviteID string) (*Invite, error) {
+	var invite Invite
+	err := pgxscan.Get(ctx, s.db, &invite, `
+		SELECT `+inviteColumns+`
+		FROM public."TagInvite" i
+		JOIN public."Tag" t ON t.id = i."tagId"
+		WHERE i.id = $1
+	`, inviteID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "invite %s not found", inviteID)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get invite: %v", err)
+	}
+	return &invite, nil
+}
+
+func (s *SqlSharingStore) ListInvitesForUser(ctx context.Context, userID string) ([]*Invite, error) {
+	var invites []*Invite
+	err := pgxscan.Select(ctx, s.db, &invites, `
+		SELECT `+inviteColumns+`
+		FROM public."TagInvite" i
+		JOIN public."Tag" t ON t.id = i."tagId"
+		WHERE i.status = 'Pending' AND i."expiresAt" > NOW()
+		AND (
+			i."inviteeId" = $1
+			OR (i."inviteeId" IS NULL AND lower(i.email) = (SELECT lower(email) FROM public."User" WHERE id = $1))
+		)
+		ORDER BY i."createdAt" DESC
+	`, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list invites: %v", err)
+	}
+	return invites, nil
+}
+
+func (s *SqlSharingStore) ListInvitesForTag(ctx context.Context, tagID string) ([]*Invite, error) {
+	var invites []*Invite
+	err := pgxscan.Select(ctx, s.db, &invites, `
+		SELECT `+inviteColumns+`
+		FROM public."TagInvite" i
+		JOIN public."Tag" t ON t.id = i."tagId"
+		WHERE i."tagId" = $1 AND i.status = 'Pending' AND i."expiresAt" > NOW()
+		ORDER BY i."createdAt" DESC
+	`, tagID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list invites: %v", err)
+	}
+	return invites, nil
+}
+
+func (s *SqlSharingStore) RespondToInvite(ctx context.Context, inviteID, userID string, accept bool) (*Invite, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var invite Invite
+	err = pgxscan.Get(ctx, tx, &invite, `
+		SELECT `+inviteColumns+`
+		FROM public."TagInvite" i
+		JOIN public."Tag" t ON t.id = i."tagId"
+		WHERE i.id = $1
+		FOR UPDATE OF i
+	`, inviteID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "invite %s not found", inviteID)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get invite: %v", err)
+	}
+
+	var userEmail *string
+	if err := tx.QueryRow(ctx, `SELECT lower(email) FROM public."User" WHERE id = $1`, userID).Scan(&userEmail); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.Internal, "failed to look up user: %v", err)
+	}
+	addressed := (invite.InviteeID != nil && *invite.InviteeID == userID) ||
+		(invite.InviteeID == nil && invite.Email != nil && userEmail != nil && strings.EqualFold(*invite.Email, *userEmail))
+	if !addressed {
+		// Don't reveal invites addressed to someone else
+		return nil, status.Errorf(codes.NotFound, "invite %s not found", inviteID)
+	}
+	if invite.Status != InvitePending {
+		return nil, status.Errorf(codes.FailedPrecondition, "invite has already been %s", strings.ToLower(invite.Status))
+	}
+	if !invite.ExpiresAt.After(time.Now()) {
+		return nil, status.Error(codes.FailedPrecondition, "invite has expired")
+	}
+
+	newStatus := InviteRejected
+	if accept {
+		newStatus = InviteAccepted
+		_, err = tx.Exec(ctx, `
+			INSERT INTO public."TagAccess" (id, "tagId", "userId", "accessType", "createdAt")
+			VALUES ($1, $2, $3, $4::"AccessType", NOW())
+			ON CONFLICT ("tagId", "userId") DO UPDATE SET "accessType" = EXCLUDED."accessType"
+		`, utils.GetCUID(), invite.TagID, userID, invite.AccessType)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to grant access: %v", err)
+		}
+		if err := accessChanged(ctx, tx, invite.TagID); err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE public."TagInvite" SET status = $2::"TagInviteStatus", "inviteeId" = $3, "updatedAt" = NOW() WHERE id = $1
+	`, inviteID, newStatus, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update invite: %v", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit invite response: %v", err)
+	}
+	invite.Status = newStatus
+	invite.InviteeID = &userID
+	return &invite, nil
+}
+
+func (s *SqlSharingStore) RevokeInvite(ctx context.Context, inviteID string) error {
+	tag, err := s.db.Exec(ctx, `DELETE FROM public."TagInvite" WHERE id = $1 AND status = 'Pending'`, inviteID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to revoke invite: %v", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "no pending invite %s", inviteID)
+	}
+	return nil
+}
+
+func (s *SqlSharingStore) ListCollaborators(ctx context.Context, tagID string) ([]*Collaborator, error) {
+	var collaborators []*Collaborator
+	err := pgxscan.Select(ctx, s.db, &collaborators, `
+		SELECT a."userId", u.name, u.email, a."accessType"::text AS "accessType", a."createdAt"
+		FROM public."TagAccess" a
+		JOIN public."User" u ON u.id = a."userId"
+		WHERE a."tagId" = $1
+		ORDER BY a."createdAt"
+	`, tagID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list collaborators: %v", err)
+	}
+	return collaborators, nil
+}
+
+func (s *SqlSharingStore) SetAccessType(ctx context.Context, tagID, userID, accessType string) error {
+	tag, err := s.db.Exec(ctx, `
+		UPDATE public."TagAccess" SET "accessType" = $3::"AccessType" WHERE "tagId" = $1 AND "userId" = $2
+	`, tagID, userID, accessType)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to update access: %v", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "user %s is not a collaborator on tag %s", userID, tagID)
+	}
+	return nil
+}
+
+func (s *SqlSharingStore) RevokeAccess(ctx context.Context, tagID, userID string) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `DELETE FROM public."TagAccess" WHERE "tagId" = $1 AND "userId" = $2`, tagID, userID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to revoke access: %v", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "user %s is not a collaborator on tag %s", userID, tagID)
+	}
+	if err := accessChanged(ctx, tx, tagID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return status.Errorf(codes.Internal, "failed to commit access change: %v", err)
+	}
+	return nil
+}
+
+// accessChanged refreshes the tag's accessCount and queues its subtree and the subtree's questions, whose indexed accessList inherits the grant
+func accessChanged(ctx context.Context, tx pgx.Tx, tagID string) error {
+	_, err := tx.Exec(ctx, `
+		UPDATE public."Tag"
+		SET "accessCount" = (SELECT COUNT(*) FROM public."TagAccess" WHERE "tagId" = $1)
+		WHERE id = $1
+	`, tagID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to update access count: %v", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		WITH RECURSIVE subtree AS (
+			SELECT id, 0 AS depth FROM public."Tag" WHERE id = $1
+			UNION ALL
+			SELECT t.id, s.depth + 1
+			FROM public."Tag" t
+			JOIN subtree s ON t."parentTagId" = s.id
+			WHERE s.depth < $2
+		)
+		INSERT INTO public."IndexOutbox" ("objectType", "objectId", action, "queuedAt")
+		SELECT 'Tag', t.id, 'upsert', NOW()
+		FROM public."Tag" t
+		WHERE t.id IN (SELECT id FROM subtree) AND t.context IS NOT NULL
+		UNION
+		SELECT 'Question', qt."questionId", 'upsert', NOW()
+		FROM public."QuestionTag" qt
+		WHERE qt."tagId" IN (SELECT id FROM subtree)
+		ON CONFLICT ("objectType", "objectId") DO UPDATE
+		SET action = 'upsert', "queuedAt" = NOW()
+	`, tagID, utils.MaxTreeDepth)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to queue shared tags for indexing: %v", err)
+	}
+	return nil
+}
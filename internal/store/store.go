@@ -5,16 +5,31 @@ import (
 	"os"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
 	"github.com/studyguides-com/study-guides-api/internal/store/admin"
+	"github.com/studyguides-com/study-guides-api/internal/store/announcement"
+	"github.com/studyguides-com/study-guides-api/internal/store/article"
+	"github.com/studyguides-com/study-guides-api/internal/store/audit"
+	"github.com/studyguides-com/study-guides-api/internal/store/billing"
+	"github.com/studyguides-com/study-guides-api/internal/store/contact"
 	"github.com/studyguides-com/study-guides-api/internal/store/devops"
+	"github.com/studyguides-com/study-guides-api/internal/store/entitlement"
+	"github.com/studyguides-com/study-guides-api/internal/store/faq"
 	"github.com/studyguides-com/study-guides-api/internal/store/indexing"
 	"github.com/studyguides-com/study-guides-api/internal/store/interaction"
 	"github.com/studyguides-com/study-guides-api/internal/store/kpi"
+	"github.com/studyguides-com/study-guides-api/internal/store/moderation"
+	"github.com/studyguides-com/study-guides-api/internal/store/notification"
+	"github.com/studyguides-com/study-guides-api/internal/store/prompt"
+	"github.com/studyguides-com/study-guides-api/internal/store/quality"
 	"github.com/studyguides-com/study-guides-api/internal/store/question"
 	"github.com/studyguides-com/study-guides-api/internal/store/roland"
 	"github.com/studyguides-com/study-guides-api/internal/store/search"
+	"github.com/studyguides-com/study-guides-api/internal/store/sharing"
+	"github.com/studyguides-com/study-guides-api/internal/store/suggestion"
 	"github.com/studyguides-com/study-guides-api/internal/store/tag"
 	"github.com/studyguides-com/study-guides-api/internal/store/user"
+	"github.com/studyguides-com/study-guides-api/internal/store/usercontent"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -30,19 +45,50 @@ type Store interface {
 	KPIStore() kpi.KPIStore
 	IndexingStore() indexing.IndexingStore
 	AdminStore() admin.AdminStore
+	ModerationStore() moderation.ModerationStore
+	QualityStore() quality.QualityStore
+	SuggestionStore() suggestion.SuggestionStore
+	PromptStore() prompt.PromptStore
+	AuditStore() audit.AuditStore
+	SharingStore() sharing.SharingStore
+	UserContentStore() usercontent.UserContentStore
+	AnnouncementStore() announcement.AnnouncementStore
+	FaqStore() faq.FaqStore
+	ContactStore() contact.ContactStore
+	NotificationStore() notification.NotificationStore
+	ArticleStore() article.ArticleStore
+	EntitlementStore() entitlement.EntitlementStore
+	BillingStore() billing.BillingStore
+	EnvironmentAdminStore(exportType sharedpb.ExportType) (admin.AdminStore, error)
 }
 
 type store struct {
-	searchStore      search.SearchStore
-	tagStore         tag.TagStore
-	userStore        user.UserStore
-	questionStore    question.QuestionStore
-	interactionStore interaction.InteractionStore
-	rolandStore      roland.RolandStore
-	devopsStore      devops.DevopsStore
-	kpiStore         kpi.KPIStore
-	indexingStore    indexing.IndexingStore
-	adminStore       admin.AdminStore
+	searchStore       search.SearchStore
+	tagStore          tag.TagStore
+	userStore         user.UserStore
+	questionStore     question.QuestionStore
+	interactionStore  interaction.InteractionStore
+	rolandStore       roland.RolandStore
+	devopsStore       devops.DevopsStore
+	kpiStore          kpi.KPIStore
+	indexingStore     indexing.IndexingStore
+	adminStore        admin.AdminStore
+	moderationStore   moderation.ModerationStore
+	qualityStore      quality.QualityStore
+	suggestionStore   suggestion.SuggestionStore
+	promptStore       prompt.PromptStore
+	auditStore        audit.AuditStore
+	sharingStore      sharing.SharingStore
+	userContentStore  usercontent.UserContentStore
+	announcementStore announcement.AnnouncementStore
+	faqStore          faq.FaqStore
+	contactStore      contact.ContactStore
+	notificationStore notification.NotificationStore
+	articleStore      article.ArticleStore
+	entitlementStore  entitlement.EntitlementStore
+	billingStore      billing.BillingStore
+	// environmentStores hold admin stores for the dev/test/prod databases bundles are promoted to
+	environmentStores map[sharedpb.ExportType]admin.AdminStore
 }
 
 func (s *store) SearchStore() search.SearchStore {
@@ -85,18 +131,94 @@ func (s *store) AdminStore() admin.AdminStore {
 	return s.adminStore
 }
 
+func (s *store) ModerationStore() moderation.ModerationStore {
+	return s.moderationStore
+}
+
+func (s *store) QualityStore() quality.QualityStore {
+	return s.qualityStore
+}
+
+func (s *store) SuggestionStore() suggestion.SuggestionStore {
+	return s.suggestionStore
+}
+
+func (s *store) PromptStore() prompt.PromptStore {
+	return s.promptStore
+}
+
+func (s *store) AuditStore() audit.AuditStore {
+	return s.auditStore
+}
+
+func (s *store) SharingStore() sharing.SharingStore {
+	return s.sharingStore
+}
+
+func (s *store) UserContentStore() usercontent.UserContentStore {
+	return s.userContentStore
+}
+
+func (s *store) AnnouncementStore() announcement.AnnouncementStore {
+	return s.announcementStore
+}
+
+func (s *store) FaqStore() faq.FaqStore {
+	return s.faqStore
+}
+
+func (s *store) ContactStore() contact.ContactStore {
+	return s.contactStore
+}
+
+func (s *store) NotificationStore() notification.NotificationStore {
+	return s.notificationStore
+}
+
+func (s *store) ArticleStore() article.ArticleStore {
+	return s.articleStore
+}
+
+func (s *store) EntitlementStore() entitlement.EntitlementStore {
+	return s.entitlementStore
+}
+
+func (s *store) BillingStore() billing.BillingStore {
+	return s.billingStore
+}
+
+func (s *store) EnvironmentAdminStore(exportType sharedpb.ExportType) (admin.AdminStore, error) {
+	environmentStore, ok := s.environmentStores[exportType]
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "no database configured for %s", exportType)
+	}
+	return environmentStore, nil
+}
+
+// environmentDatabaseURLs maps each promotion target to the env var holding its connection string
+var environmentDatabaseURLs = map[sharedpb.ExportType]string{
+	sharedpb.ExportType_EXPORT_TYPE_DEV:  "DEV_DATABASE_URL",
+	sharedpb.ExportType_EXPORT_TYPE_TEST: "TEST_DATABASE_URL",
+	sharedpb.ExportType_EXPORT_TYPE_PROD: "PROD_DATABASE_URL",
+}
+
 func NewStore() (Store, error) {
 	ctx := context.Background()
 	algoliaAppID := os.Getenv("ALGOLIA_APP_ID")
 	algoliaAdminAPIKey := os.Getenv("ALGOLIA_ADMIN_API_KEY")
 	dbURL := os.Getenv("DATABASE_URL")
 	rolandDBURL := os.Getenv("ROLAND_DATABASE_URL")
+	searchBackend := os.Getenv("SEARCH_BACKEND")
+	searchFallback := os.Getenv("SEARCH_FALLBACK")
 
-	if algoliaAppID == "" || algoliaAdminAPIKey == "" || dbURL == "" {
+	// Algolia is only required when it serves searches, the postgres backend lets
+	// local development and CI run without it
+	algoliaRequired := searchBackend != search.BackendPostgres
+	if (algoliaRequired && (algoliaAppID == "" || algoliaAdminAPIKey == "")) || dbURL == "" {
 		return nil, status.Error(codes.FailedPrecondition, "missing required environment variables")
 	}
 
-	searchStore, err := search.NewAlgoliaSearchStore(ctx, algoliaAppID, algoliaAdminAPIKey)
+	searchStore, err := search.NewSearchStore(ctx, searchBackend, searchFallback, dbURL, algoliaAppID, algoliaAdminAPIKey)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -152,16 +274,115 @@ func NewStore() (Store, error) {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	moderationStore, err := moderation.NewSqlModerationStore(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	qualityStore, err := quality.NewSqlQualityStore(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	suggestionStore, err := suggestion.NewSqlSuggestionStore(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	promptStore, err := prompt.NewSqlPromptStore(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	auditStore, err := audit.NewSqlAuditStore(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	sharingStore, err := sharing.NewSqlSharingStore(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	userContentStore, err := usercontent.NewSqlUserContentStore(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	announcementStore, err := announcement.NewSqlAnnouncementStore(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	faqStore, err := faq.NewSqlFaqStore(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	contactStore, err := contact.NewSqlContactStore(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	notificationStore, err := notification.NewSqlNotificationStore(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	articleStore, err := article.NewSqlArticleStore(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	entitlementStore, err := entitlement.NewSqlEntitlementStore(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	billingStore, err := billing.NewSqlBillingStore(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Promotion targets are optional, only configured environments can receive bundles
+	environmentStores := make(map[sharedpb.ExportType]admin.AdminStore)
+	for exportType, envVar := range environmentDatabaseURLs {
+		envDBURL := os.Getenv(envVar)
+		if envDBURL == "" {
+			continue
+		}
+		environmentStore, err := admin.NewSqlAdminStore(ctx, envDBURL, "", "")
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		environmentStores[exportType] = environmentStore
+	}
+
 	return &store{
-		searchStore:      searchStore,
-		tagStore:         tagStore,
-		userStore:        userStore,
-		questionStore:    questionStore,
-		interactionStore: interactionStore,
-		rolandStore:      rolandStore,
-		devopsStore:      devopsStore,
-		kpiStore:         kpiStore,
-		indexingStore:    indexingStore,
-		adminStore:       adminStore,
+		searchStore:       searchStore,
+		tagStore:          tagStore,
+		userStore:         userStore,
+		questionStore:     questionStore,
+		interactionStore:  interactionStore,
+		rolandStore:       rolandStore,
+		devopsStore:       devopsStore,
+		kpiStore:          kpiStore,
+		indexingStore:     indexingStore,
+		adminStore:        adminStore,
+		moderationStore:   moderationStore,
+		qualityStore:      qualityStore,
+		suggestionStore:   suggestionStore,
+		promptStore:       promptStore,
+		auditStore:        auditStore,
+		sharingStore:      sharingStore,
+		userContentStore:  userContentStore,
+		announcementStore: announcementStore,
+		faqStore:          faqStore,
+		contactStore:      contactStore,
+		notificationStore: notificationStore,
+		articleStore:      articleStore,
+		entitlementStore:  entitlementStore,
+		billingStore:      billingStore,
+		environmentStores: environmentStores,
 	}, nil
 }
@@ -0,0 +1,291 @@
+package suggestion
+
+import (
+	"context"
+	"errors"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sharedpb "github.com/studyguides-com/study-guides-api/api/v1/shared"
+	"github.com/studyguides-com/study-guides-api/internal/store/jobs"
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+)
+
+const maxSiblingAnswers = 30 // answers from the same topic shown to the model
+
+type SqlSuggestionStore struct {
+	db *pgxpool.Pool
+}
+
+func (s *SqlSuggestionStore) StartJob(ctx context.Context, jobType, description string, metadata map[string]interface{}) (string, error) {
+	return jobs.Start(ctx, s.db, jobType, description, metadata)
+}
+
+func (s *SqlSuggestionStore) FinishJob(jobID string, runErr error, results map[string]interface{}) {
+	jobs.Finish(s.db, jobID, runErr, results)
+}
+
+func (s *SqlSuggestionStore) Job(ctx context.Context, jobID string) (*jobs.Job, error) {
+	return jobs.Get(ctx, s.db, jobID)
+}
+
+func (s *SqlSuggestionStore) DistractorCandidates(ctx context.Context, minDistractors int, limit int) ([]*DistractorCandidate, error) {
+	var candidates []*DistractorCandidate
+	err := pgxscan.Select(ctx, s.db, &candidates, `
+		SELECT q.id, q."questionText", q."answerText", q.distractors, topic.name AS "topicName",
+			COALESCE((
+				SELECT array_agg(DISTINCT sibling.answer)
+				FROM (
+					SELECT o."answerText" AS answer
+					FROM public."QuestionTag" oqt
+					JOIN public."Question" o ON o.id = oqt."questionId"
+					WHERE oqt."tagId" = topic.id AND o.id <> q.id AND o."answerText" <> q."answerText"
+					LIMIT $3
+				) sibling
+			), '{}') AS "siblingAnswers"
+		FROM public."Question" q
+		LEFT JOIN LATERAL (
+			SELECT t.id, t.name
+			FROM public."QuestionTag" qt
+			JOIN public."Tag" t ON t.id = qt."tagId"
+			WHERE qt."questionId" = q.id
+			ORDER BY (t.type = 'Topic') DESC, t.id
+			LIMIT 1
+		) topic ON true
+		WHERE cardinality(q.distractors) < $1
+			AND NOT EXISTS (
+				SELECT 1 FROM public."AiSuggestion" s
+				WHERE s."targetId" = q.id AND s.kind = 'Distractors' AND s.status = 'Pending'
+			)
+		ORDER BY q.id
+		LIMIT $2
+	`, minDistractors, limit, maxSiblingAnswers)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list distractor candidates: %v", err)
+	}
+	return candidates, nil
+}
+
+func (s *SqlSuggestionStore) LearnMoreCandidates(ctx context.Context, limit int) ([]*LearnMoreCandidate, error) {
+	var candidates []*LearnMoreCandidate
+	err := pgxscan.Select(ctx, s.db, &candidates, `
+		SELECT q.id, q."questionText", q."answerText", p.title AS "passageTitle", p.body AS "passageBody",
+			(
+				WITH RECURSIVE ancestors AS (
+					SELECT t.id, t.name, t."parentTagId", 0 AS depth
+					FROM public."Tag" t WHERE t.id = topic.id
+					UNION ALL
+					SELECT t.id, t.name, t."parentTagId", a.depth + 1
+					FROM public."Tag" t JOIN ancestors a ON t.id = a."parentTagId"
+					WHERE a.depth < $2
+				)
+				SELECT string_agg(name, ' > ' ORDER BY depth DESC) FROM ancestors
+			) AS "tagPath"
+		FROM public."Question" q
+		LEFT JOIN public."Passage" p ON p.id = q."passageId"
+		LEFT JOIN LATERAL (
+			SELECT t.id
+			FROM public."QuestionTag" qt
+			JOIN public."Tag" t ON t.id = qt."tagId"
+			WHERE qt."questionId" = q.id
+			ORDER BY (t.type = 'Topic') DESC, t.id
+			LIMIT 1
+		) topic ON true
+		WHERE COALESCE(btrim(q."learnMore"), '') = ''
+			AND NOT EXISTS (
+				SELECT 1 FROM public."AiSuggestion" s
+				WHERE s."targetId" = q.id AND s.kind = 'LearnMore' AND s.status = 'Pending'
+			)
+		ORDER BY q.id
+		LIMIT $1
+	`, limit, utils.MaxTreeDepth)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list learnMore candidates: %v", err)
+	}
+	return candidates, nil
+}
+
+func (s *SqlSuggestionStore) ContentRatingCandidates(ctx context.Context, sampleSize int, limit int) ([]*ContentRatingCandidate, error) {
+	var candidates []*ContentRatingCandidate
+	err := pgxscan.Select(ctx, s.db, &candidates, `
+		SELECT t.id, t.name, t.description, t."contentDescriptors", t."metaTags",
+			COALESCE((
+				SELECT array_agg(sample."questionText")
+				FROM (
+					SELECT q."questionText"
+					FROM public."QuestionTag" qt
+					JOIN public."Question" q ON q.id = qt."questionId"
+					WHERE qt."tagId" = t.id
+					ORDER BY q.id
+					LIMIT $1
+				) sample
+			), '{}') AS "sampleQuestions"
+		FROM public."Tag" t
+		WHERE t.type = 'Topic' AND t."contentRating" = 'RatingPending'
+			AND NOT EXISTS (
+				SELECT 1 FROM public."AiSuggestion" s
+				WHERE s."targetId" = t.id AND s.kind = 'ContentRating' AND s.status = 'Pending'
+			)
+		ORDER BY t.id
+		LIMIT $2
+	`, sampleSize, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list content rating candidates: %v", err)
+	}
+	return candidates, nil
+}
+
+func (s *SqlSuggestionStore) CreateSuggestion(ctx context.Context, suggestion *Suggestion) error {
+	if suggestion.ID == "" {
+		suggestion.ID = utils.GetCUID()
+	}
+	err := s.db.QueryRow(ctx, `
+		INSERT INTO public."AiSuggestion" (id, kind, "targetType", "targetId", "jobId", payload, context,
+			model, "promptId", "promptVersion", confidence, "autoApplied", status, "reviewedAt", "createdAt")
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12,
+			CASE WHEN $12 THEN 'Approved' ELSE 'Pending' END::"AiSuggestionStatus",
+			CASE WHEN $12 THEN NOW() END, NOW())
+		RETURNING status::text, "reviewedAt", "createdAt"
+	`, suggestion.ID, suggestion.Kind, suggestion.TargetType, suggestion.TargetID, suggestion.JobID,
+		suggestion.Payload, suggestion.Context, suggestion.Model, suggestion.PromptID, suggestion.PromptVersion,
+		suggestion.Confidence, suggestion.AutoApplied,
+	).Scan(&suggestion.Status, &suggestion.ReviewedAt, &suggestion.CreatedAt)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to create suggestion: %v", err)
+	}
+	return nil
+}
+
+const suggestionColumns = `id, kind::text, "targetType", "targetId", "jobId", payload, context,
+	model, "promptId", "promptVersion", confidence, "autoApplied", status::text, "reviewedBy", "reviewedAt", "createdAt"`
+
+func (s *SqlSuggestionStore) Suggestion(ctx context.Context, id string) (*Suggestion, error) {
+	var suggestion Suggestion
+	err := pgxscan.Get(ctx, s.db, &suggestion, `SELECT `+suggestionColumns+` FROM public."AiSuggestion" WHERE id = $1`, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "suggestion %s not found", id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get suggestion: %v", err)
+	}
+	return &suggestion, nil
+}
+
+func (s *SqlSuggestionStore) ListSuggestions(ctx context.Context, kind, suggestionStatus string, limit, offset int) ([]*Suggestion, int64, error) {
+	const filter = `FROM public."AiSuggestion"
+		WHERE ($1 = '' OR kind::text = $1) AND ($2 = '' OR status::text = $2)`
+
+	var total int64
+	if err := s.db.QueryRow(ctx, `SELECT COUNT(*) `+filter, kind, suggestionStatus).Scan(&total); err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "failed to count suggestions: %v", err)
+	}
+
+	var suggestions []*Suggestion
+	err := pgxscan.Select(ctx, s.db, &suggestions, `SELECT `+suggestionColumns+` `+filter+`
+		ORDER BY "createdAt", id
+		LIMIT $3 OFFSET $4
+	`, kind, suggestionStatus, limit, offset)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "failed to list suggestions: %v", err)
+	}
+	return suggestions, total, nil
+}
+
+func (s *SqlSuggestionStore) ReviewSuggestion(ctx context.Context, id, reviewStatus, reviewedBy string, payload []byte, apply ApplyFunc) (*Suggestion, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock the suggestion so two reviewers can't both apply it
+	var suggestion Suggestion
+	err = pgxscan.Get(ctx, tx, &suggestion, `SELECT `+suggestionColumns+` FROM public."AiSuggestion" WHERE id = $1 FOR UPDATE`, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "suggestion %s not found", id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get suggestion: %v", err)
+	}
+	if suggestion.Status != StatusPending {
+		return nil, status.Errorf(codes.FailedPrecondition, "suggestion %s is already %s", id, suggestion.Status)
+	}
+	if payload != nil {
+		suggestion.Payload = payload
+	}
+
+	if reviewStatus == StatusApproved && apply != nil {
+		if err := apply(ctx, &suggestion, &QuestionTx{tx: tx}); err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.QueryRow(ctx, `
+		UPDATE public."AiSuggestion"
+		SET status = $2, "reviewedBy" = $3, "reviewedAt" = NOW(), payload = COALESCE($4, payload)
+		WHERE id = $1
+		RETURNING status::text, "reviewedBy", "reviewedAt"
+	`, id, reviewStatus, reviewedBy, payload).Scan(&suggestion.Status, &suggestion.ReviewedBy, &suggestion.ReviewedAt)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to review suggestion: %v", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit review: %v", err)
+	}
+	return &suggestion, nil
+}
+
+// QuestionTx changes single question columns inside a review transaction
+type QuestionTx struct {
+	tx pgx.Tx
+}
+
+// Question locks and returns the columns of a question that suggestions change
+func (q *QuestionTx) Question(ctx context.Context, id string) (*sharedpb.Question, error) {
+	var question sharedpb.Question
+	var learnMore *string
+	err := q.tx.QueryRow(ctx, `
+		SELECT id, "answerText", distractors, "learnMore", version
+		FROM public."Question"
+		WHERE id = $1
+		FOR UPDATE
+	`, id).Scan(&question.Id, &question.AnswerText, &question.Distractors, &learnMore, &question.Version)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "question %s not found", id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get question: %v", err)
+	}
+	question.LearnMore = learnMore
+	return &question, nil
+}
+
+// UpdateDistractors replaces the distractors of a question and bumps its version
+func (q *QuestionTx) UpdateDistractors(ctx context.Context, id string, distractors []string) error {
+	return q.update(ctx, `distractors`, id, distractors)
+}
+
+// UpdateLearnMore replaces the learnMore of a question and bumps its version
+func (q *QuestionTx) UpdateLearnMore(ctx context.Context, id, learnMore string) error {
+	return q.update(ctx, `"learnMore"`, id, learnMore)
+}
+
+func (q *QuestionTx) update(ctx context.Context, column, id string, value interface{}) error {
+	tag, err := q.tx.Exec(ctx, `
+		UPDATE public."Question"
+		SET `+column+` = $2, version = version + 1, "updatedAt" = NOW()
+		WHERE id = $1
+	`, id, value)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to update question: %v", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "question %s not found", id)
+	}
+	return nil
+}
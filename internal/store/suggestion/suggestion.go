@@ -0,0 +1,129 @@
+package suggestion
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/studyguides-com/study-guides-api/internal/store/jobs"
+)
+
+// Suggestion kinds, stored as the AiSuggestionKind enum
+const (
+	KindDistractors   = "Distractors"
+	KindLearnMore     = "LearnMore"
+	KindContentRating = "ContentRating"
+)
+
+// Suggestion statuses, stored as the AiSuggestionStatus enum
+const (
+	StatusPending  = "Pending"
+	StatusApproved = "Approved"
+	StatusRejected = "Rejected"
+)
+
+// Suggestion targets
+const (
+	TargetQuestion = "Question"
+	TargetTag      = "Tag"
+)
+
+// Job types written to the shared Job table
+const (
+	JobTypeDistractors   = "AiDistractors"
+	JobTypeLearnMore     = "AiLearnMore"
+	JobTypeContentRating = "AiContentRating"
+)
+
+type SuggestionStore interface {
+	// StartJob records a running generation job
+	StartJob(ctx context.Context, jobType, description string, metadata map[string]interface{}) (string, error)
+	// FinishJob marks a generation job completed or failed
+	FinishJob(jobID string, runErr error, results map[string]interface{})
+	// Job returns the status of a generation job
+	Job(ctx context.Context, jobID string) (*jobs.Job, error)
+
+	// DistractorCandidates returns questions with fewer than minDistractors distractors and no pending suggestion,
+	// along with answers to other questions in the same topic
+	DistractorCandidates(ctx context.Context, minDistractors int, limit int) ([]*DistractorCandidate, error)
+
+	// LearnMoreCandidates returns questions without a learnMore and no pending suggestion, with their passage and tag path
+	LearnMoreCandidates(ctx context.Context, limit int) ([]*LearnMoreCandidate, error)
+
+	// ContentRatingCandidates returns RatingPending topics with no pending suggestion, with a sample of their questions
+	ContentRatingCandidates(ctx context.Context, sampleSize int, limit int) ([]*ContentRatingCandidate, error)
+
+	// CreateSuggestion adds a suggestion to the review queue, or records an auto-applied one when AutoApplied is set
+	CreateSuggestion(ctx context.Context, suggestion *Suggestion) error
+	// Suggestion retrieves a suggestion by its ID
+	Suggestion(ctx context.Context, id string) (*Suggestion, error)
+	// ListSuggestions pages through the queue, oldest first. Empty kind or status match everything.
+	ListSuggestions(ctx context.Context, kind, suggestionStatus string, limit, offset int) ([]*Suggestion, int64, error)
+	// ReviewSuggestion locks a pending suggestion, applies it when approved and records the decision in one transaction.
+	// payload replaces the suggestion when a reviewer edited it.
+	ReviewSuggestion(ctx context.Context, id, status, reviewedBy string, payload []byte, apply ApplyFunc) (*Suggestion, error)
+}
+
+// ApplyFunc writes an approved suggestion to the catalog, question changes go through the review transaction
+type ApplyFunc func(ctx context.Context, suggestion *Suggestion, questions *QuestionTx) error
+
+// Suggestion is AI generated content waiting for, or past, review
+type Suggestion struct {
+	ID            string     `db:"id"`
+	Kind          string     `db:"kind"`
+	TargetType    string     `db:"targetType"`
+	TargetID      string     `db:"targetId"`
+	JobID         *string    `db:"jobId"`
+	Payload       []byte     `db:"payload"` // the proposed change, shape depends on Kind
+	Context       []byte     `db:"context"` // what the model was shown, for reviewers
+	Model         *string    `db:"model"`
+	PromptID      *string    `db:"promptId"`
+	PromptVersion *int32     `db:"promptVersion"`
+	Confidence    *float64   `db:"confidence"`
+	AutoApplied   bool       `db:"autoApplied"`
+	Status        string     `db:"status"`
+	ReviewedBy    *string    `db:"reviewedBy"`
+	ReviewedAt    *time.Time `db:"reviewedAt"`
+	CreatedAt     time.Time  `db:"createdAt"`
+}
+
+// DistractorCandidate is a question that needs more distractors
+type DistractorCandidate struct {
+	QuestionID     string   `db:"id"`
+	QuestionText   string   `db:"questionText"`
+	AnswerText     string   `db:"answerText"`
+	Distractors    []string `db:"distractors"`
+	TopicName      *string  `db:"topicName"`
+	SiblingAnswers []string `db:"siblingAnswers"`
+}
+
+// LearnMoreCandidate is a question that needs a learnMore explanation
+type LearnMoreCandidate struct {
+	QuestionID   string  `db:"id"`
+	QuestionText string  `db:"questionText"`
+	AnswerText   string  `db:"answerText"`
+	PassageTitle *string `db:"passageTitle"`
+	PassageBody  *string `db:"passageBody"`
+	TagPath      *string `db:"tagPath"` // root to leaf, joined with " > "
+}
+
+// ContentRatingCandidate is a topic waiting for a content rating
+type ContentRatingCandidate struct {
+	TagID              string   `db:"id"`
+	Name               string   `db:"name"`
+	Description        *string  `db:"description"`
+	ContentDescriptors []string `db:"contentDescriptors"`
+	MetaTags           []string `db:"metaTags"`
+	SampleQuestions    []string `db:"sampleQuestions"`
+}
+
+func NewSqlSuggestionStore(ctx context.Context, dbURL string) (*SqlSuggestionStore, error) {
+	db, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to connect to postgres: "+err.Error())
+	}
+	return &SqlSuggestionStore{db: db}, nil
+}
@@ -320,11 +320,12 @@ func mapRowsToTags(rows []tagRow) []*sharedpb.Tag {
 
 func (s *SqlTagStore) Report(ctx context.Context, tagID string, userId string, reportType sharedpb.ReportType, reason string) error {
 	_, err := s.db.Exec(ctx, `
-		INSERT INTO "UserTagReport" ("userId", "tagId", report)
-		VALUES ($1, $2, $3)
+		INSERT INTO "UserTagReport" ("userId", "tagId", report, reason)
+		VALUES ($1, $2, $3, NULLIF($4, ''))
 		ON CONFLICT ("userId", "tagId") 
-		DO UPDATE SET report = $3, "createdAt" = CURRENT_TIMESTAMP
-	`, userId, tagID, reportType)
+		DO UPDATE SET report = $3, reason = NULLIF($4, ''), status = 'Open', resolution = NULL,
+			"resolvedAt" = NULL, "resolvedBy" = NULL, "createdAt" = CURRENT_TIMESTAMP
+	`, userId, tagID, reportType.String(), reason)
 	if err != nil {
 		return status.Errorf(codes.Internal, "failed to report tag: %v", err)
 	}
@@ -0,0 +1,395 @@
+package usercontent
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/studyguides-com/study-guides-api/internal/utils"
+)
+
+type SqlUserContentStore struct {
+	db *pgxpool.Pool
+}
+
+// ugcContext is the ContextType every user-authored tag is created with
+const ugcContext = "UserGeneratedContent"
+
+func (s *SqlUserContentStore) Usage(ctx context.Context, ownerID string) (*Usage, error) {
+	var usage Usage
+	err := pgxscan.Get(ctx, s.db, &usage, `
+		SELECT
+			COUNT(*) FILTER (WHERE type = 'UserFolder')::int AS folders,
+			COUNT(*) FILTER (WHERE type = 'UserStudyGuide')::int AS "studyGuides",
+			COUNT(*) FILTER (WHERE type = 'UserTopic')::int AS topics,
+			(SELECT COUNT(*) FROM public."Question" WHERE "ownerId" = $1)::int AS questions
+		FROM public."Tag"
+		WHERE "ownerId" = $1 AND context = 'UserGeneratedContent'
+	`, ownerID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count user content: %v", err)
+	}
+	return &usage, nil
+}
+
+func (s *SqlUserContentStore) CreateTag(ctx context.Context, tag *NewTag, limit int) (string, error) {
+	parentType, ok := parentTypes[tag.Type]
+	if !ok {
+		return "", status.Errorf(codes.InvalidArgument, "users can't create %s tags", tag.Type)
+	}
+	if parentType == "" && tag.ParentID != nil {
+		return "", status.Errorf(codes.InvalidArgument, "a %s must be at the root", tag.Type)
+	}
+	if parentType != "" && tag.ParentID == nil {
+		return "", status.Errorf(codes.InvalidArgument, "a %s must be inside a %s", tag.Type, parentType)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockOwner(ctx, tx, tag.OwnerID); err != nil {
+		return "", err
+	}
+
+	if tag.ParentID != nil {
+		actualType, err := ownedTagType(ctx, tx, tag.OwnerID, *tag.ParentID, true)
+		if err != nil {
+			return "", err
+		}
+		if actualType != parentType {
+			return "", status.Errorf(codes.InvalidArgument, "a %s must be inside a %s, not a %s", tag.Type, parentType, actualType)
+		}
+	}
+
+	var count int
+	err = tx.QueryRow(ctx, `
+		SELECT COUNT(*) FROM public."Tag"
+		WHERE "ownerId" = $1 AND type::text = $2 AND context = 'UserGeneratedContent'
+	`, tag.OwnerID, tag.Type).Scan(&count)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to count user tags: %v", err)
+	}
+	if count >= limit {
+		return "", status.Errorf(codes.ResourceExhausted, "%s quota of %d reached", tag.Type, limit)
+	}
+
+	tagID := utils.GetCUID()
+	_, err = tx.Exec(ctx, `
+		INSERT INTO public."Tag" (
+			id, hash, name, description, type, context, "parentTagId",
+			public, "ownerId", "createdAt", "updatedAt"
+		) VALUES ($1, $2, $3, $4, $5::"TagType", $6::"ContextType", $7, false, $8, NOW(), NOW())
+	`, tagID, contentHash(tagID), tag.Name, tag.Description, tag.Type, ugcContext, tag.ParentID, tag.OwnerID)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to create tag: %v", err)
+	}
+
+	queued := []string{tagID}
+	if tag.ParentID != nil {
+		if _, err := tx.Exec(ctx, `UPDATE public."Tag" SET "hasChildren" = true WHERE id = $1`, *tag.ParentID); err != nil {
+			return "", status.Errorf(codes.Internal, "failed to update parent tag: %v", err)
+		}
+		queued = append(queued, *tag.ParentID)
+	}
+	if err := queueTags(ctx, tx, queued); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", status.Errorf(codes.Internal, "failed to commit tag: %v", err)
+	}
+	return tagID, nil
+}
+
+func (s *SqlUserContentStore) UpdateTag(ctx context.Context, ownerID, tagID string, update *TagUpdate) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE public."Tag"
+		SET name = COALESCE($3, name), description = COALESCE($4, description), "updatedAt" = NOW()
+		WHERE id = $2 AND "ownerId" = $1 AND context = 'UserGeneratedContent'
+	`, ownerID, tagID, update.Name, update.Description)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to update tag: %v", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "tag %s not found", tagID)
+	}
+
+	// Descendants and their questions carry the tag's name in their indexed ancestry
+	_, err = tx.Exec(ctx, `
+		WITH RECURSIVE subtree AS (
+			SELECT id, 0 AS depth FROM public."Tag" WHERE id = $1
+			UNION ALL
+			SELECT t.id, s.depth + 1 FROM public."Tag" t JOIN subtree s ON t."parentTagId" = s.id
+			WHERE s.depth < $2
+		)
+		INSERT INTO public."IndexOutbox" ("objectType", "objectId", action, "queuedAt")
+		SELECT 'Tag', id, 'upsert', NOW() FROM subtree
+		UNION
+		SELECT 'Question', qt."questionId", 'upsert', NOW()
+		FROM public."QuestionTag" qt
+		WHERE qt."tagId" IN (SELECT id FROM subtree)
+		ON CONFLICT ("objectType", "objectId") DO UPDATE
+		SET action = 'upsert', "queuedAt" = NOW()
+	`, tagID, utils.MaxTreeDepth)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to queue tags for indexing: %v", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return status.Errorf(codes.Internal, "failed to commit tag: %v", err)
+	}
+	return nil
+}
+
+func (s *SqlUserContentStore) OwnedTag(ctx context.Context, ownerID, tagID string) (string, error) {
+	return ownedTagType(ctx, s.db, ownerID, tagID, false)
+}
+
+func (s *SqlUserContentStore) CreateQuestion(ctx context.Context, question *NewQuestion, limit int) (string, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockOwner(ctx, tx, question.OwnerID); err != nil {
+		return "", err
+	}
+
+	tagType, err := ownedTagType(ctx, tx, question.OwnerID, question.TagID, true)
+	if err != nil {
+		return "", err
+	}
+	if tagType != TypeTopic {
+		return "", status.Errorf(codes.InvalidArgument, "questions must be added to a %s, not a %s", TypeTopic, tagType)
+	}
+
+	var count int
+	err = tx.QueryRow(ctx, `SELECT COUNT(*) FROM public."Question" WHERE "ownerId" = $1`, question.OwnerID).Scan(&count)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to count user questions: %v", err)
+	}
+	if count >= limit {
+		return "", status.Errorf(codes.ResourceExhausted, "question quota of %d reached", limit)
+	}
+
+	distractors := question.Distractors
+	if distractors == nil {
+		distractors = []string{}
+	}
+
+	questionID := utils.GetCUID()
+	_, err = tx.Exec(ctx, `
+		INSERT INTO public."Question" (
+			id, "questionText", "answerText", hash, "learnMore", distractors,
+			public, "ownerId", "createdAt", "updatedAt"
+		) VALUES ($1, $2, $3, $4, $5, $6, false, $7, NOW(), NOW())
+	`, questionID, question.QuestionText, question.AnswerText, contentHash(questionID),
+		question.LearnMore, distractors, question.OwnerID)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to create question: %v", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO public."QuestionTag" ("questionId", "tagId", "createdAt") VALUES ($1, $2, NOW())
+	`, questionID, question.TagID)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to tag question: %v", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO public."QuestionAccess" (id, "questionId", "userId", "accessType", "createdAt")
+		VALUES ($1, $2, $3, 'ReadWrite', NOW())
+	`, utils.GetCUID(), questionID, question.OwnerID)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to grant question access: %v", err)
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE public."Tag" SET "hasQuestions" = true WHERE id = $1`, question.TagID); err != nil {
+		return "", status.Errorf(codes.Internal, "failed to update topic: %v", err)
+	}
+	if err := queueTags(ctx, tx, []string{question.TagID}); err != nil {
+		return "", err
+	}
+	if err := queueQuestion(ctx, tx, questionID, "upsert"); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", status.Errorf(codes.Internal, "failed to commit question: %v", err)
+	}
+	return questionID, nil
+}
+
+func (s *SqlUserContentStore) UpdateQuestion(ctx context.Context, ownerID, questionID string, update *QuestionUpdate) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE public."Question"
+		SET "questionText" = COALESCE($3, "questionText"),
+			"answerText" = COALESCE($4, "answerText"),
+			"learnMore" = COALESCE($5, "learnMore"),
+			distractors = COALESCE($6, distractors),
+			version = version + 1,
+			"updatedAt" = NOW()
+		WHERE id = $2 AND "ownerId" = $1
+	`, ownerID, questionID, update.QuestionText, update.AnswerText, update.LearnMore, update.Distractors)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to update question: %v", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "question %s not found", questionID)
+	}
+
+	if err := queueQuestion(ctx, tx, questionID, "upsert"); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return status.Errorf(codes.Internal, "failed to commit question update: %v", err)
+	}
+	return nil
+}
+
+func (s *SqlUserContentStore) DeleteQuestion(ctx context.Context, ownerID, questionID string) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var id string
+	err = tx.QueryRow(ctx, `
+		SELECT id FROM public."Question" WHERE id = $2 AND "ownerId" = $1 FOR UPDATE
+	`, ownerID, questionID).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return status.Errorf(codes.NotFound, "question %s not found", questionID)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get question: %v", err)
+	}
+
+	var tagIDs []string
+	if err := pgxscan.Select(ctx, tx, &tagIDs, `SELECT "tagId" FROM public."QuestionTag" WHERE "questionId" = $1`, questionID); err != nil {
+		return status.Errorf(codes.Internal, "failed to get question tags: %v", err)
+	}
+
+	// Interactions, ratings, reports, progress and tags cascade, these don't
+	_, err = tx.Exec(ctx, `
+		WITH deleted_access AS (
+			DELETE FROM public."QuestionAccess" WHERE "questionId" = $1
+		),
+		deleted_survival_questions AS (
+			DELETE FROM public."SurvivalQuestion" WHERE "questionId" = $1
+		),
+		deleted_test_questions AS (
+			DELETE FROM public."TestQuestion" WHERE "questionId" = $1
+		)
+		DELETE FROM public."Question" WHERE id = $1
+	`, questionID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to delete question: %v", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE public."Tag" t
+		SET "hasQuestions" = EXISTS (SELECT 1 FROM public."QuestionTag" qt WHERE qt."tagId" = t.id)
+		WHERE t.id = ANY($1)
+	`, tagIDs)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to update topics: %v", err)
+	}
+	if err := queueTags(ctx, tx, tagIDs); err != nil {
+		return err
+	}
+	if err := queueQuestion(ctx, tx, questionID, "delete"); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return status.Errorf(codes.Internal, "failed to commit question delete: %v", err)
+	}
+	return nil
+}
+
+// lockOwner serialises a user's writes so concurrent creates can't overrun a quota
+func lockOwner(ctx context.Context, tx pgx.Tx, ownerID string) error {
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('usercontent:' || $1))`, ownerID); err != nil {
+		return status.Errorf(codes.Internal, "failed to lock user content: %v", err)
+	}
+	return nil
+}
+
+// ownedTagType returns the type of a user-generated tag belonging to the owner, optionally locking it
+func ownedTagType(ctx context.Context, db pgxscan.Querier, ownerID, tagID string, lock bool) (string, error) {
+	query := `
+		SELECT type::text FROM public."Tag"
+		WHERE id = $2 AND "ownerId" = $1 AND context = 'UserGeneratedContent'
+	`
+	if lock {
+		query += ` FOR UPDATE`
+	}
+
+	var tagType string
+	err := pgxscan.Get(ctx, db, &tagType, query, ownerID, tagID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", status.Errorf(codes.NotFound, "tag %s not found", tagID)
+	}
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get tag: %v", err)
+	}
+	return tagType, nil
+}
+
+// queueTags queues tags for the next indexing run
+func queueTags(ctx context.Context, tx pgx.Tx, tagIDs []string) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO public."IndexOutbox" ("objectType", "objectId", action, "queuedAt")
+		SELECT 'Tag', id, 'upsert', NOW() FROM unnest($1::text[]) AS id
+		ON CONFLICT ("objectType", "objectId") DO UPDATE
+		SET action = 'upsert', "queuedAt" = NOW()
+	`, tagIDs)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to queue tags for indexing: %v", err)
+	}
+	return nil
+}
+
+// queueQuestion queues the question for the next indexing run
+func queueQuestion(ctx context.Context, tx pgx.Tx, questionID, action string) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO public."IndexOutbox" ("objectType", "objectId", action, "queuedAt")
+		VALUES ('Question', $1, $2, NOW())
+		ON CONFLICT ("objectType", "objectId") DO UPDATE
+		SET action = $2, "queuedAt" = NOW()
+	`, questionID, action)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to queue question for indexing: %v", err)
+	}
+	return nil
+}
+
+// contentHash gives authored content the unique hash imported content gets from its source
+func contentHash(id string) string {
+	sum := sha256.Sum256([]byte("ugc:" + id))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,119 @@
+package usercontent
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Tag types users can author, stored as the TagType enum
+const (
+	TypeFolder     = "UserFolder"
+	TypeStudyGuide = "UserStudyGuide"
+	TypeTopic      = "UserTopic"
+)
+
+// parentTypes is the authoring hierarchy: folders sit at the root, study guides
+// live in folders and topics live in study guides. Questions attach to topics.
+var parentTypes = map[string]string{
+	TypeFolder:     "",
+	TypeStudyGuide: TypeFolder,
+	TypeTopic:      TypeStudyGuide,
+}
+
+// Quotas caps how much content a single user can author
+type Quotas struct {
+	Folders     int
+	StudyGuides int
+	Topics      int
+	Questions   int
+}
+
+// DefaultQuotas apply to every user
+var DefaultQuotas = Quotas{
+	Folders:     50,
+	StudyGuides: 200,
+	Topics:      2000,
+	Questions:   20000,
+}
+
+// Limit returns the quota for a tag type
+func (q Quotas) Limit(tagType string) int {
+	switch tagType {
+	case TypeFolder:
+		return q.Folders
+	case TypeStudyGuide:
+		return q.StudyGuides
+	case TypeTopic:
+		return q.Topics
+	}
+	return 0
+}
+
+// Every method is scoped to an owner, content belonging to someone else is reported as NotFound.
+type UserContentStore interface {
+	// Usage counts what a user has authored
+	Usage(ctx context.Context, ownerID string) (*Usage, error)
+
+	// CreateTag adds a tag under the hierarchy rules, ResourceExhausted once the owner has limit tags of that type
+	CreateTag(ctx context.Context, tag *NewTag, limit int) (string, error)
+	// UpdateTag renames a tag or changes its description
+	UpdateTag(ctx context.Context, ownerID, tagID string, update *TagUpdate) error
+	// OwnedTag checks a user-generated tag belongs to the owner and returns its type
+	OwnedTag(ctx context.Context, ownerID, tagID string) (string, error)
+
+	// CreateQuestion adds a question to a topic, ResourceExhausted once the owner has limit questions
+	CreateQuestion(ctx context.Context, question *NewQuestion, limit int) (string, error)
+	// UpdateQuestion edits a question and bumps its version
+	UpdateQuestion(ctx context.Context, ownerID, questionID string, update *QuestionUpdate) error
+	// DeleteQuestion removes a question and everything that references it
+	DeleteQuestion(ctx context.Context, ownerID, questionID string) error
+}
+
+type Usage struct {
+	Folders     int `db:"folders"`
+	StudyGuides int `db:"studyGuides"`
+	Topics      int `db:"topics"`
+	Questions   int `db:"questions"`
+}
+
+type NewTag struct {
+	OwnerID     string
+	ParentID    *string
+	Type        string
+	Name        string
+	Description *string
+}
+
+// TagUpdate holds the fields to change, nil fields are left alone
+type TagUpdate struct {
+	Name        *string
+	Description *string
+}
+
+type NewQuestion struct {
+	OwnerID      string
+	TagID        string
+	QuestionText string
+	AnswerText   string
+	LearnMore    *string
+	Distractors  []string
+}
+
+// QuestionUpdate holds the fields to change, nil fields are left alone
+type QuestionUpdate struct {
+	QuestionText *string
+	AnswerText   *string
+	LearnMore    *string
+	Distractors  *[]string
+}
+
+func NewSqlUserContentStore(ctx context.Context, dbURL string) (*SqlUserContentStore, error) {
+	db, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to connect to postgres: "+err.Error())
+	}
+	return &SqlUserContentStore{db: db}, nil
+}
@@ -33,3 +33,7 @@ func GetParserTypeForContext(contextType sharedpb.ContextType) (sharedpb.ParserT
 	}
 	return sharedpb.ParserType_PARSER_TYPE_UNSPECIFIED, false
 }
+
+// MaxTreeDepth bounds every recursive walk of the tag tree, so a corrupt parent cycle
+// ends the walk instead of looping forever
+const MaxTreeDepth = 64
@@ -0,0 +1,20 @@
+-- bundle_promotions records every bundle promoted from Roland into an import batch.
+-- It has no foreign key to bundles so the history outlives a deleted bundle.
+-- Apply to the Roland database: psql "$ROLAND_DATABASE_URL" -f migrations/roland/001_bundle_promotions.sql
+
+CREATE TABLE IF NOT EXISTS bundle_promotions (
+	id text NOT NULL,
+	bundle_id text NOT NULL,
+	short_id text NOT NULL,
+	title text NOT NULL,
+	export_type text NOT NULL,
+	promoted_by text NOT NULL,
+	batch_id text NULL,
+	succeeded bool NOT NULL,
+	error text NULL,
+	created_at timestamptz DEFAULT NOW() NOT NULL,
+	CONSTRAINT bundle_promotions_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS bundle_promotions_bundle_id_created_at_idx
+	ON bundle_promotions USING btree (bundle_id, created_at DESC);
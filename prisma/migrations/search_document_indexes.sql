@@ -0,0 +1,17 @@
+-- Full-text indexes for the Postgres search backend (internal/store/search/postgressearch.go).
+-- Prisma can't express expression indexes, so they live here as raw SQL. Each expression must
+-- match searchDocument exactly or the planner won't use the index.
+-- CONCURRENTLY can't run inside a transaction, apply the statements one at a time:
+--   psql "$DATABASE_URL" -f prisma/migrations/search_document_indexes.sql
+
+CREATE INDEX CONCURRENTLY IF NOT EXISTS tags_search_document_idx ON public."Tag"
+	USING gin (to_tsvector('simple', coalesce(name, '') || ' ' || coalesce(description, '')));
+
+CREATE INDEX CONCURRENTLY IF NOT EXISTS questions_search_document_idx ON public."Question"
+	USING gin (to_tsvector('simple', coalesce("questionText", '') || ' ' || coalesce("answerText", '')));
+
+CREATE INDEX CONCURRENTLY IF NOT EXISTS users_search_document_idx ON public."User"
+	USING gin (to_tsvector('simple', coalesce(name, '') || ' ' || coalesce(email, '') || ' ' || coalesce("gamerTag", '')));
+
+CREATE INDEX CONCURRENTLY IF NOT EXISTS faqs_search_document_idx ON public."Faq"
+	USING gin (to_tsvector('simple', coalesce(question, '') || ' ' || coalesce(answer, '')));